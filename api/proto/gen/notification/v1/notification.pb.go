@@ -96,6 +96,10 @@ const (
 	SendStatus_SUCCEEDED SendStatus = 4
 	// 发送失败
 	SendStatus_FAILED SendStatus = 5
+	// 已送达，由供应商回执确认
+	SendStatus_DELIVERED SendStatus = 6
+	// 未送达，供应商回执失败或超时未拿到回执
+	SendStatus_UNDELIVERED SendStatus = 7
 )
 
 // Enum value maps for SendStatus.
@@ -107,6 +111,8 @@ var (
 		3: "PENDING",
 		4: "SUCCEEDED",
 		5: "FAILED",
+		6: "DELIVERED",
+		7: "UNDELIVERED",
 	}
 	SendStatus_value = map[string]int32{
 		"SEND_STATUS_UNSPECIFIED": 0,
@@ -115,6 +121,8 @@ var (
 		"PENDING":                 3,
 		"SUCCEEDED":               4,
 		"FAILED":                  5,
+		"DELIVERED":               6,
+		"UNDELIVERED":             7,
 	}
 )
 
@@ -183,6 +191,8 @@ const (
 	ErrorCode_PROVIDER_NOT_FOUND ErrorCode = 15
 	// 未知渠道类型
 	ErrorCode_UNKNOWN_CHANNEL ErrorCode = 16
+	// 模版参数与模版版本的参数定义不匹配，错误信息中列出全部缺失、多余和不合法的参数
+	ErrorCode_INVALID_TEMPLATE_PARAMS ErrorCode = 17
 )
 
 // Enum value maps for ErrorCode.
@@ -205,6 +215,7 @@ var (
 		14: "QUOTA_NOT_FOUND",
 		15: "PROVIDER_NOT_FOUND",
 		16: "UNKNOWN_CHANNEL",
+		17: "INVALID_TEMPLATE_PARAMS",
 	}
 	ErrorCode_value = map[string]int32{
 		"ERROR_CODE_UNSPECIFIED":     0,
@@ -224,6 +235,7 @@ var (
 		"QUOTA_NOT_FOUND":            14,
 		"PROVIDER_NOT_FOUND":         15,
 		"UNKNOWN_CHANNEL":            16,
+		"INVALID_TEMPLATE_PARAMS":    17,
 	}
 )
 
@@ -412,7 +424,13 @@ type Notification struct {
 	// string field2 = 8;
 	// 重要，并且几乎大家都要传
 	// string importantField = 2;
-	Receiver      string `protobuf:"bytes,7,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Receiver string `protobuf:"bytes,7,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// 渠道降级时使用的接收者，key 为渠道名称（SMS、EMAIL、IN_APP）
+	// 不同渠道的接收者格式不同，没有提供接收者的渠道不参与降级
+	FallbackReceivers map[string]*ReceiverList `protobuf:"bytes,8,rep,name=fallback_receivers,json=fallbackReceivers,proto3" json:"fallback_receivers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// 接收者的语言，BCP 47 格式，如 zh-CN、en-US、ja-JP
+	// 按 语言 → en-US → 默认版本 的顺序选择已发布的模版版本，为空时使用默认版本
+	Locale        string `protobuf:"bytes,9,opt,name=locale,proto3" json:"locale,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -496,6 +514,64 @@ func (x *Notification) GetReceiver() string {
 	return ""
 }
 
+func (x *Notification) GetFallbackReceivers() map[string]*ReceiverList {
+	if x != nil {
+		return x.FallbackReceivers
+	}
+	return nil
+}
+
+func (x *Notification) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type ReceiverList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Receivers     []string               `protobuf:"bytes,1,rep,name=receivers,proto3" json:"receivers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiverList) Reset() {
+	*x = ReceiverList{}
+	mi := &file_notification_v1_notification_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiverList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiverList) ProtoMessage() {}
+
+func (x *ReceiverList) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiverList.ProtoReflect.Descriptor instead.
+func (*ReceiverList) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReceiverList) GetReceivers() []string {
+	if x != nil {
+		return x.Receivers
+	}
+	return nil
+}
+
 // 同步单条发送通知请求
 type SendNotificationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -506,7 +582,7 @@ type SendNotificationRequest struct {
 
 func (x *SendNotificationRequest) Reset() {
 	*x = SendNotificationRequest{}
-	mi := &file_notification_v1_notification_proto_msgTypes[2]
+	mi := &file_notification_v1_notification_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -518,7 +594,7 @@ func (x *SendNotificationRequest) String() string {
 func (*SendNotificationRequest) ProtoMessage() {}
 
 func (x *SendNotificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[2]
+	mi := &file_notification_v1_notification_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -531,7 +607,7 @@ func (x *SendNotificationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendNotificationRequest.ProtoReflect.Descriptor instead.
 func (*SendNotificationRequest) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{2}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *SendNotificationRequest) GetNotification() *Notification {
@@ -551,14 +627,16 @@ type SendNotificationResponse struct {
 	// 失败时的错误代码
 	ErrorCode ErrorCode `protobuf:"varint,3,opt,name=error_code,json=errorCode,proto3,enum=notification.v1.ErrorCode" json:"error_code,omitempty"`
 	// 错误详情
-	ErrorMessage  string `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	ErrorMessage string `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// 每个接收者的发送结果，部分接收者失败时 status 仍为 SUCCEEDED
+	ReceiverResults []*ReceiverResult `protobuf:"bytes,5,rep,name=receiver_results,json=receiverResults,proto3" json:"receiver_results,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *SendNotificationResponse) Reset() {
 	*x = SendNotificationResponse{}
-	mi := &file_notification_v1_notification_proto_msgTypes[3]
+	mi := &file_notification_v1_notification_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -570,7 +648,7 @@ func (x *SendNotificationResponse) String() string {
 func (*SendNotificationResponse) ProtoMessage() {}
 
 func (x *SendNotificationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[3]
+	mi := &file_notification_v1_notification_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -583,7 +661,7 @@ func (x *SendNotificationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendNotificationResponse.ProtoReflect.Descriptor instead.
 func (*SendNotificationResponse) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{3}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SendNotificationResponse) GetNotificationId() uint64 {
@@ -614,6 +692,95 @@ func (x *SendNotificationResponse) GetErrorMessage() string {
 	return ""
 }
 
+func (x *SendNotificationResponse) GetReceiverResults() []*ReceiverResult {
+	if x != nil {
+		return x.ReceiverResults
+	}
+	return nil
+}
+
+// 单个接收者的发送结果
+type ReceiverResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 接收者
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// 发送状态，只会是 SUCCEEDED 或者 FAILED
+	Status SendStatus `protobuf:"varint,2,opt,name=status,proto3,enum=notification.v1.SendStatus" json:"status,omitempty"`
+	// 最后一次发送使用的供应商
+	Provider string `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	// 失败时供应商返回的错误码
+	ErrorCode string `protobuf:"bytes,4,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// 失败时的错误详情
+	ErrorMessage  string `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiverResult) Reset() {
+	*x = ReceiverResult{}
+	mi := &file_notification_v1_notification_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiverResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiverResult) ProtoMessage() {}
+
+func (x *ReceiverResult) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiverResult.ProtoReflect.Descriptor instead.
+func (*ReceiverResult) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReceiverResult) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *ReceiverResult) GetStatus() SendStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SendStatus_SEND_STATUS_UNSPECIFIED
+}
+
+func (x *ReceiverResult) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ReceiverResult) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *ReceiverResult) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
 // 异步单条发送通知请求
 type SendNotificationAsyncRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -624,7 +791,7 @@ type SendNotificationAsyncRequest struct {
 
 func (x *SendNotificationAsyncRequest) Reset() {
 	*x = SendNotificationAsyncRequest{}
-	mi := &file_notification_v1_notification_proto_msgTypes[4]
+	mi := &file_notification_v1_notification_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -636,7 +803,7 @@ func (x *SendNotificationAsyncRequest) String() string {
 func (*SendNotificationAsyncRequest) ProtoMessage() {}
 
 func (x *SendNotificationAsyncRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[4]
+	mi := &file_notification_v1_notification_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -649,7 +816,7 @@ func (x *SendNotificationAsyncRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendNotificationAsyncRequest.ProtoReflect.Descriptor instead.
 func (*SendNotificationAsyncRequest) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{4}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SendNotificationAsyncRequest) GetNotification() *Notification {
@@ -674,7 +841,7 @@ type SendNotificationAsyncResponse struct {
 
 func (x *SendNotificationAsyncResponse) Reset() {
 	*x = SendNotificationAsyncResponse{}
-	mi := &file_notification_v1_notification_proto_msgTypes[5]
+	mi := &file_notification_v1_notification_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -686,7 +853,7 @@ func (x *SendNotificationAsyncResponse) String() string {
 func (*SendNotificationAsyncResponse) ProtoMessage() {}
 
 func (x *SendNotificationAsyncResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[5]
+	mi := &file_notification_v1_notification_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -699,7 +866,7 @@ func (x *SendNotificationAsyncResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendNotificationAsyncResponse.ProtoReflect.Descriptor instead.
 func (*SendNotificationAsyncResponse) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{5}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SendNotificationAsyncResponse) GetNotificationId() uint64 {
@@ -733,7 +900,7 @@ type BatchSendNotificationsRequest struct {
 
 func (x *BatchSendNotificationsRequest) Reset() {
 	*x = BatchSendNotificationsRequest{}
-	mi := &file_notification_v1_notification_proto_msgTypes[6]
+	mi := &file_notification_v1_notification_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -745,7 +912,7 @@ func (x *BatchSendNotificationsRequest) String() string {
 func (*BatchSendNotificationsRequest) ProtoMessage() {}
 
 func (x *BatchSendNotificationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[6]
+	mi := &file_notification_v1_notification_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -758,7 +925,7 @@ func (x *BatchSendNotificationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchSendNotificationsRequest.ProtoReflect.Descriptor instead.
 func (*BatchSendNotificationsRequest) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{6}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *BatchSendNotificationsRequest) GetNotifications() []*Notification {
@@ -783,7 +950,7 @@ type BatchSendNotificationsResponse struct {
 
 func (x *BatchSendNotificationsResponse) Reset() {
 	*x = BatchSendNotificationsResponse{}
-	mi := &file_notification_v1_notification_proto_msgTypes[7]
+	mi := &file_notification_v1_notification_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -795,7 +962,7 @@ func (x *BatchSendNotificationsResponse) String() string {
 func (*BatchSendNotificationsResponse) ProtoMessage() {}
 
 func (x *BatchSendNotificationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[7]
+	mi := &file_notification_v1_notification_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -808,7 +975,7 @@ func (x *BatchSendNotificationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchSendNotificationsResponse.ProtoReflect.Descriptor instead.
 func (*BatchSendNotificationsResponse) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{7}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *BatchSendNotificationsResponse) GetResults() []*SendNotificationResponse {
@@ -842,7 +1009,7 @@ type BatchSendNotificationsAsyncRequest struct {
 
 func (x *BatchSendNotificationsAsyncRequest) Reset() {
 	*x = BatchSendNotificationsAsyncRequest{}
-	mi := &file_notification_v1_notification_proto_msgTypes[8]
+	mi := &file_notification_v1_notification_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -854,7 +1021,7 @@ func (x *BatchSendNotificationsAsyncRequest) String() string {
 func (*BatchSendNotificationsAsyncRequest) ProtoMessage() {}
 
 func (x *BatchSendNotificationsAsyncRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[8]
+	mi := &file_notification_v1_notification_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -867,7 +1034,7 @@ func (x *BatchSendNotificationsAsyncRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use BatchSendNotificationsAsyncRequest.ProtoReflect.Descriptor instead.
 func (*BatchSendNotificationsAsyncRequest) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{8}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *BatchSendNotificationsAsyncRequest) GetNotifications() []*Notification {
@@ -888,7 +1055,7 @@ type BatchSendNotificationsAsyncResponse struct {
 
 func (x *BatchSendNotificationsAsyncResponse) Reset() {
 	*x = BatchSendNotificationsAsyncResponse{}
-	mi := &file_notification_v1_notification_proto_msgTypes[9]
+	mi := &file_notification_v1_notification_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -900,7 +1067,7 @@ func (x *BatchSendNotificationsAsyncResponse) String() string {
 func (*BatchSendNotificationsAsyncResponse) ProtoMessage() {}
 
 func (x *BatchSendNotificationsAsyncResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[9]
+	mi := &file_notification_v1_notification_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -913,7 +1080,7 @@ func (x *BatchSendNotificationsAsyncResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use BatchSendNotificationsAsyncResponse.ProtoReflect.Descriptor instead.
 func (*BatchSendNotificationsAsyncResponse) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{9}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *BatchSendNotificationsAsyncResponse) GetNotificationIds() []uint64 {
@@ -933,7 +1100,7 @@ type TxPrepareRequest struct {
 
 func (x *TxPrepareRequest) Reset() {
 	*x = TxPrepareRequest{}
-	mi := &file_notification_v1_notification_proto_msgTypes[10]
+	mi := &file_notification_v1_notification_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -945,7 +1112,7 @@ func (x *TxPrepareRequest) String() string {
 func (*TxPrepareRequest) ProtoMessage() {}
 
 func (x *TxPrepareRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[10]
+	mi := &file_notification_v1_notification_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -958,7 +1125,7 @@ func (x *TxPrepareRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TxPrepareRequest.ProtoReflect.Descriptor instead.
 func (*TxPrepareRequest) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{10}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *TxPrepareRequest) GetNotification() *Notification {
@@ -977,7 +1144,7 @@ type TxPrepareResponse struct {
 
 func (x *TxPrepareResponse) Reset() {
 	*x = TxPrepareResponse{}
-	mi := &file_notification_v1_notification_proto_msgTypes[11]
+	mi := &file_notification_v1_notification_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -989,7 +1156,7 @@ func (x *TxPrepareResponse) String() string {
 func (*TxPrepareResponse) ProtoMessage() {}
 
 func (x *TxPrepareResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[11]
+	mi := &file_notification_v1_notification_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1002,7 +1169,7 @@ func (x *TxPrepareResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TxPrepareResponse.ProtoReflect.Descriptor instead.
 func (*TxPrepareResponse) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{11}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{13}
 }
 
 // 提交事务请求
@@ -1015,7 +1182,7 @@ type TxCommitRequest struct {
 
 func (x *TxCommitRequest) Reset() {
 	*x = TxCommitRequest{}
-	mi := &file_notification_v1_notification_proto_msgTypes[12]
+	mi := &file_notification_v1_notification_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1027,7 +1194,7 @@ func (x *TxCommitRequest) String() string {
 func (*TxCommitRequest) ProtoMessage() {}
 
 func (x *TxCommitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[12]
+	mi := &file_notification_v1_notification_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1040,7 +1207,7 @@ func (x *TxCommitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TxCommitRequest.ProtoReflect.Descriptor instead.
 func (*TxCommitRequest) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{12}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *TxCommitRequest) GetKey() string {
@@ -1059,7 +1226,7 @@ type TxCommitResponse struct {
 
 func (x *TxCommitResponse) Reset() {
 	*x = TxCommitResponse{}
-	mi := &file_notification_v1_notification_proto_msgTypes[13]
+	mi := &file_notification_v1_notification_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1071,7 +1238,7 @@ func (x *TxCommitResponse) String() string {
 func (*TxCommitResponse) ProtoMessage() {}
 
 func (x *TxCommitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[13]
+	mi := &file_notification_v1_notification_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1084,7 +1251,7 @@ func (x *TxCommitResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TxCommitResponse.ProtoReflect.Descriptor instead.
 func (*TxCommitResponse) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{13}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{15}
 }
 
 // 回滚事务请求
@@ -1097,7 +1264,7 @@ type TxCancelRequest struct {
 
 func (x *TxCancelRequest) Reset() {
 	*x = TxCancelRequest{}
-	mi := &file_notification_v1_notification_proto_msgTypes[14]
+	mi := &file_notification_v1_notification_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1109,7 +1276,7 @@ func (x *TxCancelRequest) String() string {
 func (*TxCancelRequest) ProtoMessage() {}
 
 func (x *TxCancelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[14]
+	mi := &file_notification_v1_notification_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1122,7 +1289,7 @@ func (x *TxCancelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TxCancelRequest.ProtoReflect.Descriptor instead.
 func (*TxCancelRequest) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{14}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *TxCancelRequest) GetKey() string {
@@ -1141,7 +1308,7 @@ type TxCancelResponse struct {
 
 func (x *TxCancelResponse) Reset() {
 	*x = TxCancelResponse{}
-	mi := &file_notification_v1_notification_proto_msgTypes[15]
+	mi := &file_notification_v1_notification_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1153,7 +1320,7 @@ func (x *TxCancelResponse) String() string {
 func (*TxCancelResponse) ProtoMessage() {}
 
 func (x *TxCancelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[15]
+	mi := &file_notification_v1_notification_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1166,7 +1333,7 @@ func (x *TxCancelResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TxCancelResponse.ProtoReflect.Descriptor instead.
 func (*TxCancelResponse) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{15}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{17}
 }
 
 // 空结构表示立即发送
@@ -1178,7 +1345,7 @@ type SendStrategy_ImmediateStrategy struct {
 
 func (x *SendStrategy_ImmediateStrategy) Reset() {
 	*x = SendStrategy_ImmediateStrategy{}
-	mi := &file_notification_v1_notification_proto_msgTypes[16]
+	mi := &file_notification_v1_notification_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1190,7 +1357,7 @@ func (x *SendStrategy_ImmediateStrategy) String() string {
 func (*SendStrategy_ImmediateStrategy) ProtoMessage() {}
 
 func (x *SendStrategy_ImmediateStrategy) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[16]
+	mi := &file_notification_v1_notification_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1216,7 +1383,7 @@ type SendStrategy_DelayedStrategy struct {
 
 func (x *SendStrategy_DelayedStrategy) Reset() {
 	*x = SendStrategy_DelayedStrategy{}
-	mi := &file_notification_v1_notification_proto_msgTypes[17]
+	mi := &file_notification_v1_notification_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1228,7 +1395,7 @@ func (x *SendStrategy_DelayedStrategy) String() string {
 func (*SendStrategy_DelayedStrategy) ProtoMessage() {}
 
 func (x *SendStrategy_DelayedStrategy) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[17]
+	mi := &file_notification_v1_notification_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1261,7 +1428,7 @@ type SendStrategy_ScheduledStrategy struct {
 
 func (x *SendStrategy_ScheduledStrategy) Reset() {
 	*x = SendStrategy_ScheduledStrategy{}
-	mi := &file_notification_v1_notification_proto_msgTypes[18]
+	mi := &file_notification_v1_notification_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1273,7 +1440,7 @@ func (x *SendStrategy_ScheduledStrategy) String() string {
 func (*SendStrategy_ScheduledStrategy) ProtoMessage() {}
 
 func (x *SendStrategy_ScheduledStrategy) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[18]
+	mi := &file_notification_v1_notification_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1308,7 +1475,7 @@ type SendStrategy_TimeWindowStrategy struct {
 
 func (x *SendStrategy_TimeWindowStrategy) Reset() {
 	*x = SendStrategy_TimeWindowStrategy{}
-	mi := &file_notification_v1_notification_proto_msgTypes[19]
+	mi := &file_notification_v1_notification_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1320,7 +1487,7 @@ func (x *SendStrategy_TimeWindowStrategy) String() string {
 func (*SendStrategy_TimeWindowStrategy) ProtoMessage() {}
 
 func (x *SendStrategy_TimeWindowStrategy) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[19]
+	mi := &file_notification_v1_notification_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1360,7 +1527,7 @@ type SendStrategy_DeadlineStrategy struct {
 
 func (x *SendStrategy_DeadlineStrategy) Reset() {
 	*x = SendStrategy_DeadlineStrategy{}
-	mi := &file_notification_v1_notification_proto_msgTypes[20]
+	mi := &file_notification_v1_notification_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1372,7 +1539,7 @@ func (x *SendStrategy_DeadlineStrategy) String() string {
 func (*SendStrategy_DeadlineStrategy) ProtoMessage() {}
 
 func (x *SendStrategy_DeadlineStrategy) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[20]
+	mi := &file_notification_v1_notification_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1417,7 +1584,7 @@ const file_notification_v1_notification_proto_rawDesc = "" +
 	"\x15end_time_milliseconds\x18\x02 \x01(\x03R\x13endTimeMilliseconds\x1aJ\n" +
 	"\x10DeadlineStrategy\x126\n" +
 	"\bdeadline\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\bdeadlineB\x0f\n" +
-	"\rstrategy_type\"\x89\x03\n" +
+	"\rstrategy_type\"\xeb\x04\n" +
 	"\fNotification\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1c\n" +
 	"\treceivers\x18\x02 \x03(\tR\treceivers\x122\n" +
@@ -1426,18 +1593,33 @@ const file_notification_v1_notification_proto_rawDesc = "" +
 	"templateId\x12Z\n" +
 	"\x0ftemplate_params\x18\x05 \x03(\v21.notification.v1.Notification.TemplateParamsEntryR\x0etemplateParams\x129\n" +
 	"\bstrategy\x18\x06 \x01(\v2\x1d.notification.v1.SendStrategyR\bstrategy\x12\x1a\n" +
-	"\breceiver\x18\a \x01(\tR\breceiver\x1aA\n" +
+	"\breceiver\x18\a \x01(\tR\breceiver\x12c\n" +
+	"\x12fallback_receivers\x18\b \x03(\v24.notification.v1.Notification.FallbackReceiversEntryR\x11fallbackReceivers\x12\x16\n" +
+	"\x06locale\x18\t \x01(\tR\x06locale\x1aA\n" +
 	"\x13TemplateParamsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\\\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1ac\n" +
+	"\x16FallbackReceiversEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x123\n" +
+	"\x05value\x18\x02 \x01(\v2\x1d.notification.v1.ReceiverListR\x05value:\x028\x01\",\n" +
+	"\fReceiverList\x12\x1c\n" +
+	"\treceivers\x18\x01 \x03(\tR\treceivers\"\\\n" +
 	"\x17SendNotificationRequest\x12A\n" +
-	"\fnotification\x18\x01 \x01(\v2\x1d.notification.v1.NotificationR\fnotification\"\xd8\x01\n" +
+	"\fnotification\x18\x01 \x01(\v2\x1d.notification.v1.NotificationR\fnotification\"\xa4\x02\n" +
 	"\x18SendNotificationResponse\x12'\n" +
 	"\x0fnotification_id\x18\x01 \x01(\x04R\x0enotificationId\x123\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x1b.notification.v1.SendStatusR\x06status\x129\n" +
 	"\n" +
 	"error_code\x18\x03 \x01(\x0e2\x1a.notification.v1.ErrorCodeR\terrorCode\x12#\n" +
-	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\"a\n" +
+	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\x12J\n" +
+	"\x10receiver_results\x18\x05 \x03(\v2\x1f.notification.v1.ReceiverResultR\x0freceiverResults\"\xc1\x01\n" +
+	"\x0eReceiverResult\x12\x1a\n" +
+	"\breceiver\x18\x01 \x01(\tR\breceiver\x123\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1b.notification.v1.SendStatusR\x06status\x12\x1a\n" +
+	"\bprovider\x18\x03 \x01(\tR\bprovider\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x04 \x01(\tR\terrorCode\x12#\n" +
+	"\rerror_message\x18\x05 \x01(\tR\ferrorMessage\"a\n" +
 	"\x1cSendNotificationAsyncRequest\x12A\n" +
 	"\fnotification\x18\x01 \x01(\v2\x1d.notification.v1.NotificationR\fnotification\"\xa8\x01\n" +
 	"\x1dSendNotificationAsyncResponse\x12'\n" +
@@ -1470,7 +1652,7 @@ const file_notification_v1_notification_proto_rawDesc = "" +
 	"\x03SMS\x10\x01\x12\t\n" +
 	"\x05EMAIL\x10\x02\x12\n" +
 	"\n" +
-	"\x06IN_APP\x10\x03*l\n" +
+	"\x06IN_APP\x10\x03*\x8c\x01\n" +
 	"\n" +
 	"SendStatus\x12\x1b\n" +
 	"\x17SEND_STATUS_UNSPECIFIED\x10\x00\x12\v\n" +
@@ -1479,7 +1661,9 @@ const file_notification_v1_notification_proto_rawDesc = "" +
 	"\aPENDING\x10\x03\x12\r\n" +
 	"\tSUCCEEDED\x10\x04\x12\n" +
 	"\n" +
-	"\x06FAILED\x10\x05*\x9e\x03\n" +
+	"\x06FAILED\x10\x05\x12\r\n" +
+	"\tDELIVERED\x10\x06\x12\x0f\n" +
+	"\vUNDELIVERED\x10\a*\xbb\x03\n" +
 	"\tErrorCode\x12\x1a\n" +
 	"\x16ERROR_CODE_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11INVALID_PARAMETER\x10\x01\x12\x10\n" +
@@ -1498,7 +1682,8 @@ const file_notification_v1_notification_proto_rawDesc = "" +
 	"\bNO_QUOTA\x10\r\x12\x13\n" +
 	"\x0fQUOTA_NOT_FOUND\x10\x0e\x12\x16\n" +
 	"\x12PROVIDER_NOT_FOUND\x10\x0f\x12\x13\n" +
-	"\x0fUNKNOWN_CHANNEL\x10\x102\xf2\x05\n" +
+	"\x0fUNKNOWN_CHANNEL\x10\x10\x12\x1b\n" +
+	"\x17INVALID_TEMPLATE_PARAMS\x10\x112\xf2\x05\n" +
 	"\x13NotificationService\x12g\n" +
 	"\x10SendNotification\x12(.notification.v1.SendNotificationRequest\x1a).notification.v1.SendNotificationResponse\x12v\n" +
 	"\x15SendNotificationAsync\x12-.notification.v1.SendNotificationAsyncRequest\x1a..notification.v1.SendNotificationAsyncResponse\x12y\n" +
@@ -1523,76 +1708,83 @@ func file_notification_v1_notification_proto_rawDescGZIP() []byte {
 
 var (
 	file_notification_v1_notification_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-	file_notification_v1_notification_proto_msgTypes  = make([]protoimpl.MessageInfo, 22)
+	file_notification_v1_notification_proto_msgTypes  = make([]protoimpl.MessageInfo, 25)
 	file_notification_v1_notification_proto_goTypes   = []any{
 		(Channel)(0),                                // 0: notification.v1.Channel
 		(SendStatus)(0),                             // 1: notification.v1.SendStatus
 		(ErrorCode)(0),                              // 2: notification.v1.ErrorCode
 		(*SendStrategy)(nil),                        // 3: notification.v1.SendStrategy
 		(*Notification)(nil),                        // 4: notification.v1.Notification
-		(*SendNotificationRequest)(nil),             // 5: notification.v1.SendNotificationRequest
-		(*SendNotificationResponse)(nil),            // 6: notification.v1.SendNotificationResponse
-		(*SendNotificationAsyncRequest)(nil),        // 7: notification.v1.SendNotificationAsyncRequest
-		(*SendNotificationAsyncResponse)(nil),       // 8: notification.v1.SendNotificationAsyncResponse
-		(*BatchSendNotificationsRequest)(nil),       // 9: notification.v1.BatchSendNotificationsRequest
-		(*BatchSendNotificationsResponse)(nil),      // 10: notification.v1.BatchSendNotificationsResponse
-		(*BatchSendNotificationsAsyncRequest)(nil),  // 11: notification.v1.BatchSendNotificationsAsyncRequest
-		(*BatchSendNotificationsAsyncResponse)(nil), // 12: notification.v1.BatchSendNotificationsAsyncResponse
-		(*TxPrepareRequest)(nil),                    // 13: notification.v1.TxPrepareRequest
-		(*TxPrepareResponse)(nil),                   // 14: notification.v1.TxPrepareResponse
-		(*TxCommitRequest)(nil),                     // 15: notification.v1.TxCommitRequest
-		(*TxCommitResponse)(nil),                    // 16: notification.v1.TxCommitResponse
-		(*TxCancelRequest)(nil),                     // 17: notification.v1.TxCancelRequest
-		(*TxCancelResponse)(nil),                    // 18: notification.v1.TxCancelResponse
-		(*SendStrategy_ImmediateStrategy)(nil),      // 19: notification.v1.SendStrategy.ImmediateStrategy
-		(*SendStrategy_DelayedStrategy)(nil),        // 20: notification.v1.SendStrategy.DelayedStrategy
-		(*SendStrategy_ScheduledStrategy)(nil),      // 21: notification.v1.SendStrategy.ScheduledStrategy
-		(*SendStrategy_TimeWindowStrategy)(nil),     // 22: notification.v1.SendStrategy.TimeWindowStrategy
-		(*SendStrategy_DeadlineStrategy)(nil),       // 23: notification.v1.SendStrategy.DeadlineStrategy
-		nil,                                         // 24: notification.v1.Notification.TemplateParamsEntry
-		(*timestamppb.Timestamp)(nil),               // 25: google.protobuf.Timestamp
+		(*ReceiverList)(nil),                        // 5: notification.v1.ReceiverList
+		(*SendNotificationRequest)(nil),             // 6: notification.v1.SendNotificationRequest
+		(*SendNotificationResponse)(nil),            // 7: notification.v1.SendNotificationResponse
+		(*ReceiverResult)(nil),                      // 8: notification.v1.ReceiverResult
+		(*SendNotificationAsyncRequest)(nil),        // 9: notification.v1.SendNotificationAsyncRequest
+		(*SendNotificationAsyncResponse)(nil),       // 10: notification.v1.SendNotificationAsyncResponse
+		(*BatchSendNotificationsRequest)(nil),       // 11: notification.v1.BatchSendNotificationsRequest
+		(*BatchSendNotificationsResponse)(nil),      // 12: notification.v1.BatchSendNotificationsResponse
+		(*BatchSendNotificationsAsyncRequest)(nil),  // 13: notification.v1.BatchSendNotificationsAsyncRequest
+		(*BatchSendNotificationsAsyncResponse)(nil), // 14: notification.v1.BatchSendNotificationsAsyncResponse
+		(*TxPrepareRequest)(nil),                    // 15: notification.v1.TxPrepareRequest
+		(*TxPrepareResponse)(nil),                   // 16: notification.v1.TxPrepareResponse
+		(*TxCommitRequest)(nil),                     // 17: notification.v1.TxCommitRequest
+		(*TxCommitResponse)(nil),                    // 18: notification.v1.TxCommitResponse
+		(*TxCancelRequest)(nil),                     // 19: notification.v1.TxCancelRequest
+		(*TxCancelResponse)(nil),                    // 20: notification.v1.TxCancelResponse
+		(*SendStrategy_ImmediateStrategy)(nil),      // 21: notification.v1.SendStrategy.ImmediateStrategy
+		(*SendStrategy_DelayedStrategy)(nil),        // 22: notification.v1.SendStrategy.DelayedStrategy
+		(*SendStrategy_ScheduledStrategy)(nil),      // 23: notification.v1.SendStrategy.ScheduledStrategy
+		(*SendStrategy_TimeWindowStrategy)(nil),     // 24: notification.v1.SendStrategy.TimeWindowStrategy
+		(*SendStrategy_DeadlineStrategy)(nil),       // 25: notification.v1.SendStrategy.DeadlineStrategy
+		nil,                                         // 26: notification.v1.Notification.TemplateParamsEntry
+		nil,                                         // 27: notification.v1.Notification.FallbackReceiversEntry
+		(*timestamppb.Timestamp)(nil),               // 28: google.protobuf.Timestamp
 	}
 )
 
 var file_notification_v1_notification_proto_depIdxs = []int32{
-	19, // 0: notification.v1.SendStrategy.immediate:type_name -> notification.v1.SendStrategy.ImmediateStrategy
-	20, // 1: notification.v1.SendStrategy.delayed:type_name -> notification.v1.SendStrategy.DelayedStrategy
-	21, // 2: notification.v1.SendStrategy.scheduled:type_name -> notification.v1.SendStrategy.ScheduledStrategy
-	22, // 3: notification.v1.SendStrategy.time_window:type_name -> notification.v1.SendStrategy.TimeWindowStrategy
-	23, // 4: notification.v1.SendStrategy.deadline:type_name -> notification.v1.SendStrategy.DeadlineStrategy
+	21, // 0: notification.v1.SendStrategy.immediate:type_name -> notification.v1.SendStrategy.ImmediateStrategy
+	22, // 1: notification.v1.SendStrategy.delayed:type_name -> notification.v1.SendStrategy.DelayedStrategy
+	23, // 2: notification.v1.SendStrategy.scheduled:type_name -> notification.v1.SendStrategy.ScheduledStrategy
+	24, // 3: notification.v1.SendStrategy.time_window:type_name -> notification.v1.SendStrategy.TimeWindowStrategy
+	25, // 4: notification.v1.SendStrategy.deadline:type_name -> notification.v1.SendStrategy.DeadlineStrategy
 	0,  // 5: notification.v1.Notification.channel:type_name -> notification.v1.Channel
-	24, // 6: notification.v1.Notification.template_params:type_name -> notification.v1.Notification.TemplateParamsEntry
+	26, // 6: notification.v1.Notification.template_params:type_name -> notification.v1.Notification.TemplateParamsEntry
 	3,  // 7: notification.v1.Notification.strategy:type_name -> notification.v1.SendStrategy
-	4,  // 8: notification.v1.SendNotificationRequest.notification:type_name -> notification.v1.Notification
-	1,  // 9: notification.v1.SendNotificationResponse.status:type_name -> notification.v1.SendStatus
-	2,  // 10: notification.v1.SendNotificationResponse.error_code:type_name -> notification.v1.ErrorCode
-	4,  // 11: notification.v1.SendNotificationAsyncRequest.notification:type_name -> notification.v1.Notification
-	2,  // 12: notification.v1.SendNotificationAsyncResponse.error_code:type_name -> notification.v1.ErrorCode
-	4,  // 13: notification.v1.BatchSendNotificationsRequest.notifications:type_name -> notification.v1.Notification
-	6,  // 14: notification.v1.BatchSendNotificationsResponse.results:type_name -> notification.v1.SendNotificationResponse
-	4,  // 15: notification.v1.BatchSendNotificationsAsyncRequest.notifications:type_name -> notification.v1.Notification
-	4,  // 16: notification.v1.TxPrepareRequest.notification:type_name -> notification.v1.Notification
-	25, // 17: notification.v1.SendStrategy.ScheduledStrategy.send_time:type_name -> google.protobuf.Timestamp
-	25, // 18: notification.v1.SendStrategy.DeadlineStrategy.deadline:type_name -> google.protobuf.Timestamp
-	5,  // 19: notification.v1.NotificationService.SendNotification:input_type -> notification.v1.SendNotificationRequest
-	7,  // 20: notification.v1.NotificationService.SendNotificationAsync:input_type -> notification.v1.SendNotificationAsyncRequest
-	9,  // 21: notification.v1.NotificationService.BatchSendNotifications:input_type -> notification.v1.BatchSendNotificationsRequest
-	11, // 22: notification.v1.NotificationService.BatchSendNotificationsAsync:input_type -> notification.v1.BatchSendNotificationsAsyncRequest
-	13, // 23: notification.v1.NotificationService.TxPrepare:input_type -> notification.v1.TxPrepareRequest
-	15, // 24: notification.v1.NotificationService.TxCommit:input_type -> notification.v1.TxCommitRequest
-	17, // 25: notification.v1.NotificationService.TxCancel:input_type -> notification.v1.TxCancelRequest
-	6,  // 26: notification.v1.NotificationService.SendNotification:output_type -> notification.v1.SendNotificationResponse
-	8,  // 27: notification.v1.NotificationService.SendNotificationAsync:output_type -> notification.v1.SendNotificationAsyncResponse
-	10, // 28: notification.v1.NotificationService.BatchSendNotifications:output_type -> notification.v1.BatchSendNotificationsResponse
-	12, // 29: notification.v1.NotificationService.BatchSendNotificationsAsync:output_type -> notification.v1.BatchSendNotificationsAsyncResponse
-	14, // 30: notification.v1.NotificationService.TxPrepare:output_type -> notification.v1.TxPrepareResponse
-	16, // 31: notification.v1.NotificationService.TxCommit:output_type -> notification.v1.TxCommitResponse
-	18, // 32: notification.v1.NotificationService.TxCancel:output_type -> notification.v1.TxCancelResponse
-	26, // [26:33] is the sub-list for method output_type
-	19, // [19:26] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	27, // 8: notification.v1.Notification.fallback_receivers:type_name -> notification.v1.Notification.FallbackReceiversEntry
+	4,  // 9: notification.v1.SendNotificationRequest.notification:type_name -> notification.v1.Notification
+	1,  // 10: notification.v1.SendNotificationResponse.status:type_name -> notification.v1.SendStatus
+	2,  // 11: notification.v1.SendNotificationResponse.error_code:type_name -> notification.v1.ErrorCode
+	8,  // 12: notification.v1.SendNotificationResponse.receiver_results:type_name -> notification.v1.ReceiverResult
+	1,  // 13: notification.v1.ReceiverResult.status:type_name -> notification.v1.SendStatus
+	4,  // 14: notification.v1.SendNotificationAsyncRequest.notification:type_name -> notification.v1.Notification
+	2,  // 15: notification.v1.SendNotificationAsyncResponse.error_code:type_name -> notification.v1.ErrorCode
+	4,  // 16: notification.v1.BatchSendNotificationsRequest.notifications:type_name -> notification.v1.Notification
+	7,  // 17: notification.v1.BatchSendNotificationsResponse.results:type_name -> notification.v1.SendNotificationResponse
+	4,  // 18: notification.v1.BatchSendNotificationsAsyncRequest.notifications:type_name -> notification.v1.Notification
+	4,  // 19: notification.v1.TxPrepareRequest.notification:type_name -> notification.v1.Notification
+	28, // 20: notification.v1.SendStrategy.ScheduledStrategy.send_time:type_name -> google.protobuf.Timestamp
+	28, // 21: notification.v1.SendStrategy.DeadlineStrategy.deadline:type_name -> google.protobuf.Timestamp
+	5,  // 22: notification.v1.Notification.FallbackReceiversEntry.value:type_name -> notification.v1.ReceiverList
+	6,  // 23: notification.v1.NotificationService.SendNotification:input_type -> notification.v1.SendNotificationRequest
+	9,  // 24: notification.v1.NotificationService.SendNotificationAsync:input_type -> notification.v1.SendNotificationAsyncRequest
+	11, // 25: notification.v1.NotificationService.BatchSendNotifications:input_type -> notification.v1.BatchSendNotificationsRequest
+	13, // 26: notification.v1.NotificationService.BatchSendNotificationsAsync:input_type -> notification.v1.BatchSendNotificationsAsyncRequest
+	15, // 27: notification.v1.NotificationService.TxPrepare:input_type -> notification.v1.TxPrepareRequest
+	17, // 28: notification.v1.NotificationService.TxCommit:input_type -> notification.v1.TxCommitRequest
+	19, // 29: notification.v1.NotificationService.TxCancel:input_type -> notification.v1.TxCancelRequest
+	7,  // 30: notification.v1.NotificationService.SendNotification:output_type -> notification.v1.SendNotificationResponse
+	10, // 31: notification.v1.NotificationService.SendNotificationAsync:output_type -> notification.v1.SendNotificationAsyncResponse
+	12, // 32: notification.v1.NotificationService.BatchSendNotifications:output_type -> notification.v1.BatchSendNotificationsResponse
+	14, // 33: notification.v1.NotificationService.BatchSendNotificationsAsync:output_type -> notification.v1.BatchSendNotificationsAsyncResponse
+	16, // 34: notification.v1.NotificationService.TxPrepare:output_type -> notification.v1.TxPrepareResponse
+	18, // 35: notification.v1.NotificationService.TxCommit:output_type -> notification.v1.TxCommitResponse
+	20, // 36: notification.v1.NotificationService.TxCancel:output_type -> notification.v1.TxCancelResponse
+	30, // [30:37] is the sub-list for method output_type
+	23, // [23:30] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_notification_v1_notification_proto_init() }
@@ -1613,7 +1805,7 @@ func file_notification_v1_notification_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_notification_v1_notification_proto_rawDesc), len(file_notification_v1_notification_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   22,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -275,7 +275,8 @@ func (m *SendStrategy) validate(all bool) error {
 }
 
 // SendStrategyMultiError is an error wrapping multiple validation errors
-// returned by SendStrategy.ValidateAll() if the designated constraints aren't met.
+// returned by SendStrategy.ValidateAll() if the designated constraints aren't
+// met.
 type SendStrategyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
@@ -405,6 +406,54 @@ func (m *Notification) validate(all bool) error {
 
 	// no validation rules for Receiver
 
+	{
+		sorted_keys := make([]string, len(m.GetFallbackReceivers()))
+		i := 0
+		for key := range m.GetFallbackReceivers() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetFallbackReceivers()[key]
+			_ = val
+
+			// no validation rules for FallbackReceivers[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, NotificationValidationError{
+							field:  fmt.Sprintf("FallbackReceivers[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, NotificationValidationError{
+							field:  fmt.Sprintf("FallbackReceivers[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return NotificationValidationError{
+						field:  fmt.Sprintf("FallbackReceivers[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	// no validation rules for Locale
+
 	if len(errors) > 0 {
 		return NotificationMultiError(errors)
 	}
@@ -413,7 +462,8 @@ func (m *Notification) validate(all bool) error {
 }
 
 // NotificationMultiError is an error wrapping multiple validation errors
-// returned by Notification.ValidateAll() if the designated constraints aren't met.
+// returned by Notification.ValidateAll() if the designated constraints aren't
+// met.
 type NotificationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
@@ -482,9 +532,109 @@ var _ interface {
 	ErrorName() string
 } = NotificationValidationError{}
 
+// Validate checks the field values on ReceiverList with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReceiverList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReceiverList with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReceiverListMultiError, or
+// nil if none found.
+func (m *ReceiverList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReceiverList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ReceiverListMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReceiverListMultiError is an error wrapping multiple validation errors
+// returned by ReceiverList.ValidateAll() if the designated constraints aren't
+// met.
+type ReceiverListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReceiverListMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReceiverListMultiError) AllErrors() []error { return m }
+
+// ReceiverListValidationError is the validation error returned by
+// ReceiverList.Validate if the designated constraints aren't met.
+type ReceiverListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReceiverListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReceiverListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReceiverListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReceiverListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReceiverListValidationError) ErrorName() string { return "ReceiverListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReceiverListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReceiverList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReceiverListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReceiverListValidationError{}
+
 // Validate checks the field values on SendNotificationRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
 func (m *SendNotificationRequest) Validate() error {
 	return m.validate(false)
 }
@@ -614,8 +764,8 @@ var _ interface {
 } = SendNotificationRequestValidationError{}
 
 // Validate checks the field values on SendNotificationResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
 func (m *SendNotificationResponse) Validate() error {
 	return m.validate(false)
 }
@@ -643,6 +793,40 @@ func (m *SendNotificationResponse) validate(all bool) error {
 
 	// no validation rules for ErrorMessage
 
+	for idx, item := range m.GetReceiverResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SendNotificationResponseValidationError{
+						field:  fmt.Sprintf("ReceiverResults[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SendNotificationResponseValidationError{
+						field:  fmt.Sprintf("ReceiverResults[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SendNotificationResponseValidationError{
+					field:  fmt.Sprintf("ReceiverResults[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return SendNotificationResponseMultiError(errors)
 	}
@@ -723,9 +907,120 @@ var _ interface {
 	ErrorName() string
 } = SendNotificationResponseValidationError{}
 
+// Validate checks the field values on ReceiverResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReceiverResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReceiverResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReceiverResultMultiError, or
+// nil if none found.
+func (m *ReceiverResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReceiverResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Receiver
+
+	// no validation rules for Status
+
+	// no validation rules for Provider
+
+	// no validation rules for ErrorCode
+
+	// no validation rules for ErrorMessage
+
+	if len(errors) > 0 {
+		return ReceiverResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReceiverResultMultiError is an error wrapping multiple validation errors
+// returned by ReceiverResult.ValidateAll() if the designated constraints
+// aren't met.
+type ReceiverResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReceiverResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReceiverResultMultiError) AllErrors() []error { return m }
+
+// ReceiverResultValidationError is the validation error returned by
+// ReceiverResult.Validate if the designated constraints aren't met.
+type ReceiverResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReceiverResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReceiverResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReceiverResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReceiverResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReceiverResultValidationError) ErrorName() string { return "ReceiverResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReceiverResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReceiverResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReceiverResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReceiverResultValidationError{}
+
 // Validate checks the field values on SendNotificationAsyncRequest with the
 // rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
 func (m *SendNotificationAsyncRequest) Validate() error {
 	return m.validate(false)
 }
@@ -857,14 +1152,15 @@ var _ interface {
 
 // Validate checks the field values on SendNotificationAsyncResponse with the
 // rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
 func (m *SendNotificationAsyncResponse) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on SendNotificationAsyncResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
 // SendNotificationAsyncResponseMultiError, or nil if none found.
 func (m *SendNotificationAsyncResponse) ValidateAll() error {
 	return m.validate(true)
@@ -891,8 +1187,8 @@ func (m *SendNotificationAsyncResponse) validate(all bool) error {
 }
 
 // SendNotificationAsyncResponseMultiError is an error wrapping multiple
-// validation errors returned by SendNotificationAsyncResponse.ValidateAll()
-// if the designated constraints aren't met.
+// validation errors returned by SendNotificationAsyncResponse.ValidateAll() if
+// the designated constraints aren't met.
 type SendNotificationAsyncResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
@@ -966,14 +1262,15 @@ var _ interface {
 
 // Validate checks the field values on BatchSendNotificationsRequest with the
 // rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
 func (m *BatchSendNotificationsRequest) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on BatchSendNotificationsRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
 // BatchSendNotificationsRequestMultiError, or nil if none found.
 func (m *BatchSendNotificationsRequest) ValidateAll() error {
 	return m.validate(true)
@@ -1028,8 +1325,8 @@ func (m *BatchSendNotificationsRequest) validate(all bool) error {
 }
 
 // BatchSendNotificationsRequestMultiError is an error wrapping multiple
-// validation errors returned by BatchSendNotificationsRequest.ValidateAll()
-// if the designated constraints aren't met.
+// validation errors returned by BatchSendNotificationsRequest.ValidateAll() if
+// the designated constraints aren't met.
 type BatchSendNotificationsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
@@ -1103,14 +1400,15 @@ var _ interface {
 
 // Validate checks the field values on BatchSendNotificationsResponse with the
 // rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
 func (m *BatchSendNotificationsResponse) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on BatchSendNotificationsResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
 // BatchSendNotificationsResponseMultiError, or nil if none found.
 func (m *BatchSendNotificationsResponse) ValidateAll() error {
 	return m.validate(true)
@@ -1243,9 +1541,9 @@ var _ interface {
 } = BatchSendNotificationsResponseValidationError{}
 
 // Validate checks the field values on BatchSendNotificationsAsyncRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
 func (m *BatchSendNotificationsAsyncRequest) Validate() error {
 	return m.validate(false)
 }
@@ -1382,9 +1680,9 @@ var _ interface {
 } = BatchSendNotificationsAsyncRequestValidationError{}
 
 // Validate checks the field values on BatchSendNotificationsAsyncResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
 func (m *BatchSendNotificationsAsyncResponse) Validate() error {
 	return m.validate(false)
 }
@@ -1494,8 +1792,8 @@ func (m *TxPrepareRequest) Validate() error {
 }
 
 // ValidateAll checks the field values on TxPrepareRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
 // TxPrepareRequestMultiError, or nil if none found.
 func (m *TxPrepareRequest) ValidateAll() error {
 	return m.validate(true)
@@ -1623,8 +1921,8 @@ func (m *TxPrepareResponse) Validate() error {
 }
 
 // ValidateAll checks the field values on TxPrepareResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
 // TxPrepareResponseMultiError, or nil if none found.
 func (m *TxPrepareResponse) ValidateAll() error {
 	return m.validate(true)
@@ -1725,8 +2023,8 @@ func (m *TxCommitRequest) Validate() error {
 }
 
 // ValidateAll checks the field values on TxCommitRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
 // TxCommitRequestMultiError, or nil if none found.
 func (m *TxCommitRequest) ValidateAll() error {
 	return m.validate(true)
@@ -1827,8 +2125,8 @@ func (m *TxCommitResponse) Validate() error {
 }
 
 // ValidateAll checks the field values on TxCommitResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
 // TxCommitResponseMultiError, or nil if none found.
 func (m *TxCommitResponse) ValidateAll() error {
 	return m.validate(true)
@@ -1927,8 +2225,8 @@ func (m *TxCancelRequest) Validate() error {
 }
 
 // ValidateAll checks the field values on TxCancelRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
 // TxCancelRequestMultiError, or nil if none found.
 func (m *TxCancelRequest) ValidateAll() error {
 	return m.validate(true)
@@ -2029,8 +2327,8 @@ func (m *TxCancelResponse) Validate() error {
 }
 
 // ValidateAll checks the field values on TxCancelResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
 // TxCancelResponseMultiError, or nil if none found.
 func (m *TxCancelResponse) ValidateAll() error {
 	return m.validate(true)
@@ -2123,14 +2421,15 @@ var _ interface {
 
 // Validate checks the field values on SendStrategy_ImmediateStrategy with the
 // rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
 func (m *SendStrategy_ImmediateStrategy) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on SendStrategy_ImmediateStrategy with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
 // SendStrategy_ImmediateStrategyMultiError, or nil if none found.
 func (m *SendStrategy_ImmediateStrategy) ValidateAll() error {
 	return m.validate(true)
@@ -2226,7 +2525,8 @@ var _ interface {
 
 // Validate checks the field values on SendStrategy_DelayedStrategy with the
 // rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
 func (m *SendStrategy_DelayedStrategy) Validate() error {
 	return m.validate(false)
 }
@@ -2331,14 +2631,15 @@ var _ interface {
 
 // Validate checks the field values on SendStrategy_ScheduledStrategy with the
 // rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
 func (m *SendStrategy_ScheduledStrategy) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on SendStrategy_ScheduledStrategy with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
 // SendStrategy_ScheduledStrategyMultiError, or nil if none found.
 func (m *SendStrategy_ScheduledStrategy) ValidateAll() error {
 	return m.validate(true)
@@ -2463,14 +2764,15 @@ var _ interface {
 
 // Validate checks the field values on SendStrategy_TimeWindowStrategy with the
 // rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
 func (m *SendStrategy_TimeWindowStrategy) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on SendStrategy_TimeWindowStrategy with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
 // SendStrategy_TimeWindowStrategyMultiError, or nil if none found.
 func (m *SendStrategy_TimeWindowStrategy) ValidateAll() error {
 	return m.validate(true)
@@ -2570,14 +2872,15 @@ var _ interface {
 
 // Validate checks the field values on SendStrategy_DeadlineStrategy with the
 // rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
 func (m *SendStrategy_DeadlineStrategy) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on SendStrategy_DeadlineStrategy with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
+// the rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
 // SendStrategy_DeadlineStrategyMultiError, or nil if none found.
 func (m *SendStrategy_DeadlineStrategy) ValidateAll() error {
 	return m.validate(true)
@@ -2627,8 +2930,8 @@ func (m *SendStrategy_DeadlineStrategy) validate(all bool) error {
 }
 
 // SendStrategy_DeadlineStrategyMultiError is an error wrapping multiple
-// validation errors returned by SendStrategy_DeadlineStrategy.ValidateAll()
-// if the designated constraints aren't met.
+// validation errors returned by SendStrategy_DeadlineStrategy.ValidateAll() if
+// the designated constraints aren't met.
 type SendStrategy_DeadlineStrategyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
@@ -4,10 +4,14 @@ package ioc
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
 	"time"
 
-	"gitee.com/flycash/notification-platform/internal/service/provider/metrics"
-	"gitee.com/flycash/notification-platform/internal/service/provider/tracing"
+	"net/http"
+
 	"github.com/ecodeclub/ekit/pool"
 	"github.com/gotomicro/ego/core/econf"
 
@@ -16,25 +20,42 @@ import (
 
 	grpcapi "gitee.com/flycash/notification-platform/internal/api/grpc"
 	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
 	"gitee.com/flycash/notification-platform/internal/ioc"
+	"gitee.com/flycash/notification-platform/internal/pkg/circuitbreaker"
 	"gitee.com/flycash/notification-platform/internal/repository"
 	"gitee.com/flycash/notification-platform/internal/repository/cache/local"
 	"gitee.com/flycash/notification-platform/internal/repository/cache/redis"
 	"gitee.com/flycash/notification-platform/internal/repository/dao"
 	auditsvc "gitee.com/flycash/notification-platform/internal/service/audit"
 	"gitee.com/flycash/notification-platform/internal/service/channel"
+	"gitee.com/flycash/notification-platform/internal/service/channel/plugin"
 	configsvc "gitee.com/flycash/notification-platform/internal/service/config"
+	inboxsvc "gitee.com/flycash/notification-platform/internal/service/inbox"
 	notificationsvc "gitee.com/flycash/notification-platform/internal/service/notification"
 	"gitee.com/flycash/notification-platform/internal/service/notification/callback"
 	"gitee.com/flycash/notification-platform/internal/service/provider"
+	"gitee.com/flycash/notification-platform/internal/service/provider/email"
+	emailclient "gitee.com/flycash/notification-platform/internal/service/provider/email/client"
+	"gitee.com/flycash/notification-platform/internal/service/provider/inapp"
+	"gitee.com/flycash/notification-platform/internal/service/provider/limit"
+	"gitee.com/flycash/notification-platform/internal/service/provider/loadbalancer"
 	providersvc "gitee.com/flycash/notification-platform/internal/service/provider/manage"
+	"gitee.com/flycash/notification-platform/internal/service/provider/pricing"
+	"gitee.com/flycash/notification-platform/internal/service/provider/registry"
+	"gitee.com/flycash/notification-platform/internal/service/provider/sandbox"
 	"gitee.com/flycash/notification-platform/internal/service/provider/sequential"
 	"gitee.com/flycash/notification-platform/internal/service/provider/sms"
 	"gitee.com/flycash/notification-platform/internal/service/provider/sms/client"
+	"gitee.com/flycash/notification-platform/internal/service/receipt"
+	replysvc "gitee.com/flycash/notification-platform/internal/service/reply"
 	"gitee.com/flycash/notification-platform/internal/service/sender"
 	"gitee.com/flycash/notification-platform/internal/service/sendstrategy"
 	templatesvc "gitee.com/flycash/notification-platform/internal/service/template/manage"
+	"gitee.com/flycash/notification-platform/internal/service/template/render"
+	callbackweb "gitee.com/flycash/notification-platform/internal/web/callback"
 	"github.com/google/wire"
+	goredis "github.com/redis/go-redis/v9"
 )
 
 var (
@@ -57,6 +78,8 @@ var (
 		notificationsvc.NewNotificationService,
 		repository.NewNotificationRepository,
 		dao.NewNotificationDAO,
+		repository.NewReceiverResultRepository,
+		ioc.InitReceiverResultDAO,
 		redis.NewQuotaCache,
 		notificationsvc.NewSendingTimeoutTask,
 	)
@@ -67,7 +90,10 @@ var (
 		notificationsvc.NewTxCheckTask,
 	)
 	senderSvcSet = wire.NewSet(
+		ioc.InitChannelPluginManager,
+		ioc.InitChannelPluginSyncer,
 		newSMSClients,
+		newProviderRegistry,
 		newChannel,
 		newTaskPool,
 		newSender,
@@ -89,12 +115,47 @@ var (
 		repository.NewProviderRepository,
 		dao.NewProviderDAO,
 		// 加密密钥
-		ioc.InitProviderEncryptKey,
+		ioc.InitProviderKeyring,
 	)
 	templateSvcSet = wire.NewSet(
 		templatesvc.NewChannelTemplateService,
+		render.NewService,
+		wire.Bind(new(render.TemplateGetter), new(templatesvc.ChannelTemplateService)),
 		repository.NewChannelTemplateRepository,
 		dao.NewChannelTemplateDAO,
+		ioc.InitTemplateModerator,
+		ioc.InitSyncProviderAuditInfoTask,
+	)
+	inboxSvcSet = wire.NewSet(
+		inboxsvc.NewService,
+		repository.NewInboxRepository,
+		ioc.InitInboxDAO,
+	)
+	receiptSvcSet = wire.NewSet(
+		receipt.NewService,
+		repository.NewSendReceiptRepository,
+		ioc.InitSendReceiptDAO,
+		ioc.InitSendReceiptSharding,
+		ioc.InitReceiptReconcileTask,
+	)
+	replySvcSet = wire.NewSet(
+		replysvc.NewService,
+		repository.NewSmsReplyRepository,
+		dao.NewSmsReplyDAO,
+	)
+	pricingSvcSet = wire.NewSet(
+		pricing.NewService,
+		repository.NewPricingRepository,
+		dao.NewPricingDAO,
+	)
+	auditSvcSet = wire.NewSet(
+		ioc.InitKafkaProducer,
+		ioc.InitAuditService,
+		repository.NewAuditRepository,
+		dao.NewAuditDAO,
+		auditsvc.NewEscalationTask,
+		ioc.InitAuditResultConsumer,
+		ioc.InitAuditHandler,
 	)
 	schedulerSet = wire.NewSet(scheduler.NewScheduler)
 	quotaSvcSet  = wire.NewSet(
@@ -105,64 +166,267 @@ var (
 )
 
 func newChannel(
-	clients map[string]client.Client,
+	reg *registry.Registry,
+	providerSvc providersvc.Service,
 	templateSvc templatesvc.ChannelTemplateService,
+	renderer render.Service,
+	inboxSvc inboxsvc.Service,
+	pricingSvc pricing.Service,
+	configSvc configsvc.BusinessConfigService,
+	cmd goredis.Cmdable,
+	smsClients *client.Clients,
+	plugins *plugin.Manager,
+	sandboxCfg sandbox.Config,
+	sandboxSvc sandbox.Service,
 ) channel.Channel {
-	return channel.NewDispatcher(map[domain.Channel]channel.Channel{
-		domain.ChannelEmail: channel.NewSMSChannel(newMockSMSSelectorBuilder()),
-	})
+	sandboxFactory := newSandboxProviderFactory(templateSvc, renderer, sandboxCfg, sandboxSvc)
+	// 加载了插件的渠道优先使用插件
+	dispatcher := channel.NewDispatcherWithPlugins(map[domain.Channel]channel.Channel{
+		domain.ChannelSMS:   channel.NewSMSChannel(newSMSSelectorBuilder(reg, providerSvc, templateSvc, pricingSvc, cmd, smsClients, sandboxFactory)),
+		domain.ChannelEmail: channel.NewEmailChannel(newEmailSelectorBuilder(reg, providerSvc, templateSvc, renderer, pricingSvc, cmd, sandboxFactory)),
+		domain.ChannelInApp: channel.NewInAppChannel(newInAppSelectorBuilder(providerSvc, templateSvc, renderer, inboxSvc)),
+	}, plugins)
+	// 按业务方的渠道配置降级
+	return channel.NewFallbackChannel(dispatcher, configSvc, templateSvc)
 }
 
-func newSMSSelectorBuilder(
-	clients map[string]client.Client,
-	templateSvc templatesvc.ChannelTemplateService,
-) *sequential.SelectorBuilder {
-	// 构建SMS供应商
-	providers := make([]provider.Provider, 0, len(clients))
-	for name := range clients {
-		providers = append(providers, sms.NewSMSProvider(
-			name,
-			templateSvc,
-			clients[name],
-		))
+// newProviderRegistry 定期从供应商表加载供应商，新增、修改或者禁用供应商后无需重启
+func newProviderRegistry(providerSvc providersvc.Service) *registry.Registry {
+	type Config struct {
+		RefreshInterval time.Duration `yaml:"refreshInterval"`
 	}
-	return sequential.NewSelectorBuilder(providers)
+	var cfg Config
+	// 未配置时使用默认值
+	if err := econf.UnmarshalKey("provider.registry", &cfg); err != nil && !errors.Is(err, econf.ErrInvalidKey) {
+		panic(err)
+	}
+	return registry.NewRegistry(providerSvc, cfg.RefreshInterval)
 }
 
-func newSMSClients(providerSvc providersvc.Service) map[string]client.Client {
+func newSMSSelectorBuilder(
+	reg *registry.Registry,
+	providerSvc providersvc.Service,
+	templateSvc templatesvc.ChannelTemplateService,
+	pricingSvc pricing.Service,
+	cmd goredis.Cmdable,
+	smsClients *client.Clients,
+	sandboxFactory registry.Factory,
+) provider.SelectorBuilder {
 	ctx, cancelFunc := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancelFunc()
 
-	entities, err := providerSvc.GetByChannel(ctx, domain.ChannelSMS)
+	// 按供应商名称创建SMS客户端，并按供应商表中的 QPSLimit 和 DailyLimit 限流
+	// 测试发送也使用这个工厂，所以这里只创建客户端，由 smsClientsUpdater 提供给模版提审、回执对账和推送回调使用
+	providers, err := reg.Register(ctx, domain.ChannelSMS, func(entity domain.Provider) (provider.Provider, error) {
+		if entity.Name == sandbox.ProviderName {
+			return sandboxFactory(entity)
+		}
+		c, err1 := newSMSClient(entity)
+		if err1 != nil {
+			return nil, err1
+		}
+		return smsClientProvider{
+			Provider: limit.NewRedisProvider(sms.NewSMSProvider(entity.Name, templateSvc, c), cmd, entity),
+			client:   c,
+		}, nil
+	})
 	if err != nil {
 		panic(err)
 	}
-	clients := make(map[string]client.Client)
-	for i := range entities {
-		var cli client.Client
-		if entities[i].Name == "aliyun" {
-			c, err1 := client.NewAliyunSMS(entities[i].RegionID, entities[i].APIKey, entities[i].APISecret)
-			if err1 != nil {
-				panic(err1)
-			}
-			cli = c
-			clients[entities[i].Name] = cli
-		} else if entities[i].Name == "tencentcloud" {
-			c, err1 := client.NewTencentCloudSMS(entities[i].RegionID, entities[i].APIKey, entities[i].APISecret, entities[i].APPID)
-			if err1 != nil {
-				panic(err1)
-			}
-			cli = c
-			clients[entities[i].Name] = cli
+	builder := newLoadBalancerSelectorBuilder(domain.ChannelSMS, providers, providerSvc, templateSvc, pricingSvc, cmd)
+	reg.Subscribe(domain.ChannelSMS, builder)
+	updater := smsClientsUpdater{clients: smsClients}
+	updater.UpdateProviders(providers)
+	reg.Subscribe(domain.ChannelSMS, updater)
+	return builder
+}
+
+// newSandboxProviderFactory 名称为 sandbox 的供应商记录创建沙箱供应商，只渲染并保存消息，不真正发送
+// 没有开启沙箱供应商时不创建，避免生产环境误配置导致消息丢失
+func newSandboxProviderFactory(
+	templateSvc templatesvc.ChannelTemplateService,
+	renderer render.Service,
+	cfg sandbox.Config,
+	svc sandbox.Service,
+) registry.Factory {
+	return func(entity domain.Provider) (provider.Provider, error) {
+		if !cfg.Enabled {
+			return nil, fmt.Errorf("%w: 没有开启沙箱供应商", errs.ErrUnsupportedProvider)
 		}
+		return sandbox.NewProvider(entity.Name, entity.Channel, templateSvc, renderer, svc, cfg), nil
 	}
-	return clients
 }
 
-func newMockSMSSelectorBuilder() *sequential.SelectorBuilder {
-	return sequential.NewSelectorBuilder([]provider.Provider{
-		metrics.NewProvider("ali", tracing.NewProvider(provider.NewMockProvider(), "ali")),
+// updatableSelectorBuilder 供应商可以在运行时替换的 SelectorBuilder
+type updatableSelectorBuilder interface {
+	provider.SelectorBuilder
+	registry.Updater
+}
+
+// newLoadBalancerSelectorBuilder 默认按供应商表中的权重分配流量，修改权重后无需重新部署
+// algorithm 配置为 cost 时按价格表选择最便宜的供应商
+func newLoadBalancerSelectorBuilder(
+	ch domain.Channel,
+	providers map[string]provider.Provider,
+	providerSvc providersvc.Service,
+	templateSvc templatesvc.ChannelTemplateService,
+	pricingSvc pricing.Service,
+	cmd goredis.Cmdable,
+) updatableSelectorBuilder {
+	type Config struct {
+		Algorithm                         string        `yaml:"algorithm"`
+		RefreshInterval                   time.Duration `yaml:"refreshInterval"`
+		BufferLen                         int           `yaml:"bufferLen"`
+		VerificationCodePreferReliability *bool         `yaml:"verificationCodePreferReliability"`
+	}
+	var cfg Config
+	// 未配置时使用默认值
+	if err := econf.UnmarshalKey("provider.loadbalancer", &cfg); err != nil && !errors.Is(err, econf.ErrInvalidKey) {
+		panic(err)
+	}
+	breaker := newBreakerConfig(cfg.BufferLen, cmd)
+	if loadbalancer.Algorithm(cfg.Algorithm) == loadbalancer.AlgorithmCost {
+		// 验证码默认优先保证送达
+		preferReliability := cfg.VerificationCodePreferReliability == nil || *cfg.VerificationCodePreferReliability
+		return loadbalancer.NewCostSelectorBuilder(
+			ch,
+			providers,
+			pricingSvc,
+			templateSvc,
+			preferReliability,
+			cfg.RefreshInterval,
+			breaker,
+		)
+	}
+	return loadbalancer.NewWeightedSelectorBuilder(
+		ch,
+		providers,
+		providerSvc,
+		loadbalancer.Algorithm(cfg.Algorithm),
+		cfg.RefreshInterval,
+		breaker,
+	)
+}
+
+// newBreakerConfig 熔断状态保存在 Redis 中，所有实例对供应商是否可用的判断保持一致
+func newBreakerConfig(bufferLen int, cmd goredis.Cmdable) loadbalancer.BreakerConfig {
+	type Config struct {
+		FailPercent     float64       `yaml:"failPercent"`
+		OpenDuration    time.Duration `yaml:"openDuration"`
+		MaxOpenDuration time.Duration `yaml:"maxOpenDuration"`
+		HalfOpenProbes  int           `yaml:"halfOpenProbes"`
+		ProbeTimeout    time.Duration `yaml:"probeTimeout"`
+	}
+	var cfg Config
+	// 未配置时使用默认值
+	if err := econf.UnmarshalKey("provider.circuitbreaker", &cfg); err != nil && !errors.Is(err, econf.ErrInvalidKey) {
+		panic(err)
+	}
+	return loadbalancer.BreakerConfig{
+		BufferLen:   bufferLen,
+		FailPercent: cfg.FailPercent,
+		Breaker: circuitbreaker.NewRedisCircuitBreaker(cmd, circuitbreaker.Config{
+			OpenDuration:    cfg.OpenDuration,
+			MaxOpenDuration: cfg.MaxOpenDuration,
+			HalfOpenProbes:  cfg.HalfOpenProbes,
+			ProbeTimeout:    cfg.ProbeTimeout,
+		}),
+	}
+}
+
+// newSMSClients 模版提审、回执对账和推送回调使用的SMS客户端，由供应商注册中心加载短信供应商后填充
+func newSMSClients() *client.Clients {
+	return client.NewClients(nil)
+}
+
+// smsClientProvider 带上创建供应商时使用的SMS客户端
+type smsClientProvider struct {
+	provider.Provider
+	client client.Client
+}
+
+// smsClientsUpdater 注册中心加载或者替换短信供应商后更新客户端，被删除或者禁用的供应商移除对应的客户端
+type smsClientsUpdater struct {
+	clients *client.Clients
+}
+
+func (u smsClientsUpdater) UpdateProviders(providers map[string]provider.Provider) {
+	for name, p := range providers {
+		if cp, ok := p.(smsClientProvider); ok {
+			u.clients.Set(name, cp.client)
+		}
+	}
+	u.clients.Retain(slices.Collect(maps.Keys(providers)))
+}
+
+// newSMSClient 按供应商名称创建SMS客户端
+func newSMSClient(entity domain.Provider) (client.Client, error) {
+	switch entity.Name {
+	case "aliyun":
+		return client.NewAliyunSMS(entity.RegionID, entity.APIKey, entity.APISecret, entity.CallbackToken)
+	case "tencentcloud":
+		return client.NewTencentCloudSMS(entity.RegionID, entity.APIKey, entity.APISecret, entity.APPID, entity.CallbackToken)
+	default:
+		return nil, fmt.Errorf("%w: %s", errs.ErrUnsupportedProvider, entity.Name)
+	}
+}
+
+func newEmailSelectorBuilder(
+	reg *registry.Registry,
+	providerSvc providersvc.Service,
+	templateSvc templatesvc.ChannelTemplateService,
+	renderer render.Service,
+	pricingSvc pricing.Service,
+	cmd goredis.Cmdable,
+	sandboxFactory registry.Factory,
+) provider.SelectorBuilder {
+	ctx, cancelFunc := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelFunc()
+
+	// 邮件供应商都使用 SMTP 协议，APIKey 和 APISecret 分别是用户名和密码，附件下载共用一个 http.Client
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+	providers, err := reg.Register(ctx, domain.ChannelEmail, func(entity domain.Provider) (provider.Provider, error) {
+		if entity.Name == sandbox.ProviderName {
+			return sandboxFactory(entity)
+		}
+		c, err1 := emailclient.NewSMTP(entity.Endpoint, entity.APIKey, entity.APISecret)
+		if err1 != nil {
+			return nil, err1
+		}
+		return limit.NewRedisProvider(email.NewEmailProvider(entity.Name, templateSvc, renderer, c, httpClient), cmd, entity), nil
 	})
+	if err != nil {
+		panic(err)
+	}
+	builder := newLoadBalancerSelectorBuilder(domain.ChannelEmail, providers, providerSvc, templateSvc, pricingSvc, cmd)
+	reg.Subscribe(domain.ChannelEmail, builder)
+	return builder
+}
+
+func newInAppSelectorBuilder(
+	providerSvc providersvc.Service,
+	templateSvc templatesvc.ChannelTemplateService,
+	renderer render.Service,
+	inboxSvc inboxsvc.Service,
+) *sequential.SelectorBuilder {
+	ctx, cancelFunc := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelFunc()
+
+	entities, err := providerSvc.GetByChannel(ctx, domain.ChannelInApp)
+	if err != nil {
+		panic(err)
+	}
+	// 站内信没有外部供应商，供应商记录只用于关联模版，投递都写入同一个收件箱
+	providers := make([]provider.Provider, 0, len(entities))
+	for i := range entities {
+		providers = append(providers, inapp.NewInAppProvider(
+			entities[i].Name,
+			templateSvc,
+			renderer,
+			inboxSvc,
+		))
+	}
+	return sequential.NewSelectorBuilder(providers)
 }
 
 func newTaskPool() pool.TaskPool {
@@ -194,12 +458,14 @@ func newTaskPool() pool.TaskPool {
 }
 
 func newSender(repo repository.NotificationRepository,
+	resultRepo repository.ReceiverResultRepository,
 	configSvc configsvc.BusinessConfigService,
 	callbackSvc callback.Service,
+	receiptSvc receipt.Service,
 	channel channel.Channel,
 	taskPool pool.TaskPool,
 ) sender.NotificationSender {
-	s := sender.NewSender(repo, configSvc, callbackSvc, channel, taskPool)
+	s := sender.NewSender(repo, resultRepo, configSvc, callbackSvc, receiptSvc, channel, taskPool)
 	return sender.NewTracingSender(sender.NewMetricsSender(s))
 }
 
@@ -228,11 +494,27 @@ func InitGrpcServer() *ioc.App {
 		templateSvcSet,
 
 		// 审计服务
-		auditsvc.NewService,
+		auditSvcSet,
 
 		// 事务通知服务
 		txNotificationSvcSet,
 
+		// 站内信服务
+		inboxSvcSet,
+
+		// 回执对账服务
+		receiptSvcSet,
+
+		// 上行短信服务
+		replySvcSet,
+
+		// 供应商计价服务
+		pricingSvcSet,
+
+		// 沙箱供应商
+		ioc.InitSandboxConfig,
+		ioc.InitSandboxService,
+
 		// 调度器
 		schedulerSet,
 
@@ -241,7 +523,21 @@ func InitGrpcServer() *ioc.App {
 
 		// GRPC服务器
 		grpcapi.NewServer,
+		grpcapi.NewInboxServer,
+		grpcapi.NewSmsReplyServer,
+		grpcapi.NewProviderServer,
+		grpcapi.NewSandboxServer,
+		grpcapi.NewTemplateServer,
+		ioc.InitProviderTestSendService,
 		ioc.InitGrpc,
+
+		// HTTP服务器
+		callbackweb.NewHandler,
+		ioc.InitChannelPluginHandler,
+		ioc.InitProviderHandler,
+		ioc.InitSandboxHandler,
+		ioc.InitTemplateHandler,
+		ioc.InitGinServer,
 		ioc.InitTasks,
 		ioc.Crons,
 		wire.Struct(new(ioc.App), "*"),
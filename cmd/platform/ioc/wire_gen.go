@@ -8,33 +8,53 @@ package ioc
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"gitee.com/flycash/notification-platform/internal/api/grpc"
 	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
 	"gitee.com/flycash/notification-platform/internal/ioc"
+	"gitee.com/flycash/notification-platform/internal/pkg/circuitbreaker"
 	"gitee.com/flycash/notification-platform/internal/repository"
 	"gitee.com/flycash/notification-platform/internal/repository/cache/local"
 	"gitee.com/flycash/notification-platform/internal/repository/cache/redis"
 	"gitee.com/flycash/notification-platform/internal/repository/dao"
 	"gitee.com/flycash/notification-platform/internal/service/audit"
 	"gitee.com/flycash/notification-platform/internal/service/channel"
+	"gitee.com/flycash/notification-platform/internal/service/channel/plugin"
 	"gitee.com/flycash/notification-platform/internal/service/config"
+	"gitee.com/flycash/notification-platform/internal/service/inbox"
 	"gitee.com/flycash/notification-platform/internal/service/notification"
 	"gitee.com/flycash/notification-platform/internal/service/notification/callback"
 	"gitee.com/flycash/notification-platform/internal/service/provider"
+	"gitee.com/flycash/notification-platform/internal/service/provider/email"
+	client2 "gitee.com/flycash/notification-platform/internal/service/provider/email/client"
+	"gitee.com/flycash/notification-platform/internal/service/provider/inapp"
+	"gitee.com/flycash/notification-platform/internal/service/provider/limit"
+	"gitee.com/flycash/notification-platform/internal/service/provider/loadbalancer"
 	"gitee.com/flycash/notification-platform/internal/service/provider/manage"
-	"gitee.com/flycash/notification-platform/internal/service/provider/metrics"
+	"gitee.com/flycash/notification-platform/internal/service/provider/pricing"
+	"gitee.com/flycash/notification-platform/internal/service/provider/registry"
+	"gitee.com/flycash/notification-platform/internal/service/provider/sandbox"
 	"gitee.com/flycash/notification-platform/internal/service/provider/sequential"
 	"gitee.com/flycash/notification-platform/internal/service/provider/sms"
 	"gitee.com/flycash/notification-platform/internal/service/provider/sms/client"
-	"gitee.com/flycash/notification-platform/internal/service/provider/tracing"
 	"gitee.com/flycash/notification-platform/internal/service/quota"
+	"gitee.com/flycash/notification-platform/internal/service/receipt"
+	"gitee.com/flycash/notification-platform/internal/service/reply"
 	"gitee.com/flycash/notification-platform/internal/service/scheduler"
 	"gitee.com/flycash/notification-platform/internal/service/sender"
 	"gitee.com/flycash/notification-platform/internal/service/sendstrategy"
 	manage2 "gitee.com/flycash/notification-platform/internal/service/template/manage"
+	"gitee.com/flycash/notification-platform/internal/service/template/render"
+	callback2 "gitee.com/flycash/notification-platform/internal/web/callback"
 	"github.com/ecodeclub/ekit/pool"
 	"github.com/google/wire"
 	"github.com/gotomicro/ego/core/econf"
+	redis2 "github.com/redis/go-redis/v9"
+	"maps"
+	"net/http"
+	"slices"
 	"time"
 )
 
@@ -46,16 +66,22 @@ func InitGrpcServer() *ioc.App {
 	cmdable := ioc.InitRedisCmd()
 	quotaCache := redis.NewQuotaCache(cmdable)
 	notificationRepository := repository.NewNotificationRepository(notificationDAO, quotaCache)
-	service := notification.NewNotificationService(notificationRepository)
+	receiverResultDAO := ioc.InitReceiverResultDAO(v)
+	receiverResultRepository := repository.NewReceiverResultRepository(receiverResultDAO)
+	service := notification.NewNotificationService(notificationRepository, receiverResultRepository)
 	channelTemplateDAO := dao.NewChannelTemplateDAO(v)
 	channelTemplateRepository := repository.NewChannelTemplateRepository(channelTemplateDAO)
-	string2 := ioc.InitProviderEncryptKey()
-	providerDAO := dao.NewProviderDAO(v, string2)
-	providerRepository := repository.NewProviderRepository(providerDAO)
+	providerDAO := dao.NewProviderDAO(v)
+	keyring := ioc.InitProviderKeyring()
+	providerRepository := repository.NewProviderRepository(providerDAO, keyring)
 	manageService := manage.NewProviderService(providerRepository)
-	auditService := audit.NewService()
-	v2 := newSMSClients(manageService)
-	channelTemplateService := manage2.NewChannelTemplateService(channelTemplateRepository, manageService, auditService, v2)
+	auditDAO := dao.NewAuditDAO(v)
+	auditRepository := repository.NewAuditRepository(auditDAO)
+	producer := ioc.InitKafkaProducer()
+	auditService := ioc.InitAuditService(auditRepository, producer)
+	clients := newSMSClients()
+	checker := ioc.InitTemplateModerator()
+	channelTemplateService := manage2.NewChannelTemplateService(channelTemplateRepository, manageService, auditService, clients, checker)
 	businessConfigDAO := dao.NewBusinessConfigDAO(v)
 	client := ioc.InitRedisClient()
 	cache := ioc.InitGoCache()
@@ -65,10 +91,25 @@ func InitGrpcServer() *ioc.App {
 	businessConfigService := config.NewBusinessConfigService(businessConfigRepository)
 	callbackLogDAO := dao.NewCallbackLogDAO(v)
 	callbackLogRepository := repository.NewCallbackLogRepository(notificationRepository, callbackLogDAO)
-	callbackService := callback.NewService(businessConfigService, callbackLogRepository)
-	channel := newChannel(v2, channelTemplateService)
+	callbackService := callback.NewService(businessConfigService, callbackLogRepository, receiverResultRepository)
+	sendReceiptSharding := ioc.InitSendReceiptSharding(v)
+	sendReceiptDAO := ioc.InitSendReceiptDAO(sendReceiptSharding)
+	sendReceiptRepository := repository.NewSendReceiptRepository(sendReceiptDAO)
+	receiptService := receipt.NewService(sendReceiptRepository, notificationRepository, callbackService)
+	registry := newProviderRegistry(manageService)
+	renderService := render.NewService(channelTemplateService)
+	inboxDAO := ioc.InitInboxDAO(v)
+	inboxRepository := repository.NewInboxRepository(inboxDAO)
+	inboxService := inbox.NewService(inboxRepository)
+	pricingDAO := dao.NewPricingDAO(v)
+	pricingRepository := repository.NewPricingRepository(pricingDAO)
+	pricingService := pricing.NewService(pricingRepository)
+	manager := ioc.InitChannelPluginManager()
+	sandboxConfig := ioc.InitSandboxConfig()
+	sandboxService := ioc.InitSandboxService(sandboxConfig, cmdable)
+	channel := newChannel(registry, manageService, channelTemplateService, renderService, inboxService, pricingService, businessConfigService, cmdable, clients, manager, sandboxConfig, sandboxService)
 	taskPool := newTaskPool()
-	notificationSender := newSender(notificationRepository, businessConfigService, callbackService, channel, taskPool)
+	notificationSender := newSender(notificationRepository, receiverResultRepository, businessConfigService, callbackService, receiptService, channel, taskPool)
 	immediateSendStrategy := sendstrategy.NewImmediateStrategy(notificationRepository, notificationSender)
 	defaultSendStrategy := sendstrategy.NewDefaultStrategy(notificationRepository, businessConfigService)
 	sendStrategy := sendstrategy.NewDispatcher(immediateSendStrategy, defaultSendStrategy)
@@ -78,22 +119,44 @@ func InitGrpcServer() *ioc.App {
 	dlockClient := ioc.InitDistributedLock(client)
 	txNotificationService := notification.NewTxNotificationService(txNotificationRepository, businessConfigService, notificationRepository, dlockClient, notificationSender)
 	notificationServer := grpc.NewServer(service, sendService, txNotificationService, channelTemplateService)
+	inboxServer := grpc.NewInboxServer(inboxService)
+	smsReplyDAO := dao.NewSmsReplyDAO(v)
+	smsReplyRepository := repository.NewSmsReplyRepository(smsReplyDAO)
+	replyService := reply.NewService(smsReplyRepository, sendReceiptRepository, notificationRepository)
+	smsReplyServer := grpc.NewSmsReplyServer(replyService)
+	testsendService := ioc.InitProviderTestSendService(manageService, registry)
+	providerServer := grpc.NewProviderServer(manageService, testsendService)
+	sandboxServer := grpc.NewSandboxServer(sandboxService)
+	templateServer := grpc.NewTemplateServer(renderService, channelTemplateService, businessConfigService)
 	component := ioc.InitEtcdClient()
-	egrpcComponent := ioc.InitGrpc(notificationServer, component)
+	egrpcComponent := ioc.InitGrpc(notificationServer, inboxServer, smsReplyServer, providerServer, sandboxServer, templateServer, component)
+	handler := callback2.NewHandler(clients, receiptService, replyService, channelTemplateService)
+	syncer := ioc.InitChannelPluginSyncer(component, manager)
+	pluginHandler := ioc.InitChannelPluginHandler(manager, syncer)
+	providerHandler := ioc.InitProviderHandler(manageService, testsendService, pricingService)
+	sandboxHandler := ioc.InitSandboxHandler(sandboxService)
+	auditHandler := ioc.InitAuditHandler(auditService)
+	templateHandler := ioc.InitTemplateHandler(channelTemplateService, renderService, auditService)
+	eginComponent := ioc.InitGinServer(handler, pluginHandler, providerHandler, sandboxHandler, auditHandler, templateHandler)
 	asyncRequestResultCallbackTask := callback.NewAsyncRequestResultCallbackTask(dlockClient, callbackService)
 	notificationScheduler := scheduler.NewScheduler(service, notificationSender, dlockClient)
 	sendingTimeoutTask := notification.NewSendingTimeoutTask(dlockClient, notificationRepository)
 	txCheckTask := notification.NewTxCheckTask(txNotificationRepository, businessConfigService, dlockClient)
-	v3 := ioc.InitTasks(asyncRequestResultCallbackTask, notificationScheduler, sendingTimeoutTask, txCheckTask)
+	reconcileTask := ioc.InitReceiptReconcileTask(sendReceiptSharding, dlockClient, sendReceiptRepository, receiptService, clients)
+	escalationTask := audit.NewEscalationTask(dlockClient, auditService)
+	auditResultConsumer := ioc.InitAuditResultConsumer(channelTemplateService)
+	syncProviderAuditInfoTask := ioc.InitSyncProviderAuditInfoTask(dlockClient, channelTemplateService)
+	v2 := ioc.InitTasks(asyncRequestResultCallbackTask, notificationScheduler, sendingTimeoutTask, txCheckTask, reconcileTask, registry, syncer, escalationTask, auditResultConsumer, syncProviderAuditInfoTask)
 	quotaDAO := dao.NewQuotaDAO(v)
 	quotaRepository := repository.NewQuotaRepository(quotaDAO)
 	quotaService := quota.NewService(quotaRepository)
 	monthlyResetCron := quota.NewQuotaMonthlyResetCron(businessConfigRepository, quotaService)
-	v4 := ioc.Crons(monthlyResetCron, businessConfigRepository)
+	v3 := ioc.Crons(monthlyResetCron, businessConfigRepository)
 	app := &ioc.App{
 		GrpcServer: egrpcComponent,
-		Tasks:      v3,
-		Crons:      v4,
+		GinServer:  eginComponent,
+		Tasks:      v2,
+		Crons:      v3,
 	}
 	return app
 }
@@ -103,77 +166,281 @@ func InitGrpcServer() *ioc.App {
 var (
 	BaseSet              = wire.NewSet(ioc.InitDB, ioc.InitDistributedLock, ioc.InitEtcdClient, ioc.InitIDGenerator, ioc.InitRedisClient, ioc.InitGoCache, ioc.InitRedisCmd, local.NewLocalCache, redis.NewCache)
 	configSvcSet         = wire.NewSet(config.NewBusinessConfigService, repository.NewBusinessConfigRepository, dao.NewBusinessConfigDAO)
-	notificationSvcSet   = wire.NewSet(notification.NewNotificationService, repository.NewNotificationRepository, dao.NewNotificationDAO, redis.NewQuotaCache, notification.NewSendingTimeoutTask)
+	notificationSvcSet   = wire.NewSet(notification.NewNotificationService, repository.NewNotificationRepository, dao.NewNotificationDAO, repository.NewReceiverResultRepository, ioc.InitReceiverResultDAO, redis.NewQuotaCache, notification.NewSendingTimeoutTask)
 	txNotificationSvcSet = wire.NewSet(notification.NewTxNotificationService, repository.NewTxNotificationRepository, dao.NewTxNotificationDAO, notification.NewTxCheckTask)
-	senderSvcSet         = wire.NewSet(
-		newSMSClients,
+	senderSvcSet         = wire.NewSet(ioc.InitChannelPluginManager, ioc.InitChannelPluginSyncer, newSMSClients,
+		newProviderRegistry,
 		newChannel,
 		newTaskPool,
 		newSender,
 	)
 	sendNotificationSvcSet = wire.NewSet(notification.NewSendService, sendstrategy.NewDispatcher, sendstrategy.NewImmediateStrategy, sendstrategy.NewDefaultStrategy)
 	callbackSvcSet         = wire.NewSet(callback.NewService, repository.NewCallbackLogRepository, dao.NewCallbackLogDAO, callback.NewAsyncRequestResultCallbackTask)
-	providerSvcSet         = wire.NewSet(manage.NewProviderService, repository.NewProviderRepository, dao.NewProviderDAO, ioc.InitProviderEncryptKey)
-	templateSvcSet         = wire.NewSet(manage2.NewChannelTemplateService, repository.NewChannelTemplateRepository, dao.NewChannelTemplateDAO)
+	providerSvcSet         = wire.NewSet(manage.NewProviderService, repository.NewProviderRepository, dao.NewProviderDAO, ioc.InitProviderKeyring)
+	templateSvcSet         = wire.NewSet(manage2.NewChannelTemplateService, render.NewService, wire.Bind(new(render.TemplateGetter), new(manage2.ChannelTemplateService)), repository.NewChannelTemplateRepository, dao.NewChannelTemplateDAO, ioc.InitTemplateModerator, ioc.InitSyncProviderAuditInfoTask)
+	inboxSvcSet            = wire.NewSet(inbox.NewService, repository.NewInboxRepository, ioc.InitInboxDAO)
+	receiptSvcSet          = wire.NewSet(receipt.NewService, repository.NewSendReceiptRepository, ioc.InitSendReceiptDAO, ioc.InitSendReceiptSharding, ioc.InitReceiptReconcileTask)
+	replySvcSet            = wire.NewSet(reply.NewService, repository.NewSmsReplyRepository, dao.NewSmsReplyDAO)
+	pricingSvcSet          = wire.NewSet(pricing.NewService, repository.NewPricingRepository, dao.NewPricingDAO)
+	auditSvcSet            = wire.NewSet(ioc.InitKafkaProducer, ioc.InitAuditService, repository.NewAuditRepository, dao.NewAuditDAO, audit.NewEscalationTask, ioc.InitAuditResultConsumer, ioc.InitAuditHandler)
 	schedulerSet           = wire.NewSet(scheduler.NewScheduler)
 	quotaSvcSet            = wire.NewSet(quota.NewService, quota.NewQuotaMonthlyResetCron, repository.NewQuotaRepository, dao.NewQuotaDAO)
 )
 
 func newChannel(
-	clients map[string]client.Client,
+	reg *registry.Registry,
+	providerSvc manage.Service,
 	templateSvc manage2.ChannelTemplateService,
+	renderer render.Service,
+	inboxSvc inbox.Service,
+	pricingSvc pricing.Service,
+	configSvc config.BusinessConfigService,
+	cmd redis2.Cmdable,
+	smsClients *client.Clients,
+	plugins *plugin.Manager,
+	sandboxCfg sandbox.Config,
+	sandboxSvc sandbox.Service,
 ) channel.Channel {
-	return channel.NewDispatcher(map[domain.Channel]channel.Channel{domain.ChannelEmail: channel.NewSMSChannel(newMockSMSSelectorBuilder())})
+	sandboxFactory := newSandboxProviderFactory(templateSvc, renderer, sandboxCfg, sandboxSvc)
+
+	dispatcher := channel.NewDispatcherWithPlugins(map[domain.Channel]channel.Channel{domain.ChannelSMS: channel.NewSMSChannel(newSMSSelectorBuilder(reg, providerSvc, templateSvc, pricingSvc, cmd, smsClients, sandboxFactory)), domain.ChannelEmail: channel.NewEmailChannel(newEmailSelectorBuilder(reg, providerSvc, templateSvc, renderer, pricingSvc, cmd, sandboxFactory)), domain.ChannelInApp: channel.NewInAppChannel(newInAppSelectorBuilder(providerSvc, templateSvc, renderer, inboxSvc))}, plugins)
+
+	return channel.NewFallbackChannel(dispatcher, configSvc, templateSvc)
+}
+
+// newProviderRegistry 定期从供应商表加载供应商，新增、修改或者禁用供应商后无需重启
+func newProviderRegistry(providerSvc manage.Service) *registry.Registry {
+	type Config struct {
+		RefreshInterval time.Duration `yaml:"refreshInterval"`
+	}
+	var cfg Config
+
+	if err := econf.UnmarshalKey("provider.registry", &cfg); err != nil && !errors.Is(err, econf.ErrInvalidKey) {
+		panic(err)
+	}
+	return registry.NewRegistry(providerSvc, cfg.RefreshInterval)
 }
 
 func newSMSSelectorBuilder(
-	clients map[string]client.Client,
+	reg *registry.Registry,
+	providerSvc manage.Service,
 	templateSvc manage2.ChannelTemplateService,
-) *sequential.SelectorBuilder {
+	pricingSvc pricing.Service,
+	cmd redis2.Cmdable,
+	smsClients *client.Clients,
+	sandboxFactory registry.Factory,
+) provider.SelectorBuilder {
+	ctx, cancelFunc := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelFunc()
+
+	providers, err := reg.Register(ctx, domain.ChannelSMS, func(entity domain.Provider) (provider.Provider, error) {
+		if entity.Name == sandbox.ProviderName {
+			return sandboxFactory(entity)
+		}
+		c, err1 := newSMSClient(entity)
+		if err1 != nil {
+			return nil, err1
+		}
+		return smsClientProvider{
+			Provider: limit.NewRedisProvider(sms.NewSMSProvider(entity.Name, templateSvc, c), cmd, entity),
+			client:   c,
+		}, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	builder := newLoadBalancerSelectorBuilder(domain.ChannelSMS, providers, providerSvc, templateSvc, pricingSvc, cmd)
+	reg.Subscribe(domain.ChannelSMS, builder)
+	updater := smsClientsUpdater{clients: smsClients}
+	updater.UpdateProviders(providers)
+	reg.Subscribe(domain.ChannelSMS, updater)
+	return builder
+}
 
-	providers := make([]provider.Provider, 0, len(clients))
-	for name := range clients {
-		providers = append(providers, sms.NewSMSProvider(
-			name,
+// newSandboxProviderFactory 名称为 sandbox 的供应商记录创建沙箱供应商，只渲染并保存消息，不真正发送
+// 没有开启沙箱供应商时不创建，避免生产环境误配置导致消息丢失
+func newSandboxProviderFactory(
+	templateSvc manage2.ChannelTemplateService,
+	renderer render.Service,
+	cfg sandbox.Config,
+	svc sandbox.Service,
+) registry.Factory {
+	return func(entity domain.Provider) (provider.Provider, error) {
+		if !cfg.Enabled {
+			return nil, fmt.Errorf("%w: 没有开启沙箱供应商", errs.ErrUnsupportedProvider)
+		}
+		return sandbox.NewProvider(entity.Name, entity.Channel, templateSvc, renderer, svc, cfg), nil
+	}
+}
+
+// updatableSelectorBuilder 供应商可以在运行时替换的 SelectorBuilder
+type updatableSelectorBuilder interface {
+	provider.SelectorBuilder
+	registry.Updater
+}
+
+// newLoadBalancerSelectorBuilder 默认按供应商表中的权重分配流量，修改权重后无需重新部署
+// algorithm 配置为 cost 时按价格表选择最便宜的供应商
+func newLoadBalancerSelectorBuilder(
+	ch domain.Channel,
+	providers map[string]provider.Provider,
+	providerSvc manage.Service,
+	templateSvc manage2.ChannelTemplateService,
+	pricingSvc pricing.Service,
+	cmd redis2.Cmdable,
+) updatableSelectorBuilder {
+	type Config struct {
+		Algorithm                         string        `yaml:"algorithm"`
+		RefreshInterval                   time.Duration `yaml:"refreshInterval"`
+		BufferLen                         int           `yaml:"bufferLen"`
+		VerificationCodePreferReliability *bool         `yaml:"verificationCodePreferReliability"`
+	}
+	var cfg Config
+
+	if err := econf.UnmarshalKey("provider.loadbalancer", &cfg); err != nil && !errors.Is(err, econf.ErrInvalidKey) {
+		panic(err)
+	}
+	breaker := newBreakerConfig(cfg.BufferLen, cmd)
+	if loadbalancer.Algorithm(cfg.Algorithm) == loadbalancer.AlgorithmCost {
+
+		preferReliability := cfg.VerificationCodePreferReliability == nil || *cfg.VerificationCodePreferReliability
+		return loadbalancer.NewCostSelectorBuilder(
+			ch,
+			providers,
+			pricingSvc,
 			templateSvc,
-			clients[name],
-		))
+			preferReliability,
+			cfg.RefreshInterval,
+			breaker,
+		)
+	}
+	return loadbalancer.NewWeightedSelectorBuilder(
+		ch,
+		providers,
+		providerSvc, loadbalancer.Algorithm(cfg.Algorithm), cfg.RefreshInterval,
+		breaker,
+	)
+}
+
+// newBreakerConfig 熔断状态保存在 Redis 中，所有实例对供应商是否可用的判断保持一致
+func newBreakerConfig(bufferLen int, cmd redis2.Cmdable) loadbalancer.BreakerConfig {
+	type Config struct {
+		FailPercent     float64       `yaml:"failPercent"`
+		OpenDuration    time.Duration `yaml:"openDuration"`
+		MaxOpenDuration time.Duration `yaml:"maxOpenDuration"`
+		HalfOpenProbes  int           `yaml:"halfOpenProbes"`
+		ProbeTimeout    time.Duration `yaml:"probeTimeout"`
+	}
+	var cfg Config
+
+	if err := econf.UnmarshalKey("provider.circuitbreaker", &cfg); err != nil && !errors.Is(err, econf.ErrInvalidKey) {
+		panic(err)
+	}
+	return loadbalancer.BreakerConfig{
+		BufferLen:   bufferLen,
+		FailPercent: cfg.FailPercent,
+		Breaker: circuitbreaker.NewRedisCircuitBreaker(cmd, circuitbreaker.Config{
+			OpenDuration:    cfg.OpenDuration,
+			MaxOpenDuration: cfg.MaxOpenDuration,
+			HalfOpenProbes:  cfg.HalfOpenProbes,
+			ProbeTimeout:    cfg.ProbeTimeout,
+		}),
+	}
+}
+
+// newSMSClients 模版提审、回执对账和推送回调使用的SMS客户端，由供应商注册中心加载短信供应商后填充
+func newSMSClients() *client.Clients {
+	return client.NewClients(nil)
+}
+
+// smsClientProvider 带上创建供应商时使用的SMS客户端
+type smsClientProvider struct {
+	provider.Provider
+
+	client client.Client
+}
+
+// smsClientsUpdater 注册中心加载或者替换短信供应商后更新客户端，被删除或者禁用的供应商移除对应的客户端
+type smsClientsUpdater struct {
+	clients *client.Clients
+}
+
+func (u smsClientsUpdater) UpdateProviders(providers map[string]provider.Provider) {
+	for name, p := range providers {
+		if cp, ok := p.(smsClientProvider); ok {
+			u.clients.Set(name, cp.client)
+		}
+	}
+	u.clients.Retain(slices.Collect(maps.Keys(providers)))
+}
+
+// newSMSClient 按供应商名称创建SMS客户端
+func newSMSClient(entity domain.Provider) (client.Client, error) {
+	switch entity.Name {
+	case "aliyun":
+		return client.NewAliyunSMS(entity.RegionID, entity.APIKey, entity.APISecret, entity.CallbackToken)
+	case "tencentcloud":
+		return client.NewTencentCloudSMS(entity.RegionID, entity.APIKey, entity.APISecret, entity.APPID, entity.CallbackToken)
+	default:
+		return nil, fmt.Errorf("%w: %s", errs.ErrUnsupportedProvider, entity.Name)
 	}
-	return sequential.NewSelectorBuilder(providers)
 }
 
-func newSMSClients(providerSvc manage.Service) map[string]client.Client {
+func newEmailSelectorBuilder(
+	reg *registry.Registry,
+	providerSvc manage.Service,
+	templateSvc manage2.ChannelTemplateService,
+	renderer render.Service,
+	pricingSvc pricing.Service,
+	cmd redis2.Cmdable,
+	sandboxFactory registry.Factory,
+) provider.SelectorBuilder {
 	ctx, cancelFunc := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancelFunc()
 
-	entities, err := providerSvc.GetByChannel(ctx, domain.ChannelSMS)
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+	providers, err := reg.Register(ctx, domain.ChannelEmail, func(entity domain.Provider) (provider.Provider, error) {
+		if entity.Name == sandbox.ProviderName {
+			return sandboxFactory(entity)
+		}
+		c, err1 := client2.NewSMTP(entity.Endpoint, entity.APIKey, entity.APISecret)
+		if err1 != nil {
+			return nil, err1
+		}
+		return limit.NewRedisProvider(email.NewEmailProvider(entity.Name, templateSvc, renderer, c, httpClient), cmd, entity), nil
+	})
 	if err != nil {
 		panic(err)
 	}
-	clients := make(map[string]client.Client)
-	for i := range entities {
-		var cli client.Client
-		if entities[i].Name == "aliyun" {
-			c, err1 := client.NewAliyunSMS(entities[i].RegionID, entities[i].APIKey, entities[i].APISecret)
-			if err1 != nil {
-				panic(err1)
-			}
-			cli = c
-			clients[entities[i].Name] = cli
-		} else if entities[i].Name == "tencentcloud" {
-			c, err1 := client.NewTencentCloudSMS(entities[i].RegionID, entities[i].APIKey, entities[i].APISecret, entities[i].APPID)
-			if err1 != nil {
-				panic(err1)
-			}
-			cli = c
-			clients[entities[i].Name] = cli
-		}
-	}
-	return clients
+	builder := newLoadBalancerSelectorBuilder(domain.ChannelEmail, providers, providerSvc, templateSvc, pricingSvc, cmd)
+	reg.Subscribe(domain.ChannelEmail, builder)
+	return builder
 }
 
-func newMockSMSSelectorBuilder() *sequential.SelectorBuilder {
-	return sequential.NewSelectorBuilder([]provider.Provider{metrics.NewProvider("ali", tracing.NewProvider(provider.NewMockProvider(), "ali"))})
+func newInAppSelectorBuilder(
+	providerSvc manage.Service,
+	templateSvc manage2.ChannelTemplateService,
+	renderer render.Service,
+	inboxSvc inbox.Service,
+) *sequential.SelectorBuilder {
+	ctx, cancelFunc := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelFunc()
+
+	entities, err := providerSvc.GetByChannel(ctx, domain.ChannelInApp)
+	if err != nil {
+		panic(err)
+	}
+
+	providers := make([]provider.Provider, 0, len(entities))
+	for i := range entities {
+		providers = append(providers, inapp.NewInAppProvider(
+			entities[i].Name,
+			templateSvc,
+			renderer,
+			inboxSvc,
+		))
+	}
+	return sequential.NewSelectorBuilder(providers)
 }
 
 func newTaskPool() pool.TaskPool {
@@ -201,11 +468,13 @@ func newTaskPool() pool.TaskPool {
 }
 
 func newSender(repo repository.NotificationRepository,
+	resultRepo repository.ReceiverResultRepository,
 	configSvc config.BusinessConfigService,
-	callbackSvc callback.Service, channel2 channel.Channel,
+	callbackSvc callback.Service,
+	receiptSvc receipt.Service, channel2 channel.Channel,
 
 	taskPool pool.TaskPool,
 ) sender.NotificationSender {
-	s := sender.NewSender(repo, configSvc, callbackSvc, channel2, taskPool)
+	s := sender.NewSender(repo, resultRepo, configSvc, callbackSvc, receiptSvc, channel2, taskPool)
 	return sender.NewTracingSender(sender.NewMetricsSender(s))
 }
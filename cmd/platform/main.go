@@ -34,6 +34,7 @@ func main() {
 		func() server.Server {
 			return app.GrpcServer
 		}(),
+		app.GinServer,
 	).Cron(app.Crons...).
 		Run(); err != nil {
 		elog.Panic("startup", elog.FieldErr(err))
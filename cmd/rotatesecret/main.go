@@ -0,0 +1,31 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/ioc"
+	"gitee.com/flycash/notification-platform/internal/repository"
+	"gitee.com/flycash/notification-platform/internal/repository/dao"
+	"github.com/gotomicro/ego"
+	"github.com/gotomicro/ego/core/elog"
+)
+
+// 供应商密钥轮换：在 provider.secret 中新增主密钥并修改 primaryKeyID 后运行
+//
+//	go run ./cmd/rotatesecret --config=config/config.yaml
+//
+// 所有不是用当前主密钥加密的供应商密钥（包括引入信封加密之前的旧数据）都会被重新加密，可以重复运行
+func main() {
+	// 加载配置
+	_ = ego.New()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	repo := repository.NewProviderRepository(dao.NewProviderDAO(ioc.InitDB()), ioc.InitProviderKeyring())
+	rotated, err := repo.RotateSecrets(ctx)
+	if err != nil {
+		elog.Panic("重新加密供应商密钥失败", elog.Int("Rotated", rotated), elog.FieldErr(err))
+	}
+	elog.Info("重新加密供应商密钥完成", elog.Int("Rotated", rotated))
+}
@@ -0,0 +1,142 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	inboxv1 "gitee.com/flycash/notification-platform/api/proto/gen/inbox/v1"
+	"gitee.com/flycash/notification-platform/internal/api/grpc/interceptor/jwt"
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/service/inbox"
+	"github.com/ecodeclub/ekit/slice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// InboxServer 站内信gRPC服务器，业务方代表其用户调用
+type InboxServer struct {
+	inboxv1.UnimplementedInboxServiceServer
+
+	inboxSvc inbox.Service
+}
+
+// NewInboxServer 创建站内信gRPC服务器
+func NewInboxServer(inboxSvc inbox.Service) *InboxServer {
+	return &InboxServer{inboxSvc: inboxSvc}
+}
+
+// ListMessages 游标分页查询站内信
+func (s *InboxServer) ListMessages(ctx context.Context, req *inboxv1.ListMessagesRequest) (*inboxv1.ListMessagesResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	page, err := s.inboxSvc.List(ctx, domain.InboxQuery{
+		BizID:    bizID,
+		UserID:   req.UserId,
+		Cursor:   req.Cursor,
+		Limit:    int(req.Limit),
+		Archived: req.Archived,
+	})
+	if err != nil {
+		return nil, s.toGRPCError(err)
+	}
+	return &inboxv1.ListMessagesResponse{
+		Messages: slice.Map(page.Messages, func(_ int, src domain.InboxMessage) *inboxv1.InboxMessage {
+			return s.toGRPCMessage(src)
+		}),
+		NextCursor: page.NextCursor,
+		HasMore:    page.HasMore,
+	}, nil
+}
+
+// GetUnreadCount 查询收件箱未读数
+func (s *InboxServer) GetUnreadCount(ctx context.Context, req *inboxv1.GetUnreadCountRequest) (*inboxv1.GetUnreadCountResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	cnt, err := s.inboxSvc.UnreadCount(ctx, bizID, req.UserId)
+	if err != nil {
+		return nil, s.toGRPCError(err)
+	}
+	return &inboxv1.GetUnreadCountResponse{Count: cnt}, nil
+}
+
+// MarkRead 标记已读
+func (s *InboxServer) MarkRead(ctx context.Context, req *inboxv1.MarkReadRequest) (*inboxv1.MarkReadResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	affected, err := s.inboxSvc.MarkRead(ctx, bizID, req.UserId, req.Ids)
+	if err != nil {
+		return nil, s.toGRPCError(err)
+	}
+	return &inboxv1.MarkReadResponse{Affected: affected}, nil
+}
+
+// MarkAllRead 收件箱全部标记已读
+func (s *InboxServer) MarkAllRead(ctx context.Context, req *inboxv1.MarkAllReadRequest) (*inboxv1.MarkAllReadResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	affected, err := s.inboxSvc.MarkAllRead(ctx, bizID, req.UserId)
+	if err != nil {
+		return nil, s.toGRPCError(err)
+	}
+	return &inboxv1.MarkAllReadResponse{Affected: affected}, nil
+}
+
+// DeleteMessages 删除站内信
+func (s *InboxServer) DeleteMessages(ctx context.Context, req *inboxv1.DeleteMessagesRequest) (*inboxv1.DeleteMessagesResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	affected, err := s.inboxSvc.Delete(ctx, bizID, req.UserId, req.Ids)
+	if err != nil {
+		return nil, s.toGRPCError(err)
+	}
+	return &inboxv1.DeleteMessagesResponse{Affected: affected}, nil
+}
+
+// ArchiveMessages 归档站内信
+func (s *InboxServer) ArchiveMessages(ctx context.Context, req *inboxv1.ArchiveMessagesRequest) (*inboxv1.ArchiveMessagesResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	affected, err := s.inboxSvc.Archive(ctx, bizID, req.UserId, req.Ids)
+	if err != nil {
+		return nil, s.toGRPCError(err)
+	}
+	return &inboxv1.ArchiveMessagesResponse{Affected: affected}, nil
+}
+
+func (s *InboxServer) toGRPCError(err error) error {
+	if errors.Is(err, errs.ErrInvalidParameter) || errors.Is(err, errs.ErrBatchSizeOverLimit) {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "%v", err)
+}
+
+func (s *InboxServer) toGRPCMessage(msg domain.InboxMessage) *inboxv1.InboxMessage {
+	st := inboxv1.MessageStatus_UNREAD
+	if !msg.Status.IsUnread() {
+		st = inboxv1.MessageStatus_READ
+	}
+	return &inboxv1.InboxMessage{
+		Id:             msg.ID,
+		UserId:         msg.UserID,
+		NotificationId: msg.NotificationID,
+		Title:          msg.Title,
+		Content:        msg.Content,
+		Status:         st,
+		Archived:       msg.Archived,
+		ReadTime:       msg.ReadTime,
+		Ctime:          msg.Ctime,
+	}
+}
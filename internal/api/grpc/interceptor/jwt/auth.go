@@ -15,7 +15,12 @@ import (
 	"github.com/golang-jwt/jwt/v4"
 )
 
-const BizIDName = "biz_id"
+const (
+	BizIDName = "biz_id"
+	// RoleName 角色声明，管理接口要求角色为 RoleAdmin
+	RoleName  = "role"
+	RoleAdmin = "admin"
+)
 
 type InterceptorBuilder struct {
 	key string
@@ -101,6 +106,10 @@ func (b *InterceptorBuilder) JwtAuthInterceptor() grpc.UnaryServerInterceptor {
 			ctx = context.WithValue(ctx, BizIDName, int64(bizId))
 		}
 
+		if role, ok := val[RoleName].(string); ok {
+			ctx = context.WithValue(ctx, RoleName, role)
+		}
+
 		v, ok = val["Priority"]
 		if ok {
 			ctx = context.WithValue(ctx, "Priority", v)
@@ -110,6 +119,19 @@ func (b *InterceptorBuilder) JwtAuthInterceptor() grpc.UnaryServerInterceptor {
 	}
 }
 
+// AdminOnlyInterceptor services 中的服务只允许管理员调用，需要放在 JwtAuthInterceptor 之后
+// services 为 proto 中的完整服务名，如 provider.v1.ProviderService
+func AdminOnlyInterceptor(services ...string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		for _, service := range services {
+			if strings.HasPrefix(info.FullMethod, "/"+service+"/") && !IsAdmin(ctx) {
+				return nil, status.Error(codes.PermissionDenied, "admin role is required")
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
 func NewJwtAuth(key string) *InterceptorBuilder {
 	return &InterceptorBuilder{
 		key: key,
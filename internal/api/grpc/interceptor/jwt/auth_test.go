@@ -3,11 +3,15 @@
 package jwt
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestJwtAuth_Encode(t *testing.T) {
@@ -146,3 +150,45 @@ func TestNewJwtAuth(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotEmpty(t, token)
 }
+
+func TestAdminOnlyInterceptor(t *testing.T) {
+	t.Parallel()
+	interceptor := AdminOnlyInterceptor("provider.v1.ProviderService")
+	handler := func(_ context.Context, _ any) (any, error) {
+		return "ok", nil
+	}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		method   string
+		wantCode codes.Code
+	}{
+		{
+			name:     "管理员调用管理接口",
+			ctx:      context.WithValue(t.Context(), RoleName, RoleAdmin),
+			method:   "/provider.v1.ProviderService/ListProviders",
+			wantCode: codes.OK,
+		},
+		{
+			name:     "业务方调用管理接口",
+			ctx:      context.WithValue(t.Context(), BizIDName, int64(1)),
+			method:   "/provider.v1.ProviderService/ListProviders",
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "业务方调用其他接口",
+			ctx:      context.WithValue(t.Context(), BizIDName, int64(1)),
+			method:   "/notification.v1.NotificationService/SendNotification",
+			wantCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := interceptor(tt.ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
@@ -17,3 +17,9 @@ func GetBizIDFromContext(ctx context.Context) (int64, error) {
 	}
 	return v, nil
 }
+
+// IsAdmin 令牌中的角色是否为管理员
+func IsAdmin(ctx context.Context) bool {
+	role, _ := ctx.Value(RoleName).(string)
+	return role == RoleAdmin
+}
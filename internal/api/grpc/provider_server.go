@@ -0,0 +1,174 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	providerv1 "gitee.com/flycash/notification-platform/api/proto/gen/provider/v1"
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/service/provider/manage"
+	"gitee.com/flycash/notification-platform/internal/service/provider/testsend"
+	"github.com/ecodeclub/ekit/slice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ProviderServer 供应商管理gRPC服务器，只允许管理员调用，由 jwt.AdminOnlyInterceptor 校验
+type ProviderServer struct {
+	providerv1.UnimplementedProviderServiceServer
+
+	providerSvc manage.Service
+	testSendSvc testsend.Service
+}
+
+// NewProviderServer 创建供应商管理gRPC服务器
+func NewProviderServer(providerSvc manage.Service, testSendSvc testsend.Service) *ProviderServer {
+	return &ProviderServer{providerSvc: providerSvc, testSendSvc: testSendSvc}
+}
+
+func (s *ProviderServer) CreateProvider(ctx context.Context, req *providerv1.CreateProviderRequest) (*providerv1.CreateProviderResponse, error) {
+	if req.Provider == nil {
+		return nil, status.Error(codes.InvalidArgument, "供应商不能为空")
+	}
+	p := s.toDomain(req.Provider)
+	p.ID = 0
+	created, err := s.providerSvc.Create(ctx, p)
+	if err != nil {
+		return nil, s.toGRPCError(err)
+	}
+	return &providerv1.CreateProviderResponse{Provider: s.toGRPCProvider(created)}, nil
+}
+
+func (s *ProviderServer) UpdateProvider(ctx context.Context, req *providerv1.UpdateProviderRequest) (*providerv1.UpdateProviderResponse, error) {
+	if req.Provider == nil {
+		return nil, status.Error(codes.InvalidArgument, "供应商不能为空")
+	}
+	if err := s.providerSvc.Update(ctx, s.toDomain(req.Provider)); err != nil {
+		return nil, s.toGRPCError(err)
+	}
+	return &providerv1.UpdateProviderResponse{}, nil
+}
+
+func (s *ProviderServer) GetProvider(ctx context.Context, req *providerv1.GetProviderRequest) (*providerv1.GetProviderResponse, error) {
+	p, err := s.providerSvc.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, s.toGRPCError(err)
+	}
+	return &providerv1.GetProviderResponse{Provider: s.toGRPCProvider(p)}, nil
+}
+
+func (s *ProviderServer) ListProviders(ctx context.Context, req *providerv1.ListProvidersRequest) (*providerv1.ListProvidersResponse, error) {
+	providers, err := s.providerSvc.List(ctx, domain.Channel(req.Channel))
+	if err != nil {
+		return nil, s.toGRPCError(err)
+	}
+	return &providerv1.ListProvidersResponse{
+		Providers: slice.Map(providers, func(_ int, src domain.Provider) *providerv1.Provider {
+			return s.toGRPCProvider(src)
+		}),
+	}, nil
+}
+
+func (s *ProviderServer) DeleteProvider(ctx context.Context, req *providerv1.DeleteProviderRequest) (*providerv1.DeleteProviderResponse, error) {
+	if err := s.providerSvc.Delete(ctx, req.Id); err != nil {
+		return nil, s.toGRPCError(err)
+	}
+	return &providerv1.DeleteProviderResponse{}, nil
+}
+
+func (s *ProviderServer) ActivateProvider(ctx context.Context, req *providerv1.ActivateProviderRequest) (*providerv1.ActivateProviderResponse, error) {
+	if err := s.providerSvc.Activate(ctx, req.Id); err != nil {
+		return nil, s.toGRPCError(err)
+	}
+	return &providerv1.ActivateProviderResponse{}, nil
+}
+
+func (s *ProviderServer) DeactivateProvider(ctx context.Context, req *providerv1.DeactivateProviderRequest) (*providerv1.DeactivateProviderResponse, error) {
+	if err := s.providerSvc.Deactivate(ctx, req.Id); err != nil {
+		return nil, s.toGRPCError(err)
+	}
+	return &providerv1.DeactivateProviderResponse{}, nil
+}
+
+func (s *ProviderServer) UpdateProviderWeight(ctx context.Context, req *providerv1.UpdateProviderWeightRequest) (*providerv1.UpdateProviderWeightResponse, error) {
+	if err := s.providerSvc.UpdateWeight(ctx, req.Id, int(req.Weight)); err != nil {
+		return nil, s.toGRPCError(err)
+	}
+	return &providerv1.UpdateProviderWeightResponse{}, nil
+}
+
+func (s *ProviderServer) UpdateProviderLimits(ctx context.Context, req *providerv1.UpdateProviderLimitsRequest) (*providerv1.UpdateProviderLimitsResponse, error) {
+	if err := s.providerSvc.UpdateLimits(ctx, req.Id, int(req.QpsLimit), int(req.DailyLimit)); err != nil {
+		return nil, s.toGRPCError(err)
+	}
+	return &providerv1.UpdateProviderLimitsResponse{}, nil
+}
+
+// TestSend 发送失败时返回失败原因，参数错误或者供应商不存在时返回gRPC错误
+func (s *ProviderServer) TestSend(ctx context.Context, req *providerv1.TestSendRequest) (*providerv1.TestSendResponse, error) {
+	_, err := s.testSendSvc.Send(ctx, domain.ProviderTestSend{
+		ProviderID: req.Id,
+		Receiver:   req.Receiver,
+		TemplateID: req.TemplateId,
+		Params:     req.Params,
+	})
+	if errors.Is(err, errs.ErrInvalidParameter) || errors.Is(err, errs.ErrProviderNotFound) {
+		return nil, s.toGRPCError(err)
+	}
+	if err != nil {
+		return &providerv1.TestSendResponse{ErrorMessage: err.Error()}, nil
+	}
+	return &providerv1.TestSendResponse{Success: true}, nil
+}
+
+func (s *ProviderServer) toGRPCError(err error) error {
+	switch {
+	case errors.Is(err, errs.ErrInvalidParameter):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, errs.ErrProviderNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	default:
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+}
+
+func (s *ProviderServer) toDomain(p *providerv1.Provider) domain.Provider {
+	return domain.Provider{
+		ID:               p.Id,
+		Name:             p.Name,
+		Channel:          domain.Channel(p.Channel),
+		Endpoint:         p.Endpoint,
+		RegionID:         p.RegionId,
+		APIKey:           p.ApiKey,
+		APISecret:        p.ApiSecret,
+		APPID:            p.AppId,
+		Weight:           int(p.Weight),
+		QPSLimit:         int(p.QpsLimit),
+		DailyLimit:       int(p.DailyLimit),
+		AuditCallbackURL: p.AuditCallbackUrl,
+		CallbackToken:    p.CallbackToken,
+		Status:           domain.ProviderStatus(p.Status),
+	}
+}
+
+// toGRPCProvider 响应中的密钥脱敏
+func (s *ProviderServer) toGRPCProvider(p domain.Provider) *providerv1.Provider {
+	p = p.Masked()
+	return &providerv1.Provider{
+		Id:               p.ID,
+		Name:             p.Name,
+		Channel:          p.Channel.String(),
+		Endpoint:         p.Endpoint,
+		RegionId:         p.RegionID,
+		ApiKey:           p.APIKey,
+		ApiSecret:        p.APISecret,
+		AppId:            p.APPID,
+		Weight:           int32(p.Weight),
+		QpsLimit:         int32(p.QPSLimit),
+		DailyLimit:       int32(p.DailyLimit),
+		AuditCallbackUrl: p.AuditCallbackURL,
+		CallbackToken:    p.CallbackToken,
+		Status:           p.Status.String(),
+	}
+}
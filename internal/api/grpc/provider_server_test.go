@@ -0,0 +1,74 @@
+//go:build unit
+
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	providerv1 "gitee.com/flycash/notification-platform/api/proto/gen/provider/v1"
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/repository"
+	"gitee.com/flycash/notification-platform/internal/service/provider/manage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeProviderRepo 只保存一个供应商
+type fakeProviderRepo struct {
+	repository.ProviderRepository
+	provider domain.Provider
+}
+
+func (f *fakeProviderRepo) FindByID(_ context.Context, id int64) (domain.Provider, error) {
+	if id != f.provider.ID {
+		return domain.Provider{}, errs.ErrProviderNotFound
+	}
+	return f.provider, nil
+}
+
+func (f *fakeProviderRepo) Update(_ context.Context, provider domain.Provider) error {
+	f.provider = provider
+	return nil
+}
+
+func TestProviderServer_GetThenUpdate(t *testing.T) {
+	t.Parallel()
+
+	repo := &fakeProviderRepo{provider: domain.Provider{
+		ID:            1,
+		Name:          "aliyun",
+		Channel:       domain.ChannelSMS,
+		Endpoint:      "dysmsapi.aliyuncs.com",
+		APIKey:        "LTAI5tAbCdEfGh",
+		APISecret:     "real-api-secret",
+		CallbackToken: "real-callback-token",
+		Weight:        1,
+		QPSLimit:      10,
+		DailyLimit:    1000,
+		Status:        domain.ProviderStatusActive,
+	}}
+	server := NewProviderServer(manage.NewProviderService(repo), nil)
+
+	got, err := server.GetProvider(t.Context(), &providerv1.GetProviderRequest{Id: 1})
+	require.NoError(t, err)
+	assert.Equal(t, "LT******Gh", got.Provider.ApiKey)
+
+	// 原样写回查询结果会覆盖真实的密钥，必须拒绝
+	got.Provider.Weight = 5
+	_, err = server.UpdateProvider(t.Context(), &providerv1.UpdateProviderRequest{Provider: got.Provider})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, 1, repo.provider.Weight)
+
+	// 密钥留空时保留原来的密钥
+	got.Provider.ApiKey, got.Provider.ApiSecret, got.Provider.CallbackToken = "", "", ""
+	_, err = server.UpdateProvider(t.Context(), &providerv1.UpdateProviderRequest{Provider: got.Provider})
+	require.NoError(t, err)
+	assert.Equal(t, 5, repo.provider.Weight)
+	assert.Equal(t, "LTAI5tAbCdEfGh", repo.provider.APIKey)
+	assert.Equal(t, "real-api-secret", repo.provider.APISecret)
+	assert.Equal(t, "real-callback-token", repo.provider.CallbackToken)
+}
@@ -0,0 +1,66 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	replyv1 "gitee.com/flycash/notification-platform/api/proto/gen/reply/v1"
+	"gitee.com/flycash/notification-platform/internal/api/grpc/interceptor/jwt"
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/service/reply"
+	"github.com/ecodeclub/ekit/slice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SmsReplyServer 上行短信gRPC服务器，业务方查询用户的回复和退订
+type SmsReplyServer struct {
+	replyv1.UnimplementedSmsReplyServiceServer
+
+	replySvc reply.Service
+}
+
+// NewSmsReplyServer 创建上行短信gRPC服务器
+func NewSmsReplyServer(replySvc reply.Service) *SmsReplyServer {
+	return &SmsReplyServer{replySvc: replySvc}
+}
+
+// ListReplies 游标分页查询上行短信
+func (s *SmsReplyServer) ListReplies(ctx context.Context, req *replyv1.ListRepliesRequest) (*replyv1.ListRepliesResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	page, err := s.replySvc.List(ctx, domain.SmsReplyQuery{
+		BizID:       bizID,
+		PhoneNumber: req.PhoneNumber,
+		Unsubscribe: req.Unsubscribe,
+		Cursor:      req.Cursor,
+		Limit:       int(req.Limit),
+	})
+	if err != nil {
+		if errors.Is(err, errs.ErrInvalidParameter) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	return &replyv1.ListRepliesResponse{
+		Replies: slice.Map(page.Replies, func(_ int, src domain.SmsReply) *replyv1.SmsReply {
+			return &replyv1.SmsReply{
+				Id:             src.ID,
+				NotificationId: src.NotificationID,
+				Provider:       src.Provider,
+				PhoneNumber:    src.PhoneNumber,
+				Content:        src.Content,
+				SignName:       src.SignName,
+				ExtendCode:     src.ExtendCode,
+				Unsubscribe:    src.IsUnsubscribe(),
+				ReplyTime:      src.ReplyTime,
+				Ctime:          src.Ctime,
+			}
+		}),
+		NextCursor: page.NextCursor,
+		HasMore:    page.HasMore,
+	}, nil
+}
@@ -0,0 +1,112 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	sandboxv1 "gitee.com/flycash/notification-platform/api/proto/gen/sandbox/v1"
+	"gitee.com/flycash/notification-platform/internal/api/grpc/interceptor/jwt"
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/service/provider/sandbox"
+	"github.com/ecodeclub/ekit/slice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SandboxServer 沙箱消息查询gRPC服务器，业务方只能查询自己的消息
+type SandboxServer struct {
+	sandboxv1.UnimplementedSandboxServiceServer
+
+	svc sandbox.Service
+}
+
+// NewSandboxServer 创建沙箱消息查询gRPC服务器
+func NewSandboxServer(svc sandbox.Service) *SandboxServer {
+	return &SandboxServer{svc: svc}
+}
+
+// Enabled 没有开启沙箱供应商时不注册该服务
+func (s *SandboxServer) Enabled() bool {
+	return s.svc.Enabled()
+}
+
+// ListCapturedMessages 按捕获时间倒序分页查询
+func (s *SandboxServer) ListCapturedMessages(ctx context.Context, req *sandboxv1.ListCapturedMessagesRequest) (*sandboxv1.ListCapturedMessagesResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msgs, total, err := s.svc.List(ctx, domain.CapturedMessageQuery{
+		BizID:          bizID,
+		NotificationID: req.NotificationId,
+		Key:            req.Key,
+		Channel:        domain.Channel(req.Channel),
+		Receiver:       req.Receiver,
+		Offset:         int(req.Offset),
+		Limit:          int(req.Limit),
+	})
+	if err != nil {
+		return nil, s.toGRPCError(err)
+	}
+	return &sandboxv1.ListCapturedMessagesResponse{
+		Messages: slice.Map(msgs, func(_ int, src domain.CapturedMessage) *sandboxv1.CapturedMessage {
+			return s.toGRPCMessage(src)
+		}),
+		Total: int32(total),
+	}, nil
+}
+
+func (s *SandboxServer) GetCapturedMessage(ctx context.Context, req *sandboxv1.GetCapturedMessageRequest) (*sandboxv1.GetCapturedMessageResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	msg, err := s.svc.Get(ctx, bizID, req.Id)
+	if err != nil {
+		return nil, s.toGRPCError(err)
+	}
+	return &sandboxv1.GetCapturedMessageResponse{Message: s.toGRPCMessage(msg)}, nil
+}
+
+func (s *SandboxServer) ClearCapturedMessages(ctx context.Context, _ *sandboxv1.ClearCapturedMessagesRequest) (*sandboxv1.ClearCapturedMessagesResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err = s.svc.Clear(ctx, bizID); err != nil {
+		return nil, s.toGRPCError(err)
+	}
+	return &sandboxv1.ClearCapturedMessagesResponse{}, nil
+}
+
+func (s *SandboxServer) toGRPCError(err error) error {
+	switch {
+	case errors.Is(err, errs.ErrInvalidParameter):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, errs.ErrCapturedMessageNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	default:
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+}
+
+func (s *SandboxServer) toGRPCMessage(msg domain.CapturedMessage) *sandboxv1.CapturedMessage {
+	return &sandboxv1.CapturedMessage{
+		Id:                msg.ID,
+		NotificationId:    msg.NotificationID,
+		Key:               msg.Key,
+		Channel:           msg.Channel.String(),
+		Provider:          msg.Provider,
+		Receivers:         msg.Receivers,
+		TemplateId:        msg.TemplateID,
+		TemplateVersionId: msg.TemplateVersionID,
+		Params:            msg.Params,
+		Subject:           msg.Subject,
+		Content:           msg.Content,
+		Status:            msg.Status.String(),
+		ErrMessage:        msg.ErrMessage,
+		Latency:           msg.Latency,
+		Ctime:             msg.Ctime,
+	}
+}
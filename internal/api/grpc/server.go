@@ -12,6 +12,7 @@ import (
 
 	"gitee.com/flycash/notification-platform/internal/api/grpc/interceptor/jwt"
 	notificationsvc "gitee.com/flycash/notification-platform/internal/service/notification"
+	"github.com/ecodeclub/ekit/slice"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -108,7 +109,7 @@ func (s *NotificationServer) buildNotification(ctx context.Context, n *notificat
 	}
 
 	notification.BizID = bizID
-	notification.Template.VersionID = tmpl.ActiveVersionID
+	notification.Template.VersionID = tmpl.ActiveVersionIDFor(notification.Locale)
 	return notification, nil
 }
 
@@ -125,6 +126,10 @@ func (s *NotificationServer) convertToGRPCSendStatus(status domain.SendStatus) n
 		return notificationv1.SendStatus_SUCCEEDED
 	case domain.SendStatusFailed:
 		return notificationv1.SendStatus_FAILED
+	case domain.SendStatusDelivered:
+		return notificationv1.SendStatus_DELIVERED
+	case domain.SendStatusUndelivered:
+		return notificationv1.SendStatus_UNDELIVERED
 	default:
 		return notificationv1.SendStatus_SEND_STATUS_UNSPECIFIED
 	}
@@ -134,6 +139,10 @@ func (s *NotificationServer) convertToGRPCSendStatus(status domain.SendStatus) n
 func (s *NotificationServer) convertToGRPCErrorCode(err error) notificationv1.ErrorCode {
 	// 注意：这个函数只处理业务错误，系统错误由isSystemError判断后直接通过gRPC status返回
 	switch {
+	// 同时也是参数错误，需要先判断
+	case errors.Is(err, errs.ErrInvalidTemplateParams):
+		return notificationv1.ErrorCode_INVALID_TEMPLATE_PARAMS
+
 	case errors.Is(err, errs.ErrInvalidParameter):
 		return notificationv1.ErrorCode_INVALID_PARAMETER
 
@@ -316,8 +325,9 @@ func (s *NotificationServer) BatchSendNotifications(ctx context.Context, req *no
 // buildGRPCSendResponse 将领域响应转换为gRPC响应
 func (s *NotificationServer) buildGRPCSendResponse(result domain.SendResponse, err error) *notificationv1.SendNotificationResponse {
 	response := &notificationv1.SendNotificationResponse{
-		NotificationId: result.NotificationID,
-		Status:         s.convertToGRPCSendStatus(result.Status),
+		NotificationId:  result.NotificationID,
+		Status:          s.convertToGRPCSendStatus(result.Status),
+		ReceiverResults: s.buildGRPCReceiverResults(result.ReceiverResults),
 	}
 	// 如果有错误，提取错误代码和消息
 	if err != nil {
@@ -332,6 +342,19 @@ func (s *NotificationServer) buildGRPCSendResponse(result domain.SendResponse, e
 	return response
 }
 
+// buildGRPCReceiverResults 将接收者发送结果转换为gRPC响应
+func (s *NotificationServer) buildGRPCReceiverResults(results []domain.ReceiverResult) []*notificationv1.ReceiverResult {
+	return slice.Map(results, func(_ int, src domain.ReceiverResult) *notificationv1.ReceiverResult {
+		return &notificationv1.ReceiverResult{
+			Receiver:     src.Receiver,
+			Status:       s.convertToGRPCSendStatus(src.Status),
+			Provider:     src.Provider,
+			ErrorCode:    src.ErrCode,
+			ErrorMessage: src.ErrMessage,
+		}
+	})
+}
+
 // BatchSendNotificationsAsync 处理批量异步发送通知请求
 func (s *NotificationServer) BatchSendNotificationsAsync(ctx context.Context, req *notificationv1.BatchSendNotificationsAsyncRequest) (*notificationv1.BatchSendNotificationsAsyncResponse, error) {
 	// 从metadata中解析Authorization JWT Token
@@ -466,10 +489,15 @@ func (s *NotificationServer) QueryNotification(ctx context.Context, req *notific
 
 	// 将结果转换为响应
 	const zero = 0
+	results, err := s.notificationSvc.GetReceiverResults(ctx, notifications[zero].ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "查询接收者发送结果失败: %v", err)
+	}
 	return &notificationv1.QueryNotificationResponse{
 		Result: &notificationv1.SendNotificationResponse{
-			NotificationId: notifications[zero].ID,
-			Status:         s.convertToGRPCSendStatus(notifications[zero].Status),
+			NotificationId:  notifications[zero].ID,
+			Status:          s.convertToGRPCSendStatus(notifications[zero].Status),
+			ReceiverResults: s.buildGRPCReceiverResults(results[notifications[zero].ID]),
 		},
 	}, nil
 }
@@ -502,6 +530,15 @@ func (s *NotificationServer) BatchQueryNotifications(ctx context.Context, req *n
 		return nil, status.Errorf(codes.Internal, "批量查询通知失败: %v", err)
 	}
 
+	ids := make([]uint64, 0, len(notifications))
+	for i := range notifications {
+		ids = append(ids, notifications[i].ID)
+	}
+	results, err := s.notificationSvc.GetReceiverResults(ctx, ids...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "批量查询接收者发送结果失败: %v", err)
+	}
+
 	// 将结果转换为响应
 	response := &notificationv1.BatchQueryNotificationsResponse{
 		Results: make([]*notificationv1.SendNotificationResponse, 0, len(notifications)),
@@ -509,8 +546,9 @@ func (s *NotificationServer) BatchQueryNotifications(ctx context.Context, req *n
 
 	for i := range notifications {
 		response.Results = append(response.Results, &notificationv1.SendNotificationResponse{
-			NotificationId: notifications[i].ID,
-			Status:         s.convertToGRPCSendStatus(notifications[i].Status),
+			NotificationId:  notifications[i].ID,
+			Status:          s.convertToGRPCSendStatus(notifications[i].Status),
+			ReceiverResults: s.buildGRPCReceiverResults(results[notifications[i].ID]),
 		})
 	}
 
@@ -0,0 +1,89 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	templatev1 "gitee.com/flycash/notification-platform/api/proto/gen/template/v1"
+	"gitee.com/flycash/notification-platform/internal/api/grpc/interceptor/jwt"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	configsvc "gitee.com/flycash/notification-platform/internal/service/config"
+	templatesvc "gitee.com/flycash/notification-platform/internal/service/template/manage"
+	"gitee.com/flycash/notification-platform/internal/service/template/render"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TemplateServer 模版gRPC服务器
+type TemplateServer struct {
+	templatev1.UnimplementedTemplateServiceServer
+
+	renderer    render.Service
+	templateSvc templatesvc.ChannelTemplateService
+	configSvc   configsvc.BusinessConfigService
+}
+
+// NewTemplateServer 创建模版gRPC服务器
+func NewTemplateServer(
+	renderer render.Service,
+	templateSvc templatesvc.ChannelTemplateService,
+	configSvc configsvc.BusinessConfigService,
+) *TemplateServer {
+	return &TemplateServer{
+		renderer:    renderer,
+		templateSvc: templateSvc,
+		configSvc:   configSvc,
+	}
+}
+
+// PreviewTemplateVersion 使用示例参数预览模版版本，不会发送，只能预览业务方自己的模版
+func (s *TemplateServer) PreviewTemplateVersion(ctx context.Context, req *templatev1.PreviewTemplateVersionRequest) (*templatev1.PreviewTemplateVersionResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err = s.checkOwner(ctx, bizID, req.TemplateId); err != nil {
+		return nil, err
+	}
+	preview, err := s.renderer.Preview(ctx, req.TemplateId, req.VersionId, req.Params)
+	if err != nil {
+		return nil, s.toGRPCError(err)
+	}
+	return &templatev1.PreviewTemplateVersionResponse{
+		Channel:       preview.Channel.String(),
+		Subject:       preview.Subject,
+		Content:       preview.Content,
+		Charset:       string(preview.SMS.Charset),
+		Length:        int32(preview.SMS.Length),
+		Segments:      int32(preview.SMS.Count),
+		MissingParams: preview.MissingParams,
+	}, nil
+}
+
+// checkOwner 模版不属于业务方时按模版不存在处理，不暴露其他业务方的模版
+func (s *TemplateServer) checkOwner(ctx context.Context, bizID, templateID int64) error {
+	biz, err := s.configSvc.GetByID(ctx, bizID)
+	if err != nil {
+		return s.toGRPCError(err)
+	}
+	tmpl, err := s.templateSvc.GetTemplateByID(ctx, templateID)
+	if err != nil {
+		return s.toGRPCError(err)
+	}
+	if tmpl.ID == 0 || tmpl.OwnerID != biz.OwnerID || tmpl.OwnerType.String() != biz.OwnerType {
+		return status.Errorf(codes.NotFound, "%v: templateID=%d", errs.ErrTemplateNotFound, templateID)
+	}
+	return nil
+}
+
+func (s *TemplateServer) toGRPCError(err error) error {
+	switch {
+	case errors.Is(err, errs.ErrTemplateNotFound), errors.Is(err, errs.ErrTemplateVersionNotFound),
+		errors.Is(err, errs.ErrConfigNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, errs.ErrRenderTemplateFailed):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	default:
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+}
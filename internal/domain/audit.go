@@ -10,10 +10,37 @@ func (r ResourceType) IsTemplate() bool {
 	return r == ResourceTypeTemplate
 }
 
+// Audit 内部审核记录
 type Audit struct {
+	ID           int64
 	ResourceID   int64        // 模版版本ID
 	ResourceType ResourceType // TEMPLATE
 	Content      string       // 完整JSON串，模版信息-基本+版本+渠道名（多个）
+
+	ReviewerID      int64       // 当前审核人ID，0表示没有可分配的审核人
+	Level           int         // 当前审核级别，超过时限没有审核时升级到下一级
+	Status          AuditStatus // 审核中、已通过、已拒绝
+	RejectReason    string      // 拒绝原因
+	Deadline        int64       // 当前级别的审核截止时间
+	AuditTime       int64       // 审核时间
+	ResultPublished bool        // 审核结果是否已经发送到 audit_result_events
+	Ctime           int64
+	Utime           int64
+}
+
+// IsDecided 是否已经有审核结果
+func (a Audit) IsDecided() bool {
+	return a.Status.IsApproved() || a.Status.IsRejected()
+}
+
+// AuditReviewer 审核人，新的审核按待审核数量分配给0级中最空闲的审核人
+type AuditReviewer struct {
+	ID      int64
+	Name    string
+	Level   int  // 审核级别，超时的审核升级给下一级审核人
+	Enabled bool // 停用后不再分配新的审核，已分配的审核仍然可以处理
+	Ctime   int64
+	Utime   int64
 }
 
 type AuditContent struct {
@@ -28,4 +55,6 @@ type AuditContent struct {
 	Content       string   `json:"content"`       // 模板内容
 	Remark        string   `json:"remark"`        // 申请说明
 	ProviderNames []string `json:"providerNames"` // 供应商名称
+	// Findings 审核前检查发现的问题，供审核人参考
+	Findings []ModerationFinding `json:"findings,omitempty"`
 }
@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"slices"
+
 	"gitee.com/flycash/notification-platform/internal/pkg/retry"
 )
 
@@ -34,6 +36,38 @@ type ChannelItem struct {
 	Enabled  bool   `json:"enabled"`
 }
 
+// IsEnabled 渠道是否启用，没有配置的渠道视为启用
+func (c *ChannelConfig) IsEnabled(channel Channel) bool {
+	if c == nil {
+		return true
+	}
+	for i := range c.Channels {
+		if Channel(c.Channels[i].Channel) == channel {
+			return c.Channels[i].Enabled
+		}
+	}
+	return true
+}
+
+// FallbackChannels 按优先级从高到低（Priority 从小到大）返回除 channel 之外所有启用的渠道
+func (c *ChannelConfig) FallbackChannels(channel Channel) []Channel {
+	if c == nil {
+		return nil
+	}
+	items := slices.Clone(c.Channels)
+	slices.SortStableFunc(items, func(x, y ChannelItem) int {
+		return x.Priority - y.Priority
+	})
+	res := make([]Channel, 0, len(items))
+	for i := range items {
+		ch := Channel(items[i].Channel)
+		if items[i].Enabled && ch != channel && ch.IsValid() && !slices.Contains(res, ch) {
+			res = append(res, ch)
+		}
+	}
+	return res
+}
+
 type TxnConfig struct {
 	// 回查方法名
 	ServiceName string `json:"serviceName"`
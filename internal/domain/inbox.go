@@ -0,0 +1,49 @@
+package domain
+
+// InboxMessageStatus 站内信状态
+type InboxMessageStatus string
+
+const (
+	InboxMessageStatusUnread InboxMessageStatus = "UNREAD" // 未读
+	InboxMessageStatusRead   InboxMessageStatus = "READ"   // 已读
+)
+
+func (s InboxMessageStatus) String() string {
+	return string(s)
+}
+
+func (s InboxMessageStatus) IsUnread() bool {
+	return s == InboxMessageStatusUnread
+}
+
+// InboxMessage 站内信，按 (BizID, UserID) 分库分表
+type InboxMessage struct {
+	ID             int64
+	BizID          int64
+	UserID         string
+	NotificationID uint64
+	Title          string
+	Content        string
+	Status         InboxMessageStatus
+	Archived       bool
+	ReadTime       int64
+	Ctime          int64
+	Utime          int64
+}
+
+// InboxQuery 站内信游标分页查询条件
+type InboxQuery struct {
+	BizID  int64
+	UserID string
+	// Cursor 上一页最后一条站内信的ID，为0表示从最新的开始
+	Cursor   int64
+	Limit    int
+	Archived bool
+}
+
+// InboxPage 站内信分页结果
+type InboxPage struct {
+	Messages   []InboxMessage
+	NextCursor int64
+	HasMore    bool
+}
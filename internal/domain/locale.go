@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"golang.org/x/text/language"
+)
+
+// DefaultFallbackLocale 没有对应语言的模版版本时优先回退的语言，再没有则使用默认版本
+const DefaultFallbackLocale = "en-US"
+
+// NormalizeLocale 校验并规范化 BCP 47 语言标签，如 zh-cn 规范化为 zh-CN，空字符串表示默认版本
+func NormalizeLocale(locale string) (string, error) {
+	if locale == "" {
+		return "", nil
+	}
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return "", fmt.Errorf("%w: Locale = %q", errs.ErrInvalidParameter, locale)
+	}
+	return tag.String(), nil
+}
+
+// LocaleChain 选择模版版本时依次尝试的语言，如 ja-JP → en-US → 默认版本，空字符串表示默认版本
+func LocaleChain(locale string) []string {
+	chain := make([]string, 0, 3)
+	for _, l := range []string{locale, DefaultFallbackLocale, ""} {
+		if !slices.Contains(chain, l) {
+			chain = append(chain, l)
+		}
+	}
+	return chain
+}
@@ -0,0 +1,136 @@
+//go:build unit
+
+package domain
+
+import (
+	"testing"
+
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeLocale(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		locale  string
+		want    string
+		wantErr error
+	}{
+		{
+			name: "默认语言",
+		},
+		{
+			name:   "已规范",
+			locale: "ja-JP",
+			want:   "ja-JP",
+		},
+		{
+			name:   "大小写和分隔符",
+			locale: "en_us",
+			want:   "en-US",
+		},
+		{
+			name:    "格式错误",
+			locale:  "xx!!",
+			wantErr: errs.ErrInvalidParameter,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NormalizeLocale(tc.locale)
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestLocaleChain(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"ja-JP", "en-US", ""}, LocaleChain("ja-JP"))
+	assert.Equal(t, []string{"en-US", ""}, LocaleChain("en-US"))
+	assert.Equal(t, []string{"", "en-US"}, LocaleChain(""))
+}
+
+func TestChannelTemplate_ActiveVersionFor(t *testing.T) {
+	t.Parallel()
+
+	tmpl := ChannelTemplate{
+		ActiveVersionID:      1,
+		ActiveLocaleVersions: map[string]int64{"ja-JP": 2, "en-US": 3},
+		Versions: []ChannelTemplateVersion{
+			{ID: 1},
+			{ID: 2, Locale: "ja-JP"},
+			{ID: 3, Locale: "en-US"},
+		},
+	}
+
+	testCases := []struct {
+		name   string
+		tmpl   ChannelTemplate
+		locale string
+		wantID int64
+	}{
+		{
+			name:   "默认语言",
+			tmpl:   tmpl,
+			wantID: 1,
+		},
+		{
+			name:   "命中语言",
+			tmpl:   tmpl,
+			locale: "ja-JP",
+			wantID: 2,
+		},
+		{
+			name:   "回退到en-US",
+			tmpl:   tmpl,
+			locale: "fr-FR",
+			wantID: 3,
+		},
+		{
+			name: "回退到默认版本",
+			tmpl: ChannelTemplate{
+				ActiveVersionID:      1,
+				ActiveLocaleVersions: map[string]int64{"ja-JP": 2},
+				Versions:             tmpl.Versions,
+			},
+			locale: "fr-FR",
+			wantID: 1,
+		},
+		{
+			name: "没有默认版本时回退到en-US",
+			tmpl: ChannelTemplate{
+				ActiveLocaleVersions: map[string]int64{"en-US": 3},
+				Versions:             tmpl.Versions,
+			},
+			wantID: 3,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			version := tc.tmpl.ActiveVersionFor(tc.locale)
+			require.NotNil(t, version)
+			assert.Equal(t, tc.wantID, version.ID)
+		})
+	}
+}
+
+func TestChannelTemplate_IsActiveVersion(t *testing.T) {
+	t.Parallel()
+
+	tmpl := ChannelTemplate{
+		ActiveVersionID:      1,
+		ActiveLocaleVersions: map[string]int64{"ja-JP": 2},
+	}
+	assert.True(t, tmpl.IsActiveVersion(1))
+	assert.True(t, tmpl.IsActiveVersion(2))
+	assert.False(t, tmpl.IsActiveVersion(3))
+}
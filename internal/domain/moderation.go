@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+
+	"gitee.com/flycash/notification-platform/internal/errs"
+)
+
+// ModerationSeverity 审核前检查发现问题的严重程度
+type ModerationSeverity string
+
+const (
+	// ModerationSeverityWarning 可能被供应商拒绝，交给审核人判断
+	ModerationSeverityWarning ModerationSeverity = "WARNING"
+	// ModerationSeverityBlock 明确违规，直接拒绝
+	ModerationSeverityBlock ModerationSeverity = "BLOCK"
+)
+
+func (s ModerationSeverity) String() string {
+	return string(s)
+}
+
+// ModerationFinding 审核前检查发现的问题
+type ModerationFinding struct {
+	Rule     string             `json:"rule"`     // 规则名称，如 sensitive_word
+	Severity ModerationSeverity `json:"severity"` // 严重程度
+	Field    string             `json:"field"`    // 有问题的字段，如 content、signature
+	Match    string             `json:"match"`    // 命中的内容，如敏感词、链接
+	Message  string             `json:"message"`  // 问题说明
+}
+
+// ModerationResult 审核前检查结果
+type ModerationResult struct {
+	Findings []ModerationFinding
+}
+
+// Blocked 是否有明确违规的问题
+func (r ModerationResult) Blocked() bool {
+	for i := range r.Findings {
+		if r.Findings[i].Severity == ModerationSeverityBlock {
+			return true
+		}
+	}
+	return false
+}
+
+// RejectReason 明确违规的问题汇总，作为拒绝原因
+func (r ModerationResult) RejectReason() string {
+	reasons := make([]string, 0, len(r.Findings))
+	for i := range r.Findings {
+		if r.Findings[i].Severity == ModerationSeverityBlock {
+			reasons = append(reasons, r.Findings[i].Message)
+		}
+	}
+	return strings.Join(reasons, "；")
+}
+
+// ModerationRejectedError 审核前检查发现明确违规，版本已被自动拒绝
+type ModerationRejectedError struct {
+	Findings []ModerationFinding
+}
+
+func (e *ModerationRejectedError) Error() string {
+	return fmt.Sprintf("%s: %s", errs.ErrTemplateContentRejected, ModerationResult{Findings: e.Findings}.RejectReason())
+}
+
+func (e *ModerationRejectedError) Unwrap() error {
+	return errs.ErrTemplateContentRejected
+}
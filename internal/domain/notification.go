@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	notificationv1 "gitee.com/flycash/notification-platform/api/proto/gen/notification/v1"
@@ -21,12 +22,20 @@ const (
 	SendStatusSending   SendStatus = "SENDING"   // 待发送
 	SendStatusSucceeded SendStatus = "SUCCEEDED" // 发送成功
 	SendStatusFailed    SendStatus = "FAILED"    // 发送失败
+	// 以下两个状态由回执对账得出，只会从 SUCCEEDED 转换而来
+	SendStatusDelivered   SendStatus = "DELIVERED"   // 已送达
+	SendStatusUndelivered SendStatus = "UNDELIVERED" // 未送达
 )
 
 func (s SendStatus) String() string {
 	return string(s)
 }
 
+// IsAccepted 供应商已受理，包括回执对账后的 DELIVERED 和 UNDELIVERED，不能再次发送
+func (s SendStatus) IsAccepted() bool {
+	return s == SendStatusSucceeded || s == SendStatusDelivered || s == SendStatusUndelivered
+}
+
 type Template struct {
 	ID        int64             `json:"id"`        // 模板ID
 	VersionID int64             `json:"versionId"` // 版本ID
@@ -36,6 +45,18 @@ type Template struct {
 	Version string `json:"version"`
 }
 
+// Render 用 Params 替换 content 中 ${key} 格式的变量
+func (t Template) Render(content string) string {
+	if len(t.Params) == 0 {
+		return content
+	}
+	oldnew := make([]string, 0, len(t.Params)*2)
+	for k, v := range t.Params {
+		oldnew = append(oldnew, "${"+k+"}", v)
+	}
+	return strings.NewReplacer(oldnew...).Replace(content)
+}
+
 // Notification 通知领域模型
 type Notification struct {
 	ID                 uint64             `json:"id"`             // 通知唯一标识
@@ -49,6 +70,12 @@ type Notification struct {
 	ScheduledETime     time.Time          `json:"scheduledETime"` // 计划发送结束时间
 	Version            int                `json:"version"`        // 版本号
 	SendStrategyConfig SendStrategyConfig `json:"sendStrategyConfig"`
+	// FallbackReceivers 渠道降级时使用的接收者，没有提供接收者的渠道不参与降级
+	FallbackReceivers map[Channel][]string `json:"fallbackReceivers"`
+	// DeliveredChannel 实际发送成功的渠道，发生渠道降级时与 Channel 不同
+	DeliveredChannel Channel `json:"deliveredChannel"`
+	// Locale 接收者的语言，发送时按 LocaleChain 选择模版版本，为空表示默认版本
+	Locale string `json:"locale"`
 }
 
 func (n *Notification) SetSendTime() {
@@ -86,6 +113,16 @@ func (n *Notification) Validate() error {
 		return fmt.Errorf("%w: Channel = %q", errs.ErrInvalidParameter, n.Channel)
 	}
 
+	if err := n.normalizeReceivers(); err != nil {
+		return err
+	}
+
+	locale, err := NormalizeLocale(n.Locale)
+	if err != nil {
+		return err
+	}
+	n.Locale = locale
+
 	if n.Template.ID <= 0 {
 		return fmt.Errorf("%w: Template.ID = %d", errs.ErrInvalidParameter, n.Template.ID)
 	}
@@ -105,6 +142,25 @@ func (n *Notification) Validate() error {
 	return nil
 }
 
+// normalizeReceivers 校验并规范化接收者和降级接收者
+func (n *Notification) normalizeReceivers() error {
+	receivers, err := NormalizeReceivers(n.Channel, n.Receivers)
+	if err != nil {
+		return err
+	}
+	n.Receivers = receivers
+	for ch, fallback := range n.FallbackReceivers {
+		if !ch.IsValid() {
+			return fmt.Errorf("%w: FallbackReceivers Channel = %q", errs.ErrInvalidParameter, ch)
+		}
+		n.FallbackReceivers[ch], err = NormalizeReceivers(ch, fallback)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (n *Notification) IsValidBizID() error {
 	if n.BizID <= 0 {
 		return fmt.Errorf("%w: BizID = %d", errs.ErrInvalidParameter, n.BizID)
@@ -116,6 +172,13 @@ func (n *Notification) MarshalReceivers() (string, error) {
 	return n.marshal(n.Receivers)
 }
 
+func (n *Notification) MarshalFallbackReceivers() (string, error) {
+	if len(n.FallbackReceivers) == 0 {
+		return "", nil
+	}
+	return n.marshal(n.FallbackReceivers)
+}
+
 func (n *Notification) MarshalTemplateParams() (string, error) {
 	return n.marshal(n.Template.Params)
 }
@@ -143,6 +206,16 @@ func NewNotificationFromAPI(n *notificationv1.Notification) (Notification, error
 		return Notification{}, err
 	}
 
+	fallbackReceivers, err := getDomainFallbackReceivers(n)
+	if err != nil {
+		return Notification{}, err
+	}
+
+	locale, err := NormalizeLocale(n.Locale)
+	if err != nil {
+		return Notification{}, err
+	}
+
 	return Notification{
 		Key:       n.Key,
 		Receivers: n.FindReceivers(),
@@ -152,9 +225,28 @@ func NewNotificationFromAPI(n *notificationv1.Notification) (Notification, error
 			Params: n.TemplateParams,
 		},
 		SendStrategyConfig: getDomainSendStrategyConfig(n),
+		FallbackReceivers:  fallbackReceivers,
+		Locale:             locale,
 	}, nil
 }
 
+func getDomainFallbackReceivers(n *notificationv1.Notification) (map[Channel][]string, error) {
+	if len(n.FallbackReceivers) == 0 {
+		return nil, nil
+	}
+	res := make(map[Channel][]string, len(n.FallbackReceivers))
+	for ch, list := range n.FallbackReceivers {
+		channel := Channel(ch)
+		if !channel.IsValid() {
+			return nil, fmt.Errorf("%w: 降级渠道 %s", errs.ErrUnknownChannel, ch)
+		}
+		if len(list.GetReceivers()) > 0 {
+			res[channel] = list.GetReceivers()
+		}
+	}
+	return res, nil
+}
+
 func getDomainChannel(n *notificationv1.Notification) (Channel, error) {
 	switch n.Channel {
 	case notificationv1.Channel_SMS:
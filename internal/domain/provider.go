@@ -1,9 +1,11 @@
 package domain
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/pkg/secret"
 )
 
 // Channel 通知渠道
@@ -47,6 +49,9 @@ func (p ProviderStatus) String() string {
 	return string(p)
 }
 
+// SandboxProviderName 沙箱供应商的名称，沙箱供应商只渲染并保存消息，供应商侧没有模版
+const SandboxProviderName = "sandbox"
+
 // Provider 供应商领域模型
 type Provider struct {
 	ID int64 // 供应商ID
@@ -66,7 +71,30 @@ type Provider struct {
 	DailyLimit int // 每日请求数限制
 
 	AuditCallbackURL string // 审核请求回调地址
-	Status           ProviderStatus
+	// CallbackToken 推送令牌，阿里云、腾讯云的推送不带签名，
+	// 在供应商控制台配置的推送地址中带上 token=CallbackToken，平台据此确认推送来自供应商
+	CallbackToken string
+	Status        ProviderStatus
+}
+
+// Masked 返回密钥脱敏后的副本，用于日志和接口响应
+func (p Provider) Masked() Provider {
+	p.APIKey = secret.Mask(p.APIKey)
+	p.APISecret = secret.Mask(p.APISecret)
+	p.CallbackToken = secret.Mask(p.CallbackToken)
+	return p
+}
+
+// String 打印时密钥脱敏
+func (p Provider) String() string {
+	type provider Provider
+	return fmt.Sprintf("%+v", provider(p.Masked()))
+}
+
+// MarshalJSON 序列化时密钥脱敏，避免通过日志（elog.Any）或者接口响应泄露密钥
+func (p Provider) MarshalJSON() ([]byte, error) {
+	type provider Provider
+	return json.Marshal(provider(p.Masked()))
 }
 
 func (p *Provider) Validate() error {
@@ -104,3 +132,12 @@ func (p *Provider) Validate() error {
 
 	return nil
 }
+
+// ProviderTestSend 只通过指定供应商发送的测试通知
+type ProviderTestSend struct {
+	ProviderID int64
+	Receiver   string
+	// TemplateID 为0时使用渠道配置的测试模版
+	TemplateID int64
+	Params     map[string]string
+}
@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+
+	"gitee.com/flycash/notification-platform/internal/errs"
+)
+
+// DefaultCountryCode 不带国家码的手机号按中国大陆计价
+const DefaultCountryCode = "86"
+
+// ProviderPrice 供应商单价
+// CountryCode 为空表示适用所有国家和地区，BusinessType 为0表示适用所有业务类型
+type ProviderPrice struct {
+	ID           int64
+	Provider     string       // 供应商名称
+	Channel      Channel      // 渠道
+	CountryCode  string       // 国家码，不带+，例如86
+	BusinessType BusinessType // 业务类型
+	UnitPrice    int64        // 单条价格，单位为0.0001元
+	Ctime        int64
+	Utime        int64
+}
+
+func (p ProviderPrice) Validate() error {
+	if p.Provider == "" {
+		return fmt.Errorf("%w: 供应商名称不能为空", errs.ErrInvalidParameter)
+	}
+	if !p.Channel.IsValid() {
+		return fmt.Errorf("%w: 不支持的渠道类型", errs.ErrInvalidParameter)
+	}
+	if strings.HasPrefix(p.CountryCode, "+") {
+		return fmt.Errorf("%w: 国家码不能带+", errs.ErrInvalidParameter)
+	}
+	if p.BusinessType != 0 && !p.BusinessType.IsValid() {
+		return fmt.Errorf("%w: 不支持的业务类型", errs.ErrInvalidParameter)
+	}
+	if p.UnitPrice < 0 {
+		return fmt.Errorf("%w: 单价不能小于0", errs.ErrInvalidParameter)
+	}
+	return nil
+}
+
+// PriceTable 一个渠道内所有供应商的价格表
+type PriceTable []ProviderPrice
+
+// Lookup 查找供应商的单价，国家码和业务类型都匹配的价格优先，其次是只匹配国家码的，最后是通用价格
+func (t PriceTable) Lookup(provider, countryCode string, businessType BusinessType) (ProviderPrice, bool) {
+	var (
+		found ProviderPrice
+		score = -1
+	)
+	for i := range t {
+		p := t[i]
+		if p.Provider != provider ||
+			(p.CountryCode != "" && p.CountryCode != countryCode) ||
+			(p.BusinessType != 0 && p.BusinessType != businessType) {
+			continue
+		}
+		s := 0
+		if p.CountryCode != "" {
+			s += 2
+		}
+		if p.BusinessType != 0 {
+			s++
+		}
+		if s > score {
+			found, score = p, s
+		}
+	}
+	return found, score >= 0
+}
+
+// CountryCode 解析接收者的国家码，+开头的手机号按价格表中最长匹配的国家码计算，邮箱等非手机号返回空
+func (t PriceTable) CountryCode(receiver string) string {
+	if !strings.HasPrefix(receiver, "+") {
+		if strings.Contains(receiver, "@") {
+			return ""
+		}
+		return DefaultCountryCode
+	}
+	number := strings.TrimPrefix(receiver, "+")
+	code := ""
+	for i := range t {
+		if len(t[i].CountryCode) > len(code) && strings.HasPrefix(number, t[i].CountryCode) {
+			code = t[i].CountryCode
+		}
+	}
+	return code
+}
+
+// SendCost 一次发送的费用，供财务对账，接收者来自多个国家时每个国家码一条
+type SendCost struct {
+	ID             int64
+	NotificationID uint64
+	BizID          int64
+	Provider       string
+	Channel        Channel
+	CountryCode    string
+	BusinessType   BusinessType
+	UnitPrice      int64 // 发送时使用的单价，单位为0.0001元，没有配置价格时为0
+	Quantity       int64 // 计费条数，即该国家码下的接收者数量
+	Amount         int64 // 总价，单位为0.0001元
+	Ctime          int64
+}
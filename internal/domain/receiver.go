@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/pkg/receiver"
+)
+
+// NormalizeReceivers 按渠道校验并规范化接收者，去掉规范化之后重复的接收者
+// 短信为手机号，中国大陆手机号不带国家码，其余为 E.164；邮件为邮箱地址，域名转换为 Punycode；站内信为用户ID
+// 有不合法的接收者时返回的错误中列出全部不合法的接收者及原因
+func NormalizeReceivers(channel Channel, receivers []string) ([]string, error) {
+	normalize := receiverNormalizer(channel)
+	res := make([]string, 0, len(receivers))
+	seen := make(map[string]struct{}, len(receivers))
+	var rejected []string
+	for _, raw := range receivers {
+		r, err := normalize(raw)
+		if err != nil {
+			rejected = append(rejected, fmt.Sprintf("%q(%v)", raw, err))
+			continue
+		}
+		if _, ok := seen[r]; ok {
+			continue
+		}
+		seen[r] = struct{}{}
+		res = append(res, r)
+	}
+	if len(rejected) > 0 {
+		return nil, fmt.Errorf("%w: Channel = %s, 不合法的接收者: %s",
+			errs.ErrInvalidParameter, channel, strings.Join(rejected, ", "))
+	}
+	return res, nil
+}
+
+func receiverNormalizer(channel Channel) func(raw string) (string, error) {
+	switch channel {
+	case ChannelSMS:
+		return func(raw string) (string, error) {
+			p, err := receiver.ParsePhone(raw)
+			if err != nil {
+				return "", err
+			}
+			return p.String(), nil
+		}
+	case ChannelEmail:
+		return receiver.NormalizeEmail
+	default:
+		return receiver.NormalizeUserID
+	}
+}
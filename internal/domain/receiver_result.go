@@ -0,0 +1,18 @@
+package domain
+
+// ReceiverResult 单个接收者的发送结果，按通知ID和接收者唯一，与通知落在同一个分库分表中
+type ReceiverResult struct {
+	NotificationID uint64
+	Receiver       string
+	Provider       string     // 最后一次发送使用的供应商，供应商没有返回逐个接收者的结果时为空
+	Status         SendStatus // SUCCEEDED 或者 FAILED
+	ErrCode        string     // 失败时供应商返回的错误码
+	ErrMessage     string
+	Final          bool // 换供应商也无法发送，渠道不再重试，只在平台内部使用
+	Ctime          int64
+	Utime          int64
+}
+
+func (r ReceiverResult) IsFailed() bool {
+	return r.Status == SendStatusFailed
+}
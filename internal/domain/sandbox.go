@@ -0,0 +1,54 @@
+package domain
+
+// CapturedMessage 沙箱供应商捕获的消息，保存渲染后的内容，供测试环境断言
+type CapturedMessage struct {
+	ID                string // 捕获ID，同一通知重试时每次发送都会捕获一条
+	BizID             int64
+	NotificationID    uint64
+	Key               string
+	Channel           Channel
+	Provider          string
+	Receivers         []string
+	TemplateID        int64
+	TemplateVersionID int64
+	Params            map[string]string
+	Subject           string // 渲染后的标题，短信没有标题
+	Content           string // 渲染后的内容
+	Status            SendStatus
+	ErrMessage        string // 模拟失败时的错误信息
+	Latency           int64  // 模拟的发送耗时，毫秒
+	Ctime             int64
+}
+
+// CapturedMessageQuery 沙箱消息查询条件，除 BizID 外为空表示不过滤
+type CapturedMessageQuery struct {
+	BizID          int64
+	NotificationID uint64
+	Key            string
+	Channel        Channel
+	Receiver       string
+	Offset         int
+	Limit          int
+}
+
+// Match 消息是否满足查询条件
+func (q CapturedMessageQuery) Match(msg CapturedMessage) bool {
+	if q.NotificationID != 0 && msg.NotificationID != q.NotificationID {
+		return false
+	}
+	if q.Key != "" && msg.Key != q.Key {
+		return false
+	}
+	if q.Channel != "" && msg.Channel != q.Channel {
+		return false
+	}
+	if q.Receiver == "" {
+		return true
+	}
+	for _, r := range msg.Receivers {
+		if r == q.Receiver {
+			return true
+		}
+	}
+	return false
+}
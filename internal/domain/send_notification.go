@@ -81,8 +81,35 @@ func (e SendStrategyConfig) Validate() error {
 
 // SendResponse 发送响应
 type SendResponse struct {
-	NotificationID uint64     // 通知ID
-	Status         SendStatus // 发送状态
+	NotificationID uint64        // 通知ID
+	Status         SendStatus    // 发送状态
+	Receipts       []SendReceipt // 供应商回执，只在平台内部使用
+	// DeliveredChannel 实际发送成功的渠道，只在平台内部使用
+	DeliveredChannel Channel
+	// ReceiverResults 每个接收者的发送结果，部分接收者失败时 Status 仍为 SUCCEEDED
+	ReceiverResults []ReceiverResult
+}
+
+// FailedReceivers 发送失败的接收者
+func (r SendResponse) FailedReceivers() []string {
+	var res []string
+	for i := range r.ReceiverResults {
+		if r.ReceiverResults[i].IsFailed() {
+			res = append(res, r.ReceiverResults[i].Receiver)
+		}
+	}
+	return res
+}
+
+// RetryableReceivers 发送失败并且可以换供应商重试的接收者
+func (r SendResponse) RetryableReceivers() []string {
+	var res []string
+	for i := range r.ReceiverResults {
+		if r.ReceiverResults[i].IsFailed() && !r.ReceiverResults[i].Final {
+			res = append(res, r.ReceiverResults[i].Receiver)
+		}
+	}
+	return res
 }
 
 // BatchSendResponse 批量发送响应
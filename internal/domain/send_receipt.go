@@ -0,0 +1,34 @@
+package domain
+
+// SendReceiptStatus 供应商回执状态
+type SendReceiptStatus string
+
+const (
+	SendReceiptStatusPending     SendReceiptStatus = "PENDING"     // 等待回执
+	SendReceiptStatusDelivered   SendReceiptStatus = "DELIVERED"   // 已送达
+	SendReceiptStatusUndelivered SendReceiptStatus = "UNDELIVERED" // 未送达
+)
+
+func (s SendReceiptStatus) String() string {
+	return string(s)
+}
+
+func (s SendReceiptStatus) IsPending() bool {
+	return s == SendReceiptStatusPending
+}
+
+// SendReceipt 供应商回执，供应商受理成功后为每个接收者记录一条，与通知落在同一个分库分表中
+type SendReceipt struct {
+	ID             int64
+	NotificationID uint64
+	Provider       string // 供应商名称
+	Receiver       string
+	RequestID      string // 供应商请求ID
+	ReceiptID      string // 供应商回执ID，阿里云为BizId，腾讯云为SerialNo
+	Status         SendReceiptStatus
+	ErrCode        string // 未送达时供应商返回的错误码
+	QueryCount     int    // 已查询次数
+	NextQueryTime  int64  // 下次查询时间
+	Ctime          int64  // 即发送时间
+	Utime          int64
+}
@@ -0,0 +1,51 @@
+package domain
+
+import "strings"
+
+// unsubscribeKeywords 运营商约定的退订回复
+var unsubscribeKeywords = []string{"TD", "T", "N", "退订"}
+
+// SmsReply 用户回复的上行短信
+type SmsReply struct {
+	ID int64
+	// BizID 根据接收者最近一次收到的短信关联到的业务方，关联不到时为0
+	BizID          int64
+	NotificationID uint64
+	Provider       string
+	PhoneNumber    string
+	Content        string
+	SignName       string
+	ExtendCode     string
+	ReplyTime      int64
+	Ctime          int64
+}
+
+// IsUnsubscribe 是否为退订回复
+func (r SmsReply) IsUnsubscribe() bool {
+	content := strings.TrimSpace(r.Content)
+	for _, keyword := range unsubscribeKeywords {
+		if strings.EqualFold(content, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+// SmsReplyQuery 上行短信游标分页查询条件
+type SmsReplyQuery struct {
+	BizID int64
+	// PhoneNumber 为空表示不限手机号
+	PhoneNumber string
+	// Unsubscribe 为 true 时只查询退订回复
+	Unsubscribe bool
+	// Cursor 上一页最后一条上行短信的ID，为0表示从最新的开始
+	Cursor int64
+	Limit  int
+}
+
+// SmsReplyPage 上行短信分页结果
+type SmsReplyPage struct {
+	Replies    []SmsReply
+	NextCursor int64
+	HasMore    bool
+}
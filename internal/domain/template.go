@@ -103,10 +103,15 @@ type ChannelTemplate struct {
 	Description     string       // 模板描述
 	Channel         Channel      // 渠道类型
 	BusinessType    BusinessType // 业务类型
+	International   bool         // 短信模版是否同时在供应商侧申请国际/港澳台模版，用于发送境外手机号
 	ActiveVersionID int64        // 活跃版本ID，0表示无活跃版本
+	ArchivedTime    int64        // 归档时间，0表示未归档
 	Ctime           int64        // 创建时间
 	Utime           int64        // 更新时间
 
+	// ActiveLocaleVersions 各语言的活跃版本ID，key 为语言，默认版本使用 ActiveVersionID
+	ActiveLocaleVersions map[string]int64
+
 	Versions []ChannelTemplateVersion // 关联的所有版本
 }
 
@@ -139,7 +144,25 @@ func (t *ChannelTemplate) Validate() error {
 
 // HasPublished 是否已发布
 func (t *ChannelTemplate) HasPublished() bool {
-	return t.ActiveVersionID != 0
+	return t.ActiveVersionID != 0 || len(t.ActiveLocaleVersions) > 0
+}
+
+// Archived 是否已归档，归档后只能查询，不能修改和发送
+func (t *ChannelTemplate) Archived() bool {
+	return t.ArchivedTime > 0
+}
+
+// IsActiveVersion 版本是否是默认语言或者某个语言的活跃版本
+func (t *ChannelTemplate) IsActiveVersion(versionID int64) bool {
+	if versionID == t.ActiveVersionID {
+		return true
+	}
+	for _, id := range t.ActiveLocaleVersions {
+		if id == versionID {
+			return true
+		}
+	}
+	return false
 }
 
 // ActiveVersion 获取当前活跃版本
@@ -156,6 +179,29 @@ func (t *ChannelTemplate) ActiveVersion() *ChannelTemplateVersion {
 	return nil
 }
 
+// ActiveVersionIDFor 按 LocaleChain 的顺序获取语言对应的活跃版本ID，0表示无活跃版本
+func (t *ChannelTemplate) ActiveVersionIDFor(locale string) int64 {
+	for _, l := range LocaleChain(locale) {
+		id := t.ActiveLocaleVersions[l]
+		if l == "" {
+			id = t.ActiveVersionID
+		}
+		if id != 0 {
+			return id
+		}
+	}
+	return 0
+}
+
+// ActiveVersionFor 获取语言对应的活跃版本，没有该语言的版本时按 LocaleChain 回退
+func (t *ChannelTemplate) ActiveVersionFor(locale string) *ChannelTemplateVersion {
+	id := t.ActiveVersionIDFor(locale)
+	if id == 0 {
+		return nil
+	}
+	return t.GetVersion(id)
+}
+
 // GetVersion 根据版本ID获取版本
 func (t *ChannelTemplate) GetVersion(versionID int64) *ChannelTemplateVersion {
 	for i := range t.Versions {
@@ -213,25 +259,86 @@ type ChannelTemplateVersion struct {
 	AuditStatus              AuditStatus // 审核状态
 	RejectReason             string      // 拒绝原因
 	LastReviewSubmissionTime int64       // 上次提交审核时间
+	ArchivedTime             int64       // 归档时间，0表示未归档
 	Ctime                    int64       // 创建时间
 	Utime                    int64       // 更新时间
 
+	// 邮件主题或站内信标题，支持${name}格式的变量
+	Subject string
+
+	// Locale 语言，如 zh-CN、en-US，为空表示默认版本，各语言的版本分别审核和发布
+	Locale string
+
+	// Params 参数定义，发送时按此校验参数，为空表示不校验
+	Params []TemplateParam
+
+	// 以下字段仅邮件渠道使用，Signature 作为发件人
+	ReplyTo     string            // 回复地址，为空表示不设置
+	Attachments []EmailAttachment // 附件
+
 	Providers []ChannelTemplateProvider // 关联的所有供应商
 }
 
+// Archived 是否已归档，归档后不能修改、提交审核和发布
+func (v *ChannelTemplateVersion) Archived() bool {
+	return v.ArchivedTime > 0
+}
+
+// EmailAttachment 邮件附件
+type EmailAttachment struct {
+	Filename    string `json:"filename"`    // 附件文件名
+	URL         string `json:"url"`         // 附件下载地址，发送时由平台拉取
+	ContentType string `json:"contentType"` // MIME类型，为空时根据文件名推断
+	// ContentID 不为空时作为内嵌资源发送，正文中使用 cid:ContentID 引用
+	ContentID string `json:"contentId"`
+}
+
+func (a EmailAttachment) IsInline() bool {
+	return a.ContentID != ""
+}
+
+// ProviderReviewResult 单个版本提交供应商审核的结果
+// Err 为 nil 表示已提交，审核前检查发现明确违规时包含 *ModerationRejectedError，可以用 errors.As 获取
+type ProviderReviewResult struct {
+	VersionID int64
+	Err       error
+}
+
+// TemplateRegion 供应商侧模版的适用地区，腾讯云和阿里云的境外手机号需要使用国际/港澳台短信模版
+type TemplateRegion string
+
+const (
+	TemplateRegionDomestic      TemplateRegion = "DOMESTIC"      // 国内
+	TemplateRegionInternational TemplateRegion = "INTERNATIONAL" // 国际/港澳台
+)
+
+func (r TemplateRegion) String() string {
+	return string(r)
+}
+
+func (r TemplateRegion) IsInternational() bool {
+	return r == TemplateRegionInternational
+}
+
 // ChannelTemplateProvider 渠道模板供应商关联
 type ChannelTemplateProvider struct {
-	ID                       int64       // 关联ID
-	TemplateID               int64       // 模板ID
-	TemplateVersionID        int64       // 模版版本ID
-	ProviderID               int64       // 供应商ID
-	ProviderName             string      // 供应商名称
-	ProviderChannel          Channel     // 供应商渠道类型
-	RequestID                string      // 审核请求ID
-	ProviderTemplateID       string      // 供应商侧模板ID
-	AuditStatus              AuditStatus // 审核状态
-	RejectReason             string      // 拒绝原因
-	LastReviewSubmissionTime int64       // 上次提交审核时间
-	Ctime                    int64       // 创建时间
-	Utime                    int64       // 更新时间
+	ID                       int64          // 关联ID
+	TemplateID               int64          // 模板ID
+	TemplateVersionID        int64          // 模版版本ID
+	ProviderID               int64          // 供应商ID
+	ProviderName             string         // 供应商名称
+	ProviderChannel          Channel        // 供应商渠道类型
+	Region                   TemplateRegion // 适用地区，同一供应商的国内和国际/港澳台模版各一条关联
+	RequestID                string         // 审核请求ID
+	ProviderTemplateID       string         // 供应商侧模板ID
+	AuditStatus              AuditStatus    // 审核状态
+	RejectReason             string         // 拒绝原因
+	LastReviewSubmissionTime int64          // 上次提交审核时间
+	Ctime                    int64          // 创建时间
+	Utime                    int64          // 更新时间
+}
+
+// IsSandbox 沙箱供应商的关联，不需要提交供应商审核
+func (p ChannelTemplateProvider) IsSandbox() bool {
+	return p.ProviderName == SandboxProviderName
 }
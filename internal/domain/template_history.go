@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"slices"
+
+	"gitee.com/flycash/notification-platform/internal/pkg/diff"
+)
+
+// TemplatePublishAction 模版发布操作类型
+type TemplatePublishAction string
+
+const (
+	TemplatePublishActionPublish  TemplatePublishAction = "PUBLISH"  // 发布
+	TemplatePublishActionRollback TemplatePublishAction = "ROLLBACK" // 回滚
+)
+
+func (a TemplatePublishAction) String() string {
+	return string(a)
+}
+
+// TemplatePublishRecord 模版发布记录，每次修改活跃版本都会记录
+type TemplatePublishRecord struct {
+	ID                int64
+	TemplateID        int64
+	VersionID         int64 // 发布的版本ID
+	PreviousVersionID int64 // 发布前的活跃版本ID，0表示首次发布
+	OperatorID        int64 // 操作人ID
+	Action            TemplatePublishAction
+	Locale            string // 发布的版本的语言，为空表示默认版本
+	Ctime             int64
+}
+
+// TemplateFieldChange 版本字段的变化
+type TemplateFieldChange struct {
+	Field string
+	From  string
+	To    string
+}
+
+// TemplateParamChange 参数定义的变化，From 为 nil 表示新增，To 为 nil 表示删除
+type TemplateParamChange struct {
+	Name string
+	From *TemplateParam
+	To   *TemplateParam
+}
+
+// TemplateVersionDiff 两个版本之间的差异
+type TemplateVersionDiff struct {
+	TemplateID    int64
+	FromVersionID int64
+	ToVersionID   int64
+	// Fields 名称、签名、标题、回复地址和语言的变化
+	Fields []TemplateFieldChange
+	// Content 内容逐行的变化，内容没有变化时为空
+	Content []diff.Line
+	Params  []TemplateParamChange
+}
+
+// Changed 两个版本是否有差异
+func (d TemplateVersionDiff) Changed() bool {
+	return len(d.Fields) > 0 || len(d.Content) > 0 || len(d.Params) > 0
+}
+
+// DiffTemplateVersions 比较同一模版的两个版本
+func DiffTemplateVersions(from, to ChannelTemplateVersion) TemplateVersionDiff {
+	res := TemplateVersionDiff{
+		TemplateID:    to.ChannelTemplateID,
+		FromVersionID: from.ID,
+		ToVersionID:   to.ID,
+		Fields:        make([]TemplateFieldChange, 0),
+		Params:        make([]TemplateParamChange, 0),
+	}
+	for _, f := range []TemplateFieldChange{
+		{Field: "name", From: from.Name, To: to.Name},
+		{Field: "signature", From: from.Signature, To: to.Signature},
+		{Field: "subject", From: from.Subject, To: to.Subject},
+		{Field: "replyTo", From: from.ReplyTo, To: to.ReplyTo},
+		{Field: "locale", From: from.Locale, To: to.Locale},
+	} {
+		if f.From != f.To {
+			res.Fields = append(res.Fields, f)
+		}
+	}
+	if from.Content != to.Content {
+		res.Content = diff.Lines(from.Content, to.Content)
+	}
+
+	// 先按原版本的顺序列出修改和删除的参数，再列出新增的参数
+	for i := range from.Params {
+		idx := slices.IndexFunc(to.Params, func(p TemplateParam) bool { return p.Name == from.Params[i].Name })
+		switch {
+		case idx < 0:
+			res.Params = append(res.Params, TemplateParamChange{Name: from.Params[i].Name, From: &from.Params[i]})
+		case from.Params[i] != to.Params[idx]:
+			res.Params = append(res.Params, TemplateParamChange{Name: from.Params[i].Name, From: &from.Params[i], To: &to.Params[idx]})
+		}
+	}
+	for i := range to.Params {
+		if !slices.ContainsFunc(from.Params, func(p TemplateParam) bool { return p.Name == to.Params[i].Name }) {
+			res.Params = append(res.Params, TemplateParamChange{Name: to.Params[i].Name, To: &to.Params[i]})
+		}
+	}
+	return res
+}
@@ -0,0 +1,81 @@
+//go:build unit
+
+package domain
+
+import (
+	"testing"
+
+	"gitee.com/flycash/notification-platform/internal/pkg/diff"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffTemplateVersions(t *testing.T) {
+	t.Parallel()
+
+	code := TemplateParam{Name: "code", Type: TemplateParamTypeString, Required: true}
+	codeV2 := TemplateParam{Name: "code", Type: TemplateParamTypeString, Required: true, MaxLength: 6}
+	name := TemplateParam{Name: "name", Type: TemplateParamTypeString, Required: true}
+	minutes := TemplateParam{Name: "minutes", Type: TemplateParamTypeInteger}
+
+	testCases := []struct {
+		name string
+		from ChannelTemplateVersion
+		to   ChannelTemplateVersion
+		want TemplateVersionDiff
+	}{
+		{
+			name: "没有差异",
+			from: ChannelTemplateVersion{ID: 1, ChannelTemplateID: 10, Signature: "签名", Content: "内容", Params: []TemplateParam{code}},
+			to:   ChannelTemplateVersion{ID: 2, ChannelTemplateID: 10, Signature: "签名", Content: "内容", Params: []TemplateParam{code}},
+			want: TemplateVersionDiff{
+				TemplateID:    10,
+				FromVersionID: 1,
+				ToVersionID:   2,
+				Fields:        []TemplateFieldChange{},
+				Params:        []TemplateParamChange{},
+			},
+		},
+		{
+			name: "签名、内容和参数都有变化",
+			from: ChannelTemplateVersion{
+				ID:                1,
+				ChannelTemplateID: 10,
+				Signature:         "旧签名",
+				Content:           "${name}您好\n验证码${code}",
+				Params:            []TemplateParam{name, code},
+			},
+			to: ChannelTemplateVersion{
+				ID:                2,
+				ChannelTemplateID: 10,
+				Signature:         "新签名",
+				Content:           "验证码${code}\n${minutes}分钟内有效",
+				Params:            []TemplateParam{codeV2, minutes},
+			},
+			want: TemplateVersionDiff{
+				TemplateID:    10,
+				FromVersionID: 1,
+				ToVersionID:   2,
+				Fields:        []TemplateFieldChange{{Field: "signature", From: "旧签名", To: "新签名"}},
+				Content: []diff.Line{
+					{Op: diff.OpDelete, Text: "${name}您好"},
+					{Op: diff.OpEqual, Text: "验证码${code}"},
+					{Op: diff.OpInsert, Text: "${minutes}分钟内有效"},
+				},
+				Params: []TemplateParamChange{
+					{Name: "name", From: &name},
+					{Name: "code", From: &code, To: &codeV2},
+					{Name: "minutes", To: &minutes},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := DiffTemplateVersions(tc.from, tc.to)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, len(tc.want.Fields)+len(tc.want.Content)+len(tc.want.Params) > 0, got.Changed())
+		})
+	}
+}
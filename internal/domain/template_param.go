@@ -0,0 +1,172 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"gitee.com/flycash/notification-platform/internal/errs"
+)
+
+// TemplateParamType 模版参数类型，参数值都是字符串，类型只用于校验
+type TemplateParamType string
+
+const (
+	TemplateParamTypeString  TemplateParamType = "string"
+	TemplateParamTypeInteger TemplateParamType = "integer"
+	TemplateParamTypeNumber  TemplateParamType = "number"
+	// TemplateParamTypeDate 秒级或者毫秒级时间戳、RFC3339、2006-01-02 15:04:05 或者 2006-01-02
+	TemplateParamTypeDate TemplateParamType = "date"
+)
+
+func (t TemplateParamType) IsValid() bool {
+	switch t {
+	case TemplateParamTypeString, TemplateParamTypeInteger, TemplateParamTypeNumber, TemplateParamTypeDate:
+		return true
+	default:
+		return false
+	}
+}
+
+// TemplateParam 模版参数定义
+type TemplateParam struct {
+	Name      string            `json:"name"`
+	Type      TemplateParamType `json:"type"`
+	Required  bool              `json:"required"`
+	MaxLength int               `json:"maxLength"` // 最大字符数，0表示不限制
+	Pattern   string            `json:"pattern"`   // 正则表达式，为空表示不限制，需要完整匹配
+}
+
+// Validate 校验参数定义本身
+func (p TemplateParam) Validate() error {
+	if strings.TrimSpace(p.Name) == "" {
+		return fmt.Errorf("%w: 模版参数名称不能为空", errs.ErrInvalidParameter)
+	}
+	if !p.Type.IsValid() {
+		return fmt.Errorf("%w: 模版参数 %s 的类型 %q", errs.ErrInvalidParameter, p.Name, p.Type)
+	}
+	if p.MaxLength < 0 {
+		return fmt.Errorf("%w: 模版参数 %s 的最大长度 %d", errs.ErrInvalidParameter, p.Name, p.MaxLength)
+	}
+	if _, err := compilePattern(p.Pattern); err != nil {
+		return fmt.Errorf("%w: 模版参数 %s 的正则表达式 %w", errs.ErrInvalidParameter, p.Name, err)
+	}
+	return nil
+}
+
+// check 校验参数值，返回不合法的原因
+func (p TemplateParam) check(value string) string {
+	if p.MaxLength > 0 && utf8.RuneCountInString(value) > p.MaxLength {
+		return fmt.Sprintf("%s 超过 %d 个字符", p.Name, p.MaxLength)
+	}
+	if !p.matchType(value) {
+		return fmt.Sprintf("%s 不是 %s 类型", p.Name, p.Type)
+	}
+	if p.Pattern != "" {
+		re, err := compilePattern(p.Pattern)
+		if err != nil || !re.MatchString(value) {
+			return fmt.Sprintf("%s 不匹配 %s", p.Name, p.Pattern)
+		}
+	}
+	return ""
+}
+
+func (p TemplateParam) matchType(value string) bool {
+	switch p.Type {
+	case TemplateParamTypeInteger:
+		_, err := strconv.ParseInt(value, 10, 64)
+		return err == nil
+	case TemplateParamTypeNumber:
+		_, err := strconv.ParseFloat(value, 64)
+		return err == nil
+	case TemplateParamTypeDate:
+		if _, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return true
+		}
+		for _, layout := range []string{time.RFC3339, time.DateTime, time.DateOnly} {
+			if _, err := time.Parse(layout, value); err == nil {
+				return true
+			}
+		}
+		return false
+	default:
+		return true
+	}
+}
+
+// patterns 编译后的正则表达式，发送时每条通知都要校验
+var patterns sync.Map
+
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+	if re, ok := patterns.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile(`^(?:` + pattern + `)$`)
+	if err != nil {
+		return nil, err
+	}
+	patterns.Store(pattern, re)
+	return re, nil
+}
+
+// MergeTemplateParams 合并声明的参数和从模版内容中提取的参数名，未声明的参数为必填的字符串
+func MergeTemplateParams(declared []TemplateParam, extracted []string) []TemplateParam {
+	res := slices.Clone(declared)
+	for _, name := range extracted {
+		if slices.ContainsFunc(res, func(p TemplateParam) bool { return p.Name == name }) {
+			continue
+		}
+		res = append(res, TemplateParam{
+			Name:     name,
+			Type:     TemplateParamTypeString,
+			Required: true,
+		})
+	}
+	return res
+}
+
+// ValidateParams 按版本的参数定义校验发送时的参数，没有参数定义的版本不校验
+// 返回的错误中列出全部缺失、多余和不合法的参数
+func (v *ChannelTemplateVersion) ValidateParams(params map[string]string) error {
+	if len(v.Params) == 0 {
+		return nil
+	}
+	var problems []string
+	declared := make(map[string]struct{}, len(v.Params))
+	for _, p := range v.Params {
+		declared[p.Name] = struct{}{}
+		value, ok := params[p.Name]
+		if !ok || value == "" {
+			if p.Required {
+				problems = append(problems, "缺少 "+p.Name)
+			}
+			continue
+		}
+		if problem := p.check(value); problem != "" {
+			problems = append(problems, problem)
+		}
+	}
+	unknown := make([]string, 0)
+	for name := range params {
+		if _, ok := declared[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+	slices.Sort(unknown)
+	for _, name := range unknown {
+		problems = append(problems, "未定义 "+name)
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %w: 模版版本 %d, %s",
+			errs.ErrInvalidParameter, errs.ErrInvalidTemplateParams, v.ID, strings.Join(problems, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+//go:build unit
+
+package domain
+
+import (
+	"testing"
+
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplateParam_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		param   TemplateParam
+		wantErr error
+	}{
+		{
+			name:  "合法",
+			param: TemplateParam{Name: "code", Type: TemplateParamTypeString, MaxLength: 6, Pattern: `\d+`},
+		},
+		{
+			name:    "名称为空",
+			param:   TemplateParam{Type: TemplateParamTypeString},
+			wantErr: errs.ErrInvalidParameter,
+		},
+		{
+			name:    "类型不合法",
+			param:   TemplateParam{Name: "code", Type: "bool"},
+			wantErr: errs.ErrInvalidParameter,
+		},
+		{
+			name:    "最大长度为负数",
+			param:   TemplateParam{Name: "code", Type: TemplateParamTypeString, MaxLength: -1},
+			wantErr: errs.ErrInvalidParameter,
+		},
+		{
+			name:    "正则表达式不合法",
+			param:   TemplateParam{Name: "code", Type: TemplateParamTypeString, Pattern: `(\d+`},
+			wantErr: errs.ErrInvalidParameter,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.ErrorIs(t, tc.param.Validate(), tc.wantErr)
+		})
+	}
+}
+
+func TestMergeTemplateParams(t *testing.T) {
+	t.Parallel()
+
+	declared := []TemplateParam{{Name: "code", Type: TemplateParamTypeInteger, Required: true, MaxLength: 6}}
+	got := MergeTemplateParams(declared, []string{"code", "minutes"})
+	assert.Equal(t, []TemplateParam{
+		{Name: "code", Type: TemplateParamTypeInteger, Required: true, MaxLength: 6},
+		{Name: "minutes", Type: TemplateParamTypeString, Required: true},
+	}, got)
+	assert.Len(t, declared, 1)
+}
+
+func TestChannelTemplateVersion_ValidateParams(t *testing.T) {
+	t.Parallel()
+
+	version := &ChannelTemplateVersion{
+		ID: 1,
+		Params: []TemplateParam{
+			{Name: "code", Type: TemplateParamTypeString, Required: true, MaxLength: 6, Pattern: `\d+`},
+			{Name: "minutes", Type: TemplateParamTypeInteger},
+			{Name: "amount", Type: TemplateParamTypeNumber},
+			{Name: "expireAt", Type: TemplateParamTypeDate},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		version  *ChannelTemplateVersion
+		params   map[string]string
+		wantErr  bool
+		problems []string
+	}{
+		{
+			name:    "合法",
+			version: version,
+			params: map[string]string{
+				"code":     "123456",
+				"minutes":  "5",
+				"amount":   "12.5",
+				"expireAt": "2025-01-02 15:04:05",
+			},
+		},
+		{
+			name:    "可选参数可以不传",
+			version: version,
+			params:  map[string]string{"code": "123456"},
+		},
+		{
+			name:    "没有参数定义不校验",
+			version: &ChannelTemplateVersion{ID: 2},
+			params:  map[string]string{"anything": "value"},
+		},
+		{
+			name:    "缺少必填参数",
+			version: version,
+			params:  map[string]string{"minutes": "5"},
+			wantErr: true,
+			problems: []string{
+				"缺少 code",
+			},
+		},
+		{
+			name:    "全部问题",
+			version: version,
+			params: map[string]string{
+				"code":     "1234567",
+				"minutes":  "five",
+				"amount":   "12.5.1",
+				"expireAt": "tomorrow",
+				"b":        "1",
+				"a":        "1",
+			},
+			wantErr: true,
+			problems: []string{
+				"code 超过 6 个字符",
+				"minutes 不是 integer 类型",
+				"amount 不是 number 类型",
+				"expireAt 不是 date 类型",
+				"未定义 a; 未定义 b",
+			},
+		},
+		{
+			name:     "不匹配正则表达式",
+			version:  version,
+			params:   map[string]string{"code": "12ab"},
+			wantErr:  true,
+			problems: []string{`code 不匹配 \d+`},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.version.ValidateParams(tc.params)
+			if !tc.wantErr {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, errs.ErrInvalidParameter)
+			require.ErrorIs(t, err, errs.ErrInvalidTemplateParams)
+			for _, problem := range tc.problems {
+				assert.Contains(t, err.Error(), problem)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ var (
 	ErrTemplateVersionNotApprovedByPlatform = errors.New("模板版本未被内部审核通过")
 	ErrTemplateVersionNotApprovedByProvider = errors.New("模板版本未被供应商审核通过")
 	ErrTemplateAndVersionMisMatch           = errors.New("模板和版本不匹配")
+	ErrNoInternationalTemplate              = errors.New("没有通过供应商审核的国际/港澳台短信模版")
 	ErrChannelDisabled                      = errors.New("渠道已禁用")
 	ErrRateLimited                          = errors.New("请求频率受限")
 	ErrCircuitBreaker                       = errors.New("服务熔断，请稍后重试")
@@ -27,8 +28,14 @@ var (
 	ErrNoQuota                              = errors.New("额度已经用完")
 	ErrQuotaNotFound                        = errors.New("额度记录不存在")
 	ErrProviderNotFound                     = errors.New("供应商记录不存在")
+	ErrProviderSecret                       = errors.New("供应商密钥加解密失败")
+	ErrUnsupportedProvider                  = errors.New("不支持的供应商")
+	ErrSendReceiptNotFound                  = errors.New("供应商回执不存在")
 	ErrUnknownChannel                       = errors.New("未知渠道类型")
 	ErrInvalidOperation                     = errors.New("无效的操作")
+	ErrCapturedMessageNotFound              = errors.New("沙箱消息不存在")
+	ErrAuditNotFound                        = errors.New("审核记录不存在")
+	ErrInvalidReviewerToken                 = errors.New("审核人令牌无效")
 
 	ErrCreateTemplateFailed                    = errors.New("创建模版失败")
 	ErrUpdateTemplateFailed                    = errors.New("更新模版失败")
@@ -38,6 +45,11 @@ var (
 	ErrUpdateTemplateProviderAuditStatusFailed = errors.New("更新渠道供应商审核状态失败")
 	ErrSubmitVersionForInternalReviewFailed    = errors.New("提交模版版本内部审核失败")
 	ErrSubmitVersionForProviderReviewFailed    = errors.New("提交模版版本供应商审核失败")
+	ErrRenderTemplateFailed                    = errors.New("渲染模版失败")
+	ErrInvalidTemplateParams                   = errors.New("模版参数不合法")
+	ErrTemplateContentRejected                 = errors.New("模版内容未通过审核前检查")
+	ErrTemplateArchived                        = errors.New("模版已归档")
+	ErrTemplateInUse                           = errors.New("模版正在被通知使用")
 
 	ErrNoAvailableFailoverService = errors.New("没有需要接管的故障服务")
 
@@ -140,13 +140,14 @@ collectBatch:
 		return err
 	}
 
-	err = c.svc.BatchSubmitForProviderReview(ctx, versionIDs)
-	if err != nil {
-		c.logger.Warn("内部审核通过的模版，提交到供应商侧审核失败",
-			elog.FieldErr(err),
-			elog.Any("versionIDs", versionIDs),
-		)
-		return err
+	// 提交失败的版本可以在管理后台重新提交，不阻塞消费
+	for _, res := range c.svc.BatchSubmitForProviderReview(ctx, versionIDs) {
+		if res.Err != nil {
+			c.logger.Warn("内部审核通过的模版，提交到供应商侧审核失败",
+				elog.FieldErr(res.Err),
+				elog.Int64("versionID", res.VersionID),
+			)
+		}
 	}
 
 	// 只提交每个分区的最后一条消息，这也就假定当前消费者只消费一个分区
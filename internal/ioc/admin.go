@@ -0,0 +1,42 @@
+package ioc
+
+import (
+	"errors"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/service/provider/manage"
+	"gitee.com/flycash/notification-platform/internal/service/provider/pricing"
+	"gitee.com/flycash/notification-platform/internal/service/provider/registry"
+	"gitee.com/flycash/notification-platform/internal/service/provider/testsend"
+	providerweb "gitee.com/flycash/notification-platform/internal/web/provider"
+	"github.com/gotomicro/ego/core/econf"
+)
+
+// loadAdminToken HTTP 管理接口的访问令牌，未配置时不开放管理接口
+func loadAdminToken() string {
+	type Config struct {
+		Token string `yaml:"token"`
+	}
+	var cfg Config
+	if err := econf.UnmarshalKey("admin", &cfg); err != nil && !errors.Is(err, econf.ErrInvalidKey) {
+		panic(err)
+	}
+	return cfg.Token
+}
+
+// InitProviderTestSendService 测试发送使用 provider.testSend.templates 中各渠道的测试模版
+func InitProviderTestSendService(providerSvc manage.Service, reg *registry.Registry) testsend.Service {
+	type Config struct {
+		Templates map[domain.Channel]testsend.Template `yaml:"templates"`
+	}
+	var cfg Config
+	if err := econf.UnmarshalKey("provider.testSend", &cfg); err != nil && !errors.Is(err, econf.ErrInvalidKey) {
+		panic(err)
+	}
+	return testsend.NewService(providerSvc, reg, cfg.Templates)
+}
+
+// InitProviderHandler 供应商管理接口
+func InitProviderHandler(providerSvc manage.Service, testSendSvc testsend.Service, pricingSvc pricing.Service) *providerweb.Handler {
+	return providerweb.NewHandler(providerSvc, testSendSvc, pricingSvc, loadAdminToken())
+}
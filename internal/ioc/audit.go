@@ -0,0 +1,65 @@
+package ioc
+
+import (
+	"errors"
+	"time"
+
+	auditevt "gitee.com/flycash/notification-platform/internal/event/audit"
+	templateevt "gitee.com/flycash/notification-platform/internal/event/template"
+	"gitee.com/flycash/notification-platform/internal/repository"
+	"gitee.com/flycash/notification-platform/internal/service/audit"
+	templatesvc "gitee.com/flycash/notification-platform/internal/service/template/manage"
+	auditweb "gitee.com/flycash/notification-platform/internal/web/audit"
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/gotomicro/ego/core/econf"
+)
+
+// InitAuditService 审核时限等配置在 audit 下，未配置时使用默认值
+func InitAuditService(repo repository.AuditRepository, producer *kafka.Producer) audit.Service {
+	var cfg audit.Config
+	if err := econf.UnmarshalKey("audit", &cfg); err != nil && !errors.Is(err, econf.ErrInvalidKey) {
+		panic(err)
+	}
+	p, err := auditevt.NewResultCallbackEventProducer(producer)
+	if err != nil {
+		panic(err)
+	}
+	return audit.NewService(repo, p, cfg)
+}
+
+// InitAuditResultConsumer 消费审核结果，更新模版版本的内部审核状态并提交供应商审核
+func InitAuditResultConsumer(svc templatesvc.ChannelTemplateService) *templateevt.AuditResultConsumer {
+	type Config struct {
+		GroupID      string        `yaml:"groupId"`
+		BatchSize    int           `yaml:"batchSize"`
+		BatchTimeout time.Duration `yaml:"batchTimeout"`
+	}
+	cfg := Config{
+		GroupID:      "notification-platform-audit-result",
+		BatchSize:    10,
+		BatchTimeout: 5 * time.Second,
+	}
+	// 未配置时使用默认值
+	if err := econf.UnmarshalKey("audit.resultConsumer", &cfg); err != nil && !errors.Is(err, econf.ErrInvalidKey) {
+		panic(err)
+	}
+	consumer, err := kafka.NewConsumer(&kafka.ConfigMap{
+		"bootstrap.servers":  loadKafkaConfig().Addr,
+		"auto.offset.reset":  "earliest",
+		"group.id":           cfg.GroupID,
+		"enable.auto.commit": "false",
+	})
+	if err != nil {
+		panic(err)
+	}
+	c, err := templateevt.NewAuditResultConsumer(svc, consumer, cfg.BatchSize, cfg.BatchTimeout)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// InitAuditHandler 审核人处理审核以及管理审核人的接口
+func InitAuditHandler(svc audit.Service) *auditweb.Handler {
+	return auditweb.NewHandler(svc, loadAdminToken())
+}
@@ -0,0 +1,30 @@
+package ioc
+
+import (
+	auditweb "gitee.com/flycash/notification-platform/internal/web/audit"
+	"gitee.com/flycash/notification-platform/internal/web/callback"
+	pluginweb "gitee.com/flycash/notification-platform/internal/web/plugin"
+	providerweb "gitee.com/flycash/notification-platform/internal/web/provider"
+	sandboxweb "gitee.com/flycash/notification-platform/internal/web/sandbox"
+	templateweb "gitee.com/flycash/notification-platform/internal/web/template"
+	"github.com/gotomicro/ego/server/egin"
+)
+
+// InitGinServer 初始化 HTTP 服务，用于接收供应商推送，以及渠道插件、供应商、沙箱消息、内部审核和模版的管理接口
+func InitGinServer(callbackHdl *callback.Handler,
+	pluginHdl *pluginweb.Handler,
+	providerHdl *providerweb.Handler,
+	sandboxHdl *sandboxweb.Handler,
+	auditHdl *auditweb.Handler,
+	templateHdl *templateweb.Handler,
+) *egin.Component {
+	server := egin.Load("server.http").Build()
+	callbackHdl.PublicRoutes(server.Engine)
+	pluginHdl.PrivateRoutes(server.Engine)
+	providerHdl.PrivateRoutes(server.Engine)
+	sandboxHdl.PrivateRoutes(server.Engine)
+	auditHdl.PrivateRoutes(server.Engine)
+	templateHdl.PublicRoutes(server.Engine)
+	templateHdl.PrivateRoutes(server.Engine)
+	return server
+}
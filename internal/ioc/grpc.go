@@ -1,7 +1,12 @@
 package ioc
 
 import (
+	inboxv1 "gitee.com/flycash/notification-platform/api/proto/gen/inbox/v1"
 	notificationv1 "gitee.com/flycash/notification-platform/api/proto/gen/notification/v1"
+	providerv1 "gitee.com/flycash/notification-platform/api/proto/gen/provider/v1"
+	replyv1 "gitee.com/flycash/notification-platform/api/proto/gen/reply/v1"
+	sandboxv1 "gitee.com/flycash/notification-platform/api/proto/gen/sandbox/v1"
+	templatev1 "gitee.com/flycash/notification-platform/api/proto/gen/template/v1"
 	grpcapi "gitee.com/flycash/notification-platform/internal/api/grpc"
 	"gitee.com/flycash/notification-platform/internal/api/grpc/interceptor/jwt"
 	"gitee.com/flycash/notification-platform/internal/api/grpc/interceptor/log"
@@ -14,7 +19,14 @@ import (
 	"github.com/gotomicro/ego/server/egrpc"
 )
 
-func InitGrpc(noserver *grpcapi.NotificationServer, etcdClient *eetcd.Component) *egrpc.Component {
+func InitGrpc(noserver *grpcapi.NotificationServer,
+	inboxServer *grpcapi.InboxServer,
+	replyServer *grpcapi.SmsReplyServer,
+	providerServer *grpcapi.ProviderServer,
+	sandboxServer *grpcapi.SandboxServer,
+	templateServer *grpcapi.TemplateServer,
+	etcdClient *eetcd.Component,
+) *egrpc.Component {
 	// 注册全局的注册中心
 	type Config struct {
 		Key string `yaml:"key"`
@@ -36,12 +48,21 @@ func InitGrpc(noserver *grpcapi.NotificationServer, etcdClient *eetcd.Component)
 
 	traceInterceptor := tracing.UnaryServerInterceptor()
 	jwtinterceter := jwt.NewJwtAuth(cfg.Key)
+	// 供应商管理接口只允许管理员调用
+	adminInterceptor := jwt.AdminOnlyInterceptor(providerv1.ProviderService_ServiceDesc.ServiceName)
 	server := egrpc.Load("server.grpc").Build(
-		egrpc.WithUnaryInterceptor(metricsInterceptor, logInterceptor, traceInterceptor, jwtinterceter.JwtAuthInterceptor()),
+		egrpc.WithUnaryInterceptor(metricsInterceptor, logInterceptor, traceInterceptor, jwtinterceter.JwtAuthInterceptor(), adminInterceptor),
 	)
 
 	notificationv1.RegisterNotificationServiceServer(server.Server, noserver)
 	notificationv1.RegisterNotificationQueryServiceServer(server.Server, noserver)
+	inboxv1.RegisterInboxServiceServer(server.Server, inboxServer)
+	replyv1.RegisterSmsReplyServiceServer(server.Server, replyServer)
+	providerv1.RegisterProviderServiceServer(server.Server, providerServer)
+	templatev1.RegisterTemplateServiceServer(server.Server, templateServer)
+	if sandboxServer.Enabled() {
+		sandboxv1.RegisterSandboxServiceServer(server.Server, sandboxServer)
+	}
 
 	return server
 }
@@ -0,0 +1,14 @@
+package ioc
+
+import (
+	idgen "gitee.com/flycash/notification-platform/internal/pkg/id_generator"
+	"gitee.com/flycash/notification-platform/internal/repository/dao"
+	shardingdao "gitee.com/flycash/notification-platform/internal/repository/dao/sharding"
+	"github.com/ego-component/egorm"
+)
+
+// InitInboxDAO 初始化站内信分库分表存储
+func InitInboxDAO(db *egorm.Component) dao.InboxDAO {
+	dbs, strategy := initSharding(db, "inbox.sharding", &dao.InboxMessage{})
+	return shardingdao.NewInboxShardingDAO(dbs, strategy, idgen.NewGenerator())
+}
@@ -0,0 +1,32 @@
+package ioc
+
+import (
+	"errors"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/gotomicro/ego/core/econf"
+)
+
+type kafkaConfig struct {
+	Addr string `yaml:"addr"`
+}
+
+func loadKafkaConfig() kafkaConfig {
+	cfg := kafkaConfig{Addr: "localhost:9092"}
+	// 未配置时使用默认值
+	if err := econf.UnmarshalKey("kafka", &cfg); err != nil && !errors.Is(err, econf.ErrInvalidKey) {
+		panic(err)
+	}
+	return cfg
+}
+
+// InitKafkaProducer 所有事件共用一个生产者
+func InitKafkaProducer() *kafka.Producer {
+	producer, err := kafka.NewProducer(&kafka.ConfigMap{
+		"bootstrap.servers": loadKafkaConfig().Addr,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return producer
+}
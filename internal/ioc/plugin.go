@@ -0,0 +1,42 @@
+package ioc
+
+import (
+	"errors"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/service/channel/plugin"
+	pluginweb "gitee.com/flycash/notification-platform/internal/web/plugin"
+	"github.com/ego-component/eetcd"
+	"github.com/gotomicro/ego/core/econf"
+)
+
+type channelPluginConfig struct {
+	Dir          string        `yaml:"dir"`
+	CheckTimeout time.Duration `yaml:"checkTimeout"`
+	EtcdPrefix   string        `yaml:"etcdPrefix"`
+}
+
+func loadChannelPluginConfig() channelPluginConfig {
+	var cfg channelPluginConfig
+	// 未配置时使用默认值
+	if err := econf.UnmarshalKey("channel.plugin", &cfg); err != nil && !errors.Is(err, econf.ErrInvalidKey) {
+		panic(err)
+	}
+	return cfg
+}
+
+// InitChannelPluginManager 渠道插件按版本存放在 channel.plugin.dir 下
+func InitChannelPluginManager() *plugin.Manager {
+	cfg := loadChannelPluginConfig()
+	return plugin.NewManager(cfg.Dir, plugin.NewSOLoader(), cfg.CheckTimeout)
+}
+
+// InitChannelPluginSyncer 通过 etcd 在所有实例间同步渠道插件版本
+func InitChannelPluginSyncer(etcdClient *eetcd.Component, manager *plugin.Manager) *plugin.Syncer {
+	return plugin.NewSyncer(etcdClient, loadChannelPluginConfig().EtcdPrefix, manager)
+}
+
+// InitChannelPluginHandler 渠道插件管理接口
+func InitChannelPluginHandler(manager *plugin.Manager, syncer *plugin.Syncer) *pluginweb.Handler {
+	return pluginweb.NewHandler(manager, syncer, loadAdminToken())
+}
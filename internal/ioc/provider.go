@@ -1,16 +1,83 @@
 package ioc
 
-import "github.com/gotomicro/ego/core/econf"
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
 
-// InitProviderEncryptKey 提供provider服务所需的加密密钥
-func InitProviderEncryptKey() string {
+	"gitee.com/flycash/notification-platform/internal/pkg/secret"
+	"github.com/gotomicro/ego/core/econf"
+)
+
+// providerSecretKeysEnv 通过环境变量提供主密钥，格式为 "id=base64,id=base64"，优先于 provider.secret.keyFiles
+const providerSecretKeysEnv = "NOTIFICATION_PROVIDER_SECRET_KEYS"
+
+// InitProviderKeyring 提供加密供应商密钥所需的密钥环
+// 主密钥为 base64 编码的 32 字节随机数，不写在配置文件中，从环境变量或者 provider.secret.keyFiles 指定的文件中读取，
+// 新增密钥并修改 primaryKeyID 后运行 cmd/rotatesecret 完成轮换，
+// provider.key 是引入信封加密之前使用的密钥，只用于解密旧数据
+func InitProviderKeyring() *secret.Keyring {
 	type Config struct {
-		Key string `yaml:"key"`
+		Key    string `yaml:"key"`
+		Secret struct {
+			PrimaryKeyID string            `yaml:"primaryKeyID"`
+			KeyFiles     map[string]string `yaml:"keyFiles"`
+		} `yaml:"secret"`
 	}
 	var cfg Config
 	err := econf.UnmarshalKey("provider", &cfg)
 	if err != nil {
 		panic(err)
 	}
-	return cfg.Key
+	encoded, err := loadProviderSecretKeys(os.Getenv(providerSecretKeysEnv), cfg.Secret.KeyFiles)
+	if err != nil {
+		panic(err)
+	}
+	keys := make(map[string][]byte, len(encoded))
+	for id := range encoded {
+		key, err1 := base64.StdEncoding.DecodeString(encoded[id])
+		if err1 != nil {
+			panic(fmt.Errorf("供应商密钥 %s 不是合法的 base64 编码: %w", id, err1))
+		}
+		keys[id] = key
+	}
+	keyring, err := secret.NewKeyring(cfg.Secret.PrimaryKeyID, keys)
+	if err != nil {
+		panic(err)
+	}
+	if cfg.Key != "" {
+		keyring, err = keyring.WithLegacyKey(cfg.Key)
+		if err != nil {
+			panic(err)
+		}
+	}
+	return keyring
+}
+
+// loadProviderSecretKeys 环境变量中已经提供的主密钥不再读取文件
+func loadProviderSecretKeys(env string, keyFiles map[string]string) (map[string]string, error) {
+	keys := make(map[string]string, len(keyFiles))
+	for _, item := range strings.Split(env, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		id, key, ok := strings.Cut(item, "=")
+		if !ok || id == "" {
+			return nil, fmt.Errorf("环境变量 %s 格式错误，应为 id=base64,id=base64", providerSecretKeysEnv)
+		}
+		keys[id] = key
+	}
+	for id, path := range keyFiles {
+		if _, ok := keys[id]; ok || path == "" {
+			continue
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("读取供应商密钥 %s 失败: %w", id, err)
+		}
+		keys[id] = strings.TrimSpace(string(data))
+	}
+	return keys, nil
 }
@@ -0,0 +1,13 @@
+package ioc
+
+import (
+	"gitee.com/flycash/notification-platform/internal/repository/dao"
+	shardingdao "gitee.com/flycash/notification-platform/internal/repository/dao/sharding"
+	"github.com/ego-component/egorm"
+)
+
+// InitReceiverResultDAO 初始化接收者发送结果分库分表存储
+func InitReceiverResultDAO(db *egorm.Component) dao.ReceiverResultDAO {
+	dbs, strategy := initSharding(db, "receiverResult.sharding", &dao.ReceiverResult{})
+	return shardingdao.NewReceiverResultShardingDAO(dbs, strategy)
+}
@@ -0,0 +1,39 @@
+package ioc
+
+import (
+	"errors"
+
+	"gitee.com/flycash/notification-platform/internal/repository"
+	"gitee.com/flycash/notification-platform/internal/repository/cache"
+	"gitee.com/flycash/notification-platform/internal/repository/cache/local"
+	"gitee.com/flycash/notification-platform/internal/repository/cache/redis"
+	"gitee.com/flycash/notification-platform/internal/service/provider/sandbox"
+	sandboxweb "gitee.com/flycash/notification-platform/internal/web/sandbox"
+	"github.com/gotomicro/ego/core/econf"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// InitSandboxConfig 沙箱供应商配置，未配置时不开启
+func InitSandboxConfig() sandbox.Config {
+	var cfg sandbox.Config
+	if err := econf.UnmarshalKey("provider.sandbox", &cfg); err != nil && !errors.Is(err, econf.ErrInvalidKey) {
+		panic(err)
+	}
+	return cfg
+}
+
+// InitSandboxService store 为 memory 时消息保存在本机内存中，只适合单实例，默认保存在 Redis 中
+func InitSandboxService(cfg sandbox.Config, cmd goredis.Cmdable) sandbox.Service {
+	var c cache.SandboxCache
+	if cfg.Store == "memory" {
+		c = local.NewSandboxCache()
+	} else {
+		c = redis.NewSandboxCache(cmd)
+	}
+	return sandbox.NewService(repository.NewSandboxRepository(c), cfg)
+}
+
+// InitSandboxHandler 沙箱消息查询接口
+func InitSandboxHandler(svc sandbox.Service) *sandboxweb.Handler {
+	return sandboxweb.NewHandler(svc, loadAdminToken())
+}
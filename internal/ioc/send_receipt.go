@@ -0,0 +1,81 @@
+package ioc
+
+import (
+	"errors"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/pkg/loopjob"
+	"gitee.com/flycash/notification-platform/internal/pkg/retry"
+	"gitee.com/flycash/notification-platform/internal/pkg/sharding"
+	"gitee.com/flycash/notification-platform/internal/repository"
+	"gitee.com/flycash/notification-platform/internal/repository/dao"
+	shardingdao "gitee.com/flycash/notification-platform/internal/repository/dao/sharding"
+	"gitee.com/flycash/notification-platform/internal/service/provider/sms/client"
+	"gitee.com/flycash/notification-platform/internal/service/receipt"
+	"github.com/ecodeclub/ekit/syncx"
+	"github.com/ego-component/egorm"
+	"github.com/gotomicro/ego/core/econf"
+	"github.com/meoying/dlock-go"
+)
+
+// SendReceiptSharding 供应商回执的分库分表，存储和对账任务共用
+type SendReceiptSharding struct {
+	DBs      *syncx.Map[string, *egorm.Component]
+	Strategy sharding.ShardingStrategy
+}
+
+func InitSendReceiptSharding(db *egorm.Component) SendReceiptSharding {
+	dbs, strategy := initSharding(db, "receipt.sharding", &dao.SendReceipt{})
+	return SendReceiptSharding{DBs: dbs, Strategy: strategy}
+}
+
+// InitSendReceiptDAO 初始化供应商回执分库分表存储
+func InitSendReceiptDAO(s SendReceiptSharding) dao.SendReceiptDAO {
+	return shardingdao.NewSendReceiptShardingDAO(s.DBs, s.Strategy)
+}
+
+// InitReceiptReconcileTask 初始化回执对账任务
+func InitReceiptReconcileTask(
+	s SendReceiptSharding,
+	dclient dlock.Client,
+	repo repository.SendReceiptRepository,
+	svc receipt.Service,
+	smsClients *client.Clients,
+) *receipt.ReconcileTask {
+	type Config struct {
+		MaxLockedTables int `yaml:"maxLockedTables"`
+		BatchSize       int `yaml:"batchSize"`
+		// 查询间隔指数退避，达到最大查询次数仍未拿到回执视为未送达
+		InitialInterval time.Duration `yaml:"initialInterval"`
+		MaxInterval     time.Duration `yaml:"maxInterval"`
+		MaxQueryCount   int32         `yaml:"maxQueryCount"`
+	}
+	cfg := Config{
+		MaxLockedTables: 2,
+		BatchSize:       50,
+		InitialInterval: time.Minute,
+		MaxInterval:     30 * time.Minute,
+		MaxQueryCount:   10,
+	}
+	// 未配置时使用默认值
+	if err := econf.UnmarshalKey("receipt.reconcile", &cfg); err != nil && !errors.Is(err, econf.ErrInvalidKey) {
+		panic(err)
+	}
+	return receipt.NewReconcileTask(
+		dclient,
+		repo,
+		svc,
+		smsClients,
+		retry.Config{
+			Type: "exponential",
+			ExponentialBackoff: &retry.ExponentialBackoffConfig{
+				InitialInterval: cfg.InitialInterval,
+				MaxInterval:     cfg.MaxInterval,
+				MaxRetries:      cfg.MaxQueryCount,
+			},
+		},
+		loopjob.NewResourceSemaphore(cfg.MaxLockedTables),
+		s.Strategy,
+		cfg.BatchSize,
+	)
+}
@@ -0,0 +1,51 @@
+package ioc
+
+import (
+	"errors"
+	"fmt"
+
+	"gitee.com/flycash/notification-platform/internal/pkg/sharding"
+	"github.com/ecodeclub/ekit/syncx"
+	"github.com/ego-component/egorm"
+	"github.com/gotomicro/ego/core/econf"
+)
+
+// initSharding 按 key 下的配置初始化分库分表并建表
+// 未配置分库时，所有分表都建在默认库中
+func initSharding(db *egorm.Component, key string, model interface{ TableName() string }) (*syncx.Map[string, *egorm.Component], sharding.ShardingStrategy) {
+	type Config struct {
+		DBPrefix    string `yaml:"dbPrefix"`
+		TablePrefix string `yaml:"tablePrefix"`
+		TableNum    int64  `yaml:"tableNum"`
+		// DBs 各分库对应的 egorm 配置 key，下标即分库后缀
+		DBs []string `yaml:"dbs"`
+	}
+	cfg := Config{
+		DBPrefix:    "notification",
+		TablePrefix: model.TableName(),
+		TableNum:    1,
+	}
+	// 未配置时使用默认值
+	if err := econf.UnmarshalKey(key, &cfg); err != nil && !errors.Is(err, econf.ErrInvalidKey) {
+		panic(err)
+	}
+
+	dbs := &syncx.Map[string, *egorm.Component]{}
+	if len(cfg.DBs) == 0 {
+		dbs.Store(fmt.Sprintf("%s_0", cfg.DBPrefix), db)
+	}
+	for i, dbKey := range cfg.DBs {
+		dbs.Store(fmt.Sprintf("%s_%d", cfg.DBPrefix, i), egorm.Load(dbKey).Build())
+	}
+	dbNum := max(int64(len(cfg.DBs)), 1)
+	strategy := sharding.NewShardingStrategy(cfg.DBPrefix, cfg.TablePrefix, cfg.TableNum, dbNum)
+
+	// 建表
+	for _, dst := range strategy.Broadcast() {
+		shardDB, _ := dbs.Load(dst.DB)
+		if err := shardDB.Table(dst.Table).AutoMigrate(model); err != nil {
+			panic(err)
+		}
+	}
+	return dbs, strategy
+}
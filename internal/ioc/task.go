@@ -1,20 +1,38 @@
 package ioc
 
 import (
+	"gitee.com/flycash/notification-platform/internal/event/template"
+	"gitee.com/flycash/notification-platform/internal/service/audit"
+	"gitee.com/flycash/notification-platform/internal/service/channel/plugin"
 	"gitee.com/flycash/notification-platform/internal/service/notification"
 	"gitee.com/flycash/notification-platform/internal/service/notification/callback"
+	"gitee.com/flycash/notification-platform/internal/service/provider/registry"
+	"gitee.com/flycash/notification-platform/internal/service/receipt"
 	"gitee.com/flycash/notification-platform/internal/service/scheduler"
+	templatetask "gitee.com/flycash/notification-platform/internal/service/template"
 )
 
 func InitTasks(t1 *callback.AsyncRequestResultCallbackTask,
 	t2 scheduler.NotificationScheduler,
 	t3 *notification.SendingTimeoutTask,
 	t4 *notification.TxCheckTask,
+	t5 *receipt.ReconcileTask,
+	t6 *registry.Registry,
+	t7 *plugin.Syncer,
+	t8 *audit.EscalationTask,
+	t9 *template.AuditResultConsumer,
+	t10 *templatetask.SyncProviderAuditInfoTask,
 ) []Task {
 	return []Task{
 		t1,
 		t2,
 		t3,
 		t4,
+		t5,
+		t6,
+		t7,
+		t8,
+		t9,
+		t10,
 	}
 }
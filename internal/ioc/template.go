@@ -0,0 +1,46 @@
+package ioc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/service/audit"
+	templatetask "gitee.com/flycash/notification-platform/internal/service/template"
+	templatesvc "gitee.com/flycash/notification-platform/internal/service/template/manage"
+	"gitee.com/flycash/notification-platform/internal/service/template/moderation"
+	"gitee.com/flycash/notification-platform/internal/service/template/render"
+	templateweb "gitee.com/flycash/notification-platform/internal/web/template"
+	"github.com/gotomicro/ego/core/econf"
+	"github.com/meoying/dlock-go"
+)
+
+// InitTemplateModerator 模版审核前检查，规则读取 template.moderation，未配置时只做签名和业务类型检查
+func InitTemplateModerator() moderation.Checker {
+	var cfg moderation.Config
+	if err := econf.UnmarshalKey("template.moderation", &cfg); err != nil && !errors.Is(err, econf.ErrInvalidKey) {
+		panic(err)
+	}
+	return moderation.NewChecker(cfg)
+}
+
+// InitTemplateHandler 模版管理接口，修改类的接口需要管理接口令牌，发布和回滚使用审核人的访问令牌识别操作人
+func InitTemplateHandler(svc templatesvc.ChannelTemplateService, renderer render.Service, auditSvc audit.Service) *templateweb.Handler {
+	return templateweb.NewHandler(svc, renderer, loadAdminToken(), func(ctx context.Context, token string) (int64, error) {
+		reviewer, err := auditSvc.AuthenticateReviewer(ctx, token)
+		return reviewer.ID, err
+	})
+}
+
+// InitSyncProviderAuditInfoTask 轮询兜底供应商审核结果，读取 template.providerAudit，
+// 默认等待推送10分钟，没有配置推送的供应商可以将 gracePeriod 配置为0
+func InitSyncProviderAuditInfoTask(dclient dlock.Client, svc templatesvc.ChannelTemplateService) *templatetask.SyncProviderAuditInfoTask {
+	type Config struct {
+		GracePeriod time.Duration `yaml:"gracePeriod"`
+	}
+	cfg := Config{GracePeriod: 10 * time.Minute}
+	if err := econf.UnmarshalKey("template.providerAudit", &cfg); err != nil && !errors.Is(err, econf.ErrInvalidKey) {
+		panic(err)
+	}
+	return templatetask.NewSyncProviderAuditInfoTask(dclient, svc, cfg.GracePeriod)
+}
@@ -0,0 +1,117 @@
+package circuitbreaker
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+var _ CircuitBreaker = (*LocalCircuitBreaker)(nil)
+
+// breakerState 单个 key 的熔断状态，字段与 Redis 实现中的哈希字段一一对应
+type breakerState struct {
+	state      State
+	openUntil  time.Time
+	openCount  int64 // 连续打开的次数，用于计算指数增长的打开时长
+	probes     int   // 半开状态已经放行的探测请求数
+	successes  int   // 半开状态探测成功的请求数
+	halfOpenAt time.Time
+}
+
+// LocalCircuitBreaker 基于内存的熔断器，状态只在当前实例内有效，适合单实例部署和测试
+type LocalCircuitBreaker struct {
+	cfg    Config
+	mu     sync.Mutex
+	states map[string]*breakerState
+	now    func() time.Time
+}
+
+func NewLocalCircuitBreaker(cfg Config) *LocalCircuitBreaker {
+	return &LocalCircuitBreaker{
+		cfg:    cfg.withDefaults(),
+		states: make(map[string]*breakerState),
+		now:    time.Now,
+	}
+}
+
+func (l *LocalCircuitBreaker) get(key string) *breakerState {
+	s, ok := l.states[key]
+	if !ok {
+		s = &breakerState{}
+		l.states[key] = s
+	}
+	return s
+}
+
+func (l *LocalCircuitBreaker) Allow(_ context.Context, key string) (Decision, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	s, now := l.get(key), l.now()
+	switch s.state {
+	case StateOpen:
+		if now.Before(s.openUntil) {
+			return Decision{State: StateOpen, RetryAfter: s.openUntil.Sub(now)}, nil
+		}
+		l.halfOpen(s, now)
+		return Decision{Allowed: true, State: StateHalfOpen}, nil
+	case StateHalfOpen:
+		if s.probes < l.cfg.HalfOpenProbes {
+			s.probes++
+			return Decision{Allowed: true, State: StateHalfOpen}, nil
+		}
+		deadline := s.halfOpenAt.Add(l.cfg.ProbeTimeout)
+		if now.Before(deadline) {
+			return Decision{State: StateHalfOpen, RetryAfter: deadline.Sub(now)}, nil
+		}
+		// 探测请求一直没有结果，重新开始探测
+		l.halfOpen(s, now)
+		return Decision{Allowed: true, State: StateHalfOpen}, nil
+	default:
+		return Decision{Allowed: true, State: StateClosed}, nil
+	}
+}
+
+func (l *LocalCircuitBreaker) halfOpen(s *breakerState, now time.Time) {
+	s.state = StateHalfOpen
+	s.probes = 1
+	s.successes = 0
+	s.halfOpenAt = now
+}
+
+func (l *LocalCircuitBreaker) Trip(_ context.Context, key string) (time.Duration, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	s, now := l.get(key), l.now()
+	if s.state == StateOpen && now.Before(s.openUntil) {
+		return s.openUntil.Sub(now), nil
+	}
+	return l.open(s, now), nil
+}
+
+func (l *LocalCircuitBreaker) open(s *breakerState, now time.Time) time.Duration {
+	s.openCount++
+	d := l.cfg.openDuration(s.openCount)
+	s.state = StateOpen
+	s.openUntil = now.Add(d)
+	return d
+}
+
+func (l *LocalCircuitBreaker) Report(_ context.Context, key string, success bool) (time.Duration, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	s := l.get(key)
+	if s.state != StateHalfOpen {
+		return 0, nil
+	}
+	if !success {
+		return l.open(s, l.now()), nil
+	}
+	s.successes++
+	if s.successes >= l.cfg.HalfOpenProbes {
+		*s = breakerState{}
+	}
+	return 0, nil
+}
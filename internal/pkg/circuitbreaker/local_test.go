@@ -0,0 +1,138 @@
+//go:build unit
+
+package circuitbreaker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock 手动推进的时钟
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newTestBreaker() (*LocalCircuitBreaker, *fakeClock) {
+	clock := &fakeClock{now: time.Unix(1700000000, 0)}
+	b := NewLocalCircuitBreaker(Config{
+		OpenDuration:    time.Second,
+		MaxOpenDuration: 3 * time.Second,
+		HalfOpenProbes:  2,
+		ProbeTimeout:    500 * time.Millisecond,
+	})
+	b.now = clock.Now
+	return b, clock
+}
+
+func TestLocalCircuitBreaker_StateMachine(t *testing.T) {
+	t.Parallel()
+	ctx := t.Context()
+	const key = "provider:SMS:aliyun"
+	b, clock := newTestBreaker()
+
+	// 关闭状态放行所有请求
+	d, err := b.Allow(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, Decision{Allowed: true, State: StateClosed}, d)
+
+	openDuration, err := b.Trip(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, time.Second, openDuration)
+
+	// 打开状态拒绝请求，重复打开只返回剩余时长
+	clock.Advance(400 * time.Millisecond)
+	d, err = b.Allow(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, Decision{State: StateOpen, RetryAfter: 600 * time.Millisecond}, d)
+	openDuration, err = b.Trip(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, 600*time.Millisecond, openDuration)
+
+	// 到期后进入半开状态，只放行两个探测请求
+	clock.Advance(600 * time.Millisecond)
+	for i := 0; i < 2; i++ {
+		d, err = b.Allow(ctx, key)
+		require.NoError(t, err)
+		assert.Equal(t, Decision{Allowed: true, State: StateHalfOpen}, d)
+	}
+	d, err = b.Allow(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, Decision{State: StateHalfOpen, RetryAfter: 500 * time.Millisecond}, d)
+
+	// 探测失败，以翻倍的时长重新打开
+	openDuration, err = b.Report(ctx, key, false)
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Second, openDuration)
+	d, err = b.Allow(ctx, key)
+	require.NoError(t, err)
+	assert.False(t, d.Allowed)
+
+	// 再次探测失败，打开时长不超过上限
+	clock.Advance(2 * time.Second)
+	d, err = b.Allow(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, StateHalfOpen, d.State)
+	openDuration, err = b.Report(ctx, key, false)
+	require.NoError(t, err)
+	assert.Equal(t, 3*time.Second, openDuration)
+
+	// 探测全部成功后关闭，连续打开次数清零
+	clock.Advance(3 * time.Second)
+	for i := 0; i < 2; i++ {
+		d, err = b.Allow(ctx, key)
+		require.NoError(t, err)
+		require.True(t, d.Allowed)
+		openDuration, err = b.Report(ctx, key, true)
+		require.NoError(t, err)
+		assert.Zero(t, openDuration)
+	}
+	d, err = b.Allow(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, Decision{Allowed: true, State: StateClosed}, d)
+	openDuration, err = b.Trip(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, time.Second, openDuration)
+}
+
+func TestLocalCircuitBreaker_ProbeTimeout(t *testing.T) {
+	t.Parallel()
+	ctx := t.Context()
+	const key = "provider:SMS:tencentcloud"
+	b, clock := newTestBreaker()
+
+	_, err := b.Trip(ctx, key)
+	require.NoError(t, err)
+	clock.Advance(time.Second)
+	for i := 0; i < 2; i++ {
+		d, err1 := b.Allow(ctx, key)
+		require.NoError(t, err1)
+		require.True(t, d.Allowed)
+	}
+
+	// 探测请求没有上报结果，超时后重新放行
+	clock.Advance(500 * time.Millisecond)
+	d, err := b.Allow(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, Decision{Allowed: true, State: StateHalfOpen}, d)
+
+	// 不同 key 互不影响
+	d, err = b.Allow(ctx, "provider:SMS:aliyun")
+	require.NoError(t, err)
+	assert.Equal(t, StateClosed, d.State)
+
+	// 关闭状态下上报探测结果不生效
+	openDuration, err := b.Report(ctx, "provider:SMS:aliyun", false)
+	require.NoError(t, err)
+	assert.Zero(t, openDuration)
+}
@@ -0,0 +1,45 @@
+-- 判断熔断器是否放行请求，状态字段与 LocalCircuitBreaker 保持一致
+
+local key = KEYS[1]
+-- 半开状态放行的探测请求数
+local halfOpenProbes = tonumber(ARGV[1])
+-- 探测请求超时时间（毫秒）
+local probeTimeout = tonumber(ARGV[2])
+-- 状态保留时间（毫秒）
+local ttl = tonumber(ARGV[3])
+-- 当前时间戳（毫秒）
+local now = tonumber(ARGV[4])
+
+local closed, open, halfOpen = 0, 1, 2
+
+local fields = redis.call('HMGET', key, 'state', 'open_until', 'probes', 'half_open_at')
+local state = tonumber(fields[1] or closed)
+local openUntil = tonumber(fields[2] or 0)
+local probes = tonumber(fields[3] or 0)
+local halfOpenAt = tonumber(fields[4] or 0)
+
+-- 进入半开状态并放行第一个探测请求
+local function toHalfOpen()
+    redis.call('HSET', key, 'state', halfOpen, 'probes', 1, 'successes', 0, 'half_open_at', now)
+    redis.call('PEXPIRE', key, ttl)
+    return {1, halfOpen, 0}
+end
+
+if state == open then
+    if now < openUntil then
+        return {0, open, openUntil - now}
+    end
+    return toHalfOpen()
+elseif state == halfOpen then
+    if probes < halfOpenProbes then
+        redis.call('HINCRBY', key, 'probes', 1)
+        return {1, halfOpen, 0}
+    end
+    local deadline = halfOpenAt + probeTimeout
+    if now < deadline then
+        return {0, halfOpen, deadline - now}
+    end
+    -- 探测请求一直没有结果，重新开始探测
+    return toHalfOpen()
+end
+return {1, closed, 0}
@@ -0,0 +1,18 @@
+-- 半开状态下探测请求成功，探测请求全部成功后关闭熔断器
+
+local key = KEYS[1]
+-- 关闭熔断器需要的探测成功数
+local halfOpenProbes = tonumber(ARGV[1])
+
+local halfOpen = 2
+
+local state = tonumber(redis.call('HGET', key, 'state') or 0)
+if state ~= halfOpen then
+    return 0
+end
+local successes = redis.call('HINCRBY', key, 'successes', 1)
+if successes >= halfOpenProbes then
+    -- 关闭后连续打开的次数清零
+    redis.call('DEL', key)
+end
+return 0
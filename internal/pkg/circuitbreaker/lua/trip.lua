@@ -0,0 +1,43 @@
+-- 打开熔断器，连续打开时打开时长按指数增长
+
+local key = KEYS[1]
+-- 第一次打开的时长（毫秒）
+local openDuration = tonumber(ARGV[1])
+-- 打开时长上限（毫秒）
+local maxOpenDuration = tonumber(ARGV[2])
+-- 状态保留时间（毫秒）
+local ttl = tonumber(ARGV[3])
+-- 当前时间戳（毫秒）
+local now = tonumber(ARGV[4])
+-- 1 表示半开状态探测失败，只在半开状态下重新打开；0 表示失败率超过阈值，未打开时打开
+local probeFailed = tonumber(ARGV[5])
+
+local closed, open, halfOpen = 0, 1, 2
+
+local fields = redis.call('HMGET', key, 'state', 'open_until', 'open_count')
+local state = tonumber(fields[1] or closed)
+local openUntil = tonumber(fields[2] or 0)
+local openCount = tonumber(fields[3] or 0)
+
+if probeFailed == 1 then
+    if state ~= halfOpen then
+        return 0
+    end
+elseif state == open and now < openUntil then
+    return openUntil - now
+end
+
+openCount = openCount + 1
+local d = openDuration
+for _ = 2, openCount do
+    if d >= maxOpenDuration then
+        break
+    end
+    d = d * 2
+end
+if d > maxOpenDuration then
+    d = maxOpenDuration
+end
+redis.call('HSET', key, 'state', open, 'open_until', now + d, 'open_count', openCount)
+redis.call('PEXPIRE', key, ttl)
+return d
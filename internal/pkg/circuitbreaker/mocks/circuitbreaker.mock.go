@@ -0,0 +1,160 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./types.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./types.go -package=cbmocks -destination=./mocks/circuitbreaker.mock.go -typed CircuitBreaker
+//
+
+// Package cbmocks is a generated GoMock package.
+package cbmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	circuitbreaker "gitee.com/flycash/notification-platform/internal/pkg/circuitbreaker"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCircuitBreaker is a mock of CircuitBreaker interface.
+type MockCircuitBreaker struct {
+	ctrl     *gomock.Controller
+	recorder *MockCircuitBreakerMockRecorder
+	isgomock struct{}
+}
+
+// MockCircuitBreakerMockRecorder is the mock recorder for MockCircuitBreaker.
+type MockCircuitBreakerMockRecorder struct {
+	mock *MockCircuitBreaker
+}
+
+// NewMockCircuitBreaker creates a new mock instance.
+func NewMockCircuitBreaker(ctrl *gomock.Controller) *MockCircuitBreaker {
+	mock := &MockCircuitBreaker{ctrl: ctrl}
+	mock.recorder = &MockCircuitBreakerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCircuitBreaker) EXPECT() *MockCircuitBreakerMockRecorder {
+	return m.recorder
+}
+
+// Allow mocks base method.
+func (m *MockCircuitBreaker) Allow(ctx context.Context, key string) (circuitbreaker.Decision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Allow", ctx, key)
+	ret0, _ := ret[0].(circuitbreaker.Decision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Allow indicates an expected call of Allow.
+func (mr *MockCircuitBreakerMockRecorder) Allow(ctx, key any) *MockCircuitBreakerAllowCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Allow", reflect.TypeOf((*MockCircuitBreaker)(nil).Allow), ctx, key)
+	return &MockCircuitBreakerAllowCall{Call: call}
+}
+
+// MockCircuitBreakerAllowCall wrap *gomock.Call
+type MockCircuitBreakerAllowCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCircuitBreakerAllowCall) Return(arg0 circuitbreaker.Decision, arg1 error) *MockCircuitBreakerAllowCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCircuitBreakerAllowCall) Do(f func(context.Context, string) (circuitbreaker.Decision, error)) *MockCircuitBreakerAllowCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCircuitBreakerAllowCall) DoAndReturn(f func(context.Context, string) (circuitbreaker.Decision, error)) *MockCircuitBreakerAllowCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Report mocks base method.
+func (m *MockCircuitBreaker) Report(ctx context.Context, key string, success bool) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Report", ctx, key, success)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Report indicates an expected call of Report.
+func (mr *MockCircuitBreakerMockRecorder) Report(ctx, key, success any) *MockCircuitBreakerReportCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Report", reflect.TypeOf((*MockCircuitBreaker)(nil).Report), ctx, key, success)
+	return &MockCircuitBreakerReportCall{Call: call}
+}
+
+// MockCircuitBreakerReportCall wrap *gomock.Call
+type MockCircuitBreakerReportCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCircuitBreakerReportCall) Return(arg0 time.Duration, arg1 error) *MockCircuitBreakerReportCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCircuitBreakerReportCall) Do(f func(context.Context, string, bool) (time.Duration, error)) *MockCircuitBreakerReportCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCircuitBreakerReportCall) DoAndReturn(f func(context.Context, string, bool) (time.Duration, error)) *MockCircuitBreakerReportCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Trip mocks base method.
+func (m *MockCircuitBreaker) Trip(ctx context.Context, key string) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trip", ctx, key)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Trip indicates an expected call of Trip.
+func (mr *MockCircuitBreakerMockRecorder) Trip(ctx, key any) *MockCircuitBreakerTripCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trip", reflect.TypeOf((*MockCircuitBreaker)(nil).Trip), ctx, key)
+	return &MockCircuitBreakerTripCall{Call: call}
+}
+
+// MockCircuitBreakerTripCall wrap *gomock.Call
+type MockCircuitBreakerTripCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCircuitBreakerTripCall) Return(arg0 time.Duration, arg1 error) *MockCircuitBreakerTripCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCircuitBreakerTripCall) Do(f func(context.Context, string) (time.Duration, error)) *MockCircuitBreakerTripCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCircuitBreakerTripCall) DoAndReturn(f func(context.Context, string) (time.Duration, error)) *MockCircuitBreakerTripCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,99 @@
+package circuitbreaker
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	//go:embed lua/allow.lua
+	allowScript string
+	//go:embed lua/trip.lua
+	tripScript string
+	//go:embed lua/probe_success.lua
+	probeSuccessScript string
+
+	_ CircuitBreaker = (*RedisCircuitBreaker)(nil)
+)
+
+// stateTTL 熔断状态的保留时间，长时间没有变化的状态自然过期，相当于关闭
+const stateTTL = 24 * time.Hour
+
+// RedisCircuitBreaker 基于Redis的熔断器，所有实例共享同一份熔断状态
+// 一个实例打开熔断器后，其他实例也不会再把请求发给对应的供应商
+type RedisCircuitBreaker struct {
+	cmd       redis.Cmdable
+	cfg       Config
+	keyPrefix string
+}
+
+func NewRedisCircuitBreaker(cmd redis.Cmdable, cfg Config) *RedisCircuitBreaker {
+	return &RedisCircuitBreaker{
+		cmd:       cmd,
+		cfg:       cfg.withDefaults(),
+		keyPrefix: "circuitbreaker:",
+	}
+}
+
+func (r *RedisCircuitBreaker) Allow(ctx context.Context, key string) (Decision, error) {
+	res, err := r.cmd.Eval(ctx, allowScript, []string{r.getKey(key)},
+		r.cfg.HalfOpenProbes,
+		r.cfg.ProbeTimeout.Milliseconds(),
+		r.ttl().Milliseconds(),
+		time.Now().UnixMilli(),
+	).Int64Slice()
+	if err != nil {
+		return Decision{}, err
+	}
+	const resultLen = 3
+	if len(res) != resultLen {
+		return Decision{}, fmt.Errorf("熔断器脚本返回值错误: %v", res)
+	}
+	return Decision{
+		Allowed:    res[0] == 1,
+		State:      State(res[1]),
+		RetryAfter: time.Duration(res[2]) * time.Millisecond,
+	}, nil
+}
+
+func (r *RedisCircuitBreaker) Trip(ctx context.Context, key string) (time.Duration, error) {
+	return r.trip(ctx, key, false)
+}
+
+func (r *RedisCircuitBreaker) Report(ctx context.Context, key string, success bool) (time.Duration, error) {
+	if !success {
+		return r.trip(ctx, key, true)
+	}
+	return 0, r.cmd.Eval(ctx, probeSuccessScript, []string{r.getKey(key)}, r.cfg.HalfOpenProbes).Err()
+}
+
+func (r *RedisCircuitBreaker) trip(ctx context.Context, key string, probeFailed bool) (time.Duration, error) {
+	flag := 0
+	if probeFailed {
+		flag = 1
+	}
+	ms, err := r.cmd.Eval(ctx, tripScript, []string{r.getKey(key)},
+		r.cfg.OpenDuration.Milliseconds(),
+		r.cfg.MaxOpenDuration.Milliseconds(),
+		r.ttl().Milliseconds(),
+		time.Now().UnixMilli(),
+		flag,
+	).Int64()
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(ms) * time.Millisecond, nil
+}
+
+// ttl 保留时间要比最长的打开时长更长，避免打开状态提前过期
+func (r *RedisCircuitBreaker) ttl() time.Duration {
+	return max(stateTTL, 2*r.cfg.MaxOpenDuration)
+}
+
+func (r *RedisCircuitBreaker) getKey(key string) string {
+	return r.keyPrefix + key
+}
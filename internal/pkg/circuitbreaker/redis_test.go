@@ -0,0 +1,100 @@
+//go:build e2e
+
+package circuitbreaker
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	testioc "gitee.com/flycash/notification-platform/internal/test/ioc"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestRedisCircuitBreaker(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(RedisCircuitBreakerTestSuite))
+}
+
+type RedisCircuitBreakerTestSuite struct {
+	suite.Suite
+	rdb redis.Cmdable
+}
+
+func (s *RedisCircuitBreakerTestSuite) SetupSuite() {
+	s.rdb = testioc.InitRedis()
+}
+
+func (s *RedisCircuitBreakerTestSuite) newBreaker() *RedisCircuitBreaker {
+	return NewRedisCircuitBreaker(s.rdb, Config{
+		OpenDuration:    200 * time.Millisecond,
+		MaxOpenDuration: 500 * time.Millisecond,
+		HalfOpenProbes:  2,
+		ProbeTimeout:    200 * time.Millisecond,
+	})
+}
+
+// 生成唯一的测试键，避免测试冲突
+func (s *RedisCircuitBreakerTestSuite) getUniqueKey(name string) string {
+	return fmt.Sprintf("test:%s:%d", name, time.Now().UnixNano())
+}
+
+func (s *RedisCircuitBreakerTestSuite) TestStateMachine() {
+	t := s.T()
+	ctx := t.Context()
+	key := s.getUniqueKey("state_machine")
+	// 两个实例共享同一份状态
+	b1, b2 := s.newBreaker(), s.newBreaker()
+	defer s.rdb.Del(ctx, b1.getKey(key))
+
+	d, err := b1.Allow(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, Decision{Allowed: true, State: StateClosed}, d)
+
+	openDuration, err := b1.Trip(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, 200*time.Millisecond, openDuration)
+
+	// 另一个实例也看到打开状态
+	d, err = b2.Allow(ctx, key)
+	require.NoError(t, err)
+	assert.False(t, d.Allowed)
+	assert.Equal(t, StateOpen, d.State)
+	assert.Positive(t, d.RetryAfter)
+
+	// 到期后两个实例一共只放行两个探测请求
+	time.Sleep(200 * time.Millisecond)
+	for _, b := range []*RedisCircuitBreaker{b1, b2} {
+		d, err = b.Allow(ctx, key)
+		require.NoError(t, err)
+		assert.Equal(t, Decision{Allowed: true, State: StateHalfOpen}, d)
+	}
+	d, err = b1.Allow(ctx, key)
+	require.NoError(t, err)
+	assert.False(t, d.Allowed)
+	assert.Equal(t, StateHalfOpen, d.State)
+
+	// 探测失败，以翻倍的时长重新打开
+	openDuration, err = b2.Report(ctx, key, false)
+	require.NoError(t, err)
+	assert.Equal(t, 400*time.Millisecond, openDuration)
+
+	// 探测全部成功后关闭
+	time.Sleep(400 * time.Millisecond)
+	for _, b := range []*RedisCircuitBreaker{b1, b2} {
+		d, err = b.Allow(ctx, key)
+		require.NoError(t, err)
+		require.True(t, d.Allowed)
+		_, err = b.Report(ctx, key, true)
+		require.NoError(t, err)
+	}
+	d, err = b1.Allow(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, Decision{Allowed: true, State: StateClosed}, d)
+	cnt, err := s.rdb.Exists(ctx, b1.getKey(key)).Result()
+	require.NoError(t, err)
+	assert.Zero(t, cnt)
+}
@@ -0,0 +1,97 @@
+package circuitbreaker
+
+import (
+	"context"
+	"time"
+)
+
+// State 熔断器状态
+type State int8
+
+const (
+	// StateClosed 关闭，正常放行所有请求
+	StateClosed State = iota
+	// StateOpen 打开，拒绝所有请求
+	StateOpen
+	// StateHalfOpen 半开，只放行少量探测请求
+	StateHalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateOpen:
+		return "open"
+	case StateHalfOpen:
+		return "half_open"
+	default:
+		return "unknown"
+	}
+}
+
+const (
+	defaultOpenDuration    = 30 * time.Second
+	defaultMaxOpenDuration = 10 * time.Minute
+	defaultHalfOpenProbes  = 3
+	defaultProbeTimeout    = 10 * time.Second
+)
+
+// Config 熔断器配置
+type Config struct {
+	// OpenDuration 第一次打开的时长，之后每次连续打开时长翻倍
+	OpenDuration time.Duration `yaml:"openDuration"`
+	// MaxOpenDuration 打开时长的上限
+	MaxOpenDuration time.Duration `yaml:"maxOpenDuration"`
+	// HalfOpenProbes 半开状态放行的探测请求数，全部成功后关闭熔断器
+	HalfOpenProbes int `yaml:"halfOpenProbes"`
+	// ProbeTimeout 探测请求迟迟没有上报结果时（例如被限流），超过该时长后重新放行探测请求
+	ProbeTimeout time.Duration `yaml:"probeTimeout"`
+}
+
+// withDefaults 未配置的字段使用默认值
+func (c Config) withDefaults() Config {
+	if c.OpenDuration <= 0 {
+		c.OpenDuration = defaultOpenDuration
+	}
+	if c.MaxOpenDuration < c.OpenDuration {
+		c.MaxOpenDuration = max(defaultMaxOpenDuration, c.OpenDuration)
+	}
+	if c.HalfOpenProbes <= 0 {
+		c.HalfOpenProbes = defaultHalfOpenProbes
+	}
+	if c.ProbeTimeout <= 0 {
+		c.ProbeTimeout = defaultProbeTimeout
+	}
+	return c
+}
+
+// openDuration 第 n 次连续打开的时长
+func (c Config) openDuration(n int64) time.Duration {
+	d := c.OpenDuration
+	for i := int64(1); i < n && d < c.MaxOpenDuration; i++ {
+		d *= 2
+	}
+	return min(d, c.MaxOpenDuration)
+}
+
+// Decision Allow 的结果
+type Decision struct {
+	Allowed bool
+	// State 判断时熔断器的状态，半开状态下放行的请求需要通过 Report 上报结果
+	State State
+	// RetryAfter 不放行时距离下次可以尝试的时长
+	RetryAfter time.Duration
+}
+
+// CircuitBreaker 熔断器，按 key 维护关闭、打开、半开三种状态
+//
+//go:generate mockgen -source=./types.go -package=cbmocks -destination=./mocks/circuitbreaker.mock.go -typed CircuitBreaker
+type CircuitBreaker interface {
+	// Allow 判断是否放行请求，打开状态到期后转为半开状态
+	Allow(ctx context.Context, key string) (Decision, error)
+	// Trip 打开熔断器，返回打开的时长，已经打开时返回剩余的时长
+	Trip(ctx context.Context, key string) (time.Duration, error)
+	// Report 上报半开状态下探测请求的结果，探测请求全部成功后关闭熔断器，失败则重新打开并返回打开的时长
+	Report(ctx context.Context, key string, success bool) (time.Duration, error)
+}
@@ -0,0 +1,102 @@
+package diff
+
+import "strings"
+
+// Op 行的变化类型
+type Op string
+
+const (
+	OpEqual  Op = "="
+	OpInsert Op = "+"
+	OpDelete Op = "-"
+)
+
+// Line 一行的变化
+type Line struct {
+	Op   Op
+	Text string
+}
+
+// maxLCSCells 最长公共子序列表的最大单元数，约为 512 行对 512 行，内存不超过 1MB
+const maxLCSCells = 1 << 18
+
+// Lines 基于最长公共子序列按行比较，返回把 from 变为 to 的逐行编辑序列，删除的行在新增的行之前
+// 相同的开头和结尾直接保留，中间变化的部分超过 maxLCSCells 时不再逐行比较，整体作为删除和新增，
+// 避免超大内容占用 O(n·m) 的时间和内存
+func Lines(from, to string) []Line {
+	a, b := split(from), split(to)
+	res := make([]Line, 0, max(len(a), len(b)))
+
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		res = append(res, Line{Op: OpEqual, Text: a[prefix]})
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	res = append(res, lcsLines(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, text := range a[len(a)-suffix:] {
+		res = append(res, Line{Op: OpEqual, Text: text})
+	}
+	return res
+}
+
+// lcsLines 按最长公共子序列比较开头和结尾都不相同的部分
+func lcsLines(a, b []string) []Line {
+	res := make([]Line, 0, max(len(a), len(b)))
+	if len(a)*len(b) > maxLCSCells {
+		for _, text := range a {
+			res = append(res, Line{Op: OpDelete, Text: text})
+		}
+		for _, text := range b {
+			res = append(res, Line{Op: OpInsert, Text: text})
+		}
+		return res
+	}
+
+	// lcs[i*w+j] 为 a[i:] 和 b[j:] 的最长公共子序列长度
+	w := len(b) + 1
+	lcs := make([]int32, (len(a)+1)*w)
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i*w+j] = lcs[(i+1)*w+j+1] + 1
+			} else {
+				lcs[i*w+j] = max(lcs[(i+1)*w+j], lcs[i*w+j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			res = append(res, Line{Op: OpEqual, Text: a[i]})
+			i++
+			j++
+		case lcs[(i+1)*w+j] >= lcs[i*w+j+1]:
+			res = append(res, Line{Op: OpDelete, Text: a[i]})
+			i++
+		default:
+			res = append(res, Line{Op: OpInsert, Text: b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		res = append(res, Line{Op: OpDelete, Text: a[i]})
+	}
+	for ; j < len(b); j++ {
+		res = append(res, Line{Op: OpInsert, Text: b[j]})
+	}
+	return res
+}
+
+func split(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
@@ -0,0 +1,82 @@
+//go:build unit
+
+package diff
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLines(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		from string
+		to   string
+		want []Line
+	}{
+		{
+			name: "都为空",
+			want: []Line{},
+		},
+		{
+			name: "没有变化",
+			from: "a\nb",
+			to:   "a\nb",
+			want: []Line{{Op: OpEqual, Text: "a"}, {Op: OpEqual, Text: "b"}},
+		},
+		{
+			name: "新增",
+			to:   "a",
+			want: []Line{{Op: OpInsert, Text: "a"}},
+		},
+		{
+			name: "删除",
+			from: "a\nb\nc",
+			to:   "a\nc",
+			want: []Line{{Op: OpEqual, Text: "a"}, {Op: OpDelete, Text: "b"}, {Op: OpEqual, Text: "c"}},
+		},
+		{
+			name: "修改",
+			from: "您好\n验证码${code}\n谢谢",
+			to:   "您好\n验证码${code}，${minutes}分钟内有效\n谢谢",
+			want: []Line{
+				{Op: OpEqual, Text: "您好"},
+				{Op: OpDelete, Text: "验证码${code}"},
+				{Op: OpInsert, Text: "验证码${code}，${minutes}分钟内有效"},
+				{Op: OpEqual, Text: "谢谢"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, Lines(tc.from, tc.to))
+		})
+	}
+}
+
+func TestLines_Large(t *testing.T) {
+	t.Parallel()
+
+	const n = 2000
+	from := make([]string, 0, n)
+	to := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		from = append(from, "a"+strconv.Itoa(i))
+		to = append(to, "b"+strconv.Itoa(i))
+	}
+	// 开头和结尾相同，中间超过上限的部分整体作为删除和新增
+	got := Lines("head\n"+strings.Join(from, "\n")+"\ntail", "head\n"+strings.Join(to, "\n")+"\ntail")
+	require.Len(t, got, 2*n+2)
+	assert.Equal(t, Line{Op: OpEqual, Text: "head"}, got[0])
+	assert.Equal(t, Line{Op: OpDelete, Text: "a0"}, got[1])
+	assert.Equal(t, Line{Op: OpInsert, Text: "b0"}, got[n+1])
+	assert.Equal(t, Line{Op: OpEqual, Text: "tail"}, got[2*n+1])
+}
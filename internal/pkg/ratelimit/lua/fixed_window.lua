@@ -0,0 +1,31 @@
+-- 固定窗口计数限流算法
+
+-- 当前窗口的请求计数键
+local countKey = KEYS[1]
+-- 限流对象的限流事件记录键
+local limitedEventKey = KEYS[2]
+-- 窗口大小（毫秒）
+local window = tonumber(ARGV[1])
+-- 阈值（最大请求数）
+local threshold = tonumber(ARGV[2])
+-- 当前时间戳（毫秒）
+local now = tonumber(ARGV[3])
+-- 本次占用的额度
+local n = tonumber(ARGV[4] or '1')
+
+local cnt = tonumber(redis.call('GET', countKey) or '0')
+
+-- 单次占用超过阈值时只在窗口为空时放行，否则永远无法通过
+if cnt + n > threshold and (cnt > 0 or threshold <= 0) then
+    -- 执行限流并只记录最新的限流时间
+    redis.call('SET', limitedEventKey, now, 'PX', 86400000)  -- 保留一天
+    return "true"
+else
+    -- 被限流的请求不占用额度，只有放行的请求才计数
+    redis.call('INCRBY', countKey, n)
+    if cnt == 0 then
+        -- 窗口结束后计数自然过期
+        redis.call('PEXPIRE', countKey, window)
+    end
+    return "false"
+end
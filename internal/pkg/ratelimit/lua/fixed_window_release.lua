@@ -0,0 +1,12 @@
+-- 归还固定窗口中占用的额度
+
+-- 当前窗口的请求计数键
+local countKey = KEYS[1]
+-- 归还的额度
+local n = tonumber(ARGV[1])
+
+-- 窗口已经结束时计数键已经过期，不需要归还
+if redis.call('EXISTS', countKey) == 1 then
+    redis.call('DECRBY', countKey, n)
+end
+return "OK"
@@ -70,3 +70,56 @@ func (mr *MockLimiterMockRecorder) Limit(ctx, key any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockLimiter)(nil).Limit), ctx, key)
 }
+
+// MockCountLimiter is a mock of CountLimiter interface.
+type MockCountLimiter struct {
+	ctrl     *gomock.Controller
+	recorder *MockCountLimiterMockRecorder
+	isgomock struct{}
+}
+
+// MockCountLimiterMockRecorder is the mock recorder for MockCountLimiter.
+type MockCountLimiterMockRecorder struct {
+	mock *MockCountLimiter
+}
+
+// NewMockCountLimiter creates a new mock instance.
+func NewMockCountLimiter(ctrl *gomock.Controller) *MockCountLimiter {
+	mock := &MockCountLimiter{ctrl: ctrl}
+	mock.recorder = &MockCountLimiterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCountLimiter) EXPECT() *MockCountLimiterMockRecorder {
+	return m.recorder
+}
+
+// LimitN mocks base method.
+func (m *MockCountLimiter) LimitN(ctx context.Context, key string, n int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LimitN", ctx, key, n)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LimitN indicates an expected call of LimitN.
+func (mr *MockCountLimiterMockRecorder) LimitN(ctx, key, n any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LimitN", reflect.TypeOf((*MockCountLimiter)(nil).LimitN), ctx, key, n)
+}
+
+// Release mocks base method.
+func (m *MockCountLimiter) Release(ctx context.Context, key string, n int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, key, n)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockCountLimiterMockRecorder) Release(ctx, key, n any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockCountLimiter)(nil).Release), ctx, key, n)
+}
@@ -0,0 +1,90 @@
+package ratelimit
+
+import (
+	_ "embed"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/net/context"
+)
+
+var (
+	//go:embed lua/fixed_window.lua
+	fixedWindowScript string
+	//go:embed lua/fixed_window_release.lua
+	fixedWindowReleaseScript string
+
+	_ Limiter      = (*RedisFixedWindowLimiter)(nil)
+	_ CountLimiter = (*RedisFixedWindowLimiter)(nil)
+)
+
+// RedisFixedWindowLimiter 基于Redis的固定窗口计数限流器
+// 与滑动窗口相比每个窗口只占用一个计数键，适合按天这类阈值很大的场景
+// 窗口按本地时区对齐，例如 24 小时的窗口在本地时间零点重置
+type RedisFixedWindowLimiter struct {
+	cmd       redis.Cmdable
+	interval  time.Duration
+	rate      int
+	keyPrefix string
+}
+
+// NewRedisFixedWindowLimiter 创建一个基于Redis的固定窗口限流器
+func NewRedisFixedWindowLimiter(cmd redis.Cmdable, interval time.Duration, rate int) *RedisFixedWindowLimiter {
+	return &RedisFixedWindowLimiter{
+		cmd:       cmd,
+		interval:  interval,
+		rate:      rate,
+		keyPrefix: "ratelimit:",
+	}
+}
+
+// Limit 判断是否应该限流
+func (r *RedisFixedWindowLimiter) Limit(ctx context.Context, key string) (bool, error) {
+	return r.LimitN(ctx, key, 1)
+}
+
+// LimitN 判断是否应该限流，放行时占用 n 个额度
+func (r *RedisFixedWindowLimiter) LimitN(ctx context.Context, key string, n int) (bool, error) {
+	now := time.Now()
+	return r.cmd.Eval(ctx, fixedWindowScript,
+		[]string{r.getCountKey(key, now), r.getLimitedEventKey(key)},
+		r.interval.Milliseconds(),
+		r.rate,
+		now.UnixMilli(),
+		n,
+	).Bool()
+}
+
+// Release 归还当前窗口中占用的 n 个额度，窗口已经结束时不需要归还
+func (r *RedisFixedWindowLimiter) Release(ctx context.Context, key string, n int) error {
+	return r.cmd.Eval(ctx, fixedWindowReleaseScript,
+		[]string{r.getCountKey(key, time.Now())},
+		n,
+	).Err()
+}
+
+// getCountKey 获取当前窗口请求计数的Redis键
+func (r *RedisFixedWindowLimiter) getCountKey(key string, now time.Time) string {
+	_, offset := now.Zone()
+	window := (now.UnixMilli() + int64(offset)*1000) / r.interval.Milliseconds()
+	return fmt.Sprintf("%sfixed:%s:%d", r.keyPrefix, key, window)
+}
+
+// getLimitedEventKey 获取限流事件记录的Redis键
+func (r *RedisFixedWindowLimiter) getLimitedEventKey(key string) string {
+	return fmt.Sprintf("%slimitedEvent:%s", r.keyPrefix, key)
+}
+
+// LastLimitTime 获取最近一次限流发生的时间，如果没有发生过限流则返回零值
+func (r *RedisFixedWindowLimiter) LastLimitTime(ctx context.Context, key string) (time.Time, error) {
+	result, err := r.cmd.Eval(ctx, lastLimitTimeScript,
+		[]string{r.getLimitedEventKey(key)}).Int64()
+	if err != nil {
+		return time.Time{}, err
+	}
+	if result == 0 {
+		return time.Time{}, nil
+	}
+	return time.UnixMilli(result), nil
+}
@@ -0,0 +1,47 @@
+//go:build e2e
+
+package ratelimit
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	testioc "gitee.com/flycash/notification-platform/internal/test/ioc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedisFixedWindowLimiter_LimitN(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewRedisFixedWindowLimiter(testioc.InitRedis(), time.Hour, 5)
+	key := fmt.Sprintf("test:fixed:%d", time.Now().UnixNano())
+	ctx := t.Context()
+
+	// 每次占用多个额度
+	limited, err := limiter.LimitN(ctx, key, 3)
+	require.NoError(t, err)
+	assert.False(t, limited)
+	limited, err = limiter.LimitN(ctx, key, 3)
+	require.NoError(t, err)
+	assert.True(t, limited)
+
+	// 归还后可以继续占用
+	require.NoError(t, limiter.Release(ctx, key, 3))
+	limited, err = limiter.LimitN(ctx, key, 5)
+	require.NoError(t, err)
+	assert.False(t, limited)
+	limited, err = limiter.Limit(ctx, key)
+	require.NoError(t, err)
+	assert.True(t, limited)
+
+	// 单次占用超过阈值时只在窗口为空时放行
+	other := key + ":batch"
+	limited, err = limiter.LimitN(ctx, other, 10)
+	require.NoError(t, err)
+	assert.False(t, limited)
+	limited, err = limiter.LimitN(ctx, other, 1)
+	require.NoError(t, err)
+	assert.True(t, limited)
+}
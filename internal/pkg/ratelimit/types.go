@@ -13,3 +13,11 @@ type Limiter interface {
 	// LastLimitTime 获取最近一次限流发生的时间，如果没有发生过限流则返回零值
 	LastLimitTime(ctx context.Context, key string) (time.Time, error)
 }
+
+// CountLimiter 一次请求可以占用多个额度的限流器，例如批量短信按手机号数量计数
+type CountLimiter interface {
+	// LimitN 判断是否应该限流，放行时占用 n 个额度，限流时不占用
+	LimitN(ctx context.Context, key string, n int) (bool, error)
+	// Release 归还 n 个额度，放行后请求没有继续处理时使用
+	Release(ctx context.Context, key string, n int) error
+}
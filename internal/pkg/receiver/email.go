@@ -0,0 +1,48 @@
+package receiver
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+const (
+	maxEmailLen     = 254
+	maxLocalPartLen = 64
+)
+
+var ErrInvalidEmail = errors.New("邮箱格式错误")
+
+// NormalizeEmail 按 RFC 5322 校验邮箱地址，国际化域名转换为 Punycode，域名统一小写
+// 只接受纯地址，不接受带显示名的格式
+func NormalizeEmail(raw string) (string, error) {
+	addr, err := mail.ParseAddress(strings.TrimSpace(raw))
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidEmail, err)
+	}
+	if addr.Name != "" {
+		return "", fmt.Errorf("%w: 不支持带显示名的地址", ErrInvalidEmail)
+	}
+
+	at := strings.LastIndexByte(addr.Address, '@')
+	local, domain := addr.Address[:at], addr.Address[at+1:]
+	if len(local) > maxLocalPartLen {
+		return "", fmt.Errorf("%w: @ 之前超过 %d 个字节", ErrInvalidEmail, maxLocalPartLen)
+	}
+	asciiDomain, err := idna.Lookup.ToASCII(domain)
+	if err != nil {
+		return "", fmt.Errorf("%w: 域名无效 %w", ErrInvalidEmail, err)
+	}
+	if !strings.Contains(asciiDomain, ".") {
+		return "", fmt.Errorf("%w: 域名缺少顶级域名", ErrInvalidEmail)
+	}
+
+	res := local + "@" + strings.ToLower(asciiDomain)
+	if len(res) > maxEmailLen {
+		return "", fmt.Errorf("%w: 超过 %d 个字节", ErrInvalidEmail, maxEmailLen)
+	}
+	return res, nil
+}
@@ -0,0 +1,124 @@
+//go:build unit
+
+package receiver
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeEmail(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		raw     string
+		want    string
+		wantErr error
+	}{
+		{
+			name: "普通地址",
+			raw:  " Tom@Example.COM ",
+			want: "Tom@example.com",
+		},
+		{
+			name: "国际化域名",
+			raw:  "tom@bücher.de",
+			want: "tom@xn--bcher-kva.de",
+		},
+		{
+			name: "中文域名和中文用户名",
+			raw:  "用户@例子.中国",
+			want: "用户@xn--fsqu00a.xn--fiqs8s",
+		},
+		{
+			name: "尖括号",
+			raw:  "<tom@example.com>",
+			want: "tom@example.com",
+		},
+		{
+			name:    "带显示名",
+			raw:     "Tom <tom@example.com>",
+			wantErr: ErrInvalidEmail,
+		},
+		{
+			name:    "缺少@",
+			raw:     "tom.example.com",
+			wantErr: ErrInvalidEmail,
+		},
+		{
+			name:    "连续的点",
+			raw:     "tom..cat@example.com",
+			wantErr: ErrInvalidEmail,
+		},
+		{
+			name:    "缺少顶级域名",
+			raw:     "tom@localhost",
+			wantErr: ErrInvalidEmail,
+		},
+		{
+			name:    "用户名过长",
+			raw:     strings.Repeat("a", 65) + "@example.com",
+			wantErr: ErrInvalidEmail,
+		},
+		{
+			name:    "手机号",
+			raw:     "13800138000",
+			wantErr: ErrInvalidEmail,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NormalizeEmail(tc.raw)
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestNormalizeUserID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		raw     string
+		want    string
+		wantErr error
+	}{
+		{
+			name: "去掉首尾空白",
+			raw:  " user-1 ",
+			want: "user-1",
+		},
+		{
+			name:    "空",
+			raw:     "  ",
+			wantErr: ErrInvalidUserID,
+		},
+		{
+			name:    "包含空白",
+			raw:     "user 1",
+			wantErr: ErrInvalidUserID,
+		},
+		{
+			name:    "超长",
+			raw:     strings.Repeat("u", 257),
+			wantErr: ErrInvalidUserID,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NormalizeUserID(tc.raw)
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
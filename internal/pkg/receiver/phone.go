@@ -0,0 +1,147 @@
+package receiver
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// DomesticCountryCode 中国大陆的国家码，其余国家和地区（包括港澳台）都按国际/港澳台短信发送
+	DomesticCountryCode = "86"
+
+	// E.164 规定的号码最大长度，包括国家码，不包括 +
+	maxE164Digits = 15
+	// 国家码之后的号码最短长度
+	minNationalDigits = 4
+	maxCountryCodeLen = 3
+)
+
+var (
+	ErrInvalidPhone = errors.New("手机号格式错误")
+
+	domesticMobile = regexp.MustCompile(`^1[3-9]\d{9}$`)
+	phoneSeparator = strings.NewReplacer(" ", "", "-", "", "(", "", ")", "", ".", "")
+)
+
+// Phone 解析后的手机号
+type Phone struct {
+	CountryCode string // 国家或地区码，不带 +
+	Number      string // 去掉国家码后的号码
+}
+
+// ParsePhone 按 E.164 解析手机号，支持 +、00 开头的国际格式，
+// 没有国家码时只接受中国大陆11位手机号，同时兼容 86 开头的13位号码
+func ParsePhone(raw string) (Phone, error) {
+	s := phoneSeparator.Replace(strings.TrimSpace(raw))
+	switch {
+	case strings.HasPrefix(s, "+"):
+		return parseInternational(s[1:])
+	case strings.HasPrefix(s, "00"):
+		return parseInternational(s[2:])
+	}
+	if !isDigits(s) {
+		return Phone{}, fmt.Errorf("%w: 包含非数字字符", ErrInvalidPhone)
+	}
+	if domesticMobile.MatchString(s) {
+		return Phone{CountryCode: DomesticCountryCode, Number: s}, nil
+	}
+	if number, ok := strings.CutPrefix(s, DomesticCountryCode); ok && domesticMobile.MatchString(number) {
+		return Phone{CountryCode: DomesticCountryCode, Number: number}, nil
+	}
+	return Phone{}, fmt.Errorf("%w: 非中国大陆手机号需要带 + 和国家或地区码", ErrInvalidPhone)
+}
+
+func parseInternational(digits string) (Phone, error) {
+	if !isDigits(digits) {
+		return Phone{}, fmt.Errorf("%w: 包含非数字字符", ErrInvalidPhone)
+	}
+	if len(digits) > maxE164Digits {
+		return Phone{}, fmt.Errorf("%w: 超过 E.164 规定的 %d 位", ErrInvalidPhone, maxE164Digits)
+	}
+	for l := 1; l <= maxCountryCodeLen && l < len(digits); l++ {
+		code := digits[:l]
+		if _, ok := countryCodes[code]; !ok {
+			continue
+		}
+		number := digits[l:]
+		if code == DomesticCountryCode && !domesticMobile.MatchString(number) {
+			return Phone{}, fmt.Errorf("%w: 不是有效的中国大陆手机号", ErrInvalidPhone)
+		}
+		if len(number) < minNationalDigits {
+			return Phone{}, fmt.Errorf("%w: 号码过短", ErrInvalidPhone)
+		}
+		return Phone{CountryCode: code, Number: number}, nil
+	}
+	return Phone{}, fmt.Errorf("%w: 未知的国家或地区码", ErrInvalidPhone)
+}
+
+// IsDomestic 是否中国大陆手机号
+func (p Phone) IsDomestic() bool {
+	return p.CountryCode == DomesticCountryCode
+}
+
+// E164 +[国家或地区码][号码]
+func (p Phone) E164() string {
+	return "+" + p.CountryCode + p.Number
+}
+
+// String 平台内统一的格式，中国大陆手机号不带国家码，其余为 E.164
+func (p Phone) String() string {
+	if p.IsDomestic() {
+		return p.Number
+	}
+	return p.E164()
+}
+
+// NormalizePhone 转换为平台内统一的格式，无法解析时原样返回，用于处理供应商返回的手机号
+func NormalizePhone(raw string) string {
+	p, err := ParsePhone(raw)
+	if err != nil {
+		return raw
+	}
+	return p.String()
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// countryCodes ITU-T E.164 分配给国家和地区的代码
+var countryCodes = toSet(
+	"1", "7",
+	"20", "27", "30", "31", "32", "33", "34", "36", "39", "40", "41", "43", "44", "45", "46", "47", "48", "49",
+	"51", "52", "53", "54", "55", "56", "57", "58", "60", "61", "62", "63", "64", "65", "66",
+	"81", "82", "84", "86", "90", "91", "92", "93", "94", "95", "98",
+	"211", "212", "213", "216", "218", "220", "221", "222", "223", "224", "225", "226", "227", "228", "229",
+	"230", "231", "232", "233", "234", "235", "236", "237", "238", "239", "240", "241", "242", "243", "244",
+	"245", "246", "247", "248", "249", "250", "251", "252", "253", "254", "255", "256", "257", "258", "260",
+	"261", "262", "263", "264", "265", "266", "267", "268", "269", "290", "291", "297", "298", "299",
+	"350", "351", "352", "353", "354", "355", "356", "357", "358", "359", "370", "371", "372", "373", "374",
+	"375", "376", "377", "378", "380", "381", "382", "383", "385", "386", "387", "389",
+	"420", "421", "423",
+	"500", "501", "502", "503", "504", "505", "506", "507", "508", "509",
+	"590", "591", "592", "593", "594", "595", "596", "597", "598", "599",
+	"670", "672", "673", "674", "675", "676", "677", "678", "679", "680", "681", "682", "683", "685", "686",
+	"687", "688", "689", "690", "691", "692",
+	"850", "852", "853", "855", "856", "880", "886",
+	"960", "961", "962", "963", "964", "965", "966", "967", "968", "970", "971", "972", "973", "974", "975",
+	"976", "977", "992", "993", "994", "995", "996", "998",
+)
+
+func toSet(codes ...string) map[string]struct{} {
+	set := make(map[string]struct{}, len(codes))
+	for _, code := range codes {
+		set[code] = struct{}{}
+	}
+	return set
+}
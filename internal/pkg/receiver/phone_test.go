@@ -0,0 +1,138 @@
+//go:build unit
+
+package receiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePhone(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		raw          string
+		wantPhone    Phone
+		wantString   string
+		wantE164     string
+		wantDomestic bool
+		wantErr      error
+	}{
+		{
+			name:         "中国大陆11位手机号",
+			raw:          "13800138000",
+			wantPhone:    Phone{CountryCode: "86", Number: "13800138000"},
+			wantString:   "13800138000",
+			wantE164:     "+8613800138000",
+			wantDomestic: true,
+		},
+		{
+			name:         "中国大陆E164",
+			raw:          "+86 138-0013-8000",
+			wantPhone:    Phone{CountryCode: "86", Number: "13800138000"},
+			wantString:   "13800138000",
+			wantE164:     "+8613800138000",
+			wantDomestic: true,
+		},
+		{
+			name:         "00开头",
+			raw:          "008613800138000",
+			wantPhone:    Phone{CountryCode: "86", Number: "13800138000"},
+			wantString:   "13800138000",
+			wantE164:     "+8613800138000",
+			wantDomestic: true,
+		},
+		{
+			name:         "86开头不带加号",
+			raw:          "8613800138000",
+			wantPhone:    Phone{CountryCode: "86", Number: "13800138000"},
+			wantString:   "13800138000",
+			wantE164:     "+8613800138000",
+			wantDomestic: true,
+		},
+		{
+			name:       "香港",
+			raw:        "+852 5123 4567",
+			wantPhone:  Phone{CountryCode: "852", Number: "51234567"},
+			wantString: "+85251234567",
+			wantE164:   "+85251234567",
+		},
+		{
+			name:       "美国",
+			raw:        "+1 (415) 555-2671",
+			wantPhone:  Phone{CountryCode: "1", Number: "4155552671"},
+			wantString: "+14155552671",
+			wantE164:   "+14155552671",
+		},
+		{
+			name:       "英国",
+			raw:        "+447911123456",
+			wantPhone:  Phone{CountryCode: "44", Number: "7911123456"},
+			wantString: "+447911123456",
+			wantE164:   "+447911123456",
+		},
+		{
+			name:    "没有国家码的非大陆号码",
+			raw:     "51234567",
+			wantErr: ErrInvalidPhone,
+		},
+		{
+			name:    "非数字",
+			raw:     "user1",
+			wantErr: ErrInvalidPhone,
+		},
+		{
+			name:    "空",
+			raw:     "",
+			wantErr: ErrInvalidPhone,
+		},
+		{
+			name:    "超过15位",
+			raw:     "+4479111234567890",
+			wantErr: ErrInvalidPhone,
+		},
+		{
+			name:    "未知国家码",
+			raw:     "+999123456",
+			wantErr: ErrInvalidPhone,
+		},
+		{
+			name:    "无效的大陆手机号",
+			raw:     "+8612345",
+			wantErr: ErrInvalidPhone,
+		},
+		{
+			name:    "号码过短",
+			raw:     "+44123",
+			wantErr: ErrInvalidPhone,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			phone, err := ParsePhone(tc.raw)
+			assert.ErrorIs(t, err, tc.wantErr)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.wantPhone, phone)
+			assert.Equal(t, tc.wantString, phone.String())
+			assert.Equal(t, tc.wantE164, phone.E164())
+			assert.Equal(t, tc.wantDomestic, phone.IsDomestic())
+		})
+	}
+}
+
+func TestNormalizePhone(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "13800138000", NormalizePhone("+8613800138000"))
+	require.Equal(t, "+85251234567", NormalizePhone("0085251234567"))
+	// 无法解析时原样返回
+	require.Equal(t, "abc", NormalizePhone("abc"))
+}
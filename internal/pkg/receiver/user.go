@@ -0,0 +1,30 @@
+package receiver
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// 与站内信表 user_id 列的长度一致
+const maxUserIDLen = 256
+
+var ErrInvalidUserID = errors.New("用户ID格式错误")
+
+// NormalizeUserID 去掉首尾空白，用户ID不能为空，不能包含空白和控制字符
+func NormalizeUserID(raw string) (string, error) {
+	userID := strings.TrimSpace(raw)
+	if userID == "" {
+		return "", fmt.Errorf("%w: 不能为空", ErrInvalidUserID)
+	}
+	if len(userID) > maxUserIDLen {
+		return "", fmt.Errorf("%w: 超过 %d 个字节", ErrInvalidUserID, maxUserIDLen)
+	}
+	if strings.IndexFunc(userID, func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsControl(r)
+	}) >= 0 {
+		return "", fmt.Errorf("%w: 包含空白或控制字符", ErrInvalidUserID)
+	}
+	return userID, nil
+}
@@ -0,0 +1,201 @@
+package secret
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// KeySize 主密钥和数据密钥的长度，使用 AES-256
+	KeySize = 32
+	// envelopePrefix 信封加密的密文前缀，完整格式为 enc:v1:<主密钥ID>:<加密后的数据密钥>:<密文>
+	envelopePrefix = "enc:v1:"
+	envelopeParts  = 3
+)
+
+var (
+	ErrInvalidKey        = errors.New("无效的加密密钥")
+	ErrUnknownKeyID      = errors.New("未知的加密密钥ID")
+	ErrInvalidCiphertext = errors.New("无效的密文")
+)
+
+// Keyring 信封加密的密钥环
+// 每次加密生成一个随机的数据密钥，用数据密钥加密明文，再用当前主密钥加密数据密钥，
+// 密文中带有主密钥ID，轮换主密钥后旧密文仍然可以用旧主密钥解密，再重新加密即可完成轮换
+type Keyring struct {
+	primaryID string
+	keys      map[string]cipher.AEAD
+	// legacy 引入信封加密之前直接用配置的密钥加密的密文，只用于解密
+	legacy cipher.AEAD
+}
+
+// NewKeyring 创建密钥环，keys 的 key 为主密钥ID，加密时使用 primaryID 对应的主密钥
+func NewKeyring(primaryID string, keys map[string][]byte) (*Keyring, error) {
+	if _, ok := keys[primaryID]; !ok {
+		return nil, fmt.Errorf("%w: 缺少当前主密钥 %q", ErrUnknownKeyID, primaryID)
+	}
+	k := &Keyring{
+		primaryID: primaryID,
+		keys:      make(map[string]cipher.AEAD, len(keys)),
+	}
+	for id, key := range keys {
+		if id == "" || strings.Contains(id, ":") {
+			return nil, fmt.Errorf("%w: 密钥ID %q 不能为空或者包含冒号", ErrInvalidKey, id)
+		}
+		if len(key) != KeySize {
+			return nil, fmt.Errorf("%w: 密钥 %q 的长度必须为 %d 字节", ErrInvalidKey, id, KeySize)
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, err
+		}
+		k.keys[id] = aead
+	}
+	return k, nil
+}
+
+// WithLegacyKey 设置引入信封加密之前使用的密钥，与旧版本一样不足 KeySize 的部分补零，超出的部分截断
+func (k *Keyring) WithLegacyKey(key string) (*Keyring, error) {
+	buf := make([]byte, KeySize)
+	copy(buf, key)
+	aead, err := newAEAD(buf)
+	if err != nil {
+		return nil, err
+	}
+	k.legacy = aead
+	return k, nil
+}
+
+// PrimaryKeyID 当前用于加密的主密钥ID
+func (k *Keyring) PrimaryKeyID() string {
+	return k.primaryID
+}
+
+// Encrypt 使用当前主密钥加密
+func (k *Keyring) Encrypt(plaintext string) (string, error) {
+	dataKey := make([]byte, KeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", err
+	}
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+	// 主密钥ID作为附加数据，防止篡改密文中的主密钥ID
+	wrappedKey, err := seal(k.keys[k.primaryID], dataKey, []byte(k.primaryID))
+	if err != nil {
+		return "", err
+	}
+	ciphertext, err := seal(dataAEAD, []byte(plaintext), nil)
+	if err != nil {
+		return "", err
+	}
+	return envelopePrefix + k.primaryID + ":" +
+		base64.StdEncoding.EncodeToString(wrappedKey) + ":" +
+		base64.StdEncoding.EncodeToString(ciphertext), nil
+}
+
+// Decrypt 解密 Encrypt 生成的密文，不是信封加密格式的密文使用旧版本的密钥解密
+func (k *Keyring) Decrypt(encrypted string) (string, error) {
+	if !IsEnvelope(encrypted) {
+		return k.decryptLegacy(encrypted)
+	}
+	parts := strings.Split(strings.TrimPrefix(encrypted, envelopePrefix), ":")
+	if len(parts) != envelopeParts {
+		return "", fmt.Errorf("%w: 格式错误", ErrInvalidCiphertext)
+	}
+	keyID := parts[0]
+	kek, ok := k.keys[keyID]
+	if !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnknownKeyID, keyID)
+	}
+	wrappedKey, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidCiphertext, err)
+	}
+	dataKey, err := open(kek, wrappedKey, []byte(keyID))
+	if err != nil {
+		return "", err
+	}
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+	ciphertext, err := base64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidCiphertext, err)
+	}
+	plaintext, err := open(dataAEAD, ciphertext, nil)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+func (k *Keyring) decryptLegacy(encrypted string) (string, error) {
+	if k.legacy == nil {
+		return "", fmt.Errorf("%w: 没有配置旧版本的密钥", ErrInvalidCiphertext)
+	}
+	ciphertext, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidCiphertext, err)
+	}
+	plaintext, err := open(k.legacy, ciphertext, nil)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// NeedsRotation 密文不是用当前主密钥加密的，需要重新加密
+func (k *Keyring) NeedsRotation(encrypted string) bool {
+	return KeyID(encrypted) != k.primaryID
+}
+
+// IsEnvelope 是否为信封加密格式的密文
+func IsEnvelope(s string) bool {
+	return strings.HasPrefix(s, envelopePrefix)
+}
+
+// KeyID 返回加密使用的主密钥ID，不是信封加密格式时返回空字符串
+func KeyID(encrypted string) string {
+	if !IsEnvelope(encrypted) {
+		return ""
+	}
+	id, _, _ := strings.Cut(strings.TrimPrefix(encrypted, envelopePrefix), ":")
+	return id
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidKey, err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal 加密，随机生成的 nonce 放在密文前面
+func seal(aead cipher.AEAD, plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func open(aead cipher.AEAD, ciphertext, additionalData []byte) ([]byte, error) {
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, fmt.Errorf("%w: 密文太短", ErrInvalidCiphertext)
+	}
+	nonce, ciphertext := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, additionalData)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCiphertext, err)
+	}
+	return plaintext, nil
+}
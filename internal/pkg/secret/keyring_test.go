@@ -0,0 +1,159 @@
+//go:build unit
+
+package secret
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestKeyring(t *testing.T, primaryID string) *Keyring {
+	t.Helper()
+	k, err := NewKeyring(primaryID, map[string][]byte{
+		"k1": bytes.Repeat([]byte{1}, KeySize),
+		"k2": bytes.Repeat([]byte{2}, KeySize),
+	})
+	require.NoError(t, err)
+	return k
+}
+
+func TestNewKeyring(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		primaryID string
+		keys      map[string][]byte
+		wantErr   error
+	}{
+		{
+			name:      "缺少当前主密钥",
+			primaryID: "k2",
+			keys:      map[string][]byte{"k1": make([]byte, KeySize)},
+			wantErr:   ErrUnknownKeyID,
+		},
+		{
+			name:      "密钥长度错误",
+			primaryID: "k1",
+			keys:      map[string][]byte{"k1": make([]byte, 16)},
+			wantErr:   ErrInvalidKey,
+		},
+		{
+			name:      "密钥ID包含冒号",
+			primaryID: "k:1",
+			keys:      map[string][]byte{"k:1": make([]byte, KeySize)},
+			wantErr:   ErrInvalidKey,
+		},
+		{
+			name:      "创建成功",
+			primaryID: "k1",
+			keys:      map[string][]byte{"k1": make([]byte, KeySize)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewKeyring(tt.primaryID, tt.keys)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestKeyring_EncryptDecrypt(t *testing.T) {
+	t.Parallel()
+	k := newTestKeyring(t, "k1")
+
+	encrypted, err := k.Encrypt("api-secret")
+	require.NoError(t, err)
+	assert.True(t, IsEnvelope(encrypted))
+	assert.Equal(t, "k1", KeyID(encrypted))
+	assert.NotContains(t, encrypted, "api-secret")
+	assert.False(t, k.NeedsRotation(encrypted))
+
+	// 每次加密使用不同的数据密钥
+	encrypted2, err := k.Encrypt("api-secret")
+	require.NoError(t, err)
+	assert.NotEqual(t, encrypted, encrypted2)
+
+	plaintext, err := k.Decrypt(encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, "api-secret", plaintext)
+
+	// 篡改密文中的主密钥ID
+	_, err = k.Decrypt(strings.Replace(encrypted, "enc:v1:k1:", "enc:v1:k2:", 1))
+	assert.ErrorIs(t, err, ErrInvalidCiphertext)
+	_, err = k.Decrypt("enc:v1:k3:AAAA:AAAA")
+	assert.ErrorIs(t, err, ErrUnknownKeyID)
+	_, err = k.Decrypt("enc:v1:k1:AAAA")
+	assert.ErrorIs(t, err, ErrInvalidCiphertext)
+}
+
+func TestKeyring_Rotate(t *testing.T) {
+	t.Parallel()
+	old := newTestKeyring(t, "k1")
+	encrypted, err := old.Encrypt("api-secret")
+	require.NoError(t, err)
+
+	// 轮换后旧密文仍然可以解密，重新加密后使用新的主密钥
+	rotated := newTestKeyring(t, "k2")
+	assert.True(t, rotated.NeedsRotation(encrypted))
+	plaintext, err := rotated.Decrypt(encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, "api-secret", plaintext)
+	reEncrypted, err := rotated.Encrypt(plaintext)
+	require.NoError(t, err)
+	assert.Equal(t, "k2", KeyID(reEncrypted))
+	assert.False(t, rotated.NeedsRotation(reEncrypted))
+}
+
+func TestKeyring_DecryptLegacy(t *testing.T) {
+	t.Parallel()
+	const legacyKey = "test_key"
+	// 旧版本的加密方式
+	key := make([]byte, KeySize)
+	copy(key, legacyKey)
+	block, err := aes.NewCipher(key)
+	require.NoError(t, err)
+	gcm, err := cipher.NewGCM(block)
+	require.NoError(t, err)
+	nonce := make([]byte, gcm.NonceSize())
+	_, err = rand.Read(nonce)
+	require.NoError(t, err)
+	legacy := base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, []byte("api-secret"), nil))
+
+	k := newTestKeyring(t, "k1")
+	_, err = k.Decrypt(legacy)
+	assert.ErrorIs(t, err, ErrInvalidCiphertext)
+
+	k, err = k.WithLegacyKey(legacyKey)
+	require.NoError(t, err)
+	assert.True(t, k.NeedsRotation(legacy))
+	plaintext, err := k.Decrypt(legacy)
+	require.NoError(t, err)
+	assert.Equal(t, "api-secret", plaintext)
+}
+
+func TestMask(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "", want: ""},
+		{input: "secret", want: "******"},
+		{input: "LTAI5tAbCdEfGh", want: "LT******Gh"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, Mask(tt.input))
+		assert.Equal(t, tt.input != "", IsMasked(Mask(tt.input)))
+	}
+	assert.False(t, IsMasked("LTAI5tAbCdEfGh"))
+	assert.False(t, IsMasked("secret"))
+}
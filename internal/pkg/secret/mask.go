@@ -0,0 +1,34 @@
+package secret
+
+import "strings"
+
+const (
+	// maskVisible 脱敏后首尾各保留的字符数
+	maskVisible = 2
+	// maskMinLen 不超过该长度的值全部隐藏
+	maskMinLen = 8
+	maskChar   = "*"
+	maskLen    = 6
+)
+
+// Mask 对密钥脱敏，只保留首尾少量字符用于辨认，用于日志和接口响应
+func Mask(s string) string {
+	if s == "" {
+		return ""
+	}
+	runes := []rune(s)
+	if len(runes) <= maskMinLen {
+		return strings.Repeat(maskChar, maskLen)
+	}
+	return string(runes[:maskVisible]) + strings.Repeat(maskChar, maskLen) + string(runes[len(runes)-maskVisible:])
+}
+
+// IsMasked 判断是否为 Mask 脱敏后的值，避免把接口返回的脱敏值当作新的密钥写回
+func IsMasked(s string) bool {
+	stars := strings.Repeat(maskChar, maskLen)
+	if s == stars {
+		return true
+	}
+	runes := []rune(s)
+	return len(runes) == 2*maskVisible+maskLen && string(runes[maskVisible:maskVisible+maskLen]) == stars
+}
@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"context"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/repository/dao"
+	"github.com/ecodeclub/ekit/slice"
+)
+
+// AuditRepository 内部审核仓储接口
+type AuditRepository interface {
+	Create(ctx context.Context, audit domain.Audit) (domain.Audit, error)
+	GetByID(ctx context.Context, id int64) (domain.Audit, error)
+	// ListByReviewer 按截止时间升序分页查询审核人名下指定状态的审核
+	ListByReviewer(ctx context.Context, reviewerID int64, status domain.AuditStatus, offset, limit int) ([]domain.Audit, int64, error)
+	// Decide 记录审核结果，审核已结束或者不由 reviewerID 负责时返回 errs.ErrInvalidOperation
+	Decide(ctx context.Context, audit domain.Audit) error
+	FindOverdue(ctx context.Context, now int64, limit int) ([]domain.Audit, error)
+	// Reassign 把审核中的审核从 fromReviewerID 转给 audit.ReviewerID，审核已经结束或者被转走时返回 false
+	Reassign(ctx context.Context, audit domain.Audit, fromReviewerID int64) (bool, error)
+	FindUnpublished(ctx context.Context, limit int) ([]domain.Audit, error)
+	MarkPublished(ctx context.Context, id int64) error
+
+	SaveReviewer(ctx context.Context, reviewer domain.AuditReviewer) (domain.AuditReviewer, error)
+	ListReviewers(ctx context.Context) ([]domain.AuditReviewer, error)
+	// FindLeastLoadedReviewer 该级别中审核中数量最少的已启用审核人，没有时返回的 ID 为0
+	FindLeastLoadedReviewer(ctx context.Context, level int) (domain.AuditReviewer, error)
+	// UpdateReviewerTokenHash 替换审核人的访问令牌
+	UpdateReviewerTokenHash(ctx context.Context, id int64, tokenHash string) error
+	// FindReviewerByTokenHash 按访问令牌查找审核人，没有时返回的 ID 为0
+	FindReviewerByTokenHash(ctx context.Context, tokenHash string) (domain.AuditReviewer, error)
+}
+
+type auditRepository struct {
+	dao dao.AuditDAO
+}
+
+func NewAuditRepository(dao dao.AuditDAO) AuditRepository {
+	return &auditRepository{dao: dao}
+}
+
+func (r *auditRepository) Create(ctx context.Context, audit domain.Audit) (domain.Audit, error) {
+	created, err := r.dao.Create(ctx, r.toEntity(audit))
+	if err != nil {
+		return domain.Audit{}, err
+	}
+	return r.toDomain(created), nil
+}
+
+func (r *auditRepository) GetByID(ctx context.Context, id int64) (domain.Audit, error) {
+	audit, err := r.dao.GetByID(ctx, id)
+	if err != nil {
+		return domain.Audit{}, err
+	}
+	return r.toDomain(audit), nil
+}
+
+func (r *auditRepository) ListByReviewer(ctx context.Context, reviewerID int64, status domain.AuditStatus, offset, limit int) ([]domain.Audit, int64, error) {
+	audits, err := r.dao.ListByReviewer(ctx, reviewerID, status.String(), offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err := r.dao.CountByReviewer(ctx, reviewerID, status.String())
+	if err != nil {
+		return nil, 0, err
+	}
+	return r.toDomains(audits), total, nil
+}
+
+func (r *auditRepository) Decide(ctx context.Context, audit domain.Audit) error {
+	return r.dao.Decide(ctx, audit.ID, audit.ReviewerID, audit.Status.String(), audit.RejectReason, audit.AuditTime)
+}
+
+func (r *auditRepository) FindOverdue(ctx context.Context, now int64, limit int) ([]domain.Audit, error) {
+	audits, err := r.dao.FindOverdue(ctx, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	return r.toDomains(audits), nil
+}
+
+func (r *auditRepository) Reassign(ctx context.Context, audit domain.Audit, fromReviewerID int64) (bool, error) {
+	return r.dao.Reassign(ctx, audit.ID, fromReviewerID, audit.ReviewerID, audit.Level, audit.Deadline)
+}
+
+func (r *auditRepository) FindUnpublished(ctx context.Context, limit int) ([]domain.Audit, error) {
+	audits, err := r.dao.FindUnpublished(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	return r.toDomains(audits), nil
+}
+
+func (r *auditRepository) MarkPublished(ctx context.Context, id int64) error {
+	return r.dao.MarkPublished(ctx, id)
+}
+
+func (r *auditRepository) SaveReviewer(ctx context.Context, reviewer domain.AuditReviewer) (domain.AuditReviewer, error) {
+	saved, err := r.dao.SaveReviewer(ctx, dao.AuditReviewer{
+		ID:      reviewer.ID,
+		Name:    reviewer.Name,
+		Level:   reviewer.Level,
+		Enabled: reviewer.Enabled,
+	})
+	if err != nil {
+		return domain.AuditReviewer{}, err
+	}
+	return r.toReviewerDomain(saved), nil
+}
+
+func (r *auditRepository) ListReviewers(ctx context.Context) ([]domain.AuditReviewer, error) {
+	reviewers, err := r.dao.ListReviewers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(reviewers, func(_ int, src dao.AuditReviewer) domain.AuditReviewer {
+		return r.toReviewerDomain(src)
+	}), nil
+}
+
+func (r *auditRepository) FindLeastLoadedReviewer(ctx context.Context, level int) (domain.AuditReviewer, error) {
+	reviewer, err := r.dao.FindLeastLoadedReviewer(ctx, level)
+	if err != nil {
+		return domain.AuditReviewer{}, err
+	}
+	return r.toReviewerDomain(reviewer), nil
+}
+
+func (r *auditRepository) UpdateReviewerTokenHash(ctx context.Context, id int64, tokenHash string) error {
+	return r.dao.UpdateReviewerTokenHash(ctx, id, tokenHash)
+}
+
+func (r *auditRepository) FindReviewerByTokenHash(ctx context.Context, tokenHash string) (domain.AuditReviewer, error) {
+	reviewer, err := r.dao.FindReviewerByTokenHash(ctx, tokenHash)
+	if err != nil {
+		return domain.AuditReviewer{}, err
+	}
+	return r.toReviewerDomain(reviewer), nil
+}
+
+func (r *auditRepository) toDomains(audits []dao.Audit) []domain.Audit {
+	return slice.Map(audits, func(_ int, src dao.Audit) domain.Audit {
+		return r.toDomain(src)
+	})
+}
+
+func (r *auditRepository) toEntity(audit domain.Audit) dao.Audit {
+	return dao.Audit{
+		ID:              audit.ID,
+		ResourceID:      audit.ResourceID,
+		ResourceType:    string(audit.ResourceType),
+		Content:         audit.Content,
+		ReviewerID:      audit.ReviewerID,
+		Level:           audit.Level,
+		Status:          audit.Status.String(),
+		RejectReason:    audit.RejectReason,
+		Deadline:        audit.Deadline,
+		AuditTime:       audit.AuditTime,
+		ResultPublished: audit.ResultPublished,
+		Ctime:           audit.Ctime,
+		Utime:           audit.Utime,
+	}
+}
+
+func (r *auditRepository) toDomain(audit dao.Audit) domain.Audit {
+	return domain.Audit{
+		ID:              audit.ID,
+		ResourceID:      audit.ResourceID,
+		ResourceType:    domain.ResourceType(audit.ResourceType),
+		Content:         audit.Content,
+		ReviewerID:      audit.ReviewerID,
+		Level:           audit.Level,
+		Status:          domain.AuditStatus(audit.Status),
+		RejectReason:    audit.RejectReason,
+		Deadline:        audit.Deadline,
+		AuditTime:       audit.AuditTime,
+		ResultPublished: audit.ResultPublished,
+		Ctime:           audit.Ctime,
+		Utime:           audit.Utime,
+	}
+}
+
+func (r *auditRepository) toReviewerDomain(reviewer dao.AuditReviewer) domain.AuditReviewer {
+	return domain.AuditReviewer{
+		ID:      reviewer.ID,
+		Name:    reviewer.Name,
+		Level:   reviewer.Level,
+		Enabled: reviewer.Enabled,
+		Ctime:   reviewer.Ctime,
+		Utime:   reviewer.Utime,
+	}
+}
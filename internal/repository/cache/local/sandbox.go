@@ -0,0 +1,82 @@
+package local
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/repository/cache"
+)
+
+var _ cache.SandboxCache = (*SandboxCache)(nil)
+
+// SandboxCache 只在单个实例内可见，用于单实例的测试环境和本地调试
+type SandboxCache struct {
+	mu       sync.RWMutex
+	messages map[int64][]sandboxEntry // 按捕获时间正序
+}
+
+type sandboxEntry struct {
+	msg      domain.CapturedMessage
+	expireAt time.Time
+}
+
+func NewSandboxCache() *SandboxCache {
+	return &SandboxCache{messages: make(map[int64][]sandboxEntry)}
+}
+
+func (s *SandboxCache) Save(_ context.Context, msg domain.CapturedMessage, retention time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	entries := s.alive(msg.BizID, now)
+	entries = append(entries, sandboxEntry{msg: msg, expireAt: now.Add(retention)})
+	if len(entries) > cache.SandboxMaxMessages {
+		entries = slices.Clone(entries[len(entries)-cache.SandboxMaxMessages:])
+	}
+	s.messages[msg.BizID] = entries
+	return nil
+}
+
+func (s *SandboxCache) Find(_ context.Context, bizID int64) ([]domain.CapturedMessage, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	entries := s.alive(bizID, time.Now())
+	res := make([]domain.CapturedMessage, 0, len(entries))
+	for i := len(entries) - 1; i >= 0; i-- {
+		res = append(res, entries[i].msg)
+	}
+	return res, nil
+}
+
+func (s *SandboxCache) Get(_ context.Context, bizID int64, id string) (domain.CapturedMessage, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, entry := range s.alive(bizID, time.Now()) {
+		if entry.msg.ID == id {
+			return entry.msg, nil
+		}
+	}
+	return domain.CapturedMessage{}, cache.ErrKeyNotFound
+}
+
+func (s *SandboxCache) Clear(_ context.Context, bizID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.messages, bizID)
+	return nil
+}
+
+// alive 未过期的消息，不修改 messages，调用方需要持有锁
+func (s *SandboxCache) alive(bizID int64, now time.Time) []sandboxEntry {
+	entries := s.messages[bizID]
+	idx := slices.IndexFunc(entries, func(e sandboxEntry) bool {
+		return e.expireAt.After(now)
+	})
+	if idx < 0 {
+		return nil
+	}
+	return entries[idx:]
+}
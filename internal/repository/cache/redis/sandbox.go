@@ -0,0 +1,103 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/repository/cache"
+	"github.com/redis/go-redis/v9"
+)
+
+var _ cache.SandboxCache = (*SandboxCache)(nil)
+
+// SandboxCache 消息内容保存为带过期时间的字符串，业务的消息索引为按捕获时间排序的有序集合
+type SandboxCache struct {
+	client redis.Cmdable
+}
+
+func NewSandboxCache(client redis.Cmdable) *SandboxCache {
+	return &SandboxCache{client: client}
+}
+
+func (s *SandboxCache) Save(ctx context.Context, msg domain.CapturedMessage, retention time.Duration) error {
+	val, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	indexKey := cache.SandboxIndexKey(msg.BizID)
+	expired := time.Now().Add(-retention).UnixMilli()
+	_, err = s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, cache.SandboxMessageKey(msg.BizID, msg.ID), val, retention)
+		pipe.ZAdd(ctx, indexKey, redis.Z{Score: float64(msg.Ctime), Member: msg.ID})
+		// 清理过期的和超出数量的索引
+		pipe.ZRemRangeByScore(ctx, indexKey, "-inf", "("+strconv.FormatInt(expired, 10))
+		pipe.ZRemRangeByRank(ctx, indexKey, 0, -cache.SandboxMaxMessages-1)
+		pipe.Expire(ctx, indexKey, retention)
+		return nil
+	})
+	return err
+}
+
+func (s *SandboxCache) Find(ctx context.Context, bizID int64) ([]domain.CapturedMessage, error) {
+	ids, err := s.client.ZRevRange(ctx, cache.SandboxIndexKey(bizID), 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis执行ZREVRANGE失败: %w", err)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, cache.SandboxMessageKey(bizID, id))
+	}
+	vals, err := s.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis执行MGET失败: %w", err)
+	}
+	res := make([]domain.CapturedMessage, 0, len(vals))
+	for _, val := range vals {
+		// 消息已过期但索引还没有清理
+		str, ok := val.(string)
+		if !ok {
+			continue
+		}
+		var msg domain.CapturedMessage
+		if err = json.Unmarshal([]byte(str), &msg); err != nil {
+			return nil, err
+		}
+		res = append(res, msg)
+	}
+	return res, nil
+}
+
+func (s *SandboxCache) Get(ctx context.Context, bizID int64, id string) (domain.CapturedMessage, error) {
+	val, err := s.client.Get(ctx, cache.SandboxMessageKey(bizID, id)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return domain.CapturedMessage{}, cache.ErrKeyNotFound
+	}
+	if err != nil {
+		return domain.CapturedMessage{}, err
+	}
+	var msg domain.CapturedMessage
+	err = json.Unmarshal(val, &msg)
+	return msg, err
+}
+
+func (s *SandboxCache) Clear(ctx context.Context, bizID int64) error {
+	indexKey := cache.SandboxIndexKey(bizID)
+	ids, err := s.client.ZRange(ctx, indexKey, 0, -1).Result()
+	if err != nil {
+		return fmt.Errorf("redis执行ZRANGE失败: %w", err)
+	}
+	keys := make([]string, 0, len(ids)+1)
+	for _, id := range ids {
+		keys = append(keys, cache.SandboxMessageKey(bizID, id))
+	}
+	keys = append(keys, indexKey)
+	return s.client.Del(ctx, keys...).Err()
+}
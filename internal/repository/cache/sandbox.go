@@ -0,0 +1,37 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+)
+
+const (
+	SandboxPrefix = "sandbox"
+	// SandboxMaxMessages 每个业务最多保留的消息数，超出时丢弃最早的消息
+	SandboxMaxMessages = 1000
+)
+
+// SandboxCache 沙箱消息存储，按业务隔离，超过保留时长的消息自动清理
+type SandboxCache interface {
+	// Save 保存消息，retention 为该业务的保留时长
+	Save(ctx context.Context, msg domain.CapturedMessage, retention time.Duration) error
+	// Find 按捕获时间倒序返回业务保留期内的全部消息
+	Find(ctx context.Context, bizID int64) ([]domain.CapturedMessage, error)
+	// Get 不存在或已过期时返回 ErrKeyNotFound
+	Get(ctx context.Context, bizID int64, id string) (domain.CapturedMessage, error)
+	// Clear 清空业务的全部消息
+	Clear(ctx context.Context, bizID int64) error
+}
+
+// SandboxIndexKey 业务的消息索引
+func SandboxIndexKey(bizID int64) string {
+	return fmt.Sprintf("%s:%d:messages", SandboxPrefix, bizID)
+}
+
+// SandboxMessageKey 单条消息
+func SandboxMessageKey(bizID int64, id string) string {
+	return fmt.Sprintf("%s:%d:message:%s", SandboxPrefix, bizID, id)
+}
@@ -0,0 +1,264 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"github.com/ego-component/egorm"
+	"gorm.io/gorm"
+)
+
+// Audit 内部审核记录表
+type Audit struct {
+	ID              int64  `gorm:"primaryKey;autoIncrement;comment:'审核记录ID'"`
+	ResourceID      int64  `gorm:"type:BIGINT;NOT NULL;index:idx_resource,priority:2;comment:'审核的资源ID，如模版版本ID'"`
+	ResourceType    string `gorm:"type:VARCHAR(32);NOT NULL;index:idx_resource,priority:1;comment:'资源类型'"`
+	Content         string `gorm:"type:TEXT;NOT NULL;comment:'审核内容，完整JSON串'"`
+	ReviewerID      int64  `gorm:"type:BIGINT;NOT NULL;DEFAULT:0;index:idx_reviewer_status,priority:1;comment:'当前审核人ID，0表示没有可分配的审核人'"`
+	Level           int    `gorm:"type:INT;NOT NULL;DEFAULT:0;comment:'当前审核级别'"`
+	Status          string `gorm:"type:ENUM('IN_REVIEW','APPROVED','REJECTED');NOT NULL;DEFAULT:'IN_REVIEW';index:idx_reviewer_status,priority:2;index:idx_status_deadline,priority:1;comment:'审核状态'"`
+	RejectReason    string `gorm:"type:VARCHAR(512);NOT NULL;DEFAULT:'';comment:'拒绝原因'"`
+	Deadline        int64  `gorm:"type:BIGINT;NOT NULL;DEFAULT:0;index:idx_status_deadline,priority:2;comment:'当前级别的审核截止时间'"`
+	AuditTime       int64  `gorm:"type:BIGINT;NOT NULL;DEFAULT:0;comment:'审核时间'"`
+	ResultPublished bool   `gorm:"type:BOOLEAN;NOT NULL;DEFAULT:false;index:idx_result_published;comment:'审核结果是否已经发送'"`
+	Ctime           int64
+	Utime           int64
+}
+
+// TableName 重命名表
+func (Audit) TableName() string {
+	return "audits"
+}
+
+// AuditReviewer 审核人表
+type AuditReviewer struct {
+	ID      int64  `gorm:"primaryKey;autoIncrement;comment:'审核人ID'"`
+	Name    string `gorm:"type:VARCHAR(64);NOT NULL;comment:'审核人名称'"`
+	Level   int    `gorm:"type:INT;NOT NULL;DEFAULT:0;index:idx_level_enabled,priority:1;comment:'审核级别'"`
+	Enabled bool   `gorm:"type:BOOLEAN;NOT NULL;index:idx_level_enabled,priority:2;comment:'是否参与分配'"`
+	// TokenHash 审核人访问令牌的 SHA-256，令牌明文只在生成时返回一次
+	TokenHash string `gorm:"type:CHAR(64);NOT NULL;DEFAULT:'';index:idx_token_hash;comment:'访问令牌的SHA-256'"`
+	Ctime     int64
+	Utime     int64
+}
+
+// TableName 重命名表
+func (AuditReviewer) TableName() string {
+	return "audit_reviewers"
+}
+
+type AuditDAO interface {
+	Create(ctx context.Context, audit Audit) (Audit, error)
+	GetByID(ctx context.Context, id int64) (Audit, error)
+	// ListByReviewer 按截止时间升序分页查询审核人名下指定状态的审核
+	ListByReviewer(ctx context.Context, reviewerID int64, status string, offset, limit int) ([]Audit, error)
+	CountByReviewer(ctx context.Context, reviewerID int64, status string) (int64, error)
+	// Decide 记录审核结果，只有审核中且由 reviewerID 负责的审核才能更新，否则返回 errs.ErrInvalidOperation
+	Decide(ctx context.Context, id, reviewerID int64, status, rejectReason string, auditTime int64) error
+	// FindOverdue 查找超过截止时间仍在审核中的审核
+	FindOverdue(ctx context.Context, now int64, limit int) ([]Audit, error)
+	// Reassign 把审核中的审核从 fromReviewerID 转给 toReviewerID，审核已经结束或者被转走时返回 false
+	Reassign(ctx context.Context, id, fromReviewerID, toReviewerID int64, level int, deadline int64) (bool, error)
+	// FindUnpublished 查找已经有结果但是结果还没有发送的审核
+	FindUnpublished(ctx context.Context, limit int) ([]Audit, error)
+	MarkPublished(ctx context.Context, id int64) error
+
+	// SaveReviewer ID 为0时新增，否则更新名称、级别和是否启用
+	SaveReviewer(ctx context.Context, reviewer AuditReviewer) (AuditReviewer, error)
+	ListReviewers(ctx context.Context) ([]AuditReviewer, error)
+	// FindLeastLoadedReviewer 查找该级别中审核中数量最少的已启用审核人，数量相同时选ID最小的，没有时返回的 ID 为0
+	FindLeastLoadedReviewer(ctx context.Context, level int) (AuditReviewer, error)
+	// UpdateReviewerTokenHash 替换审核人的访问令牌，审核人不存在时返回 errs.ErrInvalidParameter
+	UpdateReviewerTokenHash(ctx context.Context, id int64, tokenHash string) error
+	// FindReviewerByTokenHash 按访问令牌查找审核人，没有时返回的 ID 为0
+	FindReviewerByTokenHash(ctx context.Context, tokenHash string) (AuditReviewer, error)
+}
+
+type auditDAO struct {
+	db *egorm.Component
+}
+
+func NewAuditDAO(db *egorm.Component) AuditDAO {
+	return &auditDAO{db: db}
+}
+
+func (d *auditDAO) Create(ctx context.Context, audit Audit) (Audit, error) {
+	now := time.Now().Unix()
+	audit.Ctime, audit.Utime = now, now
+	err := d.db.WithContext(ctx).Create(&audit).Error
+	return audit, err
+}
+
+func (d *auditDAO) GetByID(ctx context.Context, id int64) (Audit, error) {
+	var audit Audit
+	err := d.db.WithContext(ctx).First(&audit, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return Audit{}, fmt.Errorf("%w: id=%d", errs.ErrAuditNotFound, id)
+		}
+		return Audit{}, err
+	}
+	return audit, nil
+}
+
+func (d *auditDAO) ListByReviewer(ctx context.Context, reviewerID int64, status string, offset, limit int) ([]Audit, error) {
+	var res []Audit
+	err := d.db.WithContext(ctx).
+		Where("reviewer_id = ? AND status = ?", reviewerID, status).
+		Order("deadline ASC, id ASC").
+		Offset(offset).Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (d *auditDAO) CountByReviewer(ctx context.Context, reviewerID int64, status string) (int64, error) {
+	var total int64
+	err := d.db.WithContext(ctx).Model(&Audit{}).
+		Where("reviewer_id = ? AND status = ?", reviewerID, status).
+		Count(&total).Error
+	return total, err
+}
+
+func (d *auditDAO) Decide(ctx context.Context, id, reviewerID int64, status, rejectReason string, auditTime int64) error {
+	res := d.db.WithContext(ctx).Model(&Audit{}).
+		Where("id = ? AND reviewer_id = ? AND status = ?", id, reviewerID, domain.AuditStatusInReview.String()).
+		Updates(map[string]any{
+			"status":        status,
+			"reject_reason": rejectReason,
+			"audit_time":    auditTime,
+			"utime":         time.Now().Unix(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("%w: 审核已结束或者已转给其他审核人, id=%d", errs.ErrInvalidOperation, id)
+	}
+	return nil
+}
+
+func (d *auditDAO) FindOverdue(ctx context.Context, now int64, limit int) ([]Audit, error) {
+	var res []Audit
+	err := d.db.WithContext(ctx).
+		Where("status = ? AND deadline <= ?", domain.AuditStatusInReview.String(), now).
+		Order("deadline ASC").
+		Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (d *auditDAO) Reassign(ctx context.Context, id, fromReviewerID, toReviewerID int64, level int, deadline int64) (bool, error) {
+	res := d.db.WithContext(ctx).Model(&Audit{}).
+		Where("id = ? AND reviewer_id = ? AND status = ?", id, fromReviewerID, domain.AuditStatusInReview.String()).
+		Updates(map[string]any{
+			"reviewer_id": toReviewerID,
+			"level":       level,
+			"deadline":    deadline,
+			"utime":       time.Now().Unix(),
+		})
+	return res.RowsAffected > 0, res.Error
+}
+
+func (d *auditDAO) FindUnpublished(ctx context.Context, limit int) ([]Audit, error) {
+	var res []Audit
+	err := d.db.WithContext(ctx).
+		Where("result_published = ? AND status IN ?", false,
+			[]string{domain.AuditStatusApproved.String(), domain.AuditStatusRejected.String()}).
+		Order("id ASC").
+		Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (d *auditDAO) MarkPublished(ctx context.Context, id int64) error {
+	return d.db.WithContext(ctx).Model(&Audit{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"result_published": true,
+			"utime":            time.Now().Unix(),
+		}).Error
+}
+
+func (d *auditDAO) SaveReviewer(ctx context.Context, reviewer AuditReviewer) (AuditReviewer, error) {
+	now := time.Now().Unix()
+	reviewer.Utime = now
+	if reviewer.ID == 0 {
+		reviewer.Ctime = now
+		err := d.db.WithContext(ctx).Create(&reviewer).Error
+		return reviewer, err
+	}
+	var saved AuditReviewer
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&AuditReviewer{}).
+			Where("id = ?", reviewer.ID).
+			Updates(map[string]any{
+				"name":    reviewer.Name,
+				"level":   reviewer.Level,
+				"enabled": reviewer.Enabled,
+				"utime":   now,
+			}).Error
+		if err != nil {
+			return err
+		}
+		return tx.First(&saved, reviewer.ID).Error
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return AuditReviewer{}, fmt.Errorf("%w: 审核人不存在, id=%d", errs.ErrInvalidParameter, reviewer.ID)
+	}
+	return saved, err
+}
+
+func (d *auditDAO) ListReviewers(ctx context.Context) ([]AuditReviewer, error) {
+	var res []AuditReviewer
+	err := d.db.WithContext(ctx).Order("level ASC, id ASC").Find(&res).Error
+	return res, err
+}
+
+func (d *auditDAO) FindLeastLoadedReviewer(ctx context.Context, level int) (AuditReviewer, error) {
+	var res []AuditReviewer
+	err := d.db.WithContext(ctx).
+		Table("audit_reviewers AS r").
+		Select("r.*").
+		Joins("LEFT JOIN audits AS a ON a.reviewer_id = r.id AND a.status = ?", domain.AuditStatusInReview.String()).
+		Where("r.level = ? AND r.enabled = ?", level, true).
+		Group("r.id").
+		Order("COUNT(a.id) ASC, r.id ASC").
+		Limit(1).
+		Find(&res).Error
+	if err != nil || len(res) == 0 {
+		return AuditReviewer{}, err
+	}
+	return res[0], nil
+}
+
+func (d *auditDAO) UpdateReviewerTokenHash(ctx context.Context, id int64, tokenHash string) error {
+	res := d.db.WithContext(ctx).Model(&AuditReviewer{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"token_hash": tokenHash,
+			"utime":      time.Now().Unix(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("%w: 审核人不存在, id=%d", errs.ErrInvalidParameter, id)
+	}
+	return nil
+}
+
+func (d *auditDAO) FindReviewerByTokenHash(ctx context.Context, tokenHash string) (AuditReviewer, error) {
+	var res []AuditReviewer
+	err := d.db.WithContext(ctx).
+		Where("token_hash = ?", tokenHash).
+		Limit(1).
+		Find(&res).Error
+	if err != nil || len(res) == 0 {
+		return AuditReviewer{}, err
+	}
+	return res[0], nil
+}
@@ -0,0 +1,41 @@
+package dao
+
+import "context"
+
+// InboxDAO 站内信存储，按 (biz_id, user_id) 分库分表，所有操作都限定在单个用户内
+type InboxDAO interface {
+	// BatchCreate 批量写入站内信，同一通知对同一用户重复写入时忽略
+	BatchCreate(ctx context.Context, msgs []InboxMessage) error
+	// List 按ID倒序游标分页，cursor 为0表示从最新的开始
+	List(ctx context.Context, bizID int64, userID string, cursor int64, limit int, archived bool) ([]InboxMessage, error)
+	// CountUnread 统计收件箱中的未读数
+	CountUnread(ctx context.Context, bizID int64, userID string) (int64, error)
+	// MarkRead 将指定站内信标记为已读
+	MarkRead(ctx context.Context, bizID int64, userID string, ids []int64) (int64, error)
+	// MarkAllRead 将收件箱中的站内信全部标记为已读
+	MarkAllRead(ctx context.Context, bizID int64, userID string) (int64, error)
+	// Delete 删除指定站内信
+	Delete(ctx context.Context, bizID int64, userID string, ids []int64) (int64, error)
+	// Archive 归档指定站内信
+	Archive(ctx context.Context, bizID int64, userID string, ids []int64) (int64, error)
+}
+
+// InboxMessage 站内信表
+type InboxMessage struct {
+	ID             int64  `gorm:"primaryKey;comment:'雪花算法ID'"`
+	BizID          int64  `gorm:"type:BIGINT;NOT NULL;index:idx_biz_id_user_id_archived,priority:1;comment:'业务ID'"`
+	UserID         string `gorm:"type:VARCHAR(256);NOT NULL;index:idx_biz_id_user_id_archived,priority:2;uniqueIndex:idx_notification_id_user_id,priority:2;comment:'接收用户ID'"`
+	NotificationID uint64 `gorm:"type:BIGINT UNSIGNED;NOT NULL;uniqueIndex:idx_notification_id_user_id,priority:1;comment:'来源通知ID'"`
+	Title          string `gorm:"type:VARCHAR(512);NOT NULL;DEFAULT:'';comment:'标题'"`
+	Content        string `gorm:"type:TEXT;NOT NULL;comment:'渲染后的内容'"`
+	Status         string `gorm:"type:ENUM('UNREAD','READ');NOT NULL;DEFAULT:'UNREAD';comment:'状态'"`
+	Archived       bool   `gorm:"NOT NULL;DEFAULT:false;index:idx_biz_id_user_id_archived,priority:3;comment:'是否已归档'"`
+	ReadTime       int64  `gorm:"type:BIGINT;NOT NULL;DEFAULT:0;comment:'阅读时间'"`
+	Ctime          int64
+	Utime          int64
+}
+
+// TableName 重命名表，分库分表时以此为前缀
+func (InboxMessage) TableName() string {
+	return "inbox"
+}
@@ -12,6 +12,12 @@ func InitTables(db *egorm.Component) error {
 		&ChannelTemplate{},
 		&ChannelTemplateVersion{},
 		&ChannelTemplateProvider{},
+		&ChannelTemplatePublishRecord{},
 		&Quota{},
+		&SmsReply{},
+		&ProviderPrice{},
+		&SendCost{},
+		&Audit{},
+		&AuditReviewer{},
 	)
 }
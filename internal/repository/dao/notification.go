@@ -61,10 +61,13 @@ type Notification struct {
 	Key               string `gorm:"type:VARCHAR(256);NOT NULL;uniqueIndex:idx_biz_id_key,priority:2;comment:'业务内唯一标识，区分同一个业务内的不同通知'"`
 	Receivers         string `gorm:"type:TEXT;NOT NULL;comment:'接收者(手机/邮箱/用户ID)，JSON数组'"`
 	Channel           string `gorm:"type:ENUM('SMS','EMAIL','IN_APP');NOT NULL;comment:'发送渠道'"`
-	TemplateID        int64  `gorm:"type:BIGINT;NOT NULL;comment:'模板ID'"`
-	TemplateVersionID int64  `gorm:"type:BIGINT;NOT NULL;comment:'模板版本ID'"`
+	TemplateID        int64  `gorm:"type:BIGINT;NOT NULL;index:idx_template_id_version_id,priority:1;comment:'模板ID'"`
+	TemplateVersionID int64  `gorm:"type:BIGINT;NOT NULL;index:idx_template_id_version_id,priority:2;comment:'模板版本ID'"`
 	TemplateParams    string `gorm:"NOT NULL;comment:'模版参数'"`
-	Status            string `gorm:"type:ENUM('PREPARE','CANCELED','PENDING','SENDING','SUCCEEDED','FAILED');DEFAULT:'PENDING';index:idx_biz_id_status,priority:2;index:idx_scheduled,priority:3;comment:'发送状态'"`
+	FallbackReceivers string `gorm:"type:TEXT;comment:'渠道降级时使用的接收者，JSON对象，key为渠道'"`
+	DeliveredChannel  string `gorm:"type:VARCHAR(16);NOT NULL;DEFAULT:'';comment:'实际发送成功的渠道，发生渠道降级时与channel不同'"`
+	Locale            string `gorm:"type:VARCHAR(35);NOT NULL;DEFAULT:'';comment:'接收者的语言，为空表示默认版本'"`
+	Status            string `gorm:"type:ENUM('PREPARE','CANCELED','PENDING','SENDING','SUCCEEDED','FAILED','DELIVERED','UNDELIVERED');DEFAULT:'PENDING';index:idx_biz_id_status,priority:2;index:idx_scheduled,priority:3;comment:'发送状态'"`
 	ScheduledSTime    int64  `gorm:"column:scheduled_stime;index:idx_scheduled,priority:1;comment:'计划发送开始时间'"`
 	ScheduledETime    int64  `gorm:"column:scheduled_etime;index:idx_scheduled,priority:2;comment:'计划发送结束时间'"`
 	Version           int    `gorm:"type:INT;NOT NULL;DEFAULT:1;comment:'版本号，用于CAS操作'"`
@@ -72,6 +75,31 @@ type Notification struct {
 	Utime             int64
 }
 
+// DeliveredChannelExpr 标记发送成功时实际发送成功的渠道，没有记录时使用请求的渠道
+func DeliveredChannelExpr(notification Notification) any {
+	if notification.DeliveredChannel == "" {
+		return gorm.Expr("`channel`")
+	}
+	return notification.DeliveredChannel
+}
+
+// DeliveredChannelCase 批量标记发送成功时设置实际发送渠道的 SQL 表达式，没有发生降级的通知使用请求的渠道
+// 渠道取值只来自 domain.Channel 的枚举，非法的取值会被忽略
+func DeliveredChannelCase(notifications []Notification) string {
+	var sb strings.Builder
+	for i := range notifications {
+		ch := domain.Channel(notifications[i].DeliveredChannel)
+		if !ch.IsValid() || ch.String() == notifications[i].Channel {
+			continue
+		}
+		fmt.Fprintf(&sb, " WHEN %d THEN '%s'", notifications[i].ID, ch)
+	}
+	if sb.Len() == 0 {
+		return "`channel`"
+	}
+	return "CASE `id`" + sb.String() + " ELSE `channel` END"
+}
+
 // CheckErrIsIDDuplicate 判断是否是主键冲突
 func CheckErrIsIDDuplicate(id uint64, err error) bool {
 	return strings.Contains(err.Error(), fmt.Sprintf("%d", id))
@@ -339,6 +367,7 @@ func (d *notificationDAO) BatchUpdateStatusSucceededOrFailed(ctx context.Context
 	successIDs := slice.Map(successNotifications, func(_ int, src Notification) uint64 {
 		return src.ID
 	})
+	deliveredChannel := DeliveredChannelCase(successNotifications)
 
 	failedIDs := slice.Map(failedNotifications, func(_ int, src Notification) uint64 {
 		return src.ID
@@ -347,7 +376,7 @@ func (d *notificationDAO) BatchUpdateStatusSucceededOrFailed(ctx context.Context
 	// 开启事务
 	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		if len(successIDs) != 0 {
-			err := d.batchMarkSuccess(tx, successIDs)
+			err := d.batchMarkSuccess(tx, successIDs, deliveredChannel)
 			if err != nil {
 				return err
 			}
@@ -367,14 +396,15 @@ func (d *notificationDAO) BatchUpdateStatusSucceededOrFailed(ctx context.Context
 	})
 }
 
-func (d *notificationDAO) batchMarkSuccess(tx *gorm.DB, successIDs []uint64) error {
+func (d *notificationDAO) batchMarkSuccess(tx *gorm.DB, successIDs []uint64, deliveredChannel string) error {
 	now := time.Now().Unix()
 	err := tx.Model(&Notification{}).
 		Where("id IN ?", successIDs).
 		Updates(map[string]any{
-			"version": gorm.Expr("version + 1"),
-			"utime":   now,
-			"status":  domain.SendStatusSucceeded.String(),
+			"version":           gorm.Expr("version + 1"),
+			"utime":             now,
+			"status":            domain.SendStatusSucceeded.String(),
+			"delivered_channel": gorm.Expr(deliveredChannel),
 		}).Error
 	if err != nil {
 		return err
@@ -405,9 +435,10 @@ func (d *notificationDAO) MarkSuccess(ctx context.Context, notification Notifica
 		err := tx.Model(&Notification{}).
 			Where("id = ?", notification.ID).
 			Updates(map[string]any{
-				"status":  notification.Status,
-				"utime":   now,
-				"version": gorm.Expr("version + 1"),
+				"status":            notification.Status,
+				"delivered_channel": DeliveredChannelExpr(notification),
+				"utime":             now,
+				"version":           gorm.Expr("version + 1"),
 			}).Error
 		if err != nil {
 			return err
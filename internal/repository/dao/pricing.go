@@ -0,0 +1,87 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/ego-component/egorm"
+	"gorm.io/gorm/clause"
+)
+
+// ProviderPrice 供应商价格表
+type ProviderPrice struct {
+	ID           int64  `gorm:"primaryKey;autoIncrement;comment:'价格ID'"`
+	Provider     string `gorm:"type:VARCHAR(64);NOT NULL;uniqueIndex:idx_provider_channel_country_type,priority:1;comment:'供应商名称'"`
+	Channel      string `gorm:"type:ENUM('SMS','EMAIL','IN_APP');NOT NULL;uniqueIndex:idx_provider_channel_country_type,priority:2;index:idx_channel;comment:'渠道'"`
+	CountryCode  string `gorm:"type:VARCHAR(8);NOT NULL;DEFAULT:'';uniqueIndex:idx_provider_channel_country_type,priority:3;comment:'国家码，为空表示适用所有国家和地区'"`
+	BusinessType int64  `gorm:"type:SMALLINT;NOT NULL;DEFAULT:0;uniqueIndex:idx_provider_channel_country_type,priority:4;comment:'业务类型，为0表示适用所有业务类型'"`
+	UnitPrice    int64  `gorm:"type:BIGINT;NOT NULL;comment:'单条价格，单位为0.0001元'"`
+	Ctime        int64
+	Utime        int64
+}
+
+// TableName 重命名表
+func (ProviderPrice) TableName() string {
+	return "provider_prices"
+}
+
+// SendCost 发送费用表
+type SendCost struct {
+	ID             int64  `gorm:"primaryKey;autoIncrement;comment:'费用ID'"`
+	NotificationID uint64 `gorm:"type:BIGINT UNSIGNED;NOT NULL;uniqueIndex:idx_notification_provider_country,priority:1;comment:'通知ID'"`
+	BizID          int64  `gorm:"type:BIGINT;NOT NULL;comment:'业务ID'"`
+	Provider       string `gorm:"type:VARCHAR(64);NOT NULL;uniqueIndex:idx_notification_provider_country,priority:2;index:idx_provider_ctime,priority:1;comment:'供应商名称'"`
+	Channel        string `gorm:"type:ENUM('SMS','EMAIL','IN_APP');NOT NULL;comment:'渠道'"`
+	CountryCode    string `gorm:"type:VARCHAR(8);NOT NULL;DEFAULT:'';uniqueIndex:idx_notification_provider_country,priority:3;comment:'国家码'"`
+	BusinessType   int64  `gorm:"type:SMALLINT;NOT NULL;DEFAULT:0;comment:'业务类型'"`
+	UnitPrice      int64  `gorm:"type:BIGINT;NOT NULL;comment:'单条价格，单位为0.0001元'"`
+	Quantity       int64  `gorm:"type:BIGINT;NOT NULL;comment:'计费条数'"`
+	Amount         int64  `gorm:"type:BIGINT;NOT NULL;comment:'总价，单位为0.0001元'"`
+	Ctime          int64  `gorm:"index:idx_provider_ctime,priority:2"`
+}
+
+// TableName 重命名表
+func (SendCost) TableName() string {
+	return "send_costs"
+}
+
+type PricingDAO interface {
+	// SavePrice 新增或者更新供应商单价
+	SavePrice(ctx context.Context, price ProviderPrice) error
+	// FindPricesByChannel 查找渠道内所有供应商的单价
+	FindPricesByChannel(ctx context.Context, channel string) ([]ProviderPrice, error)
+	// CreateCost 记录发送费用，同一通知在同一供应商的同一国家码只记录一次
+	CreateCost(ctx context.Context, cost SendCost) error
+}
+
+type pricingDAO struct {
+	db *egorm.Component
+}
+
+func NewPricingDAO(db *egorm.Component) PricingDAO {
+	return &pricingDAO{db: db}
+}
+
+func (p *pricingDAO) SavePrice(ctx context.Context, price ProviderPrice) error {
+	now := time.Now().UnixMilli()
+	price.ID = 0
+	price.Ctime, price.Utime = now, now
+	return p.db.WithContext(ctx).Clauses(clause.OnConflict{
+		DoUpdates: clause.Assignments(map[string]any{
+			"unit_price": price.UnitPrice,
+			"utime":      now,
+		}),
+	}).Create(&price).Error
+}
+
+func (p *pricingDAO) FindPricesByChannel(ctx context.Context, channel string) ([]ProviderPrice, error) {
+	var res []ProviderPrice
+	err := p.db.WithContext(ctx).Where("channel = ?", channel).Find(&res).Error
+	return res, err
+}
+
+func (p *pricingDAO) CreateCost(ctx context.Context, cost SendCost) error {
+	cost.ID = 0
+	cost.Ctime = time.Now().UnixMilli()
+	return p.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&cost).Error
+}
@@ -2,19 +2,10 @@ package dao
 
 import (
 	"context"
-	"crypto/aes"
-	"crypto/cipher"
-	"crypto/rand"
-	"encoding/base64"
-	"errors"
-	"io"
 	"time"
 
 	"github.com/ego-component/egorm"
-)
-
-const (
-	KEYSIZE = 32
+	"gorm.io/gorm"
 )
 
 // Provider 供应商模型
@@ -25,14 +16,15 @@ type Provider struct {
 
 	Endpoint  string `gorm:"type:VARCHAR(255);NOT NULL;comment:'API入口地址'"`
 	RegionID  string
-	APIKey    string `gorm:"type:VARCHAR(255);NOT NULL;comment:'API密钥，明文'"`
-	APISecret string `gorm:"type:VARCHAR(512);NOT NULL;comment:'API密钥,加密'"`
+	APIKey    string `gorm:"type:VARCHAR(512);NOT NULL;comment:'API密钥，信封加密'"`
+	APISecret string `gorm:"type:VARCHAR(512);NOT NULL;comment:'API密钥，信封加密'"`
 	APPID     string `gorm:"type:VARCHAR(512);comment:'应用ID，仅腾讯云使用'"`
 
 	Weight           int    `gorm:"type:INT;NOT NULL;comment:'权重'"`
 	QPSLimit         int    `gorm:"type:INT;NOT NULL;comment:'每秒请求数限制'"`
 	DailyLimit       int    `gorm:"type:INT;NOT NULL;comment:'每日请求数限制'"`
 	AuditCallbackURL string `gorm:"type:VARCHAR(256);comment:'回调URL，供应商通知审核结果'"`
+	CallbackToken    string `gorm:"type:VARCHAR(512);NOT NULL;DEFAULT:'';comment:'推送令牌，信封加密'"`
 	Status           string `gorm:"type:ENUM('ACTIVE','INACTIVE');NOT NULL;DEFAULT:'ACTIVE';comment:'状态，启用-ACTIVE，禁用-INACTIVE'"`
 	Ctime            int64
 	Utime            int64
@@ -52,95 +44,42 @@ type ProviderDAO interface {
 	FindByID(ctx context.Context, id int64) (Provider, error)
 	// FindByChannel 查找指定渠道的所有供应商
 	FindByChannel(ctx context.Context, channel string) ([]Provider, error)
+	// List 查找所有供应商，包括禁用的供应商，channel 为空表示所有渠道
+	List(ctx context.Context, channel string) ([]Provider, error)
+	// UpdateStatus 启用或者禁用供应商
+	UpdateStatus(ctx context.Context, id int64, status string) error
+	// UpdateWeight 更新权重
+	UpdateWeight(ctx context.Context, id int64, weight int) error
+	// UpdateLimits 更新限流
+	UpdateLimits(ctx context.Context, id int64, qpsLimit, dailyLimit int) error
+	// Delete 删除供应商
+	Delete(ctx context.Context, id int64) error
+	// FindAfterID 按ID升序分批查找所有供应商，包括禁用的供应商
+	FindAfterID(ctx context.Context, id int64, limit int) ([]Provider, error)
+	// UpdateSecrets 只更新密钥和推送令牌，old 中的密钥和推送令牌用于乐观锁，被并发修改时不更新
+	UpdateSecrets(ctx context.Context, provider Provider, old Provider) (bool, error)
 }
 
+// providerDAO 只负责存储，密钥的加解密由仓储层负责
 type providerDAO struct {
-	db         *egorm.Component
-	encryptKey []byte
+	db *egorm.Component
 }
 
-func NewProviderDAO(db *egorm.Component, encryptKey string) ProviderDAO {
-	// 确保加密密钥长度为32字节
-	key := make([]byte, KEYSIZE)
-	copy(key, encryptKey)
+func NewProviderDAO(db *egorm.Component) ProviderDAO {
 	return &providerDAO{
-		db:         db,
-		encryptKey: key,
+		db: db,
 	}
 }
 
-// encrypt 使用AES-GCM加密
-func (p *providerDAO) encrypt(plaintext string) (string, error) {
-	block, err := aes.NewCipher(p.encryptKey)
-	if err != nil {
-		return "", err
-	}
-
-	gcm, err := cipher.NewGCM(block)
-	if err != nil {
-		return "", err
-	}
-
-	nonce := make([]byte, gcm.NonceSize())
-	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
-		return "", err
-	}
-
-	ciphertext := gcm.Seal(nonce, nonce, []byte(plaintext), nil)
-	return base64.StdEncoding.EncodeToString(ciphertext), nil
-}
-
-// decrypt 使用AES-GCM解密
-func (p *providerDAO) decrypt(encrypted string) (string, error) {
-	ciphertext, err := base64.StdEncoding.DecodeString(encrypted)
-	if err != nil {
-		return "", err
-	}
-
-	block, err := aes.NewCipher(p.encryptKey)
-	if err != nil {
-		return "", err
-	}
-
-	gcm, err := cipher.NewGCM(block)
-	if err != nil {
-		return "", err
-	}
-
-	if len(ciphertext) < gcm.NonceSize() {
-		return "", errors.New("ciphertext太短了")
-	}
-
-	nonce := ciphertext[:gcm.NonceSize()]
-	ciphertext = ciphertext[gcm.NonceSize():]
-
-	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
-	if err != nil {
-		return "", err
-	}
-
-	return string(plaintext), nil
-}
-
 // Create 创建供应商
 func (p *providerDAO) Create(ctx context.Context, provider Provider) (Provider, error) {
 	now := time.Now().Unix()
 	provider.Ctime = now
 	provider.Utime = now
 
-	apiSecret := provider.APISecret
-	encryptedSecret, err := p.encrypt(apiSecret)
-	if err != nil {
-		return Provider{}, err
-	}
-	provider.APISecret = encryptedSecret
-
 	if err := p.db.WithContext(ctx).Create(&provider).Error; err != nil {
 		return Provider{}, err
 	}
-
-	provider.APISecret = apiSecret
-
 	return provider, nil
 }
 
@@ -153,23 +92,19 @@ func (p *providerDAO) Update(ctx context.Context, provider Provider) error {
 		"name":               provider.Name,
 		"channel":            provider.Channel,
 		"endpoint":           provider.Endpoint,
-		"api_key":            provider.APIKey,
+		"region_id":          provider.RegionID,
+		"app_id":             provider.APPID,
 		"weight":             provider.Weight,
 		"qps_limit":          provider.QPSLimit,
 		"daily_limit":        provider.DailyLimit,
 		"audit_callback_url": provider.AuditCallbackURL,
+		"api_key":            provider.APIKey,
+		"api_secret":         provider.APISecret,
+		"callback_token":     provider.CallbackToken,
 		"status":             provider.Status,
 		"utime":              provider.Utime,
 	}
 
-	if provider.APISecret != "" {
-		encryptedSecret, err := p.encrypt(provider.APISecret)
-		if err != nil {
-			return err
-		}
-		updates["api_secret"] = encryptedSecret
-	}
-
 	// 直接更新，无需显式事务
 	return p.db.WithContext(ctx).Model(&Provider{}).Where("id = ?", provider.ID).Updates(updates).Error
 }
@@ -178,39 +113,84 @@ func (p *providerDAO) Update(ctx context.Context, provider Provider) error {
 func (p *providerDAO) FindByID(ctx context.Context, id int64) (Provider, error) {
 	var provider Provider
 	err := p.db.WithContext(ctx).Where("id = ?", id).First(&provider).Error
-	if err != nil {
-		return Provider{}, err
-	}
-
-	if provider.APISecret != "" {
-		decryptedSecret, err := p.decrypt(provider.APISecret)
-		if err != nil {
-			return Provider{}, err
-		}
-		provider.APISecret = decryptedSecret
-	}
-
-	return provider, nil
+	return provider, err
 }
 
 // FindByChannel 查找指定渠道的所有供应商
 func (p *providerDAO) FindByChannel(ctx context.Context, channel string) ([]Provider, error) {
 	var providers []Provider
 	err := p.db.WithContext(ctx).Where("channel = ? AND status = ?", channel, "ACTIVE").Find(&providers).Error
-	if err != nil {
-		return nil, err
+	return providers, err
+}
+
+// List 查找所有供应商，包括禁用的供应商
+func (p *providerDAO) List(ctx context.Context, channel string) ([]Provider, error) {
+	var providers []Provider
+	query := p.db.WithContext(ctx)
+	if channel != "" {
+		query = query.Where("channel = ?", channel)
+	}
+	err := query.Order("id ASC").Find(&providers).Error
+	return providers, err
+}
+
+// UpdateStatus 启用或者禁用供应商
+func (p *providerDAO) UpdateStatus(ctx context.Context, id int64, status string) error {
+	return p.updates(ctx, id, map[string]any{"status": status})
+}
+
+// UpdateWeight 更新权重
+func (p *providerDAO) UpdateWeight(ctx context.Context, id int64, weight int) error {
+	return p.updates(ctx, id, map[string]any{"weight": weight})
+}
+
+// UpdateLimits 更新限流
+func (p *providerDAO) UpdateLimits(ctx context.Context, id int64, qpsLimit, dailyLimit int) error {
+	return p.updates(ctx, id, map[string]any{"qps_limit": qpsLimit, "daily_limit": dailyLimit})
+}
+
+// updates 更新部分字段，供应商不存在时返回 gorm.ErrRecordNotFound
+func (p *providerDAO) updates(ctx context.Context, id int64, updates map[string]any) error {
+	updates["utime"] = time.Now().Unix()
+	res := p.db.WithContext(ctx).Model(&Provider{}).Where("id = ?", id).Updates(updates)
+	if res.Error != nil {
+		return res.Error
 	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
 
-	for i := range providers {
-		if providers[i].APISecret == "" {
-			continue
-		}
-		decryptedSecret, err1 := p.decrypt(providers[i].APISecret)
-		if err1 != nil {
-			return nil, err1
-		}
-		providers[i].APISecret = decryptedSecret
+// Delete 删除供应商
+func (p *providerDAO) Delete(ctx context.Context, id int64) error {
+	res := p.db.WithContext(ctx).Where("id = ?", id).Delete(&Provider{})
+	if res.Error != nil {
+		return res.Error
 	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// FindAfterID 按ID升序分批查找所有供应商，包括禁用的供应商
+func (p *providerDAO) FindAfterID(ctx context.Context, id int64, limit int) ([]Provider, error) {
+	var providers []Provider
+	err := p.db.WithContext(ctx).Where("id > ?", id).Order("id ASC").Limit(limit).Find(&providers).Error
+	return providers, err
+}
 
-	return providers, nil
+// UpdateSecrets 只更新密钥和推送令牌
+func (p *providerDAO) UpdateSecrets(ctx context.Context, provider Provider, old Provider) (bool, error) {
+	res := p.db.WithContext(ctx).Model(&Provider{}).
+		Where("id = ? AND api_key = ? AND api_secret = ? AND callback_token = ?",
+			provider.ID, old.APIKey, old.APISecret, old.CallbackToken).
+		Updates(map[string]any{
+			"api_key":        provider.APIKey,
+			"api_secret":     provider.APISecret,
+			"callback_token": provider.CallbackToken,
+			"utime":          time.Now().Unix(),
+		})
+	return res.RowsAffected > 0, res.Error
 }
@@ -0,0 +1,29 @@
+package dao
+
+import "context"
+
+// ReceiverResultDAO 接收者发送结果存储，按通知ID分库分表
+type ReceiverResultDAO interface {
+	// Upsert 批量写入接收者发送结果，同一通知同一接收者已存在时覆盖为最新结果
+	Upsert(ctx context.Context, results []ReceiverResult) error
+	// FindByNotificationIDs 查找通知的全部接收者发送结果
+	FindByNotificationIDs(ctx context.Context, notificationIDs []uint64) ([]ReceiverResult, error)
+}
+
+// ReceiverResult 接收者发送结果表
+type ReceiverResult struct {
+	ID             int64  `gorm:"primaryKey;autoIncrement;comment:'结果ID'"`
+	NotificationID uint64 `gorm:"type:BIGINT UNSIGNED;NOT NULL;uniqueIndex:idx_notification_id_receiver,priority:1;comment:'通知ID'"`
+	Receiver       string `gorm:"type:VARCHAR(256);NOT NULL;uniqueIndex:idx_notification_id_receiver,priority:2;comment:'接收者'"`
+	Provider       string `gorm:"type:VARCHAR(64);NOT NULL;DEFAULT:'';comment:'最后一次发送的供应商名称'"`
+	Status         string `gorm:"type:ENUM('SUCCEEDED','FAILED');NOT NULL;comment:'发送状态'"`
+	ErrCode        string `gorm:"type:VARCHAR(128);NOT NULL;DEFAULT:'';comment:'供应商错误码'"`
+	ErrMessage     string `gorm:"type:VARCHAR(512);NOT NULL;DEFAULT:'';comment:'错误信息'"`
+	Ctime          int64
+	Utime          int64
+}
+
+// TableName 重命名表，分库分表时以此为前缀
+func (ReceiverResult) TableName() string {
+	return "receiver_result"
+}
@@ -0,0 +1,40 @@
+package dao
+
+import "context"
+
+// SendReceiptDAO 供应商回执存储，按通知ID分库分表，与通知落在同一张分表对应的回执表中
+type SendReceiptDAO interface {
+	// BatchCreate 批量写入回执，同一通知对同一接收者重复写入时忽略
+	BatchCreate(ctx context.Context, receipts []SendReceipt) error
+	// FindPending 查找到期需要查询的回执，查询哪张表由 ctx 中的 Dst 决定
+	FindPending(ctx context.Context, now int64, limit int) ([]SendReceipt, error)
+	// FindByNotificationID 查找通知的全部回执
+	FindByNotificationID(ctx context.Context, notificationID uint64) ([]SendReceipt, error)
+	// Update 更新等待中的回执，回执已有结果时返回 false
+	Update(ctx context.Context, receipt SendReceipt) (bool, error)
+	// FindByReceiptID 按供应商回执ID和接收者查找回执，供应商推送中没有通知ID，需要查询全部分表
+	FindByReceiptID(ctx context.Context, provider, receiptID, receiver string) (SendReceipt, error)
+	// FindLatestByReceiver 查找供应商最近一次发给接收者的回执，用于把上行短信关联到通知，需要查询全部分表
+	FindLatestByReceiver(ctx context.Context, provider, receiver string) (SendReceipt, error)
+}
+
+// SendReceipt 供应商回执表
+type SendReceipt struct {
+	ID             int64  `gorm:"primaryKey;autoIncrement;comment:'回执ID'"`
+	NotificationID uint64 `gorm:"type:BIGINT UNSIGNED;NOT NULL;uniqueIndex:idx_notification_id_receiver,priority:1;comment:'通知ID'"`
+	Provider       string `gorm:"type:VARCHAR(64);NOT NULL;comment:'供应商名称'"`
+	Receiver       string `gorm:"type:VARCHAR(256);NOT NULL;uniqueIndex:idx_notification_id_receiver,priority:2;index:idx_receiver_ctime,priority:1;comment:'接收者'"`
+	RequestID      string `gorm:"type:VARCHAR(128);NOT NULL;DEFAULT:'';comment:'供应商请求ID'"`
+	ReceiptID      string `gorm:"type:VARCHAR(128);NOT NULL;index:idx_receipt_id;comment:'供应商回执ID，阿里云为BizId，腾讯云为SerialNo'"`
+	Status         string `gorm:"type:ENUM('PENDING','DELIVERED','UNDELIVERED');NOT NULL;DEFAULT:'PENDING';index:idx_status_next_query_time,priority:1;comment:'回执状态'"`
+	ErrCode        string `gorm:"type:VARCHAR(128);NOT NULL;DEFAULT:'';comment:'供应商错误码'"`
+	QueryCount     int    `gorm:"type:INT;NOT NULL;DEFAULT:0;comment:'已查询次数'"`
+	NextQueryTime  int64  `gorm:"type:BIGINT;NOT NULL;DEFAULT:0;index:idx_status_next_query_time,priority:2;comment:'下次查询时间'"`
+	Ctime          int64  `gorm:"index:idx_receiver_ctime,priority:2"`
+	Utime          int64
+}
+
+// TableName 重命名表，分库分表时以此为前缀
+func (SendReceipt) TableName() string {
+	return "send_receipt"
+}
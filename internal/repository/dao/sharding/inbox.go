@@ -0,0 +1,168 @@
+package sharding
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	idgen "gitee.com/flycash/notification-platform/internal/pkg/id_generator"
+	"gitee.com/flycash/notification-platform/internal/pkg/sharding"
+	"gitee.com/flycash/notification-platform/internal/repository/dao"
+	"github.com/ecodeclub/ekit/syncx"
+	"github.com/ego-component/egorm"
+	"golang.org/x/sync/errgroup"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// InboxShardingDAO 站内信分库分表实现，同一用户的站内信落在同一张表中
+type InboxShardingDAO struct {
+	dbs              *syncx.Map[string, *egorm.Component]
+	shardingStrategy sharding.ShardingStrategy
+	idGenerator      *idgen.Generator
+}
+
+func NewInboxShardingDAO(dbs *syncx.Map[string, *egorm.Component],
+	shardingStrategy sharding.ShardingStrategy,
+	idGenerator *idgen.Generator,
+) *InboxShardingDAO {
+	return &InboxShardingDAO{
+		dbs:              dbs,
+		shardingStrategy: shardingStrategy,
+		idGenerator:      idGenerator,
+	}
+}
+
+func (s *InboxShardingDAO) BatchCreate(ctx context.Context, msgs []dao.InboxMessage) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	now := time.Now().UnixMilli()
+	// 按目标表分组，每张表一条 INSERT
+	groups := make(map[sharding.Dst][]dao.InboxMessage)
+	for i := range msgs {
+		msg := msgs[i]
+		msg.ID = s.idGenerator.GenerateID(msg.BizID, msg.UserID)
+		msg.Status = domain.InboxMessageStatusUnread.String()
+		msg.Ctime, msg.Utime = now, now
+		dst := s.shardingStrategy.Shard(msg.BizID, msg.UserID)
+		groups[dst] = append(groups[dst], msg)
+	}
+
+	var eg errgroup.Group
+	for dst, group := range groups {
+		gormDB, ok := s.dbs.Load(dst.DB)
+		if !ok {
+			return fmt.Errorf("未知库名 %s", dst.DB)
+		}
+		eg.Go(func() error {
+			// 重试发送时同一通知会再次投递，依赖唯一索引去重
+			return gormDB.WithContext(ctx).Table(dst.Table).
+				Clauses(clause.OnConflict{DoNothing: true}).
+				Create(&group).Error
+		})
+	}
+	return eg.Wait()
+}
+
+func (s *InboxShardingDAO) List(ctx context.Context, bizID int64, userID string, cursor int64, limit int, archived bool) ([]dao.InboxMessage, error) {
+	gormDB, dst, err := s.userDB(bizID, userID)
+	if err != nil {
+		return nil, err
+	}
+	query := gormDB.WithContext(ctx).Table(dst.Table).
+		Where("biz_id = ? AND user_id = ? AND archived = ?", bizID, userID, archived)
+	if cursor > 0 {
+		query = query.Where("id < ?", cursor)
+	}
+	var msgs []dao.InboxMessage
+	err = query.Order("id DESC").Limit(limit).Find(&msgs).Error
+	return msgs, err
+}
+
+func (s *InboxShardingDAO) CountUnread(ctx context.Context, bizID int64, userID string) (int64, error) {
+	gormDB, dst, err := s.userDB(bizID, userID)
+	if err != nil {
+		return 0, err
+	}
+	var cnt int64
+	err = gormDB.WithContext(ctx).Table(dst.Table).
+		Where("biz_id = ? AND user_id = ? AND archived = ? AND status = ?",
+			bizID, userID, false, domain.InboxMessageStatusUnread.String()).
+		Count(&cnt).Error
+	return cnt, err
+}
+
+func (s *InboxShardingDAO) MarkRead(ctx context.Context, bizID int64, userID string, ids []int64) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	return s.markRead(ctx, bizID, userID, func(db *gorm.DB) *gorm.DB {
+		return db.Where("id IN ?", ids)
+	})
+}
+
+func (s *InboxShardingDAO) MarkAllRead(ctx context.Context, bizID int64, userID string) (int64, error) {
+	return s.markRead(ctx, bizID, userID, func(db *gorm.DB) *gorm.DB {
+		return db.Where("archived = ?", false)
+	})
+}
+
+func (s *InboxShardingDAO) markRead(ctx context.Context, bizID int64, userID string, scope func(db *gorm.DB) *gorm.DB) (int64, error) {
+	gormDB, dst, err := s.userDB(bizID, userID)
+	if err != nil {
+		return 0, err
+	}
+	now := time.Now().UnixMilli()
+	res := gormDB.WithContext(ctx).Table(dst.Table).
+		Where("biz_id = ? AND user_id = ? AND status = ?", bizID, userID, domain.InboxMessageStatusUnread.String()).
+		Scopes(scope).
+		Updates(map[string]any{
+			"status":    domain.InboxMessageStatusRead.String(),
+			"read_time": now,
+			"utime":     now,
+		})
+	return res.RowsAffected, res.Error
+}
+
+func (s *InboxShardingDAO) Delete(ctx context.Context, bizID int64, userID string, ids []int64) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	gormDB, dst, err := s.userDB(bizID, userID)
+	if err != nil {
+		return 0, err
+	}
+	res := gormDB.WithContext(ctx).Table(dst.Table).
+		Where("biz_id = ? AND user_id = ? AND id IN ?", bizID, userID, ids).
+		Delete(&dao.InboxMessage{})
+	return res.RowsAffected, res.Error
+}
+
+func (s *InboxShardingDAO) Archive(ctx context.Context, bizID int64, userID string, ids []int64) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	gormDB, dst, err := s.userDB(bizID, userID)
+	if err != nil {
+		return 0, err
+	}
+	res := gormDB.WithContext(ctx).Table(dst.Table).
+		Where("biz_id = ? AND user_id = ? AND archived = ? AND id IN ?", bizID, userID, false, ids).
+		Updates(map[string]any{
+			"archived": true,
+			"utime":    time.Now().UnixMilli(),
+		})
+	return res.RowsAffected, res.Error
+}
+
+func (s *InboxShardingDAO) userDB(bizID int64, userID string) (*egorm.Component, sharding.Dst, error) {
+	dst := s.shardingStrategy.Shard(bizID, userID)
+	gormDB, ok := s.dbs.Load(dst.DB)
+	if !ok {
+		return nil, dst, fmt.Errorf("未知库名 %s", dst.DB)
+	}
+	return gormDB, dst, nil
+}
@@ -322,13 +322,15 @@ func (s *NotificationShardingDAO) BatchUpdateStatusSucceededOrFailed(ctx context
 		modifyID, exists := tableMap[notificationDst.Table]
 		if !exists {
 			modifyID = &modifyIds{
-				callbackTab: callbackDst.Table,
-				successIds:  []uint64{notification.ID},
-				failedIds:   []uint64{},
+				callbackTab:  callbackDst.Table,
+				successIds:   []uint64{notification.ID},
+				successNotis: []dao.Notification{notification},
+				failedIds:    []uint64{},
 			}
 			tableMap[notificationDst.Table] = modifyID
 		} else {
 			modifyID.successIds = append(modifyID.successIds, notification.ID)
+			modifyID.successNotis = append(modifyID.successNotis, notification)
 			if modifyID.callbackTab == "" {
 				modifyID.callbackTab = callbackDst.Table
 			}
@@ -394,9 +396,10 @@ func (s *NotificationShardingDAO) MarkSuccess(ctx context.Context, entity dao.No
 			Model(&dao.Notification{}).
 			Where("id = ?", entity.ID).
 			Updates(map[string]any{
-				"status":  entity.Status,
-				"utime":   now,
-				"version": gorm.Expr("version + 1"),
+				"status":            entity.Status,
+				"delivered_channel": dao.DeliveredChannelExpr(entity),
+				"utime":             now,
+				"version":           gorm.Expr("version + 1"),
 			}).Error
 		if err != nil {
 			return err
@@ -547,8 +550,8 @@ func (s *NotificationShardingDAO) batchMark(tx *gorm.DB, ids map[string]*modifyI
 	for notificationTab := range ids {
 		modifyID := ids[notificationTab]
 		if len(modifyID.successIds) > 0 {
-			notificationSQL := fmt.Sprintf("UPDATE %s SET `version` = `version` + 1,`utime` = %d,`status` = '%s' WHERE id IN (%s) ",
-				notificationTab, now, domain.SendStatusSucceeded.String(), modifyID.successToStr(),
+			notificationSQL := fmt.Sprintf("UPDATE %s SET `version` = `version` + 1,`utime` = %d,`status` = '%s',`delivered_channel` = %s WHERE id IN (%s) ",
+				notificationTab, now, domain.SendStatusSucceeded.String(), dao.DeliveredChannelCase(modifyID.successNotis), modifyID.successToStr(),
 			)
 			callbackSQL := fmt.Sprintf("UPDATE %s SET `status` = '%s',utime = %d  WHERE notification_id IN (%s)", modifyID.callbackTab, domain.CallbackLogStatusPending.String(), now, modifyID.successToStr())
 			sqls = append(sqls, notificationSQL, callbackSQL)
@@ -572,9 +575,10 @@ func (s *NotificationShardingDAO) batchMark(tx *gorm.DB, ids map[string]*modifyI
 }
 
 type modifyIds struct {
-	callbackTab string
-	successIds  []uint64
-	failedIds   []uint64
+	callbackTab  string
+	successIds   []uint64
+	successNotis []dao.Notification // 用于设置实际发送成功的渠道
+	failedIds    []uint64
 }
 
 func (m *modifyIds) failToStr() string {
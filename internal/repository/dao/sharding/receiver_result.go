@@ -0,0 +1,100 @@
+package sharding
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/pkg/sharding"
+	"gitee.com/flycash/notification-platform/internal/repository/dao"
+	"github.com/ecodeclub/ekit/syncx"
+	"github.com/ego-component/egorm"
+	"golang.org/x/sync/errgroup"
+	"gorm.io/gorm/clause"
+)
+
+// ReceiverResultShardingDAO 接收者发送结果分库分表实现，按通知ID路由，同一通知的结果落在同一张表中
+type ReceiverResultShardingDAO struct {
+	dbs              *syncx.Map[string, *egorm.Component]
+	shardingStrategy sharding.ShardingStrategy
+}
+
+func NewReceiverResultShardingDAO(dbs *syncx.Map[string, *egorm.Component],
+	shardingStrategy sharding.ShardingStrategy,
+) *ReceiverResultShardingDAO {
+	return &ReceiverResultShardingDAO{
+		dbs:              dbs,
+		shardingStrategy: shardingStrategy,
+	}
+}
+
+func (s *ReceiverResultShardingDAO) Upsert(ctx context.Context, results []dao.ReceiverResult) error {
+	if len(results) == 0 {
+		return nil
+	}
+
+	now := time.Now().UnixMilli()
+	// 按目标表分组，每张表一条 INSERT
+	groups := make(map[sharding.Dst][]dao.ReceiverResult)
+	for i := range results {
+		result := results[i]
+		result.ID = 0
+		result.Ctime, result.Utime = now, now
+		dst := s.shardingStrategy.ShardWithID(int64(result.NotificationID))
+		groups[dst] = append(groups[dst], result)
+	}
+
+	var eg errgroup.Group
+	for dst, group := range groups {
+		gormDB, ok := s.dbs.Load(dst.DB)
+		if !ok {
+			return fmt.Errorf("未知库名 %s", dst.DB)
+		}
+		eg.Go(func() error {
+			// 重试只发送给失败的接收者，以最新一次的结果为准
+			return gormDB.WithContext(ctx).Table(dst.Table).
+				Clauses(clause.OnConflict{
+					Columns:   []clause.Column{{Name: "notification_id"}, {Name: "receiver"}},
+					DoUpdates: clause.AssignmentColumns([]string{"provider", "status", "err_code", "err_message", "utime"}),
+				}).
+				Create(&group).Error
+		})
+	}
+	return eg.Wait()
+}
+
+func (s *ReceiverResultShardingDAO) FindByNotificationIDs(ctx context.Context, notificationIDs []uint64) ([]dao.ReceiverResult, error) {
+	groups := make(map[sharding.Dst][]uint64)
+	for _, id := range notificationIDs {
+		dst := s.shardingStrategy.ShardWithID(int64(id))
+		groups[dst] = append(groups[dst], id)
+	}
+
+	var (
+		eg  errgroup.Group
+		mu  sync.Mutex
+		res []dao.ReceiverResult
+	)
+	for dst, ids := range groups {
+		gormDB, ok := s.dbs.Load(dst.DB)
+		if !ok {
+			return nil, fmt.Errorf("未知库名 %s", dst.DB)
+		}
+		eg.Go(func() error {
+			var found []dao.ReceiverResult
+			err := gormDB.WithContext(ctx).Table(dst.Table).
+				Where("notification_id IN ?", ids).
+				Order("id ASC").
+				Find(&found).Error
+			if err != nil {
+				return err
+			}
+			mu.Lock()
+			res = append(res, found...)
+			mu.Unlock()
+			return nil
+		})
+	}
+	return res, eg.Wait()
+}
@@ -0,0 +1,183 @@
+package sharding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/pkg/sharding"
+	"gitee.com/flycash/notification-platform/internal/repository/dao"
+	"github.com/ecodeclub/ekit/syncx"
+	"github.com/ego-component/egorm"
+	"golang.org/x/sync/errgroup"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SendReceiptShardingDAO 供应商回执分库分表实现，按通知ID路由，同一通知的回执落在同一张表中
+type SendReceiptShardingDAO struct {
+	dbs              *syncx.Map[string, *egorm.Component]
+	shardingStrategy sharding.ShardingStrategy
+}
+
+func NewSendReceiptShardingDAO(dbs *syncx.Map[string, *egorm.Component],
+	shardingStrategy sharding.ShardingStrategy,
+) *SendReceiptShardingDAO {
+	return &SendReceiptShardingDAO{
+		dbs:              dbs,
+		shardingStrategy: shardingStrategy,
+	}
+}
+
+func (s *SendReceiptShardingDAO) BatchCreate(ctx context.Context, receipts []dao.SendReceipt) error {
+	if len(receipts) == 0 {
+		return nil
+	}
+
+	now := time.Now().UnixMilli()
+	// 按目标表分组，每张表一条 INSERT
+	groups := make(map[sharding.Dst][]dao.SendReceipt)
+	for i := range receipts {
+		receipt := receipts[i]
+		receipt.ID = 0
+		receipt.Status = domain.SendReceiptStatusPending.String()
+		receipt.Ctime, receipt.Utime = now, now
+		dst := s.shardingStrategy.ShardWithID(int64(receipt.NotificationID))
+		groups[dst] = append(groups[dst], receipt)
+	}
+
+	var eg errgroup.Group
+	for dst, group := range groups {
+		gormDB, ok := s.dbs.Load(dst.DB)
+		if !ok {
+			return fmt.Errorf("未知库名 %s", dst.DB)
+		}
+		eg.Go(func() error {
+			// 重试发送时同一通知会再次写入，依赖唯一索引去重
+			return gormDB.WithContext(ctx).Table(dst.Table).
+				Clauses(clause.OnConflict{DoNothing: true}).
+				Create(&group).Error
+		})
+	}
+	return eg.Wait()
+}
+
+func (s *SendReceiptShardingDAO) FindPending(ctx context.Context, now int64, limit int) ([]dao.SendReceipt, error) {
+	dst, ok := sharding.DstFromCtx(ctx)
+	if !ok {
+		return nil, errors.New("Dst 未找到，无法确定应该查询哪个表")
+	}
+	gormDB, ok := s.dbs.Load(dst.DB)
+	if !ok {
+		return nil, fmt.Errorf("未知库名 %s", dst.DB)
+	}
+	var res []dao.SendReceipt
+	err := gormDB.WithContext(ctx).Table(dst.Table).
+		Where("status = ? AND next_query_time <= ?", domain.SendReceiptStatusPending.String(), now).
+		Order("next_query_time ASC").
+		Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (s *SendReceiptShardingDAO) FindByNotificationID(ctx context.Context, notificationID uint64) ([]dao.SendReceipt, error) {
+	gormDB, dst, err := s.notificationDB(notificationID)
+	if err != nil {
+		return nil, err
+	}
+	var res []dao.SendReceipt
+	err = gormDB.WithContext(ctx).Table(dst.Table).
+		Where("notification_id = ?", notificationID).
+		Find(&res).Error
+	return res, err
+}
+
+func (s *SendReceiptShardingDAO) Update(ctx context.Context, receipt dao.SendReceipt) (bool, error) {
+	gormDB, dst, err := s.notificationDB(receipt.NotificationID)
+	if err != nil {
+		return false, err
+	}
+	res := gormDB.WithContext(ctx).Table(dst.Table).
+		Where("id = ? AND status = ?", receipt.ID, domain.SendReceiptStatusPending.String()).
+		Updates(map[string]any{
+			"status":          receipt.Status,
+			"err_code":        receipt.ErrCode,
+			"query_count":     receipt.QueryCount,
+			"next_query_time": receipt.NextQueryTime,
+			"utime":           time.Now().UnixMilli(),
+		})
+	return res.RowsAffected > 0, res.Error
+}
+
+func (s *SendReceiptShardingDAO) FindByReceiptID(ctx context.Context, provider, receiptID, receiver string) (dao.SendReceipt, error) {
+	receipts, err := s.broadcastFind(ctx, func(db *gorm.DB) *gorm.DB {
+		return db.Where("receipt_id = ? AND provider = ? AND receiver = ?", receiptID, provider, receiver).Limit(1)
+	})
+	if err != nil {
+		return dao.SendReceipt{}, err
+	}
+	if len(receipts) == 0 {
+		return dao.SendReceipt{}, fmt.Errorf("%w: receiptID=%s", errs.ErrSendReceiptNotFound, receiptID)
+	}
+	return receipts[0], nil
+}
+
+func (s *SendReceiptShardingDAO) FindLatestByReceiver(ctx context.Context, provider, receiver string) (dao.SendReceipt, error) {
+	receipts, err := s.broadcastFind(ctx, func(db *gorm.DB) *gorm.DB {
+		return db.Where("receiver = ? AND provider = ?", receiver, provider).Order("ctime DESC").Limit(1)
+	})
+	if err != nil {
+		return dao.SendReceipt{}, err
+	}
+	if len(receipts) == 0 {
+		return dao.SendReceipt{}, fmt.Errorf("%w: receiver=%s", errs.ErrSendReceiptNotFound, receiver)
+	}
+	// 每张表各取最近一条，再取其中最新的
+	latest := receipts[0]
+	for i := range receipts {
+		if receipts[i].Ctime > latest.Ctime {
+			latest = receipts[i]
+		}
+	}
+	return latest, nil
+}
+
+// broadcastFind 在全部分表上并发执行同一查询并合并结果
+func (s *SendReceiptShardingDAO) broadcastFind(ctx context.Context, query func(db *gorm.DB) *gorm.DB) ([]dao.SendReceipt, error) {
+	var (
+		eg  errgroup.Group
+		mu  sync.Mutex
+		res []dao.SendReceipt
+	)
+	for _, dst := range s.shardingStrategy.Broadcast() {
+		gormDB, ok := s.dbs.Load(dst.DB)
+		if !ok {
+			return nil, fmt.Errorf("未知库名 %s", dst.DB)
+		}
+		eg.Go(func() error {
+			var found []dao.SendReceipt
+			err := query(gormDB.WithContext(ctx).Table(dst.Table)).Find(&found).Error
+			if err != nil {
+				return err
+			}
+			mu.Lock()
+			res = append(res, found...)
+			mu.Unlock()
+			return nil
+		})
+	}
+	return res, eg.Wait()
+}
+
+func (s *SendReceiptShardingDAO) notificationDB(notificationID uint64) (*egorm.Component, sharding.Dst, error) {
+	dst := s.shardingStrategy.ShardWithID(int64(notificationID))
+	gormDB, ok := s.dbs.Load(dst.DB)
+	if !ok {
+		return nil, dst, fmt.Errorf("未知库名 %s", dst.DB)
+	}
+	return gormDB, dst, nil
+}
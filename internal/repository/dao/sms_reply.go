@@ -0,0 +1,71 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/ego-component/egorm"
+)
+
+// SmsReply 上行短信表
+type SmsReply struct {
+	ID             int64  `gorm:"primaryKey;autoIncrement;comment:'上行短信ID'"`
+	BizID          int64  `gorm:"type:BIGINT;NOT NULL;DEFAULT:0;index:idx_biz_id_unsubscribe,priority:1;comment:'关联到的业务ID，关联不到时为0'"`
+	NotificationID uint64 `gorm:"type:BIGINT UNSIGNED;NOT NULL;DEFAULT:0;comment:'关联到的通知ID'"`
+	Provider       string `gorm:"type:VARCHAR(64);NOT NULL;comment:'供应商名称'"`
+	PhoneNumber    string `gorm:"type:VARCHAR(32);NOT NULL;index:idx_phone_number;comment:'回复的手机号'"`
+	Content        string `gorm:"type:VARCHAR(512);NOT NULL;comment:'回复内容'"`
+	SignName       string `gorm:"type:VARCHAR(64);NOT NULL;DEFAULT:'';comment:'短信签名'"`
+	ExtendCode     string `gorm:"type:VARCHAR(32);NOT NULL;DEFAULT:'';comment:'扩展码'"`
+	Unsubscribe    bool   `gorm:"type:BOOLEAN;NOT NULL;DEFAULT:false;index:idx_biz_id_unsubscribe,priority:2;comment:'是否为退订回复'"`
+	ReplyTime      int64  `gorm:"type:BIGINT;NOT NULL;DEFAULT:0;comment:'回复时间'"`
+	Ctime          int64
+	Utime          int64
+}
+
+// TableName 重命名表
+func (SmsReply) TableName() string {
+	return "sms_replies"
+}
+
+type SmsReplyDAO interface {
+	// BatchCreate 批量写入上行短信
+	BatchCreate(ctx context.Context, replies []SmsReply) error
+	// List 按ID倒序游标分页查询业务方的上行短信，phoneNumber 为空时不限手机号
+	List(ctx context.Context, bizID int64, phoneNumber string, unsubscribe bool, cursor int64, limit int) ([]SmsReply, error)
+}
+
+type smsReplyDAO struct {
+	db *egorm.Component
+}
+
+func NewSmsReplyDAO(db *egorm.Component) SmsReplyDAO {
+	return &smsReplyDAO{db: db}
+}
+
+func (s *smsReplyDAO) BatchCreate(ctx context.Context, replies []SmsReply) error {
+	if len(replies) == 0 {
+		return nil
+	}
+	now := time.Now().UnixMilli()
+	for i := range replies {
+		replies[i].Ctime, replies[i].Utime = now, now
+	}
+	return s.db.WithContext(ctx).Create(&replies).Error
+}
+
+func (s *smsReplyDAO) List(ctx context.Context, bizID int64, phoneNumber string, unsubscribe bool, cursor int64, limit int) ([]SmsReply, error) {
+	query := s.db.WithContext(ctx).Model(&SmsReply{}).Where("biz_id = ?", bizID)
+	if unsubscribe {
+		query = query.Where("unsubscribe = ?", true)
+	}
+	if phoneNumber != "" {
+		query = query.Where("phone_number = ?", phoneNumber)
+	}
+	if cursor > 0 {
+		query = query.Where("id < ?", cursor)
+	}
+	var res []SmsReply
+	err := query.Order("id DESC").Limit(limit).Find(&res).Error
+	return res, err
+}
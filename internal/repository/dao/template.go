@@ -9,8 +9,10 @@ import (
 	"gitee.com/flycash/notification-platform/internal/domain"
 	"gitee.com/flycash/notification-platform/internal/errs"
 	"github.com/ecodeclub/ekit/slice"
+	"github.com/ecodeclub/ekit/sqlx"
 	"github.com/ego-component/egorm"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // ChannelTemplate 渠道模板表
@@ -22,9 +24,13 @@ type ChannelTemplate struct {
 	Description     string `gorm:"type:VARCHAR(512);NOT NULL;comment:'模板描述'"`
 	Channel         string `gorm:"type:ENUM('SMS','EMAIL','IN_APP');NOT NULL;comment:'渠道类型'"`
 	BusinessType    int64  `gorm:"type:BIGINT;NOT NULL;DEFAULT:1;comment:'业务类型：1-推广营销、2-通知、3-验证码等'"`
+	International   bool   `gorm:"NOT NULL;DEFAULT:false;comment:'短信模版是否同时申请国际/港澳台模版'"`
 	ActiveVersionID int64  `gorm:"type:BIGINT;DEFAULT:0;index:idx_active_version;comment:'当前启用的版本ID，0表示无活跃版本'"`
+	ArchivedTime    int64  `gorm:"NOT NULL;DEFAULT:0;comment:'归档时间，0表示未归档'"`
 	Ctime           int64
 	Utime           int64
+	// 各语言的活跃版本，默认版本使用 ActiveVersionID
+	ActiveLocaleVersions sqlx.JsonColumn[map[string]int64] `gorm:"type:JSON;comment:'各语言当前启用的版本ID，{\"en-US\":12,\"ja-JP\":13}'"`
 }
 
 // TableName 重命名表
@@ -40,6 +46,14 @@ type ChannelTemplateVersion struct {
 	Signature         string `gorm:"type:VARCHAR(64);comment:'已通过所有供应商审核的短信签名/邮件发件人'"`
 	Content           string `gorm:"type:TEXT;NOT NULL;comment:'原始模板内容，使用平台统一变量格式，如${name}'"`
 	Remark            string `gorm:"type:TEXT;NOT NULL;comment:'申请说明,描述使用短信的业务场景，并提供短信完整示例（填入变量内容），信息完整有助于提高模板审核通过率。'"`
+	// 邮件渠道专用字段
+	Subject     string                                    `gorm:"type:VARCHAR(256);comment:'邮件主题或站内信标题，支持平台统一变量格式'"`
+	ReplyTo     string                                    `gorm:"type:VARCHAR(256);comment:'邮件回复地址'"`
+	Attachments sqlx.JsonColumn[[]domain.EmailAttachment] `gorm:"type:JSON;comment:'邮件附件，[{\"filename\":\"a.pdf\",\"url\":\"https://...\",\"contentId\":\"\"}]'"`
+	// 参数定义，发送时按此校验参数
+	Params sqlx.JsonColumn[[]domain.TemplateParam] `gorm:"type:JSON;comment:'参数定义，[{\"name\":\"code\",\"type\":\"string\",\"required\":true,\"maxLength\":6,\"pattern\":\"[0-9]+\"}]'"`
+	// 语言，各语言的版本分别审核和发布
+	Locale string `gorm:"type:VARCHAR(35);NOT NULL;DEFAULT:'';comment:'语言，如zh-CN、en-US，为空表示默认版本'"`
 	// 审核相关信息，AuditID之后的为冗余的信息
 	AuditID                  int64  `gorm:"type:BIGINT;NOT NULL;DEFAULT:0;comment:'审核表ID, 0表示尚未提交审核或者未拿到审核结果'"`
 	AuditorID                int64  `gorm:"type:BIGINT;comment:'审核人ID'"`
@@ -47,6 +61,7 @@ type ChannelTemplateVersion struct {
 	AuditStatus              string `gorm:"type:ENUM('PENDING','IN_REVIEW','REJECTED','APPROVED');NOT NULL;DEFAULT:'PENDING';comment:'内部审核状态，PENDING表示未提交审核；IN_REVIEW表示已提交审核；APPROVED表示审核通过；REJECTED表示审核未通过'"`
 	RejectReason             string `gorm:"type:VARCHAR(512);comment:'拒绝原因'"`
 	LastReviewSubmissionTime int64  `gorm:"comment:'上一次提交审核时间'"`
+	ArchivedTime             int64  `gorm:"NOT NULL;DEFAULT:0;comment:'归档时间，0表示未归档'"`
 	Ctime                    int64
 	Utime                    int64
 }
@@ -61,10 +76,11 @@ type ChannelTemplateProvider struct {
 	TemplateID               int64  `gorm:"type:BIGINT;NOT NULL;uniqueIndex:idx_template_version_provider,priority:1;uniqueIndex:idx_tmpl_ver_name_chan,priority:1;comment:'渠道模版ID'"`
 	TemplateVersionID        int64  `gorm:"type:BIGINT;NOT NULL;uniqueIndex:idx_template_version_provider,priority:2;uniqueIndex:idx_tmpl_ver_name_chan,priority:2;comment:'渠道模版版本ID'"`
 	ProviderID               int64  `gorm:"type:BIGINT;NOT NULL;uniqueIndex:idx_template_version_provider,priority:3;comment:'供应商ID'"`
-	ProviderName             string `gorm:"type:VARCHAR(64);NOT NULL;uniqueIndex:idx_tmpl_ver_name_chan,priority:3;comment:'供应商名称'"`
+	ProviderName             string `gorm:"type:VARCHAR(64);NOT NULL;uniqueIndex:idx_tmpl_ver_name_chan,priority:3;index:idx_name_provider_template_id,priority:1;comment:'供应商名称'"`
 	ProviderChannel          string `gorm:"type:ENUM('SMS','EMAIL','IN_APP');NOT NULL;uniqueIndex:idx_tmpl_ver_name_chan,priority:4;comment:'渠道类型'"`
+	Region                   string `gorm:"type:ENUM('DOMESTIC','INTERNATIONAL');NOT NULL;DEFAULT:'DOMESTIC';uniqueIndex:idx_template_version_provider,priority:4;uniqueIndex:idx_tmpl_ver_name_chan,priority:5;comment:'适用地区：DOMESTIC-国内，INTERNATIONAL-国际/港澳台'"`
 	RequestID                string `gorm:"type:VARCHAR(256);index:idx_request_id;comment:'审核请求在供应商侧的ID，用于排查问题'"`
-	ProviderTemplateID       string `gorm:"type:VARCHAR(256);comment:'当前版本模版在供应商侧的ID，审核通过后才会有值'"`
+	ProviderTemplateID       string `gorm:"type:VARCHAR(256);index:idx_name_provider_template_id,priority:2;comment:'当前版本模版在供应商侧的ID，审核通过后才会有值'"`
 	AuditStatus              string `gorm:"type:ENUM('PENDING','IN_REVIEW','REJECTED','APPROVED');NOT NULL;DEFAULT:'PENDING';index:idx_audit_status;comment:'供应商侧模版审核状态，PENDING表示未提交审核；IN_REVIEW表示已提交审核；APPROVED表示审核通过；REJECTED表示审核未通过'"`
 	RejectReason             string `gorm:"type:VARCHAR(512);comment:'供应商侧拒绝原因'"`
 	LastReviewSubmissionTime int64  `gorm:"comment:'上一次提交审核时间'"`
@@ -77,6 +93,23 @@ func (ChannelTemplateProvider) TableName() string {
 	return "channel_template_providers"
 }
 
+// ChannelTemplatePublishRecord 模版发布记录表
+type ChannelTemplatePublishRecord struct {
+	ID                int64  `gorm:"primaryKey;autoIncrement;comment:'发布记录ID'"`
+	TemplateID        int64  `gorm:"type:BIGINT;NOT NULL;index:idx_template_id_ctime,priority:1;comment:'渠道模版ID'"`
+	VersionID         int64  `gorm:"type:BIGINT;NOT NULL;comment:'发布的版本ID'"`
+	PreviousVersionID int64  `gorm:"type:BIGINT;NOT NULL;DEFAULT:0;comment:'发布前的活跃版本ID，0表示首次发布'"`
+	OperatorID        int64  `gorm:"type:BIGINT;NOT NULL;DEFAULT:0;comment:'操作人ID'"`
+	Action            string `gorm:"type:ENUM('PUBLISH','ROLLBACK');NOT NULL;comment:'操作类型：PUBLISH-发布，ROLLBACK-回滚'"`
+	Locale            string `gorm:"type:VARCHAR(35);NOT NULL;DEFAULT:'';comment:'发布的版本的语言，为空表示默认版本'"`
+	Ctime             int64  `gorm:"index:idx_template_id_ctime,priority:2"`
+}
+
+// TableName 重命名表
+func (ChannelTemplatePublishRecord) TableName() string {
+	return "channel_template_publish_records"
+}
+
 // ChannelTemplateDAO 提供模板数据访问对象接口
 type ChannelTemplateDAO interface {
 	// 模版相关方法
@@ -90,11 +123,23 @@ type ChannelTemplateDAO interface {
 	// CreateTemplate 创建模板
 	CreateTemplate(ctx context.Context, template ChannelTemplate) (ChannelTemplate, error)
 
-	// UpdateTemplate 更新模板
+	// UpdateTemplate 更新模板，已归档的模版返回 errs.ErrTemplateArchived
 	UpdateTemplate(ctx context.Context, template ChannelTemplate) error
 
-	// SetTemplateActiveVersion 设置模板的活跃版本
-	SetTemplateActiveVersion(ctx context.Context, templateID, versionID int64) error
+	// SetTemplateActiveVersion 设置模板的活跃版本，同时记录发布历史
+	SetTemplateActiveVersion(ctx context.Context, record ChannelTemplatePublishRecord) error
+
+	// GetPublishRecords 按时间倒序获取模版的发布记录
+	GetPublishRecords(ctx context.Context, templateID int64, offset, limit int) ([]ChannelTemplatePublishRecord, error)
+
+	// TotalPublishRecords 统计模版的发布记录总数
+	TotalPublishRecords(ctx context.Context, templateID int64) (int64, error)
+
+	// ArchiveTemplate 归档模版，已归档的不修改
+	ArchiveTemplate(ctx context.Context, id int64) error
+
+	// DeleteTemplate 删除模版以及所有的版本、供应商关联和发布记录，有通知使用该模版时返回 errs.ErrTemplateInUse
+	DeleteTemplate(ctx context.Context, id int64) error
 
 	// 模版版本相关方法
 
@@ -110,6 +155,12 @@ type ChannelTemplateDAO interface {
 	// ForkTemplateVersion 基于已有版本创建新版本
 	ForkTemplateVersion(ctx context.Context, versionID int64) (ChannelTemplateVersion, error)
 
+	// ArchiveTemplateVersion 归档模版版本，已归档的不修改
+	ArchiveTemplateVersion(ctx context.Context, versionID int64) error
+
+	// DeleteTemplateVersion 删除模版版本以及供应商关联，有通知使用该版本时返回 errs.ErrTemplateInUse
+	DeleteTemplateVersion(ctx context.Context, templateID, versionID int64) error
+
 	// 供应商关联相关方法
 
 	// GetProvidersByVersionIDs 根据版本ID列表获取供应商列表
@@ -124,6 +175,9 @@ type ChannelTemplateDAO interface {
 	// GetApprovedProvidersByTemplateIDAndVersionID 获取已审核通过的供应商列表
 	GetApprovedProvidersByTemplateIDAndVersionID(ctx context.Context, templateID, versionID int64) ([]ChannelTemplateProvider, error)
 
+	// GetProvidersByProviderTemplateIDs 根据供应商名称和供应商侧模版ID获取供应商关联
+	GetProvidersByProviderTemplateIDs(ctx context.Context, providerName string, providerTemplateIDs []string) ([]ChannelTemplateProvider, error)
+
 	// GetProvidersByTemplateIDAndVersionID 获取模板和版本关联的所有供应商
 	GetProvidersByTemplateIDAndVersionID(ctx context.Context, templateID, versionID int64) ([]ChannelTemplateProvider, error)
 
@@ -198,29 +252,148 @@ func (d *channelTemplateDAO) CreateTemplate(ctx context.Context, template Channe
 
 // UpdateTemplate 更新模板基本信息
 func (d *channelTemplateDAO) UpdateTemplate(ctx context.Context, template ChannelTemplate) error {
-	// 只允许用户更新name、description、business_type这三个字段
+	// 只允许用户更新name、description、business_type、international这几个字段
 	updateData := map[string]any{
 		"name":          template.Name,
 		"description":   template.Description,
 		"business_type": template.BusinessType,
+		"international": template.International,
 		"utime":         time.Now().Unix(),
 	}
 
-	return d.db.WithContext(ctx).Model(&ChannelTemplate{}).
-		Where("id = ?", template.ID).
-		Updates(updateData).Error
+	res := d.db.WithContext(ctx).Model(&ChannelTemplate{}).
+		Where("id = ? AND archived_time = 0", template.ID).
+		Updates(updateData)
+	if res.Error != nil || res.RowsAffected > 0 {
+		return res.Error
+	}
+	// 没有更新时确认是否因为已归档
+	var cnt int64
+	err := d.db.WithContext(ctx).Model(&ChannelTemplate{}).
+		Where("id = ? AND archived_time > 0", template.ID).
+		Count(&cnt).Error
+	if err != nil {
+		return err
+	}
+	if cnt > 0 {
+		return fmt.Errorf("%w: templateID=%d", errs.ErrTemplateArchived, template.ID)
+	}
+	return nil
+}
+
+// SetTemplateActiveVersion 设置模板活跃版本，发布前的活跃版本在事务内读取
+func (d *channelTemplateDAO) SetTemplateActiveVersion(ctx context.Context, record ChannelTemplatePublishRecord) error {
+	now := time.Now().Unix()
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var template ChannelTemplate
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&template, "id = ?", record.TemplateID).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fmt.Errorf("%w", errs.ErrTemplateNotFound)
+			}
+			return err
+		}
+		updates := map[string]any{
+			"active_version_id": record.VersionID,
+			"utime":             now,
+		}
+		record.PreviousVersionID = template.ActiveVersionID
+		if record.Locale != "" {
+			// 其他语言的版本只修改该语言的活跃版本
+			versions := template.ActiveLocaleVersions.Val
+			if versions == nil {
+				versions = make(map[string]int64, 1)
+			}
+			record.PreviousVersionID = versions[record.Locale]
+			versions[record.Locale] = record.VersionID
+			updates = map[string]any{
+				"active_locale_versions": sqlx.JsonColumn[map[string]int64]{Val: versions, Valid: true},
+				"utime":                  now,
+			}
+		}
+		err = tx.Model(&ChannelTemplate{}).
+			Where("id = ?", record.TemplateID).
+			Updates(updates).Error
+		if err != nil {
+			return err
+		}
+		record.Ctime = now
+		return tx.Create(&record).Error
+	})
+}
+
+// GetPublishRecords 按时间倒序获取模版的发布记录
+func (d *channelTemplateDAO) GetPublishRecords(ctx context.Context, templateID int64, offset, limit int) ([]ChannelTemplatePublishRecord, error) {
+	var records []ChannelTemplatePublishRecord
+	err := d.db.WithContext(ctx).
+		Where("template_id = ?", templateID).
+		Order("ctime DESC, id DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&records).Error
+	return records, err
+}
+
+// TotalPublishRecords 统计模版的发布记录总数
+func (d *channelTemplateDAO) TotalPublishRecords(ctx context.Context, templateID int64) (int64, error) {
+	var res int64
+	err := d.db.WithContext(ctx).Model(&ChannelTemplatePublishRecord{}).
+		Where("template_id = ?", templateID).
+		Count(&res).Error
+	return res, err
 }
 
-// SetTemplateActiveVersion 设置模板活跃版本
-func (d *channelTemplateDAO) SetTemplateActiveVersion(ctx context.Context, templateID, versionID int64) error {
+// ArchiveTemplate 归档模版
+func (d *channelTemplateDAO) ArchiveTemplate(ctx context.Context, id int64) error {
+	now := time.Now().Unix()
 	return d.db.WithContext(ctx).Model(&ChannelTemplate{}).
-		Where("id = ?", templateID).
+		Where("id = ? AND archived_time = 0", id).
 		Updates(map[string]any{
-			"active_version_id": versionID,
-			"utime":             time.Now().Unix(),
+			"archived_time": now,
+			"utime":         now,
 		}).Error
 }
 
+// DeleteTemplate 在事务内确认没有通知使用该模版后删除
+func (d *channelTemplateDAO) DeleteTemplate(ctx context.Context, id int64) error {
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var template ChannelTemplate
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&template, "id = ?", id).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fmt.Errorf("%w", errs.ErrTemplateNotFound)
+			}
+			return err
+		}
+		if err = d.checkNotInUse(tx.Where("template_id = ?", id)); err != nil {
+			return err
+		}
+		if err = tx.Where("template_id = ?", id).Delete(&ChannelTemplateProvider{}).Error; err != nil {
+			return err
+		}
+		if err = tx.Where("channel_template_id = ?", id).Delete(&ChannelTemplateVersion{}).Error; err != nil {
+			return err
+		}
+		if err = tx.Where("template_id = ?", id).Delete(&ChannelTemplatePublishRecord{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&ChannelTemplate{}, "id = ?", id).Error
+	})
+}
+
+// checkNotInUse 确认没有符合条件的通知
+func (d *channelTemplateDAO) checkNotInUse(query *gorm.DB) error {
+	var ids []uint64
+	err := query.Model(&Notification{}).Select("id").Limit(1).Find(&ids).Error
+	if err != nil {
+		return err
+	}
+	if len(ids) > 0 {
+		return fmt.Errorf("%w: 通知ID %d", errs.ErrTemplateInUse, ids[0])
+	}
+	return nil
+}
+
 // 模版版本相关方法
 
 // GetTemplateVersionsByTemplateIDs 根据模板IDs获取版本列表
@@ -263,6 +436,56 @@ func (d *channelTemplateDAO) CreateTemplateVersion(ctx context.Context, version
 	return version, nil
 }
 
+// ArchiveTemplateVersion 归档模版版本
+func (d *channelTemplateDAO) ArchiveTemplateVersion(ctx context.Context, versionID int64) error {
+	now := time.Now().Unix()
+	return d.db.WithContext(ctx).Model(&ChannelTemplateVersion{}).
+		Where("id = ? AND archived_time = 0", versionID).
+		Updates(map[string]any{
+			"archived_time": now,
+			"utime":         now,
+		}).Error
+}
+
+// DeleteTemplateVersion 锁住模版，避免删除的同时发布该版本，确认没有通知使用该版本后删除
+func (d *channelTemplateDAO) DeleteTemplateVersion(ctx context.Context, templateID, versionID int64) error {
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var template ChannelTemplate
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&template, "id = ?", templateID).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fmt.Errorf("%w", errs.ErrTemplateNotFound)
+			}
+			return err
+		}
+		if template.ActiveVersionID == versionID {
+			return fmt.Errorf("%w: 版本 %d 是当前生效的版本", errs.ErrInvalidOperation, versionID)
+		}
+		for _, id := range template.ActiveLocaleVersions.Val {
+			if id == versionID {
+				return fmt.Errorf("%w: 版本 %d 是当前生效的版本", errs.ErrInvalidOperation, versionID)
+			}
+		}
+		err = d.checkNotInUse(tx.Where("template_id = ? AND template_version_id = ?", templateID, versionID))
+		if err != nil {
+			return err
+		}
+		err = tx.Where("template_id = ? AND template_version_id = ?", templateID, versionID).
+			Delete(&ChannelTemplateProvider{}).Error
+		if err != nil {
+			return err
+		}
+		res := tx.Where("id = ? AND channel_template_id = ?", versionID, templateID).Delete(&ChannelTemplateVersion{})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return fmt.Errorf("%w", errs.ErrTemplateVersionNotFound)
+		}
+		return nil
+	})
+}
+
 func (d *channelTemplateDAO) ForkTemplateVersion(ctx context.Context, versionID int64) (ChannelTemplateVersion, error) {
 	now := time.Now().Unix()
 	var created ChannelTemplateVersion
@@ -283,6 +506,11 @@ func (d *channelTemplateDAO) ForkTemplateVersion(ctx context.Context, versionID
 			Signature:                old.Signature,
 			Content:                  old.Content,
 			Remark:                   old.Remark,
+			Params:                   old.Params,
+			Locale:                   old.Locale,
+			Subject:                  old.Subject,
+			ReplyTo:                  old.ReplyTo,
+			Attachments:              old.Attachments,
 			AuditID:                  0,
 			AuditorID:                0,
 			AuditTime:                0,
@@ -312,6 +540,7 @@ func (d *channelTemplateDAO) ForkTemplateVersion(ctx context.Context, versionID
 				ProviderID:               src.ProviderID,
 				ProviderName:             src.ProviderName,
 				ProviderChannel:          src.ProviderChannel,
+				Region:                   src.Region,
 				RequestID:                "",
 				ProviderTemplateID:       "",
 				AuditStatus:              domain.AuditStatusPending.String(),
@@ -385,6 +614,18 @@ func (d *channelTemplateDAO) GetApprovedProvidersByTemplateIDAndVersionID(ctx co
 	return providers, err
 }
 
+// GetProvidersByProviderTemplateIDs 根据供应商名称和供应商侧模版ID获取供应商关联，用于处理供应商推送的审核结果
+func (d *channelTemplateDAO) GetProvidersByProviderTemplateIDs(ctx context.Context, providerName string, providerTemplateIDs []string) ([]ChannelTemplateProvider, error) {
+	if len(providerTemplateIDs) == 0 {
+		return []ChannelTemplateProvider{}, nil
+	}
+	var providers []ChannelTemplateProvider
+	err := d.db.WithContext(ctx).Model(&ChannelTemplateProvider{}).
+		Where("provider_name = ? AND provider_template_id IN (?)",
+			providerName, providerTemplateIDs).Find(&providers).Error
+	return providers, err
+}
+
 func (d *channelTemplateDAO) GetProvidersByTemplateIDAndVersionID(ctx context.Context, templateID, versionID int64) ([]ChannelTemplateProvider, error) {
 	var providers []ChannelTemplateProvider
 	err := d.db.WithContext(ctx).Model(&ChannelTemplateProvider{}).
@@ -397,11 +638,16 @@ func (d *channelTemplateDAO) GetProvidersByTemplateIDAndVersionID(ctx context.Co
 func (d *channelTemplateDAO) UpdateTemplateVersion(ctx context.Context, version ChannelTemplateVersion) error {
 	// 只允许更新部分字段
 	updateData := map[string]any{
-		"name":      version.Name,
-		"signature": version.Signature,
-		"content":   version.Content,
-		"remark":    version.Remark,
-		"utime":     time.Now().Unix(),
+		"name":        version.Name,
+		"signature":   version.Signature,
+		"content":     version.Content,
+		"remark":      version.Remark,
+		"params":      version.Params,
+		"locale":      version.Locale,
+		"subject":     version.Subject,
+		"reply_to":    version.ReplyTo,
+		"attachments": version.Attachments,
+		"utime":       time.Now().Unix(),
 	}
 
 	return d.db.WithContext(ctx).Model(&ChannelTemplateVersion{}).
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/repository/dao"
+	"github.com/ecodeclub/ekit/slice"
+)
+
+// InboxRepository 站内信仓储接口
+type InboxRepository interface {
+	BatchCreate(ctx context.Context, msgs []domain.InboxMessage) error
+	List(ctx context.Context, query domain.InboxQuery) ([]domain.InboxMessage, error)
+	CountUnread(ctx context.Context, bizID int64, userID string) (int64, error)
+	MarkRead(ctx context.Context, bizID int64, userID string, ids []int64) (int64, error)
+	MarkAllRead(ctx context.Context, bizID int64, userID string) (int64, error)
+	Delete(ctx context.Context, bizID int64, userID string, ids []int64) (int64, error)
+	Archive(ctx context.Context, bizID int64, userID string, ids []int64) (int64, error)
+}
+
+type inboxRepository struct {
+	dao dao.InboxDAO
+}
+
+func NewInboxRepository(dao dao.InboxDAO) InboxRepository {
+	return &inboxRepository{dao: dao}
+}
+
+func (r *inboxRepository) BatchCreate(ctx context.Context, msgs []domain.InboxMessage) error {
+	return r.dao.BatchCreate(ctx, slice.Map(msgs, func(_ int, src domain.InboxMessage) dao.InboxMessage {
+		return r.toEntity(src)
+	}))
+}
+
+func (r *inboxRepository) List(ctx context.Context, query domain.InboxQuery) ([]domain.InboxMessage, error) {
+	entities, err := r.dao.List(ctx, query.BizID, query.UserID, query.Cursor, query.Limit, query.Archived)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(entities, func(_ int, src dao.InboxMessage) domain.InboxMessage {
+		return r.toDomain(src)
+	}), nil
+}
+
+func (r *inboxRepository) CountUnread(ctx context.Context, bizID int64, userID string) (int64, error) {
+	return r.dao.CountUnread(ctx, bizID, userID)
+}
+
+func (r *inboxRepository) MarkRead(ctx context.Context, bizID int64, userID string, ids []int64) (int64, error) {
+	return r.dao.MarkRead(ctx, bizID, userID, ids)
+}
+
+func (r *inboxRepository) MarkAllRead(ctx context.Context, bizID int64, userID string) (int64, error) {
+	return r.dao.MarkAllRead(ctx, bizID, userID)
+}
+
+func (r *inboxRepository) Delete(ctx context.Context, bizID int64, userID string, ids []int64) (int64, error) {
+	return r.dao.Delete(ctx, bizID, userID, ids)
+}
+
+func (r *inboxRepository) Archive(ctx context.Context, bizID int64, userID string, ids []int64) (int64, error) {
+	return r.dao.Archive(ctx, bizID, userID, ids)
+}
+
+func (r *inboxRepository) toEntity(msg domain.InboxMessage) dao.InboxMessage {
+	return dao.InboxMessage{
+		ID:             msg.ID,
+		BizID:          msg.BizID,
+		UserID:         msg.UserID,
+		NotificationID: msg.NotificationID,
+		Title:          msg.Title,
+		Content:        msg.Content,
+		Status:         msg.Status.String(),
+		Archived:       msg.Archived,
+		ReadTime:       msg.ReadTime,
+		Ctime:          msg.Ctime,
+		Utime:          msg.Utime,
+	}
+}
+
+func (r *inboxRepository) toDomain(msg dao.InboxMessage) domain.InboxMessage {
+	return domain.InboxMessage{
+		ID:             msg.ID,
+		BizID:          msg.BizID,
+		UserID:         msg.UserID,
+		NotificationID: msg.NotificationID,
+		Title:          msg.Title,
+		Content:        msg.Content,
+		Status:         domain.InboxMessageStatus(msg.Status),
+		Archived:       msg.Archived,
+		ReadTime:       msg.ReadTime,
+		Ctime:          msg.Ctime,
+		Utime:          msg.Utime,
+	}
+}
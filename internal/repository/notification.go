@@ -95,6 +95,7 @@ func (r *notificationRepository) Create(ctx context.Context, notification domain
 func (r *notificationRepository) toEntity(notification domain.Notification) dao.Notification {
 	templateParams, _ := notification.MarshalTemplateParams()
 	receivers, _ := notification.MarshalReceivers()
+	fallbackReceivers, _ := notification.MarshalFallbackReceivers()
 	return dao.Notification{
 		ID:                notification.ID,
 		BizID:             notification.BizID,
@@ -104,6 +105,9 @@ func (r *notificationRepository) toEntity(notification domain.Notification) dao.
 		TemplateID:        notification.Template.ID,
 		TemplateVersionID: notification.Template.VersionID,
 		TemplateParams:    templateParams,
+		FallbackReceivers: fallbackReceivers,
+		DeliveredChannel:  notification.DeliveredChannel.String(),
+		Locale:            notification.Locale,
 		Status:            notification.Status.String(),
 		ScheduledSTime:    notification.ScheduledSTime.UnixMilli(),
 		ScheduledETime:    notification.ScheduledETime.UnixMilli(),
@@ -119,6 +123,11 @@ func (r *notificationRepository) toDomain(n dao.Notification) domain.Notificatio
 	var receivers []string
 	_ = json.Unmarshal([]byte(n.Receivers), &receivers)
 
+	var fallbackReceivers map[domain.Channel][]string
+	if n.FallbackReceivers != "" {
+		_ = json.Unmarshal([]byte(n.FallbackReceivers), &fallbackReceivers)
+	}
+
 	return domain.Notification{
 		ID:        n.ID,
 		BizID:     n.BizID,
@@ -130,10 +139,13 @@ func (r *notificationRepository) toDomain(n dao.Notification) domain.Notificatio
 			VersionID: n.TemplateVersionID,
 			Params:    templateParams,
 		},
-		Status:         domain.SendStatus(n.Status),
-		ScheduledSTime: time.UnixMilli(n.ScheduledSTime),
-		ScheduledETime: time.UnixMilli(n.ScheduledETime),
-		Version:        n.Version,
+		Status:            domain.SendStatus(n.Status),
+		ScheduledSTime:    time.UnixMilli(n.ScheduledSTime),
+		ScheduledETime:    time.UnixMilli(n.ScheduledETime),
+		Version:           n.Version,
+		FallbackReceivers: fallbackReceivers,
+		DeliveredChannel:  domain.Channel(n.DeliveredChannel),
+		Locale:            n.Locale,
 	}
 }
 
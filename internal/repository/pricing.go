@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/repository/dao"
+	"github.com/ecodeclub/ekit/slice"
+)
+
+// PricingRepository 供应商价格和发送费用仓储接口
+type PricingRepository interface {
+	SavePrice(ctx context.Context, price domain.ProviderPrice) error
+	FindPricesByChannel(ctx context.Context, channel domain.Channel) (domain.PriceTable, error)
+	CreateCost(ctx context.Context, cost domain.SendCost) error
+}
+
+type pricingRepository struct {
+	dao dao.PricingDAO
+}
+
+func NewPricingRepository(dao dao.PricingDAO) PricingRepository {
+	return &pricingRepository{dao: dao}
+}
+
+func (r *pricingRepository) SavePrice(ctx context.Context, price domain.ProviderPrice) error {
+	return r.dao.SavePrice(ctx, dao.ProviderPrice{
+		ID:           price.ID,
+		Provider:     price.Provider,
+		Channel:      price.Channel.String(),
+		CountryCode:  price.CountryCode,
+		BusinessType: price.BusinessType.ToInt64(),
+		UnitPrice:    price.UnitPrice,
+	})
+}
+
+func (r *pricingRepository) FindPricesByChannel(ctx context.Context, channel domain.Channel) (domain.PriceTable, error) {
+	entities, err := r.dao.FindPricesByChannel(ctx, channel.String())
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(entities, func(_ int, src dao.ProviderPrice) domain.ProviderPrice {
+		return domain.ProviderPrice{
+			ID:           src.ID,
+			Provider:     src.Provider,
+			Channel:      domain.Channel(src.Channel),
+			CountryCode:  src.CountryCode,
+			BusinessType: domain.BusinessType(src.BusinessType),
+			UnitPrice:    src.UnitPrice,
+			Ctime:        src.Ctime,
+			Utime:        src.Utime,
+		}
+	}), nil
+}
+
+func (r *pricingRepository) CreateCost(ctx context.Context, cost domain.SendCost) error {
+	return r.dao.CreateCost(ctx, dao.SendCost{
+		NotificationID: cost.NotificationID,
+		BizID:          cost.BizID,
+		Provider:       cost.Provider,
+		Channel:        cost.Channel.String(),
+		CountryCode:    cost.CountryCode,
+		BusinessType:   cost.BusinessType.ToInt64(),
+		UnitPrice:      cost.UnitPrice,
+		Quantity:       cost.Quantity,
+		Amount:         cost.Amount,
+	})
+}
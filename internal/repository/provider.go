@@ -7,7 +7,9 @@ import (
 
 	"gitee.com/flycash/notification-platform/internal/domain"
 	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/pkg/secret"
 	"gitee.com/flycash/notification-platform/internal/repository/dao"
+	"github.com/gotomicro/ego/core/elog"
 
 	"gorm.io/gorm"
 )
@@ -22,59 +24,135 @@ type ProviderRepository interface {
 	FindByID(ctx context.Context, id int64) (domain.Provider, error)
 	// FindByChannel 查找指定渠道的所有供应商
 	FindByChannel(ctx context.Context, channel domain.Channel) ([]domain.Provider, error)
+	// List 查找所有供应商，包括禁用的供应商，channel 为空表示所有渠道
+	List(ctx context.Context, channel domain.Channel) ([]domain.Provider, error)
+	// UpdateStatus 启用或者禁用供应商
+	UpdateStatus(ctx context.Context, id int64, status domain.ProviderStatus) error
+	// UpdateWeight 更新权重
+	UpdateWeight(ctx context.Context, id int64, weight int) error
+	// UpdateLimits 更新限流
+	UpdateLimits(ctx context.Context, id int64, qpsLimit, dailyLimit int) error
+	// Delete 删除供应商
+	Delete(ctx context.Context, id int64) error
+	// RotateSecrets 用当前主密钥重新加密所有不是用当前主密钥加密的密钥，返回重新加密的供应商数量
+	RotateSecrets(ctx context.Context) (int, error)
 }
 
+const rotateBatchSize = 100
+
+// providerRepository APIKey、APISecret 和 CallbackToken 使用信封加密存储，读取时透明解密
 type providerRepository struct {
-	dao dao.ProviderDAO
+	dao     dao.ProviderDAO
+	keyring *secret.Keyring
+	logger  *elog.Component
 }
 
-func NewProviderRepository(d dao.ProviderDAO) ProviderRepository {
-	return &providerRepository{dao: d}
+func NewProviderRepository(d dao.ProviderDAO, keyring *secret.Keyring) ProviderRepository {
+	return &providerRepository{
+		dao:     d,
+		keyring: keyring,
+		logger:  elog.DefaultLogger,
+	}
 }
 
 func (p *providerRepository) Create(ctx context.Context, provider domain.Provider) (domain.Provider, error) {
-	created, err := p.dao.Create(ctx, p.toEntity(provider))
+	entity, err := p.toEntity(provider)
+	if err != nil {
+		return domain.Provider{}, err
+	}
+	created, err := p.dao.Create(ctx, entity)
 	if err != nil {
 		return domain.Provider{}, err
 	}
-	return p.toDomain(created), nil
+	provider.ID = created.ID
+	return provider, nil
 }
 
-func (p *providerRepository) toDomain(d dao.Provider) domain.Provider {
+func (p *providerRepository) toDomain(d dao.Provider) (domain.Provider, error) {
+	apiKey, err := p.decrypt(d.APIKey, true)
+	if err != nil {
+		return domain.Provider{}, fmt.Errorf("%w: 解密供应商 %d 的 APIKey 失败: %w", errs.ErrProviderSecret, d.ID, err)
+	}
+	apiSecret, err := p.decrypt(d.APISecret, false)
+	if err != nil {
+		return domain.Provider{}, fmt.Errorf("%w: 解密供应商 %d 的 APISecret 失败: %w", errs.ErrProviderSecret, d.ID, err)
+	}
+	callbackToken, err := p.decrypt(d.CallbackToken, false)
+	if err != nil {
+		return domain.Provider{}, fmt.Errorf("%w: 解密供应商 %d 的 CallbackToken 失败: %w", errs.ErrProviderSecret, d.ID, err)
+	}
 	return domain.Provider{
 		ID:               d.ID,
 		Name:             d.Name,
 		Channel:          domain.Channel(d.Channel),
 		Endpoint:         d.Endpoint,
-		APIKey:           d.APIKey,
-		APISecret:        d.APISecret,
+		RegionID:         d.RegionID,
+		APIKey:           apiKey,
+		APISecret:        apiSecret,
+		APPID:            d.APPID,
 		Weight:           d.Weight,
 		QPSLimit:         d.QPSLimit,
 		DailyLimit:       d.DailyLimit,
 		AuditCallbackURL: d.AuditCallbackURL,
+		CallbackToken:    callbackToken,
 		Status:           domain.ProviderStatus(d.Status),
-	}
+	}, nil
 }
 
-func (p *providerRepository) toEntity(provider domain.Provider) dao.Provider {
+func (p *providerRepository) toEntity(provider domain.Provider) (dao.Provider, error) {
+	apiKey, err := p.encrypt(provider.APIKey)
+	if err != nil {
+		return dao.Provider{}, fmt.Errorf("%w: 加密 APIKey 失败: %w", errs.ErrProviderSecret, err)
+	}
+	apiSecret, err := p.encrypt(provider.APISecret)
+	if err != nil {
+		return dao.Provider{}, fmt.Errorf("%w: 加密 APISecret 失败: %w", errs.ErrProviderSecret, err)
+	}
+	callbackToken, err := p.encrypt(provider.CallbackToken)
+	if err != nil {
+		return dao.Provider{}, fmt.Errorf("%w: 加密 CallbackToken 失败: %w", errs.ErrProviderSecret, err)
+	}
 	daoProvider := dao.Provider{
 		ID:               provider.ID,
 		Name:             provider.Name,
 		Channel:          provider.Channel.String(),
 		Endpoint:         provider.Endpoint,
-		APIKey:           provider.APIKey,
-		APISecret:        provider.APISecret,
+		RegionID:         provider.RegionID,
+		APIKey:           apiKey,
+		APISecret:        apiSecret,
+		APPID:            provider.APPID,
 		Weight:           provider.Weight,
 		QPSLimit:         provider.QPSLimit,
 		DailyLimit:       provider.DailyLimit,
 		AuditCallbackURL: provider.AuditCallbackURL,
+		CallbackToken:    callbackToken,
 		Status:           provider.Status.String(),
 	}
-	return daoProvider
+	return daoProvider, nil
+}
+
+// encrypt 空值不加密
+func (p *providerRepository) encrypt(plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+	return p.keyring.Encrypt(plaintext)
+}
+
+// decrypt 兼容引入信封加密之前的数据：APIKey 是明文，APISecret 是用旧密钥加密的
+func (p *providerRepository) decrypt(encrypted string, legacyPlaintext bool) (string, error) {
+	if encrypted == "" || (legacyPlaintext && !secret.IsEnvelope(encrypted)) {
+		return encrypted, nil
+	}
+	return p.keyring.Decrypt(encrypted)
 }
 
 func (p *providerRepository) Update(ctx context.Context, provider domain.Provider) error {
-	return p.dao.Update(ctx, p.toEntity(provider))
+	entity, err := p.toEntity(provider)
+	if err != nil {
+		return err
+	}
+	return p.dao.Update(ctx, entity)
 }
 
 func (p *providerRepository) FindByID(ctx context.Context, id int64) (domain.Provider, error) {
@@ -86,7 +164,7 @@ func (p *providerRepository) FindByID(ctx context.Context, id int64) (domain.Pro
 		}
 		return domain.Provider{}, err
 	}
-	return p.toDomain(provider), nil
+	return p.toDomain(provider)
 }
 
 func (p *providerRepository) FindByChannel(ctx context.Context, channel domain.Channel) ([]domain.Provider, error) {
@@ -94,11 +172,101 @@ func (p *providerRepository) FindByChannel(ctx context.Context, channel domain.C
 	if err != nil {
 		return nil, err
 	}
+	return p.toDomains(providers)
+}
+
+func (p *providerRepository) List(ctx context.Context, channel domain.Channel) ([]domain.Provider, error) {
+	providers, err := p.dao.List(ctx, channel.String())
+	if err != nil {
+		return nil, err
+	}
+	return p.toDomains(providers)
+}
 
+func (p *providerRepository) toDomains(providers []dao.Provider) ([]domain.Provider, error) {
 	result := make([]domain.Provider, 0, len(providers))
 	for i := range providers {
-		result = append(result, p.toDomain(providers[i]))
+		provider, err1 := p.toDomain(providers[i])
+		if err1 != nil {
+			return nil, err1
+		}
+		result = append(result, provider)
 	}
 
 	return result, nil
 }
+
+func (p *providerRepository) UpdateStatus(ctx context.Context, id int64, status domain.ProviderStatus) error {
+	return p.toDomainErr(p.dao.UpdateStatus(ctx, id, status.String()))
+}
+
+func (p *providerRepository) UpdateWeight(ctx context.Context, id int64, weight int) error {
+	return p.toDomainErr(p.dao.UpdateWeight(ctx, id, weight))
+}
+
+func (p *providerRepository) UpdateLimits(ctx context.Context, id int64, qpsLimit, dailyLimit int) error {
+	return p.toDomainErr(p.dao.UpdateLimits(ctx, id, qpsLimit, dailyLimit))
+}
+
+func (p *providerRepository) Delete(ctx context.Context, id int64) error {
+	return p.toDomainErr(p.dao.Delete(ctx, id))
+}
+
+func (p *providerRepository) toDomainErr(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("%w", errs.ErrProviderNotFound)
+	}
+	return err
+}
+
+func (p *providerRepository) RotateSecrets(ctx context.Context) (int, error) {
+	var rotated int
+	var lastID int64
+	for {
+		providers, err := p.dao.FindAfterID(ctx, lastID, rotateBatchSize)
+		if err != nil {
+			return rotated, err
+		}
+		for i := range providers {
+			ok, err1 := p.rotate(ctx, providers[i])
+			if err1 != nil {
+				return rotated, err1
+			}
+			if ok {
+				rotated++
+			}
+		}
+		if len(providers) < rotateBatchSize {
+			return rotated, nil
+		}
+		lastID = providers[len(providers)-1].ID
+	}
+}
+
+// rotate 重新加密单个供应商的密钥，已经使用当前主密钥加密的不处理
+func (p *providerRepository) rotate(ctx context.Context, entity dao.Provider) (bool, error) {
+	if !p.needsRotation(entity.APIKey) && !p.needsRotation(entity.APISecret) && !p.needsRotation(entity.CallbackToken) {
+		return false, nil
+	}
+	provider, err := p.toDomain(entity)
+	if err != nil {
+		return false, err
+	}
+	updated, err := p.toEntity(provider)
+	if err != nil {
+		return false, err
+	}
+	ok, err := p.dao.UpdateSecrets(ctx, updated, entity)
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		// 密钥在轮换期间被修改过，修改时已经使用了当前主密钥
+		p.logger.Warn("供应商密钥已被修改，跳过重新加密", elog.Int64("ProviderID", entity.ID))
+	}
+	return ok, nil
+}
+
+func (p *providerRepository) needsRotation(encrypted string) bool {
+	return encrypted != "" && p.keyring.NeedsRotation(encrypted)
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/repository/dao"
+	"github.com/ecodeclub/ekit/slice"
+)
+
+// ReceiverResultRepository 接收者发送结果仓储接口
+type ReceiverResultRepository interface {
+	// Save 保存接收者发送结果，已存在的覆盖为最新结果
+	Save(ctx context.Context, results []domain.ReceiverResult) error
+	// FindByNotificationIDs 按通知ID分组返回接收者发送结果
+	FindByNotificationIDs(ctx context.Context, notificationIDs []uint64) (map[uint64][]domain.ReceiverResult, error)
+}
+
+type receiverResultRepository struct {
+	dao dao.ReceiverResultDAO
+}
+
+func NewReceiverResultRepository(dao dao.ReceiverResultDAO) ReceiverResultRepository {
+	return &receiverResultRepository{dao: dao}
+}
+
+func (r *receiverResultRepository) Save(ctx context.Context, results []domain.ReceiverResult) error {
+	return r.dao.Upsert(ctx, slice.Map(results, func(_ int, src domain.ReceiverResult) dao.ReceiverResult {
+		return r.toEntity(src)
+	}))
+}
+
+func (r *receiverResultRepository) FindByNotificationIDs(ctx context.Context, notificationIDs []uint64) (map[uint64][]domain.ReceiverResult, error) {
+	if len(notificationIDs) == 0 {
+		return map[uint64][]domain.ReceiverResult{}, nil
+	}
+	entities, err := r.dao.FindByNotificationIDs(ctx, notificationIDs)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[uint64][]domain.ReceiverResult, len(notificationIDs))
+	for i := range entities {
+		res[entities[i].NotificationID] = append(res[entities[i].NotificationID], r.toDomain(entities[i]))
+	}
+	return res, nil
+}
+
+func (r *receiverResultRepository) toEntity(result domain.ReceiverResult) dao.ReceiverResult {
+	return dao.ReceiverResult{
+		NotificationID: result.NotificationID,
+		Receiver:       result.Receiver,
+		Provider:       result.Provider,
+		Status:         result.Status.String(),
+		ErrCode:        result.ErrCode,
+		ErrMessage:     result.ErrMessage,
+		Ctime:          result.Ctime,
+		Utime:          result.Utime,
+	}
+}
+
+func (r *receiverResultRepository) toDomain(result dao.ReceiverResult) domain.ReceiverResult {
+	return domain.ReceiverResult{
+		NotificationID: result.NotificationID,
+		Receiver:       result.Receiver,
+		Provider:       result.Provider,
+		Status:         domain.SendStatus(result.Status),
+		ErrCode:        result.ErrCode,
+		ErrMessage:     result.ErrMessage,
+		Ctime:          result.Ctime,
+		Utime:          result.Utime,
+	}
+}
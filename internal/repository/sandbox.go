@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/repository/cache"
+)
+
+// SandboxRepository 沙箱消息仓储接口
+type SandboxRepository interface {
+	Save(ctx context.Context, msg domain.CapturedMessage, retention time.Duration) error
+	Find(ctx context.Context, bizID int64) ([]domain.CapturedMessage, error)
+	Get(ctx context.Context, bizID int64, id string) (domain.CapturedMessage, error)
+	Clear(ctx context.Context, bizID int64) error
+}
+
+type sandboxRepository struct {
+	cache cache.SandboxCache
+}
+
+func NewSandboxRepository(c cache.SandboxCache) SandboxRepository {
+	return &sandboxRepository{cache: c}
+}
+
+func (r *sandboxRepository) Save(ctx context.Context, msg domain.CapturedMessage, retention time.Duration) error {
+	return r.cache.Save(ctx, msg, retention)
+}
+
+func (r *sandboxRepository) Find(ctx context.Context, bizID int64) ([]domain.CapturedMessage, error) {
+	return r.cache.Find(ctx, bizID)
+}
+
+func (r *sandboxRepository) Get(ctx context.Context, bizID int64, id string) (domain.CapturedMessage, error) {
+	msg, err := r.cache.Get(ctx, bizID, id)
+	if errors.Is(err, cache.ErrKeyNotFound) {
+		return domain.CapturedMessage{}, fmt.Errorf("%w: id = %s", errs.ErrCapturedMessageNotFound, id)
+	}
+	return msg, err
+}
+
+func (r *sandboxRepository) Clear(ctx context.Context, bizID int64) error {
+	return r.cache.Clear(ctx, bizID)
+}
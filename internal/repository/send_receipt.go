@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/repository/dao"
+	"github.com/ecodeclub/ekit/slice"
+)
+
+// SendReceiptRepository 供应商回执仓储接口
+type SendReceiptRepository interface {
+	BatchCreate(ctx context.Context, receipts []domain.SendReceipt) error
+	FindPending(ctx context.Context, now int64, limit int) ([]domain.SendReceipt, error)
+	FindByNotificationID(ctx context.Context, notificationID uint64) ([]domain.SendReceipt, error)
+	Update(ctx context.Context, receipt domain.SendReceipt) (bool, error)
+	FindByReceiptID(ctx context.Context, provider, receiptID, receiver string) (domain.SendReceipt, error)
+	FindLatestByReceiver(ctx context.Context, provider, receiver string) (domain.SendReceipt, error)
+}
+
+type sendReceiptRepository struct {
+	dao dao.SendReceiptDAO
+}
+
+func NewSendReceiptRepository(dao dao.SendReceiptDAO) SendReceiptRepository {
+	return &sendReceiptRepository{dao: dao}
+}
+
+func (r *sendReceiptRepository) BatchCreate(ctx context.Context, receipts []domain.SendReceipt) error {
+	return r.dao.BatchCreate(ctx, slice.Map(receipts, func(_ int, src domain.SendReceipt) dao.SendReceipt {
+		return r.toEntity(src)
+	}))
+}
+
+func (r *sendReceiptRepository) FindPending(ctx context.Context, now int64, limit int) ([]domain.SendReceipt, error) {
+	entities, err := r.dao.FindPending(ctx, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(entities, func(_ int, src dao.SendReceipt) domain.SendReceipt {
+		return r.toDomain(src)
+	}), nil
+}
+
+func (r *sendReceiptRepository) FindByNotificationID(ctx context.Context, notificationID uint64) ([]domain.SendReceipt, error) {
+	entities, err := r.dao.FindByNotificationID(ctx, notificationID)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(entities, func(_ int, src dao.SendReceipt) domain.SendReceipt {
+		return r.toDomain(src)
+	}), nil
+}
+
+func (r *sendReceiptRepository) Update(ctx context.Context, receipt domain.SendReceipt) (bool, error) {
+	return r.dao.Update(ctx, r.toEntity(receipt))
+}
+
+func (r *sendReceiptRepository) FindByReceiptID(ctx context.Context, provider, receiptID, receiver string) (domain.SendReceipt, error) {
+	entity, err := r.dao.FindByReceiptID(ctx, provider, receiptID, receiver)
+	if err != nil {
+		return domain.SendReceipt{}, err
+	}
+	return r.toDomain(entity), nil
+}
+
+func (r *sendReceiptRepository) FindLatestByReceiver(ctx context.Context, provider, receiver string) (domain.SendReceipt, error) {
+	entity, err := r.dao.FindLatestByReceiver(ctx, provider, receiver)
+	if err != nil {
+		return domain.SendReceipt{}, err
+	}
+	return r.toDomain(entity), nil
+}
+
+func (r *sendReceiptRepository) toEntity(receipt domain.SendReceipt) dao.SendReceipt {
+	return dao.SendReceipt{
+		ID:             receipt.ID,
+		NotificationID: receipt.NotificationID,
+		Provider:       receipt.Provider,
+		Receiver:       receipt.Receiver,
+		RequestID:      receipt.RequestID,
+		ReceiptID:      receipt.ReceiptID,
+		Status:         receipt.Status.String(),
+		ErrCode:        receipt.ErrCode,
+		QueryCount:     receipt.QueryCount,
+		NextQueryTime:  receipt.NextQueryTime,
+		Ctime:          receipt.Ctime,
+		Utime:          receipt.Utime,
+	}
+}
+
+func (r *sendReceiptRepository) toDomain(receipt dao.SendReceipt) domain.SendReceipt {
+	return domain.SendReceipt{
+		ID:             receipt.ID,
+		NotificationID: receipt.NotificationID,
+		Provider:       receipt.Provider,
+		Receiver:       receipt.Receiver,
+		RequestID:      receipt.RequestID,
+		ReceiptID:      receipt.ReceiptID,
+		Status:         domain.SendReceiptStatus(receipt.Status),
+		ErrCode:        receipt.ErrCode,
+		QueryCount:     receipt.QueryCount,
+		NextQueryTime:  receipt.NextQueryTime,
+		Ctime:          receipt.Ctime,
+		Utime:          receipt.Utime,
+	}
+}
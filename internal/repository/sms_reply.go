@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/repository/dao"
+	"github.com/ecodeclub/ekit/slice"
+)
+
+// SmsReplyRepository 上行短信仓储接口
+type SmsReplyRepository interface {
+	BatchCreate(ctx context.Context, replies []domain.SmsReply) error
+	List(ctx context.Context, query domain.SmsReplyQuery) ([]domain.SmsReply, error)
+}
+
+type smsReplyRepository struct {
+	dao dao.SmsReplyDAO
+}
+
+func NewSmsReplyRepository(dao dao.SmsReplyDAO) SmsReplyRepository {
+	return &smsReplyRepository{dao: dao}
+}
+
+func (r *smsReplyRepository) BatchCreate(ctx context.Context, replies []domain.SmsReply) error {
+	return r.dao.BatchCreate(ctx, slice.Map(replies, func(_ int, src domain.SmsReply) dao.SmsReply {
+		return r.toEntity(src)
+	}))
+}
+
+func (r *smsReplyRepository) List(ctx context.Context, query domain.SmsReplyQuery) ([]domain.SmsReply, error) {
+	entities, err := r.dao.List(ctx, query.BizID, query.PhoneNumber, query.Unsubscribe, query.Cursor, query.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(entities, func(_ int, src dao.SmsReply) domain.SmsReply {
+		return r.toDomain(src)
+	}), nil
+}
+
+func (r *smsReplyRepository) toEntity(reply domain.SmsReply) dao.SmsReply {
+	return dao.SmsReply{
+		ID:             reply.ID,
+		BizID:          reply.BizID,
+		NotificationID: reply.NotificationID,
+		Provider:       reply.Provider,
+		PhoneNumber:    reply.PhoneNumber,
+		Content:        reply.Content,
+		SignName:       reply.SignName,
+		ExtendCode:     reply.ExtendCode,
+		Unsubscribe:    reply.IsUnsubscribe(),
+		ReplyTime:      reply.ReplyTime,
+		Ctime:          reply.Ctime,
+	}
+}
+
+func (r *smsReplyRepository) toDomain(reply dao.SmsReply) domain.SmsReply {
+	return domain.SmsReply{
+		ID:             reply.ID,
+		BizID:          reply.BizID,
+		NotificationID: reply.NotificationID,
+		Provider:       reply.Provider,
+		PhoneNumber:    reply.PhoneNumber,
+		Content:        reply.Content,
+		SignName:       reply.SignName,
+		ExtendCode:     reply.ExtendCode,
+		ReplyTime:      reply.ReplyTime,
+		Ctime:          reply.Ctime,
+	}
+}
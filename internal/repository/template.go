@@ -6,6 +6,7 @@ import (
 	"gitee.com/flycash/notification-platform/internal/domain"
 	"gitee.com/flycash/notification-platform/internal/repository/dao"
 	"github.com/ecodeclub/ekit/slice"
+	"github.com/ecodeclub/ekit/sqlx"
 )
 
 // ChannelTemplateRepository 提供模板数据存储的仓库接口
@@ -24,8 +25,17 @@ type ChannelTemplateRepository interface {
 	// UpdateTemplate 更新模板
 	UpdateTemplate(ctx context.Context, template domain.ChannelTemplate) error
 
-	// SetTemplateActiveVersion 设置模板的活跃版本
-	SetTemplateActiveVersion(ctx context.Context, templateID, versionID int64) error
+	// SetTemplateActiveVersion 设置模板的活跃版本，同时记录发布历史，记录中发布前的活跃版本由存储层填充
+	SetTemplateActiveVersion(ctx context.Context, record domain.TemplatePublishRecord) error
+
+	// GetPublishRecords 按时间倒序获取模版的发布记录
+	GetPublishRecords(ctx context.Context, templateID int64, offset, limit int) (records []domain.TemplatePublishRecord, total int64, err error)
+
+	// ArchiveTemplate 归档模版
+	ArchiveTemplate(ctx context.Context, templateID int64) error
+
+	// DeleteTemplate 删除模版以及所有的版本，有通知使用该模版时返回 errs.ErrTemplateInUse
+	DeleteTemplate(ctx context.Context, templateID int64) error
 
 	// 模版版本相关方法
 
@@ -38,6 +48,12 @@ type ChannelTemplateRepository interface {
 	// ForkTemplateVersion 基于已有版本创建新版本
 	ForkTemplateVersion(ctx context.Context, versionID int64) (domain.ChannelTemplateVersion, error)
 
+	// ArchiveTemplateVersion 归档模版版本
+	ArchiveTemplateVersion(ctx context.Context, versionID int64) error
+
+	// DeleteTemplateVersion 删除模版版本，生效中的版本返回 errs.ErrInvalidOperation，有通知使用该版本时返回 errs.ErrTemplateInUse
+	DeleteTemplateVersion(ctx context.Context, templateID, versionID int64) error
+
 	// 供应商相关方法
 
 	// GetProviderByNameAndChannel 根据名称和渠道获取供应商
@@ -49,6 +65,9 @@ type ChannelTemplateRepository interface {
 	// GetApprovedProvidersByTemplateIDAndVersionID 获取已审核通过的供应商列表
 	GetApprovedProvidersByTemplateIDAndVersionID(ctx context.Context, templateID, versionID int64) ([]domain.ChannelTemplateProvider, error)
 
+	// GetProvidersByProviderTemplateIDs 根据供应商名称和供应商侧模版ID获取供应商关联
+	GetProvidersByProviderTemplateIDs(ctx context.Context, providerName string, providerTemplateIDs []string) ([]domain.ChannelTemplateProvider, error)
+
 	// GetProvidersByTemplateIDAndVersionID 获取模板和版本关联的所有供应商
 	GetProvidersByTemplateIDAndVersionID(ctx context.Context, templateID, versionID int64) ([]domain.ChannelTemplateProvider, error)
 
@@ -181,8 +200,45 @@ func (r *channelTemplateRepository) UpdateTemplate(ctx context.Context, template
 	return r.dao.UpdateTemplate(ctx, r.toTemplateEntity(template))
 }
 
-func (r *channelTemplateRepository) SetTemplateActiveVersion(ctx context.Context, templateID, versionID int64) error {
-	return r.dao.SetTemplateActiveVersion(ctx, templateID, versionID)
+func (r *channelTemplateRepository) SetTemplateActiveVersion(ctx context.Context, record domain.TemplatePublishRecord) error {
+	return r.dao.SetTemplateActiveVersion(ctx, dao.ChannelTemplatePublishRecord{
+		TemplateID: record.TemplateID,
+		VersionID:  record.VersionID,
+		OperatorID: record.OperatorID,
+		Action:     record.Action.String(),
+		Locale:     record.Locale,
+	})
+}
+
+func (r *channelTemplateRepository) GetPublishRecords(ctx context.Context, templateID int64, offset, limit int) (records []domain.TemplatePublishRecord, total int64, err error) {
+	daoRecords, err := r.dao.GetPublishRecords(ctx, templateID, offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err = r.dao.TotalPublishRecords(ctx, templateID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return slice.Map(daoRecords, func(_ int, src dao.ChannelTemplatePublishRecord) domain.TemplatePublishRecord {
+		return domain.TemplatePublishRecord{
+			ID:                src.ID,
+			TemplateID:        src.TemplateID,
+			VersionID:         src.VersionID,
+			PreviousVersionID: src.PreviousVersionID,
+			OperatorID:        src.OperatorID,
+			Action:            domain.TemplatePublishAction(src.Action),
+			Locale:            src.Locale,
+			Ctime:             src.Ctime,
+		}
+	}), total, nil
+}
+
+func (r *channelTemplateRepository) ArchiveTemplate(ctx context.Context, templateID int64) error {
+	return r.dao.ArchiveTemplate(ctx, templateID)
+}
+
+func (r *channelTemplateRepository) DeleteTemplate(ctx context.Context, templateID int64) error {
+	return r.dao.DeleteTemplate(ctx, templateID)
 }
 
 // 模版版本相关方法
@@ -242,6 +298,14 @@ func (r *channelTemplateRepository) ForkTemplateVersion(ctx context.Context, ver
 	return version, nil
 }
 
+func (r *channelTemplateRepository) ArchiveTemplateVersion(ctx context.Context, versionID int64) error {
+	return r.dao.ArchiveTemplateVersion(ctx, versionID)
+}
+
+func (r *channelTemplateRepository) DeleteTemplateVersion(ctx context.Context, templateID, versionID int64) error {
+	return r.dao.DeleteTemplateVersion(ctx, templateID, versionID)
+}
+
 // 供应商相关方法
 
 func (r *channelTemplateRepository) GetProviderByNameAndChannel(ctx context.Context, templateID, versionID int64, providerName string, channel domain.Channel) ([]domain.ChannelTemplateProvider, error) {
@@ -289,6 +353,16 @@ func (r *channelTemplateRepository) GetApprovedProvidersByTemplateIDAndVersionID
 	}), nil
 }
 
+func (r *channelTemplateRepository) GetProvidersByProviderTemplateIDs(ctx context.Context, providerName string, providerTemplateIDs []string) ([]domain.ChannelTemplateProvider, error) {
+	providers, err := r.dao.GetProvidersByProviderTemplateIDs(ctx, providerName, providerTemplateIDs)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(providers, func(_ int, src dao.ChannelTemplateProvider) domain.ChannelTemplateProvider {
+		return r.toProviderDomain(src)
+	}), nil
+}
+
 func (r *channelTemplateRepository) GetProvidersByTemplateIDAndVersionID(ctx context.Context, templateID, versionID int64) ([]domain.ChannelTemplateProvider, error) {
 	providers, err := r.dao.GetProvidersByTemplateIDAndVersionID(ctx, templateID, versionID)
 	if err != nil {
@@ -348,9 +422,13 @@ func (r *channelTemplateRepository) toTemplateDomain(daoTemplate dao.ChannelTemp
 		Description:     daoTemplate.Description,
 		Channel:         domain.Channel(daoTemplate.Channel),
 		BusinessType:    domain.BusinessType(daoTemplate.BusinessType),
+		International:   daoTemplate.International,
 		ActiveVersionID: daoTemplate.ActiveVersionID,
+		ArchivedTime:    daoTemplate.ArchivedTime,
 		Ctime:           daoTemplate.Ctime,
 		Utime:           daoTemplate.Utime,
+
+		ActiveLocaleVersions: daoTemplate.ActiveLocaleVersions.Val,
 	}
 }
 
@@ -368,8 +446,14 @@ func (r *channelTemplateRepository) toVersionDomain(daoVersion dao.ChannelTempla
 		AuditStatus:              domain.AuditStatus(daoVersion.AuditStatus),
 		RejectReason:             daoVersion.RejectReason,
 		LastReviewSubmissionTime: daoVersion.LastReviewSubmissionTime,
+		ArchivedTime:             daoVersion.ArchivedTime,
 		Ctime:                    daoVersion.Ctime,
 		Utime:                    daoVersion.Utime,
+		Subject:                  daoVersion.Subject,
+		ReplyTo:                  daoVersion.ReplyTo,
+		Attachments:              daoVersion.Attachments.Val,
+		Params:                   daoVersion.Params.Val,
+		Locale:                   daoVersion.Locale,
 	}
 }
 
@@ -381,6 +465,7 @@ func (r *channelTemplateRepository) toProviderDomain(daoProvider dao.ChannelTemp
 		ProviderID:               daoProvider.ProviderID,
 		ProviderName:             daoProvider.ProviderName,
 		ProviderChannel:          domain.Channel(daoProvider.ProviderChannel),
+		Region:                   domain.TemplateRegion(daoProvider.Region),
 		RequestID:                daoProvider.RequestID,
 		ProviderTemplateID:       daoProvider.ProviderTemplateID,
 		AuditStatus:              domain.AuditStatus(daoProvider.AuditStatus),
@@ -400,6 +485,7 @@ func (r *channelTemplateRepository) toTemplateEntity(domainTemplate domain.Chann
 		Description:     domainTemplate.Description,
 		Channel:         domainTemplate.Channel.String(),
 		BusinessType:    domainTemplate.BusinessType.ToInt64(),
+		International:   domainTemplate.International,
 		ActiveVersionID: domainTemplate.ActiveVersionID,
 	}
 }
@@ -418,6 +504,17 @@ func (r *channelTemplateRepository) toVersionEntity(domainVersion domain.Channel
 		AuditStatus:              domainVersion.AuditStatus.String(),
 		RejectReason:             domainVersion.RejectReason,
 		LastReviewSubmissionTime: domainVersion.LastReviewSubmissionTime,
+		Subject:                  domainVersion.Subject,
+		ReplyTo:                  domainVersion.ReplyTo,
+		Attachments: sqlx.JsonColumn[[]domain.EmailAttachment]{
+			Val:   domainVersion.Attachments,
+			Valid: len(domainVersion.Attachments) != 0,
+		},
+		Params: sqlx.JsonColumn[[]domain.TemplateParam]{
+			Val:   domainVersion.Params,
+			Valid: len(domainVersion.Params) != 0,
+		},
+		Locale: domainVersion.Locale,
 	}
 }
 
@@ -429,6 +526,7 @@ func (r *channelTemplateRepository) toProviderEntity(domainProvider domain.Chann
 		ProviderID:               domainProvider.ProviderID,
 		ProviderName:             domainProvider.ProviderName,
 		ProviderChannel:          domainProvider.ProviderChannel.String(),
+		Region:                   domainProvider.Region.String(),
 		RequestID:                domainProvider.RequestID,
 		ProviderTemplateID:       domainProvider.ProviderTemplateID,
 		AuditStatus:              domainProvider.AuditStatus.String(),
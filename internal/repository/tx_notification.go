@@ -45,6 +45,7 @@ func (t *txNotificationRepo) Create(ctx context.Context, txn domain.TxNotificati
 func (t *txNotificationRepo) toEntity(notification domain.Notification) dao.Notification {
 	templateParams, _ := notification.MarshalTemplateParams()
 	receivers, _ := notification.MarshalReceivers()
+	fallbackReceivers, _ := notification.MarshalFallbackReceivers()
 	return dao.Notification{
 		ID:                notification.ID,
 		BizID:             notification.BizID,
@@ -54,6 +55,8 @@ func (t *txNotificationRepo) toEntity(notification domain.Notification) dao.Noti
 		TemplateID:        notification.Template.ID,
 		TemplateVersionID: notification.Template.VersionID,
 		TemplateParams:    templateParams,
+		FallbackReceivers: fallbackReceivers,
+		Locale:            notification.Locale,
 		Status:            string(notification.Status),
 		ScheduledSTime:    notification.ScheduledSTime.UnixMilli(),
 		ScheduledETime:    notification.ScheduledETime.UnixMilli(),
@@ -2,22 +2,291 @@ package audit
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
 
 	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	auditevt "gitee.com/flycash/notification-platform/internal/event/audit"
+	"gitee.com/flycash/notification-platform/internal/repository"
+	"github.com/gotomicro/ego/core/elog"
 )
 
+const (
+	defaultSLA        = 24 * time.Hour
+	defaultQueueLimit = 20
+	maxQueueLimit     = 100
+	reviewerTokenSize = 32
+)
+
+// Config 审核配置
+type Config struct {
+	// SLA 每一级的审核时限，超时后升级给下一级审核人，没有下一级时重新计时，默认24小时
+	SLA time.Duration `yaml:"sla"`
+}
+
 //go:generate mockgen -source=./audit.go -destination=./mocks/audit.mock.go -package=auditmocks -typed Service
 type Service interface {
+	// CreateAudit 创建审核记录并分配给0级中待审核数量最少的审核人，返回审核记录ID
 	CreateAudit(ctx context.Context, req domain.Audit) (int64, error)
+	GetAudit(ctx context.Context, id int64) (domain.Audit, error)
+	// ListReviewerQueue 审核人的待审核队列，按截止时间升序
+	ListReviewerQueue(ctx context.Context, reviewerID int64, offset, limit int) ([]domain.Audit, int64, error)
+	// Approve 审核通过，并将结果发送到 audit_result_events
+	Approve(ctx context.Context, auditID, reviewerID int64) error
+	// Reject 审核拒绝，必须填写原因，并将结果发送到 audit_result_events
+	Reject(ctx context.Context, auditID, reviewerID int64, reason string) error
+
+	// EscalateOverdue 把超时的审核升级给下一级审核人，返回处理的数量
+	EscalateOverdue(ctx context.Context, limit int) (int, error)
+	// PublishResults 重新发送之前发送失败的审核结果，返回处理的数量
+	PublishResults(ctx context.Context, limit int) (int, error)
+
+	// SaveReviewer ID 为0时新增审核人，否则修改
+	SaveReviewer(ctx context.Context, reviewer domain.AuditReviewer) (domain.AuditReviewer, error)
+	ListReviewers(ctx context.Context) ([]domain.AuditReviewer, error)
+	// ResetReviewerToken 为审核人生成新的访问令牌，旧令牌立即失效，令牌明文只在这里返回一次
+	ResetReviewerToken(ctx context.Context, reviewerID int64) (string, error)
+	// AuthenticateReviewer 按访问令牌识别审核人，令牌无效时返回 errs.ErrInvalidReviewerToken
+	AuthenticateReviewer(ctx context.Context, token string) (domain.AuditReviewer, error)
+}
+
+type service struct {
+	repo     repository.AuditRepository
+	producer auditevt.ResultCallbackEventProducer
+	sla      time.Duration
+	logger   *elog.Component
+}
+
+func NewService(repo repository.AuditRepository, producer auditevt.ResultCallbackEventProducer, cfg Config) Service {
+	if cfg.SLA <= 0 {
+		cfg.SLA = defaultSLA
+	}
+	return &service{
+		repo:     repo,
+		producer: producer,
+		sla:      cfg.SLA,
+		logger:   elog.DefaultLogger.With(elog.FieldComponent("audit")),
+	}
+}
+
+func (s *service) CreateAudit(ctx context.Context, req domain.Audit) (int64, error) {
+	if req.ResourceID <= 0 {
+		return 0, fmt.Errorf("%w: 资源ID", errs.ErrInvalidParameter)
+	}
+	if !req.ResourceType.IsTemplate() {
+		return 0, fmt.Errorf("%w: 资源类型 %s", errs.ErrInvalidParameter, req.ResourceType)
+	}
+
+	reviewer, err := s.repo.FindLeastLoadedReviewer(ctx, 0)
+	if err != nil {
+		return 0, err
+	}
+	if reviewer.ID == 0 {
+		// 先创建，超时后由升级任务重新分配
+		s.logger.Warn("没有可分配的审核人", elog.Int64("ResourceID", req.ResourceID))
+	}
+
+	audit, err := s.repo.Create(ctx, domain.Audit{
+		ResourceID:   req.ResourceID,
+		ResourceType: req.ResourceType,
+		Content:      req.Content,
+		ReviewerID:   reviewer.ID,
+		Status:       domain.AuditStatusInReview,
+		Deadline:     time.Now().Add(s.sla).Unix(),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return audit.ID, nil
+}
+
+func (s *service) GetAudit(ctx context.Context, id int64) (domain.Audit, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *service) ListReviewerQueue(ctx context.Context, reviewerID int64, offset, limit int) ([]domain.Audit, int64, error) {
+	if reviewerID <= 0 {
+		return nil, 0, fmt.Errorf("%w: 审核人ID", errs.ErrInvalidParameter)
+	}
+	if offset < 0 {
+		return nil, 0, fmt.Errorf("%w: offset", errs.ErrInvalidParameter)
+	}
+	if limit <= 0 {
+		limit = defaultQueueLimit
+	}
+	limit = min(limit, maxQueueLimit)
+	return s.repo.ListByReviewer(ctx, reviewerID, domain.AuditStatusInReview, offset, limit)
+}
+
+func (s *service) Approve(ctx context.Context, auditID, reviewerID int64) error {
+	return s.decide(ctx, auditID, reviewerID, domain.AuditStatusApproved, "")
 }
 
-type service struct{}
+func (s *service) Reject(ctx context.Context, auditID, reviewerID int64, reason string) error {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return fmt.Errorf("%w: 拒绝原因不能为空", errs.ErrInvalidParameter)
+	}
+	return s.decide(ctx, auditID, reviewerID, domain.AuditStatusRejected, reason)
+}
+
+func (s *service) decide(ctx context.Context, auditID, reviewerID int64, status domain.AuditStatus, reason string) error {
+	if auditID <= 0 {
+		return fmt.Errorf("%w: 审核记录ID", errs.ErrInvalidParameter)
+	}
+	if reviewerID <= 0 {
+		return fmt.Errorf("%w: 审核人ID", errs.ErrInvalidParameter)
+	}
+
+	audit, err := s.repo.GetByID(ctx, auditID)
+	if err != nil {
+		return err
+	}
+	if audit.IsDecided() {
+		return fmt.Errorf("%w: 审核已结束", errs.ErrInvalidOperation)
+	}
+	if audit.ReviewerID != reviewerID {
+		return fmt.Errorf("%w: 审核已分配给其他审核人", errs.ErrInvalidOperation)
+	}
+
+	audit.Status = status
+	audit.RejectReason = reason
+	audit.AuditTime = time.Now().Unix()
+	if err = s.repo.Decide(ctx, audit); err != nil {
+		return err
+	}
+
+	// 审核结果已经保存，发送失败时由 PublishResults 重试
+	if err = s.publish(ctx, audit); err != nil {
+		s.logger.Warn("发送审核结果失败，稍后重试",
+			elog.Int64("AuditID", audit.ID),
+			elog.FieldErr(err))
+	}
+	return nil
+}
+
+func (s *service) publish(ctx context.Context, audit domain.Audit) error {
+	err := s.producer.Produce(ctx, auditevt.CallbackResultEvent{
+		ResourceID:   audit.ResourceID,
+		ResourceType: audit.ResourceType,
+		AuditID:      audit.ID,
+		AuditorID:    audit.ReviewerID,
+		AuditTime:    audit.AuditTime,
+		AuditStatus:  audit.Status.String(),
+		RejectReason: audit.RejectReason,
+	})
+	if err != nil {
+		return err
+	}
+	return s.repo.MarkPublished(ctx, audit.ID)
+}
+
+func (s *service) EscalateOverdue(ctx context.Context, limit int) (int, error) {
+	now := time.Now()
+	audits, err := s.repo.FindOverdue(ctx, now.Unix(), limit)
+	if err != nil {
+		return 0, err
+	}
+	for i := range audits {
+		if err1 := s.escalate(ctx, audits[i], now); err1 != nil {
+			s.logger.Warn("升级超时审核失败",
+				elog.Int64("AuditID", audits[i].ID),
+				elog.FieldErr(err1))
+		}
+	}
+	return len(audits), nil
+}
+
+// escalate 已分配的审核升级到下一级，没有分配审核人的审核在当前级别重新分配
+// 找不到审核人时保持不变并重新计时，避免每一轮都重复处理
+func (s *service) escalate(ctx context.Context, audit domain.Audit, now time.Time) error {
+	from := audit.ReviewerID
+	level := audit.Level
+	if from != 0 {
+		level++
+	}
+	reviewer, err := s.repo.FindLeastLoadedReviewer(ctx, level)
+	if err != nil {
+		return err
+	}
+	if reviewer.ID != 0 {
+		audit.ReviewerID = reviewer.ID
+		audit.Level = level
+	} else {
+		s.logger.Warn("审核超时，但是没有可升级的审核人",
+			elog.Int64("AuditID", audit.ID),
+			elog.Int("Level", level))
+	}
+	audit.Deadline = now.Add(s.sla).Unix()
+	_, err = s.repo.Reassign(ctx, audit, from)
+	return err
+}
+
+func (s *service) PublishResults(ctx context.Context, limit int) (int, error) {
+	audits, err := s.repo.FindUnpublished(ctx, limit)
+	if err != nil {
+		return 0, err
+	}
+	for i := range audits {
+		if err1 := s.publish(ctx, audits[i]); err1 != nil {
+			// 保持顺序，后面的等下一轮
+			return i, err1
+		}
+	}
+	return len(audits), nil
+}
+
+func (s *service) SaveReviewer(ctx context.Context, reviewer domain.AuditReviewer) (domain.AuditReviewer, error) {
+	reviewer.Name = strings.TrimSpace(reviewer.Name)
+	if reviewer.Name == "" {
+		return domain.AuditReviewer{}, fmt.Errorf("%w: 审核人名称", errs.ErrInvalidParameter)
+	}
+	if reviewer.Level < 0 {
+		return domain.AuditReviewer{}, fmt.Errorf("%w: 审核级别不能小于0", errs.ErrInvalidParameter)
+	}
+	return s.repo.SaveReviewer(ctx, reviewer)
+}
+
+func (s *service) ListReviewers(ctx context.Context) ([]domain.AuditReviewer, error) {
+	return s.repo.ListReviewers(ctx)
+}
+
+func (s *service) ResetReviewerToken(ctx context.Context, reviewerID int64) (string, error) {
+	if reviewerID <= 0 {
+		return "", fmt.Errorf("%w: 审核人ID", errs.ErrInvalidParameter)
+	}
+	b := make([]byte, reviewerTokenSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(b)
+	if err := s.repo.UpdateReviewerTokenHash(ctx, reviewerID, hashReviewerToken(token)); err != nil {
+		return "", err
+	}
+	return token, nil
+}
 
-func NewService() Service {
-	return &service{}
+func (s *service) AuthenticateReviewer(ctx context.Context, token string) (domain.AuditReviewer, error) {
+	if token == "" {
+		return domain.AuditReviewer{}, errs.ErrInvalidReviewerToken
+	}
+	reviewer, err := s.repo.FindReviewerByTokenHash(ctx, hashReviewerToken(token))
+	if err != nil {
+		return domain.AuditReviewer{}, err
+	}
+	if reviewer.ID == 0 {
+		return domain.AuditReviewer{}, errs.ErrInvalidReviewerToken
+	}
+	return reviewer, nil
 }
 
-func (s *service) CreateAudit(_ context.Context, _ domain.Audit) (int64, error) {
-	// TODO implement me
-	panic("implement me")
+// hashReviewerToken 只保存令牌的 SHA-256，数据库泄露时无法直接使用
+func hashReviewerToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
 }
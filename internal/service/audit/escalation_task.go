@@ -0,0 +1,51 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/pkg/loopjob"
+	"github.com/gotomicro/ego/core/elog"
+	"github.com/meoying/dlock-go"
+)
+
+// EscalationTask 升级超时的审核，并重新发送发送失败的审核结果
+type EscalationTask struct {
+	dclient   dlock.Client
+	svc       Service
+	batchSize int
+	logger    *elog.Component
+}
+
+func NewEscalationTask(dclient dlock.Client, svc Service) *EscalationTask {
+	const batchSize = 50
+	return &EscalationTask{
+		dclient:   dclient,
+		svc:       svc,
+		batchSize: batchSize,
+		logger:    elog.DefaultLogger.With(elog.FieldComponent("audit")),
+	}
+}
+
+func (t *EscalationTask) Start(ctx context.Context) {
+	const key = "notification_audit_escalation"
+	lj := loopjob.NewInfiniteLoop(t.dclient, t.Handle, key)
+	lj.Run(ctx)
+}
+
+func (t *EscalationTask) Handle(ctx context.Context) error {
+	const defaultSleepTime = time.Second * 10
+	escalated, err := t.svc.EscalateOverdue(ctx, t.batchSize)
+	if err != nil {
+		return err
+	}
+	published, err := t.svc.PublishResults(ctx, t.batchSize)
+	if err != nil {
+		t.logger.Warn("重新发送审核结果失败", elog.FieldErr(err))
+	}
+	// 说明需要处理的不多，可以休息一下
+	if escalated < t.batchSize && published < t.batchSize {
+		time.Sleep(defaultSleepTime)
+	}
+	return nil
+}
@@ -41,6 +41,83 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// Approve mocks base method.
+func (m *MockService) Approve(ctx context.Context, auditID, reviewerID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approve", ctx, auditID, reviewerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Approve indicates an expected call of Approve.
+func (mr *MockServiceMockRecorder) Approve(ctx, auditID, reviewerID any) *MockServiceApproveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approve", reflect.TypeOf((*MockService)(nil).Approve), ctx, auditID, reviewerID)
+	return &MockServiceApproveCall{Call: call}
+}
+
+// MockServiceApproveCall wrap *gomock.Call
+type MockServiceApproveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceApproveCall) Return(arg0 error) *MockServiceApproveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceApproveCall) Do(f func(context.Context, int64, int64) error) *MockServiceApproveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceApproveCall) DoAndReturn(f func(context.Context, int64, int64) error) *MockServiceApproveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// AuthenticateReviewer mocks base method.
+func (m *MockService) AuthenticateReviewer(ctx context.Context, token string) (domain.AuditReviewer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticateReviewer", ctx, token)
+	ret0, _ := ret[0].(domain.AuditReviewer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthenticateReviewer indicates an expected call of AuthenticateReviewer.
+func (mr *MockServiceMockRecorder) AuthenticateReviewer(ctx, token any) *MockServiceAuthenticateReviewerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateReviewer", reflect.TypeOf((*MockService)(nil).AuthenticateReviewer), ctx, token)
+	return &MockServiceAuthenticateReviewerCall{Call: call}
+}
+
+// MockServiceAuthenticateReviewerCall wrap *gomock.Call
+type MockServiceAuthenticateReviewerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceAuthenticateReviewerCall) Return(arg0 domain.AuditReviewer, arg1 error) *MockServiceAuthenticateReviewerCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceAuthenticateReviewerCall) Do(f func(context.Context, string) (domain.AuditReviewer, error)) *MockServiceAuthenticateReviewerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceAuthenticateReviewerCall) DoAndReturn(f func(context.Context, string) (domain.AuditReviewer, error)) *MockServiceAuthenticateReviewerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateAudit mocks base method.
 func (m *MockService) CreateAudit(ctx context.Context, req domain.Audit) (int64, error) {
 	m.ctrl.T.Helper()
@@ -79,3 +156,315 @@ func (c *MockServiceCreateAuditCall) DoAndReturn(f func(context.Context, domain.
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// EscalateOverdue mocks base method.
+func (m *MockService) EscalateOverdue(ctx context.Context, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EscalateOverdue", ctx, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EscalateOverdue indicates an expected call of EscalateOverdue.
+func (mr *MockServiceMockRecorder) EscalateOverdue(ctx, limit any) *MockServiceEscalateOverdueCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EscalateOverdue", reflect.TypeOf((*MockService)(nil).EscalateOverdue), ctx, limit)
+	return &MockServiceEscalateOverdueCall{Call: call}
+}
+
+// MockServiceEscalateOverdueCall wrap *gomock.Call
+type MockServiceEscalateOverdueCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceEscalateOverdueCall) Return(arg0 int, arg1 error) *MockServiceEscalateOverdueCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceEscalateOverdueCall) Do(f func(context.Context, int) (int, error)) *MockServiceEscalateOverdueCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceEscalateOverdueCall) DoAndReturn(f func(context.Context, int) (int, error)) *MockServiceEscalateOverdueCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetAudit mocks base method.
+func (m *MockService) GetAudit(ctx context.Context, id int64) (domain.Audit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAudit", ctx, id)
+	ret0, _ := ret[0].(domain.Audit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAudit indicates an expected call of GetAudit.
+func (mr *MockServiceMockRecorder) GetAudit(ctx, id any) *MockServiceGetAuditCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAudit", reflect.TypeOf((*MockService)(nil).GetAudit), ctx, id)
+	return &MockServiceGetAuditCall{Call: call}
+}
+
+// MockServiceGetAuditCall wrap *gomock.Call
+type MockServiceGetAuditCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceGetAuditCall) Return(arg0 domain.Audit, arg1 error) *MockServiceGetAuditCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceGetAuditCall) Do(f func(context.Context, int64) (domain.Audit, error)) *MockServiceGetAuditCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceGetAuditCall) DoAndReturn(f func(context.Context, int64) (domain.Audit, error)) *MockServiceGetAuditCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListReviewerQueue mocks base method.
+func (m *MockService) ListReviewerQueue(ctx context.Context, reviewerID int64, offset, limit int) ([]domain.Audit, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReviewerQueue", ctx, reviewerID, offset, limit)
+	ret0, _ := ret[0].([]domain.Audit)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListReviewerQueue indicates an expected call of ListReviewerQueue.
+func (mr *MockServiceMockRecorder) ListReviewerQueue(ctx, reviewerID, offset, limit any) *MockServiceListReviewerQueueCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReviewerQueue", reflect.TypeOf((*MockService)(nil).ListReviewerQueue), ctx, reviewerID, offset, limit)
+	return &MockServiceListReviewerQueueCall{Call: call}
+}
+
+// MockServiceListReviewerQueueCall wrap *gomock.Call
+type MockServiceListReviewerQueueCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceListReviewerQueueCall) Return(arg0 []domain.Audit, arg1 int64, arg2 error) *MockServiceListReviewerQueueCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceListReviewerQueueCall) Do(f func(context.Context, int64, int, int) ([]domain.Audit, int64, error)) *MockServiceListReviewerQueueCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceListReviewerQueueCall) DoAndReturn(f func(context.Context, int64, int, int) ([]domain.Audit, int64, error)) *MockServiceListReviewerQueueCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListReviewers mocks base method.
+func (m *MockService) ListReviewers(ctx context.Context) ([]domain.AuditReviewer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReviewers", ctx)
+	ret0, _ := ret[0].([]domain.AuditReviewer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReviewers indicates an expected call of ListReviewers.
+func (mr *MockServiceMockRecorder) ListReviewers(ctx any) *MockServiceListReviewersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReviewers", reflect.TypeOf((*MockService)(nil).ListReviewers), ctx)
+	return &MockServiceListReviewersCall{Call: call}
+}
+
+// MockServiceListReviewersCall wrap *gomock.Call
+type MockServiceListReviewersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceListReviewersCall) Return(arg0 []domain.AuditReviewer, arg1 error) *MockServiceListReviewersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceListReviewersCall) Do(f func(context.Context) ([]domain.AuditReviewer, error)) *MockServiceListReviewersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceListReviewersCall) DoAndReturn(f func(context.Context) ([]domain.AuditReviewer, error)) *MockServiceListReviewersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// PublishResults mocks base method.
+func (m *MockService) PublishResults(ctx context.Context, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishResults", ctx, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishResults indicates an expected call of PublishResults.
+func (mr *MockServiceMockRecorder) PublishResults(ctx, limit any) *MockServicePublishResultsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishResults", reflect.TypeOf((*MockService)(nil).PublishResults), ctx, limit)
+	return &MockServicePublishResultsCall{Call: call}
+}
+
+// MockServicePublishResultsCall wrap *gomock.Call
+type MockServicePublishResultsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServicePublishResultsCall) Return(arg0 int, arg1 error) *MockServicePublishResultsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServicePublishResultsCall) Do(f func(context.Context, int) (int, error)) *MockServicePublishResultsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServicePublishResultsCall) DoAndReturn(f func(context.Context, int) (int, error)) *MockServicePublishResultsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Reject mocks base method.
+func (m *MockService) Reject(ctx context.Context, auditID, reviewerID int64, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reject", ctx, auditID, reviewerID, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reject indicates an expected call of Reject.
+func (mr *MockServiceMockRecorder) Reject(ctx, auditID, reviewerID, reason any) *MockServiceRejectCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reject", reflect.TypeOf((*MockService)(nil).Reject), ctx, auditID, reviewerID, reason)
+	return &MockServiceRejectCall{Call: call}
+}
+
+// MockServiceRejectCall wrap *gomock.Call
+type MockServiceRejectCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceRejectCall) Return(arg0 error) *MockServiceRejectCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceRejectCall) Do(f func(context.Context, int64, int64, string) error) *MockServiceRejectCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceRejectCall) DoAndReturn(f func(context.Context, int64, int64, string) error) *MockServiceRejectCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ResetReviewerToken mocks base method.
+func (m *MockService) ResetReviewerToken(ctx context.Context, reviewerID int64) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetReviewerToken", ctx, reviewerID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetReviewerToken indicates an expected call of ResetReviewerToken.
+func (mr *MockServiceMockRecorder) ResetReviewerToken(ctx, reviewerID any) *MockServiceResetReviewerTokenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetReviewerToken", reflect.TypeOf((*MockService)(nil).ResetReviewerToken), ctx, reviewerID)
+	return &MockServiceResetReviewerTokenCall{Call: call}
+}
+
+// MockServiceResetReviewerTokenCall wrap *gomock.Call
+type MockServiceResetReviewerTokenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceResetReviewerTokenCall) Return(arg0 string, arg1 error) *MockServiceResetReviewerTokenCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceResetReviewerTokenCall) Do(f func(context.Context, int64) (string, error)) *MockServiceResetReviewerTokenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceResetReviewerTokenCall) DoAndReturn(f func(context.Context, int64) (string, error)) *MockServiceResetReviewerTokenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveReviewer mocks base method.
+func (m *MockService) SaveReviewer(ctx context.Context, reviewer domain.AuditReviewer) (domain.AuditReviewer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveReviewer", ctx, reviewer)
+	ret0, _ := ret[0].(domain.AuditReviewer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveReviewer indicates an expected call of SaveReviewer.
+func (mr *MockServiceMockRecorder) SaveReviewer(ctx, reviewer any) *MockServiceSaveReviewerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveReviewer", reflect.TypeOf((*MockService)(nil).SaveReviewer), ctx, reviewer)
+	return &MockServiceSaveReviewerCall{Call: call}
+}
+
+// MockServiceSaveReviewerCall wrap *gomock.Call
+type MockServiceSaveReviewerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceSaveReviewerCall) Return(arg0 domain.AuditReviewer, arg1 error) *MockServiceSaveReviewerCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceSaveReviewerCall) Do(f func(context.Context, domain.AuditReviewer) (domain.AuditReviewer, error)) *MockServiceSaveReviewerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceSaveReviewerCall) DoAndReturn(f func(context.Context, domain.AuditReviewer) (domain.AuditReviewer, error)) *MockServiceSaveReviewerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -16,9 +16,16 @@ type Channel interface {
 	Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error)
 }
 
+// Plugins 运行时加载的渠道插件
+type Plugins interface {
+	// Get 获取渠道当前生效的插件，没有加载插件时返回 false
+	Get(channel domain.Channel) (Channel, bool)
+}
+
 // Dispatcher 渠道分发器，对外伪装成Channel，作为统一入口
 type Dispatcher struct {
 	channels map[domain.Channel]Channel
+	plugins  Plugins
 }
 
 // NewDispatcher 创建渠道分发器
@@ -28,7 +35,20 @@ func NewDispatcher(channels map[domain.Channel]Channel) *Dispatcher {
 	}
 }
 
+// NewDispatcherWithPlugins 创建支持渠道插件的分发器，渠道加载了插件时优先使用插件，卸载插件后恢复使用内置实现
+func NewDispatcherWithPlugins(channels map[domain.Channel]Channel, plugins Plugins) *Dispatcher {
+	return &Dispatcher{
+		channels: channels,
+		plugins:  plugins,
+	}
+}
+
 func (d *Dispatcher) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
+	if d.plugins != nil {
+		if plugin, ok := d.plugins.Get(notification.Channel); ok {
+			return plugin.Send(ctx, notification)
+		}
+	}
 	channel, ok := d.channels[notification.Channel]
 	if !ok {
 		return domain.SendResponse{}, fmt.Errorf("%w: %s", errs.ErrNoAvailableChannel, notification.Channel)
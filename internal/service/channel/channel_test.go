@@ -204,3 +204,39 @@ func (s *ChannelTestSuite) TestDispatcherSend() {
 		})
 	}
 }
+
+// mapPlugins 固定的渠道插件
+type mapPlugins map[domain.Channel]Channel
+
+func (p mapPlugins) Get(channel domain.Channel) (Channel, bool) {
+	c, ok := p[channel]
+	return c, ok
+}
+
+func (s *ChannelTestSuite) TestDispatcherWithPlugins() {
+	t := s.T()
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	builtinSMS := channelmocks.NewMockChannel(ctrl)
+	builtinEmail := channelmocks.NewMockChannel(ctrl)
+	pluginSMS := channelmocks.NewMockChannel(ctrl)
+	// 加载了插件的渠道使用插件，其他渠道使用内置实现
+	pluginSMS.EXPECT().Send(gomock.Any(), gomock.Any()).Return(domain.SendResponse{NotificationID: 1}, nil)
+	builtinEmail.EXPECT().Send(gomock.Any(), gomock.Any()).Return(domain.SendResponse{NotificationID: 2}, nil)
+
+	dispatcher := NewDispatcherWithPlugins(map[domain.Channel]Channel{
+		domain.ChannelSMS:   builtinSMS,
+		domain.ChannelEmail: builtinEmail,
+	}, mapPlugins{domain.ChannelSMS: pluginSMS})
+
+	resp, err := dispatcher.Send(t.Context(), domain.Notification{ID: 1, Channel: domain.ChannelSMS})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), resp.NotificationID)
+
+	resp, err = dispatcher.Send(t.Context(), domain.Notification{ID: 2, Channel: domain.ChannelEmail})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), resp.NotificationID)
+}
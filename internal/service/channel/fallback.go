@@ -0,0 +1,96 @@
+package channel
+
+import (
+	"context"
+	"fmt"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	configsvc "gitee.com/flycash/notification-platform/internal/service/config"
+	templatesvc "gitee.com/flycash/notification-platform/internal/service/template/manage"
+	"github.com/gotomicro/ego/core/elog"
+)
+
+// fallbackChannel 渠道降级装饰器
+// 请求的渠道被禁用或者所有供应商都发送失败时，按业务方渠道配置的优先级依次尝试其他启用的渠道，
+// 降级渠道使用同一模板族中对应渠道的模板重新渲染
+type fallbackChannel struct {
+	channel     Channel
+	configSvc   configsvc.BusinessConfigService
+	templateSvc templatesvc.ChannelTemplateService
+	logger      *elog.Component
+}
+
+// NewFallbackChannel 创建支持渠道降级的渠道
+func NewFallbackChannel(
+	channel Channel,
+	configSvc configsvc.BusinessConfigService,
+	templateSvc templatesvc.ChannelTemplateService,
+) Channel {
+	return &fallbackChannel{
+		channel:     channel,
+		configSvc:   configSvc,
+		templateSvc: templateSvc,
+		logger:      elog.DefaultLogger.With(elog.FieldComponent("channel.fallback")),
+	}
+}
+
+func (f *fallbackChannel) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
+	cfg, err := f.configSvc.GetByID(ctx, notification.BizID)
+	if err != nil || cfg.ChannelConfig == nil {
+		// 没有渠道配置，不降级
+		return f.send(ctx, notification)
+	}
+
+	var lastErr error
+	if cfg.ChannelConfig.IsEnabled(notification.Channel) {
+		resp, err1 := f.send(ctx, notification)
+		if err1 == nil {
+			return resp, nil
+		}
+		lastErr = err1
+	} else {
+		lastErr = fmt.Errorf("%w: %s", errs.ErrChannelDisabled, notification.Channel)
+	}
+
+	for _, ch := range cfg.ChannelConfig.FallbackChannels(notification.Channel) {
+		receivers := notification.FallbackReceivers[ch]
+		if len(receivers) == 0 {
+			continue
+		}
+		tmpl, err1 := f.templateSvc.GetFamilyTemplate(ctx, notification.Template.ID, ch)
+		if err1 != nil {
+			f.logger.Warn("获取降级渠道模板失败",
+				elog.Any("NotificationID", notification.ID),
+				elog.String("Channel", ch.String()),
+				elog.FieldErr(err1))
+			continue
+		}
+		n := notification
+		n.Channel = ch
+		n.Receivers = receivers
+		n.Template.ID = tmpl.ID
+		n.Template.VersionID = tmpl.ActiveVersionIDFor(n.Locale)
+		resp, err1 := f.send(ctx, n)
+		if err1 != nil {
+			lastErr = err1
+			continue
+		}
+		f.logger.Info("渠道降级发送成功",
+			elog.Any("NotificationID", notification.ID),
+			elog.String("From", notification.Channel.String()),
+			elog.String("To", ch.String()),
+			elog.FieldErr(lastErr))
+		return resp, nil
+	}
+	return domain.SendResponse{}, lastErr
+}
+
+func (f *fallbackChannel) send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
+	resp, err := f.channel.Send(ctx, notification)
+	if err != nil {
+		return domain.SendResponse{}, err
+	}
+	resp.DeliveredChannel = notification.Channel
+	return resp, nil
+}
@@ -0,0 +1,146 @@
+//go:build unit
+
+package channel
+
+import (
+	"errors"
+	"testing"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	channelmocks "gitee.com/flycash/notification-platform/internal/service/channel/mocks"
+	configmocks "gitee.com/flycash/notification-platform/internal/service/config/mocks"
+	templatemocks "gitee.com/flycash/notification-platform/internal/service/template/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFallbackChannel_Send(t *testing.T) {
+	t.Parallel()
+	sendErr := errors.New("mock error")
+	channelConfig := &domain.ChannelConfig{
+		Channels: []domain.ChannelItem{
+			{Channel: "IN_APP", Priority: 3, Enabled: true},
+			{Channel: "EMAIL", Priority: 2, Enabled: true},
+			{Channel: "SMS", Priority: 1, Enabled: true},
+		},
+	}
+	notification := domain.Notification{
+		ID:        1,
+		BizID:     2,
+		Channel:   domain.ChannelSMS,
+		Receivers: []string{"13800138000"},
+		Template:  domain.Template{ID: 10, VersionID: 11, Params: map[string]string{"code": "123456"}},
+		FallbackReceivers: map[domain.Channel][]string{
+			domain.ChannelEmail: {"test@example.com"},
+			domain.ChannelInApp: {"user-1"},
+		},
+	}
+	emailNotification := notification
+	emailNotification.Channel = domain.ChannelEmail
+	emailNotification.Receivers = []string{"test@example.com"}
+	emailNotification.Template = domain.Template{ID: 20, VersionID: 21, Params: map[string]string{"code": "123456"}}
+
+	tests := []struct {
+		name        string
+		config      domain.BusinessConfig
+		configErr   error
+		setupMocks  func(ch *channelmocks.MockChannel, templateSvc *templatemocks.MockChannelTemplateService)
+		wantChannel domain.Channel
+		wantErr     error
+	}{
+		{
+			name:      "没有渠道配置时不降级",
+			configErr: errs.ErrConfigNotFound,
+			setupMocks: func(ch *channelmocks.MockChannel, _ *templatemocks.MockChannelTemplateService) {
+				ch.EXPECT().Send(gomock.Any(), notification).Return(domain.SendResponse{}, sendErr)
+			},
+			wantErr: sendErr,
+		},
+		{
+			name:   "请求的渠道发送成功",
+			config: domain.BusinessConfig{ChannelConfig: channelConfig},
+			setupMocks: func(ch *channelmocks.MockChannel, _ *templatemocks.MockChannelTemplateService) {
+				ch.EXPECT().Send(gomock.Any(), notification).
+					Return(domain.SendResponse{NotificationID: 1, Status: domain.SendStatusSucceeded}, nil)
+			},
+			wantChannel: domain.ChannelSMS,
+		},
+		{
+			name:   "请求的渠道失败后按优先级降级",
+			config: domain.BusinessConfig{ChannelConfig: channelConfig},
+			setupMocks: func(ch *channelmocks.MockChannel, templateSvc *templatemocks.MockChannelTemplateService) {
+				gomock.InOrder(
+					ch.EXPECT().Send(gomock.Any(), notification).Return(domain.SendResponse{}, sendErr),
+					templateSvc.EXPECT().GetFamilyTemplate(gomock.Any(), int64(10), domain.ChannelEmail).
+						Return(domain.ChannelTemplate{ID: 20, ActiveVersionID: 21}, nil),
+					ch.EXPECT().Send(gomock.Any(), emailNotification).
+						Return(domain.SendResponse{NotificationID: 1, Status: domain.SendStatusSucceeded}, nil),
+				)
+			},
+			wantChannel: domain.ChannelEmail,
+		},
+		{
+			name: "请求的渠道被禁用时直接降级",
+			config: domain.BusinessConfig{ChannelConfig: &domain.ChannelConfig{
+				Channels: []domain.ChannelItem{
+					{Channel: "SMS", Priority: 1, Enabled: false},
+					{Channel: "EMAIL", Priority: 2, Enabled: true},
+				},
+			}},
+			setupMocks: func(ch *channelmocks.MockChannel, templateSvc *templatemocks.MockChannelTemplateService) {
+				templateSvc.EXPECT().GetFamilyTemplate(gomock.Any(), int64(10), domain.ChannelEmail).
+					Return(domain.ChannelTemplate{ID: 20, ActiveVersionID: 21}, nil)
+				ch.EXPECT().Send(gomock.Any(), emailNotification).
+					Return(domain.SendResponse{NotificationID: 1, Status: domain.SendStatusSucceeded}, nil)
+			},
+			wantChannel: domain.ChannelEmail,
+		},
+		{
+			name:   "没有模板的渠道跳过，所有渠道失败时返回最后的错误",
+			config: domain.BusinessConfig{ChannelConfig: channelConfig},
+			setupMocks: func(ch *channelmocks.MockChannel, templateSvc *templatemocks.MockChannelTemplateService) {
+				ch.EXPECT().Send(gomock.Any(), notification).Return(domain.SendResponse{}, sendErr)
+				templateSvc.EXPECT().GetFamilyTemplate(gomock.Any(), int64(10), domain.ChannelEmail).
+					Return(domain.ChannelTemplate{}, errs.ErrTemplateNotFound)
+				templateSvc.EXPECT().GetFamilyTemplate(gomock.Any(), int64(10), domain.ChannelInApp).
+					Return(domain.ChannelTemplate{ID: 30, ActiveVersionID: 31}, nil)
+				ch.EXPECT().Send(gomock.Any(), gomock.Any()).Return(domain.SendResponse{}, errs.ErrNoAvailableProvider)
+			},
+			wantErr: errs.ErrNoAvailableProvider,
+		},
+		{
+			name: "没有降级接收者的渠道不参与降级",
+			config: domain.BusinessConfig{ChannelConfig: &domain.ChannelConfig{
+				Channels: []domain.ChannelItem{
+					{Channel: "SMS", Priority: 2, Enabled: false},
+					{Channel: "PUSH", Priority: 1, Enabled: true},
+				},
+			}},
+			setupMocks: func(_ *channelmocks.MockChannel, _ *templatemocks.MockChannelTemplateService) {},
+			wantErr:    errs.ErrChannelDisabled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ch := channelmocks.NewMockChannel(ctrl)
+			configSvc := configmocks.NewMockBusinessConfigService(ctrl)
+			configSvc.EXPECT().GetByID(gomock.Any(), notification.BizID).Return(tt.config, tt.configErr)
+			templateSvc := templatemocks.NewMockChannelTemplateService(ctrl)
+			tt.setupMocks(ch, templateSvc)
+
+			resp, err := NewFallbackChannel(ch, configSvc, templateSvc).Send(t.Context(), notification)
+			assert.ErrorIs(t, err, tt.wantErr)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tt.wantChannel, resp.DeliveredChannel)
+			assert.Equal(t, domain.SendStatusSucceeded, resp.Status)
+		})
+	}
+}
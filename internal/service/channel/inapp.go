@@ -0,0 +1,17 @@
+package channel
+
+import (
+	"gitee.com/flycash/notification-platform/internal/service/provider"
+)
+
+type inAppChannel struct {
+	baseChannel
+}
+
+func NewInAppChannel(builder provider.SelectorBuilder) Channel {
+	return &inAppChannel{
+		baseChannel{
+			builder: builder,
+		},
+	}
+}
@@ -0,0 +1,141 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/service/channel"
+	"github.com/gotomicro/ego/core/elog"
+)
+
+const defaultCheckTimeout = 5 * time.Second
+
+var (
+	_ channel.Plugins = (*Manager)(nil)
+
+	// versionPattern 版本号只能包含字母、数字、点、下划线和中划线，避免拼接路径时越过插件目录
+	versionPattern = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+)
+
+// Manager 管理当前实例加载的渠道插件
+// 插件文件按版本存放在 <dir>/<渠道>/<版本>.so，每个渠道同一时间只有一个版本生效，
+// 新版本自检通过后才会原子替换旧版本，自检失败时继续使用旧版本
+type Manager struct {
+	dir          string
+	loader       Loader
+	checkTimeout time.Duration
+
+	mu     sync.RWMutex
+	active map[domain.Channel]Plugin
+
+	logger *elog.Component
+}
+
+// NewManager 创建插件管理器，checkTimeout 为插件自检的超时时间
+func NewManager(dir string, loader Loader, checkTimeout time.Duration) *Manager {
+	if checkTimeout <= 0 {
+		checkTimeout = defaultCheckTimeout
+	}
+	return &Manager{
+		dir:          dir,
+		loader:       loader,
+		checkTimeout: checkTimeout,
+		active:       make(map[domain.Channel]Plugin),
+		logger:       elog.DefaultLogger.With(elog.FieldComponent("channel.plugin")),
+	}
+}
+
+// Get 获取渠道当前生效的插件
+func (m *Manager) Get(ch domain.Channel) (channel.Channel, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	p, ok := m.active[ch]
+	return p, ok
+}
+
+// Versions 返回各渠道当前生效的插件版本
+func (m *Manager) Versions() map[domain.Channel]string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	res := make(map[domain.Channel]string, len(m.active))
+	for ch, p := range m.active {
+		res[ch] = p.Version()
+	}
+	return res
+}
+
+// Load 加载渠道插件的指定版本，自检通过后替换当前版本，正在进行的发送继续使用旧版本
+func (m *Manager) Load(ctx context.Context, ch domain.Channel, version string) error {
+	if !ch.IsValid() {
+		return fmt.Errorf("%w: %s", errs.ErrUnknownChannel, ch)
+	}
+	if !versionPattern.MatchString(version) {
+		return fmt.Errorf("%w: 版本号 %q 不合法", errs.ErrInvalidParameter, version)
+	}
+	m.mu.RLock()
+	current, ok := m.active[ch]
+	m.mu.RUnlock()
+	if ok && current.Version() == version {
+		return nil
+	}
+
+	path := filepath.Join(m.dir, ch.String(), version+".so")
+	p, err := m.loader.Load(path)
+	if err != nil {
+		return err
+	}
+	if p.Channel() != ch || p.Version() != version {
+		return fmt.Errorf("%w: 插件 %s 声明的渠道 %s 和版本 %s 与文件不一致",
+			ErrInvalidPlugin, path, p.Channel(), p.Version())
+	}
+	if err = m.selfCheck(ctx, p); err != nil {
+		m.logger.Error("渠道插件自检失败，继续使用当前版本",
+			elog.String("Channel", ch.String()),
+			elog.String("Version", version),
+			elog.FieldErr(err))
+		return err
+	}
+
+	m.mu.Lock()
+	previous, ok := m.active[ch]
+	m.active[ch] = p
+	m.mu.Unlock()
+	fields := []elog.Field{elog.String("Channel", ch.String()), elog.String("Version", version)}
+	if ok {
+		fields = append(fields, elog.String("Previous", previous.Version()))
+	}
+	m.logger.Info("渠道插件已生效", fields...)
+	return nil
+}
+
+// selfCheck 插件自检，插件 panic 也视为自检失败
+func (m *Manager) selfCheck(ctx context.Context, p Plugin) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, m.checkTimeout)
+	defer cancel()
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: %v", ErrSelfCheckFailed, r)
+		}
+	}()
+	if err = p.SelfCheck(ctx); err != nil {
+		return fmt.Errorf("%w: %w", ErrSelfCheckFailed, err)
+	}
+	return nil
+}
+
+// Unload 卸载渠道插件，恢复使用内置实现
+func (m *Manager) Unload(ch domain.Channel) {
+	m.mu.Lock()
+	p, ok := m.active[ch]
+	delete(m.active, ch)
+	m.mu.Unlock()
+	if ok {
+		m.logger.Info("渠道插件已卸载", elog.String("Channel", ch.String()), elog.String("Version", p.Version()))
+	}
+}
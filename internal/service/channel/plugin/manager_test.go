@@ -0,0 +1,182 @@
+//go:build unit
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakePlugin 自检结果可控的插件
+type fakePlugin struct {
+	channel   domain.Channel
+	version   string
+	checkErr  error
+	checkPnc  bool
+	sendCount int
+}
+
+func (p *fakePlugin) Send(_ context.Context, n domain.Notification) (domain.SendResponse, error) {
+	p.sendCount++
+	return domain.SendResponse{NotificationID: n.ID, Status: domain.SendStatusSucceeded}, nil
+}
+
+func (p *fakePlugin) Channel() domain.Channel { return p.channel }
+
+func (p *fakePlugin) Version() string { return p.version }
+
+func (p *fakePlugin) SelfCheck(_ context.Context) error {
+	if p.checkPnc {
+		panic("mock panic")
+	}
+	return p.checkErr
+}
+
+// mapLoader 按路径返回插件
+type mapLoader map[string]Plugin
+
+func (l mapLoader) Load(path string) (Plugin, error) {
+	p, ok := l[path]
+	if !ok {
+		return nil, ErrInvalidPlugin
+	}
+	return p, nil
+}
+
+func TestManager_Load(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		channel domain.Channel
+		version string
+		plugin  Plugin
+		// 加载前生效的插件
+		before Plugin
+		// 加载后生效的版本
+		wantVersion string
+		wantErr     error
+	}{
+		{
+			name:        "加载新插件",
+			channel:     domain.ChannelSMS,
+			version:     "v1",
+			plugin:      &fakePlugin{channel: domain.ChannelSMS, version: "v1"},
+			wantVersion: "v1",
+		},
+		{
+			name:        "升级插件",
+			channel:     domain.ChannelSMS,
+			version:     "v2",
+			plugin:      &fakePlugin{channel: domain.ChannelSMS, version: "v2"},
+			before:      &fakePlugin{channel: domain.ChannelSMS, version: "v1"},
+			wantVersion: "v2",
+		},
+		{
+			name:        "自检失败继续使用旧版本",
+			channel:     domain.ChannelSMS,
+			version:     "v2",
+			plugin:      &fakePlugin{channel: domain.ChannelSMS, version: "v2", checkErr: errors.New("mock error")},
+			before:      &fakePlugin{channel: domain.ChannelSMS, version: "v1"},
+			wantVersion: "v1",
+			wantErr:     ErrSelfCheckFailed,
+		},
+		{
+			name:        "自检panic继续使用旧版本",
+			channel:     domain.ChannelSMS,
+			version:     "v2",
+			plugin:      &fakePlugin{channel: domain.ChannelSMS, version: "v2", checkPnc: true},
+			before:      &fakePlugin{channel: domain.ChannelSMS, version: "v1"},
+			wantVersion: "v1",
+			wantErr:     ErrSelfCheckFailed,
+		},
+		{
+			name:        "插件声明的版本与文件不一致",
+			channel:     domain.ChannelSMS,
+			version:     "v2",
+			plugin:      &fakePlugin{channel: domain.ChannelSMS, version: "v3"},
+			before:      &fakePlugin{channel: domain.ChannelSMS, version: "v1"},
+			wantVersion: "v1",
+			wantErr:     ErrInvalidPlugin,
+		},
+		{
+			name:    "插件声明的渠道与文件不一致",
+			channel: domain.ChannelSMS,
+			version: "v1",
+			plugin:  &fakePlugin{channel: domain.ChannelEmail, version: "v1"},
+			wantErr: ErrInvalidPlugin,
+		},
+		{
+			name:    "插件文件不存在",
+			channel: domain.ChannelSMS,
+			version: "v1",
+			wantErr: ErrInvalidPlugin,
+		},
+		{
+			name:    "版本号包含路径",
+			channel: domain.ChannelSMS,
+			version: "../../v1",
+			wantErr: errs.ErrInvalidParameter,
+		},
+		{
+			name:    "未知渠道",
+			channel: domain.Channel("UNKNOWN"),
+			version: "v1",
+			wantErr: errs.ErrUnknownChannel,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			loader := mapLoader{}
+			if tc.plugin != nil {
+				loader["plugins/"+tc.channel.String()+"/"+tc.version+".so"] = tc.plugin
+			}
+			m := NewManager("plugins", loader, 0)
+			if tc.before != nil {
+				m.active[tc.channel] = tc.before
+			}
+
+			err := m.Load(t.Context(), tc.channel, tc.version)
+			assert.ErrorIs(t, err, tc.wantErr)
+
+			p, ok := m.Get(tc.channel)
+			if tc.wantVersion == "" {
+				assert.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+			assert.Equal(t, tc.wantVersion, p.(Plugin).Version())
+		})
+	}
+}
+
+func TestManager_Unload(t *testing.T) {
+	t.Parallel()
+
+	v1 := &fakePlugin{channel: domain.ChannelSMS, version: "v1"}
+	m := NewManager("plugins", mapLoader{"plugins/SMS/v1.so": v1}, 0)
+	require.NoError(t, m.Load(t.Context(), domain.ChannelSMS, "v1"))
+	// 已经生效的版本不会重复加载
+	require.NoError(t, m.Load(t.Context(), domain.ChannelSMS, "v1"))
+	assert.Equal(t, map[domain.Channel]string{domain.ChannelSMS: "v1"}, m.Versions())
+
+	p, ok := m.Get(domain.ChannelSMS)
+	require.True(t, ok)
+	_, err := p.Send(t.Context(), domain.Notification{ID: 1, Channel: domain.ChannelSMS})
+	require.NoError(t, err)
+	assert.Equal(t, 1, v1.sendCount)
+
+	m.Unload(domain.ChannelSMS)
+	_, ok = m.Get(domain.ChannelSMS)
+	assert.False(t, ok)
+	assert.Empty(t, m.Versions())
+}
@@ -0,0 +1,118 @@
+package plugin
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"github.com/ego-component/eetcd"
+	"github.com/gotomicro/ego/core/elog"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const (
+	DefaultKeyPrefix = "/notification-platform/channel-plugins/"
+	retryInterval    = 5 * time.Second
+)
+
+// Syncer 通过 etcd 在所有实例间同步渠道插件的版本
+// key 为 <prefix><渠道>，value 为版本号，删除 key 表示卸载插件；
+// 实例启动时加载 etcd 中记录的版本，之后监听变化，自检失败的实例继续使用旧版本
+type Syncer struct {
+	client  *eetcd.Component
+	prefix  string
+	manager *Manager
+	logger  *elog.Component
+}
+
+// NewSyncer 创建插件同步器，prefix 为空时使用 DefaultKeyPrefix
+func NewSyncer(client *eetcd.Component, prefix string, manager *Manager) *Syncer {
+	if prefix == "" {
+		prefix = DefaultKeyPrefix
+	}
+	return &Syncer{
+		client:  client,
+		prefix:  prefix,
+		manager: manager,
+		logger:  elog.DefaultLogger.With(elog.FieldComponent("channel.plugin")),
+	}
+}
+
+// Publish 通知所有实例加载渠道插件的指定版本
+func (s *Syncer) Publish(ctx context.Context, ch domain.Channel, version string) error {
+	_, err := s.client.Put(ctx, s.prefix+ch.String(), version)
+	return err
+}
+
+// Remove 通知所有实例卸载渠道插件
+func (s *Syncer) Remove(ctx context.Context, ch domain.Channel) error {
+	_, err := s.client.Delete(ctx, s.prefix+ch.String())
+	return err
+}
+
+// Start 加载 etcd 中记录的插件版本并监听变化，直到 ctx 结束
+func (s *Syncer) Start(ctx context.Context) {
+	for {
+		err := s.sync(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		s.logger.Warn("同步渠道插件失败，稍后重试", elog.FieldErr(err))
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+// sync 全量加载一次，再从下一个版本开始监听，监听中断时返回
+func (s *Syncer) sync(ctx context.Context) error {
+	resp, err := s.client.Get(ctx, s.prefix, clientv3.WithPrefix())
+	if err != nil {
+		return err
+	}
+	loaded := make(map[domain.Channel]struct{}, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		ch := s.channel(kv.Key)
+		loaded[ch] = struct{}{}
+		s.load(ctx, ch, string(kv.Value))
+	}
+	// 监听中断期间被删除的插件
+	for ch := range s.manager.Versions() {
+		if _, ok := loaded[ch]; !ok {
+			s.manager.Unload(ch)
+		}
+	}
+
+	watchChan := s.client.Watch(ctx, s.prefix, clientv3.WithPrefix(), clientv3.WithRev(resp.Header.Revision+1))
+	for watchResp := range watchChan {
+		if err = watchResp.Err(); err != nil {
+			return err
+		}
+		for _, event := range watchResp.Events {
+			ch := s.channel(event.Kv.Key)
+			switch event.Type {
+			case clientv3.EventTypePut:
+				s.load(ctx, ch, string(event.Kv.Value))
+			case clientv3.EventTypeDelete:
+				s.manager.Unload(ch)
+			}
+		}
+	}
+	return ctx.Err()
+}
+
+func (s *Syncer) load(ctx context.Context, ch domain.Channel, version string) {
+	if err := s.manager.Load(ctx, ch, version); err != nil {
+		s.logger.Error("加载渠道插件失败",
+			elog.String("Channel", ch.String()),
+			elog.String("Version", version),
+			elog.FieldErr(err))
+	}
+}
+
+func (s *Syncer) channel(key []byte) domain.Channel {
+	return domain.Channel(strings.TrimPrefix(string(key), s.prefix))
+}
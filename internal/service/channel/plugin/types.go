@@ -0,0 +1,64 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	goplugin "plugin"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/service/channel"
+)
+
+// SymbolName 插件中导出的变量名，变量需要实现 Plugin 接口
+//
+//	var Plugin myPlugin
+const SymbolName = "Plugin"
+
+var (
+	ErrInvalidPlugin   = errors.New("无效的渠道插件")
+	ErrSelfCheckFailed = errors.New("渠道插件自检失败")
+)
+
+// Plugin 渠道插件，实现 channel.Channel 接口，加载后替换对应渠道的内置实现
+// 插件用 go build -buildmode=plugin 编译，必须与平台使用相同版本的 Go 和依赖，
+// 同一个插件的不同版本需要用 -ldflags=-pluginpath=<唯一路径> 区分，否则无法在同一进程中加载
+type Plugin interface {
+	channel.Channel
+	// Channel 插件实现的渠道
+	Channel() domain.Channel
+	// Version 插件版本，与插件文件名一致
+	Version() string
+	// SelfCheck 加载后、生效前的自检，例如检查配置和供应商的连通性，失败时继续使用旧版本
+	SelfCheck(ctx context.Context) error
+}
+
+// Loader 从文件加载插件
+type Loader interface {
+	Load(path string) (Plugin, error)
+}
+
+// soLoader 使用标准库 plugin 加载 .so 文件
+// Go 插件加载后无法卸载，同一个文件重复加载时返回第一次加载的结果
+type soLoader struct{}
+
+// NewSOLoader 创建从 .so 文件加载插件的 Loader
+func NewSOLoader() Loader {
+	return soLoader{}
+}
+
+func (soLoader) Load(path string) (Plugin, error) {
+	p, err := goplugin.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w: 打开插件 %s 失败: %w", ErrInvalidPlugin, path, err)
+	}
+	sym, err := p.Lookup(SymbolName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: 插件 %s 没有导出 %s: %w", ErrInvalidPlugin, path, SymbolName, err)
+	}
+	res, ok := sym.(Plugin)
+	if !ok {
+		return nil, fmt.Errorf("%w: 插件 %s 导出的 %s 没有实现 Plugin 接口", ErrInvalidPlugin, path, SymbolName)
+	}
+	return res, nil
+}
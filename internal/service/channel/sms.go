@@ -2,7 +2,9 @@ package channel
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 
 	"gitee.com/flycash/notification-platform/internal/domain"
 	"gitee.com/flycash/notification-platform/internal/errs"
@@ -13,26 +15,66 @@ type baseChannel struct {
 	builder provider.SelectorBuilder
 }
 
+// Send 依次尝试供应商，供应商返回部分接收者失败时，只把可以重试的失败接收者交给下一个供应商
+// 所有供应商都尝试过后，只要有接收者发送成功就视为发送成功，失败的接收者记录在 ReceiverResults 中
 func (s *baseChannel) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
 	selector, err := s.builder.Build()
 	if err != nil {
 		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
 	}
 
+	var merged *domain.SendResponse
+	pending := notification
 	for {
 		// 获取供应商
-		p, err1 := selector.Next(ctx, notification)
+		p, err1 := selector.Next(ctx, pending)
 		if err1 != nil {
+			if merged != nil {
+				// 部分接收者发送成功
+				return *merged, nil
+			}
 			// 没有可用的供应商
 			return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err1)
 		}
 
 		// 使用当前供应商发送
-		resp, err2 := p.Send(ctx, notification)
-		if err2 == nil {
-			return resp, nil
+		resp, err2 := p.Send(ctx, pending)
+		if err2 != nil {
+			// 所有供应商都没有国际/港澳台模版，换供应商也无法发送
+			if errors.Is(err2, errs.ErrNoInternationalTemplate) {
+				if merged != nil {
+					return *merged, nil
+				}
+				return domain.SendResponse{}, err2
+			}
+			continue
 		}
+		merged = s.merge(merged, resp)
+		retryable := resp.RetryableReceivers()
+		if len(retryable) == 0 {
+			return *merged, nil
+		}
+		pending.Receivers = retryable
+	}
+}
+
+// merge 合并多个供应商的发送结果，同一接收者以最后一次发送的结果为准
+func (s *baseChannel) merge(merged *domain.SendResponse, resp domain.SendResponse) *domain.SendResponse {
+	if merged == nil {
+		return &resp
+	}
+	merged.Receipts = append(merged.Receipts, resp.Receipts...)
+	for _, result := range resp.ReceiverResults {
+		idx := slices.IndexFunc(merged.ReceiverResults, func(r domain.ReceiverResult) bool {
+			return r.Receiver == result.Receiver
+		})
+		if idx < 0 {
+			merged.ReceiverResults = append(merged.ReceiverResults, result)
+			continue
+		}
+		merged.ReceiverResults[idx] = result
 	}
+	return merged
 }
 
 type smsChannel struct {
@@ -238,3 +238,107 @@ func (s *SMSTestSuite) TestSMSChannelSend() {
 		})
 	}
 }
+
+func (s *SMSTestSuite) TestSMSChannelSendRetryFailedReceivers() {
+	t := s.T()
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	notification := domain.Notification{
+		ID:        1,
+		Channel:   domain.ChannelSMS,
+		Receivers: []string{"13800138000", "13800138001", "13800138002"},
+	}
+	result := func(receiver, provider string, status domain.SendStatus) domain.ReceiverResult {
+		return domain.ReceiverResult{NotificationID: 1, Receiver: receiver, Provider: provider, Status: status}
+	}
+
+	aliyun := providermocks.NewMockProvider(ctrl)
+	aliyun.EXPECT().Send(gomock.Any(), notification).Return(domain.SendResponse{
+		NotificationID: 1,
+		Status:         domain.SendStatusSucceeded,
+		ReceiverResults: []domain.ReceiverResult{
+			result("13800138000", "aliyun", domain.SendStatusSucceeded),
+			result("13800138001", "aliyun", domain.SendStatusFailed),
+			result("13800138002", "aliyun", domain.SendStatusFailed),
+		},
+	}, nil)
+	// 只重试失败的接收者
+	retry := notification
+	retry.Receivers = []string{"13800138001", "13800138002"}
+	tencent := providermocks.NewMockProvider(ctrl)
+	tencent.EXPECT().Send(gomock.Any(), retry).Return(domain.SendResponse{}, errors.New("mock error"))
+	huawei := providermocks.NewMockProvider(ctrl)
+	huawei.EXPECT().Send(gomock.Any(), retry).Return(domain.SendResponse{
+		NotificationID: 1,
+		Status:         domain.SendStatusSucceeded,
+		ReceiverResults: []domain.ReceiverResult{
+			result("13800138001", "huawei", domain.SendStatusSucceeded),
+			result("13800138002", "huawei", domain.SendStatusFailed),
+		},
+	}, nil)
+
+	selector := providermocks.NewMockSelector(ctrl)
+	gomock.InOrder(
+		selector.EXPECT().Next(gomock.Any(), notification).Return(aliyun, nil),
+		selector.EXPECT().Next(gomock.Any(), retry).Return(tencent, nil),
+		selector.EXPECT().Next(gomock.Any(), retry).Return(huawei, nil),
+		selector.EXPECT().Next(gomock.Any(), gomock.Any()).Return(nil, errs.ErrNoAvailableProvider),
+	)
+	builder := providermocks.NewMockSelectorBuilder(ctrl)
+	builder.EXPECT().Build().Return(selector, nil)
+
+	resp, err := NewSMSChannel(builder).Send(t.Context(), notification)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.SendStatusSucceeded, resp.Status)
+	assert.Equal(t, []domain.ReceiverResult{
+		result("13800138000", "aliyun", domain.SendStatusSucceeded),
+		result("13800138001", "huawei", domain.SendStatusSucceeded),
+		result("13800138002", "huawei", domain.SendStatusFailed),
+	}, resp.ReceiverResults)
+}
+
+func (s *SMSTestSuite) TestSMSChannelSendNoInternationalTemplate() {
+	t := s.T()
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	notification := domain.Notification{
+		ID:        1,
+		Channel:   domain.ChannelSMS,
+		Receivers: []string{"13800138000", "+85251234567"},
+	}
+
+	// 没有国际/港澳台模版的境外手机号不再换供应商重试
+	aliyun := providermocks.NewMockProvider(ctrl)
+	aliyun.EXPECT().Send(gomock.Any(), notification).Return(domain.SendResponse{
+		NotificationID: 1,
+		Status:         domain.SendStatusSucceeded,
+		ReceiverResults: []domain.ReceiverResult{
+			{NotificationID: 1, Receiver: "13800138000", Provider: "aliyun", Status: domain.SendStatusSucceeded},
+			{NotificationID: 1, Receiver: "+85251234567", Provider: "aliyun", Status: domain.SendStatusFailed, Final: true},
+		},
+	}, nil)
+	selector := providermocks.NewMockSelector(ctrl)
+	selector.EXPECT().Next(gomock.Any(), notification).Return(aliyun, nil)
+	builder := providermocks.NewMockSelectorBuilder(ctrl)
+	builder.EXPECT().Build().Return(selector, nil)
+
+	resp, err := NewSMSChannel(builder).Send(t.Context(), notification)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"+85251234567"}, resp.FailedReceivers())
+
+	// 全部是这种境外手机号时直接失败
+	notification.Receivers = []string{"+85251234567"}
+	aliyun.EXPECT().Send(gomock.Any(), notification).
+		Return(domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, errs.ErrNoInternationalTemplate))
+	selector.EXPECT().Next(gomock.Any(), notification).Return(aliyun, nil)
+	builder.EXPECT().Build().Return(selector, nil)
+
+	_, err = NewSMSChannel(builder).Send(t.Context(), notification)
+	assert.ErrorIs(t, err, errs.ErrNoInternationalTemplate)
+}
@@ -0,0 +1,145 @@
+package inbox
+
+import (
+	"context"
+	"fmt"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/repository"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+	// maxBatchSize 单次标记已读、删除、归档的最大条数
+	maxBatchSize = 100
+)
+
+// Service 站内信服务
+//
+//go:generate mockgen -source=./inbox.go -destination=./mocks/inbox.mock.go -package=inboxmocks -typed Service
+type Service interface {
+	// Deliver 投递站内信
+	Deliver(ctx context.Context, msgs []domain.InboxMessage) error
+	// List 游标分页查询站内信
+	List(ctx context.Context, query domain.InboxQuery) (domain.InboxPage, error)
+	// UnreadCount 查询收件箱未读数
+	UnreadCount(ctx context.Context, bizID int64, userID string) (int64, error)
+	// MarkRead 标记已读
+	MarkRead(ctx context.Context, bizID int64, userID string, ids []int64) (int64, error)
+	// MarkAllRead 收件箱全部标记已读
+	MarkAllRead(ctx context.Context, bizID int64, userID string) (int64, error)
+	// Delete 删除站内信
+	Delete(ctx context.Context, bizID int64, userID string, ids []int64) (int64, error)
+	// Archive 归档站内信
+	Archive(ctx context.Context, bizID int64, userID string, ids []int64) (int64, error)
+}
+
+type service struct {
+	repo repository.InboxRepository
+}
+
+func NewService(repo repository.InboxRepository) Service {
+	return &service{repo: repo}
+}
+
+func (s *service) Deliver(ctx context.Context, msgs []domain.InboxMessage) error {
+	for i := range msgs {
+		if err := s.checkOwner(msgs[i].BizID, msgs[i].UserID); err != nil {
+			return err
+		}
+	}
+	return s.repo.BatchCreate(ctx, msgs)
+}
+
+func (s *service) List(ctx context.Context, query domain.InboxQuery) (domain.InboxPage, error) {
+	if err := s.checkOwner(query.BizID, query.UserID); err != nil {
+		return domain.InboxPage{}, err
+	}
+	if query.Cursor < 0 {
+		return domain.InboxPage{}, fmt.Errorf("%w: Cursor = %d", errs.ErrInvalidParameter, query.Cursor)
+	}
+	if query.Limit <= 0 {
+		query.Limit = defaultPageSize
+	}
+	if query.Limit > maxPageSize {
+		query.Limit = maxPageSize
+	}
+
+	// 多查一条用于判断是否还有下一页
+	pageSize := query.Limit
+	query.Limit++
+	msgs, err := s.repo.List(ctx, query)
+	if err != nil {
+		return domain.InboxPage{}, err
+	}
+
+	page := domain.InboxPage{Messages: msgs}
+	if len(msgs) > pageSize {
+		page.Messages = msgs[:pageSize]
+		page.HasMore = true
+	}
+	if len(page.Messages) > 0 {
+		page.NextCursor = page.Messages[len(page.Messages)-1].ID
+	}
+	return page, nil
+}
+
+func (s *service) UnreadCount(ctx context.Context, bizID int64, userID string) (int64, error) {
+	if err := s.checkOwner(bizID, userID); err != nil {
+		return 0, err
+	}
+	return s.repo.CountUnread(ctx, bizID, userID)
+}
+
+func (s *service) MarkRead(ctx context.Context, bizID int64, userID string, ids []int64) (int64, error) {
+	if err := s.checkBatch(bizID, userID, ids); err != nil {
+		return 0, err
+	}
+	return s.repo.MarkRead(ctx, bizID, userID, ids)
+}
+
+func (s *service) MarkAllRead(ctx context.Context, bizID int64, userID string) (int64, error) {
+	if err := s.checkOwner(bizID, userID); err != nil {
+		return 0, err
+	}
+	return s.repo.MarkAllRead(ctx, bizID, userID)
+}
+
+func (s *service) Delete(ctx context.Context, bizID int64, userID string, ids []int64) (int64, error) {
+	if err := s.checkBatch(bizID, userID, ids); err != nil {
+		return 0, err
+	}
+	return s.repo.Delete(ctx, bizID, userID, ids)
+}
+
+func (s *service) Archive(ctx context.Context, bizID int64, userID string, ids []int64) (int64, error) {
+	if err := s.checkBatch(bizID, userID, ids); err != nil {
+		return 0, err
+	}
+	return s.repo.Archive(ctx, bizID, userID, ids)
+}
+
+func (s *service) checkOwner(bizID int64, userID string) error {
+	if bizID <= 0 {
+		return fmt.Errorf("%w: BizID = %d", errs.ErrInvalidParameter, bizID)
+	}
+	if userID == "" {
+		return fmt.Errorf("%w: UserID 不能为空", errs.ErrInvalidParameter)
+	}
+	return nil
+}
+
+func (s *service) checkBatch(bizID int64, userID string, ids []int64) error {
+	if err := s.checkOwner(bizID, userID); err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		return fmt.Errorf("%w: 站内信ID列表不能为空", errs.ErrInvalidParameter)
+	}
+	if len(ids) > maxBatchSize {
+		return fmt.Errorf("%w: %d", errs.ErrBatchSizeOverLimit, len(ids))
+	}
+	return nil
+}
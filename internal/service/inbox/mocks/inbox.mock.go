@@ -0,0 +1,314 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./inbox.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./inbox.go -destination=./mocks/inbox.mock.go -package=inboxmocks -typed Service
+//
+
+// Package inboxmocks is a generated GoMock package.
+package inboxmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "gitee.com/flycash/notification-platform/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// Archive mocks base method.
+func (m *MockService) Archive(ctx context.Context, bizID int64, userID string, ids []int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Archive", ctx, bizID, userID, ids)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Archive indicates an expected call of Archive.
+func (mr *MockServiceMockRecorder) Archive(ctx, bizID, userID, ids any) *MockServiceArchiveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archive", reflect.TypeOf((*MockService)(nil).Archive), ctx, bizID, userID, ids)
+	return &MockServiceArchiveCall{Call: call}
+}
+
+// MockServiceArchiveCall wrap *gomock.Call
+type MockServiceArchiveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceArchiveCall) Return(arg0 int64, arg1 error) *MockServiceArchiveCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceArchiveCall) Do(f func(context.Context, int64, string, []int64) (int64, error)) *MockServiceArchiveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceArchiveCall) DoAndReturn(f func(context.Context, int64, string, []int64) (int64, error)) *MockServiceArchiveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Delete mocks base method.
+func (m *MockService) Delete(ctx context.Context, bizID int64, userID string, ids []int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, bizID, userID, ids)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockServiceMockRecorder) Delete(ctx, bizID, userID, ids any) *MockServiceDeleteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockService)(nil).Delete), ctx, bizID, userID, ids)
+	return &MockServiceDeleteCall{Call: call}
+}
+
+// MockServiceDeleteCall wrap *gomock.Call
+type MockServiceDeleteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceDeleteCall) Return(arg0 int64, arg1 error) *MockServiceDeleteCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceDeleteCall) Do(f func(context.Context, int64, string, []int64) (int64, error)) *MockServiceDeleteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceDeleteCall) DoAndReturn(f func(context.Context, int64, string, []int64) (int64, error)) *MockServiceDeleteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Deliver mocks base method.
+func (m *MockService) Deliver(ctx context.Context, msgs []domain.InboxMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deliver", ctx, msgs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Deliver indicates an expected call of Deliver.
+func (mr *MockServiceMockRecorder) Deliver(ctx, msgs any) *MockServiceDeliverCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deliver", reflect.TypeOf((*MockService)(nil).Deliver), ctx, msgs)
+	return &MockServiceDeliverCall{Call: call}
+}
+
+// MockServiceDeliverCall wrap *gomock.Call
+type MockServiceDeliverCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceDeliverCall) Return(arg0 error) *MockServiceDeliverCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceDeliverCall) Do(f func(context.Context, []domain.InboxMessage) error) *MockServiceDeliverCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceDeliverCall) DoAndReturn(f func(context.Context, []domain.InboxMessage) error) *MockServiceDeliverCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockService) List(ctx context.Context, query domain.InboxQuery) (domain.InboxPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, query)
+	ret0, _ := ret[0].(domain.InboxPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockServiceMockRecorder) List(ctx, query any) *MockServiceListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockService)(nil).List), ctx, query)
+	return &MockServiceListCall{Call: call}
+}
+
+// MockServiceListCall wrap *gomock.Call
+type MockServiceListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceListCall) Return(arg0 domain.InboxPage, arg1 error) *MockServiceListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceListCall) Do(f func(context.Context, domain.InboxQuery) (domain.InboxPage, error)) *MockServiceListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceListCall) DoAndReturn(f func(context.Context, domain.InboxQuery) (domain.InboxPage, error)) *MockServiceListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MarkAllRead mocks base method.
+func (m *MockService) MarkAllRead(ctx context.Context, bizID int64, userID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllRead", ctx, bizID, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAllRead indicates an expected call of MarkAllRead.
+func (mr *MockServiceMockRecorder) MarkAllRead(ctx, bizID, userID any) *MockServiceMarkAllReadCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllRead", reflect.TypeOf((*MockService)(nil).MarkAllRead), ctx, bizID, userID)
+	return &MockServiceMarkAllReadCall{Call: call}
+}
+
+// MockServiceMarkAllReadCall wrap *gomock.Call
+type MockServiceMarkAllReadCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceMarkAllReadCall) Return(arg0 int64, arg1 error) *MockServiceMarkAllReadCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceMarkAllReadCall) Do(f func(context.Context, int64, string) (int64, error)) *MockServiceMarkAllReadCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceMarkAllReadCall) DoAndReturn(f func(context.Context, int64, string) (int64, error)) *MockServiceMarkAllReadCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MarkRead mocks base method.
+func (m *MockService) MarkRead(ctx context.Context, bizID int64, userID string, ids []int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", ctx, bizID, userID, ids)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockServiceMockRecorder) MarkRead(ctx, bizID, userID, ids any) *MockServiceMarkReadCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockService)(nil).MarkRead), ctx, bizID, userID, ids)
+	return &MockServiceMarkReadCall{Call: call}
+}
+
+// MockServiceMarkReadCall wrap *gomock.Call
+type MockServiceMarkReadCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceMarkReadCall) Return(arg0 int64, arg1 error) *MockServiceMarkReadCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceMarkReadCall) Do(f func(context.Context, int64, string, []int64) (int64, error)) *MockServiceMarkReadCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceMarkReadCall) DoAndReturn(f func(context.Context, int64, string, []int64) (int64, error)) *MockServiceMarkReadCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UnreadCount mocks base method.
+func (m *MockService) UnreadCount(ctx context.Context, bizID int64, userID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnreadCount", ctx, bizID, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnreadCount indicates an expected call of UnreadCount.
+func (mr *MockServiceMockRecorder) UnreadCount(ctx, bizID, userID any) *MockServiceUnreadCountCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnreadCount", reflect.TypeOf((*MockService)(nil).UnreadCount), ctx, bizID, userID)
+	return &MockServiceUnreadCountCall{Call: call}
+}
+
+// MockServiceUnreadCountCall wrap *gomock.Call
+type MockServiceUnreadCountCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceUnreadCountCall) Return(arg0 int64, arg1 error) *MockServiceUnreadCountCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceUnreadCountCall) Do(f func(context.Context, int64, string) (int64, error)) *MockServiceUnreadCountCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceUnreadCountCall) DoAndReturn(f func(context.Context, int64, string) (int64, error)) *MockServiceUnreadCountCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -13,6 +13,7 @@ import (
 	"gitee.com/flycash/notification-platform/internal/pkg/retry"
 	"gitee.com/flycash/notification-platform/internal/repository"
 	"gitee.com/flycash/notification-platform/internal/service/config"
+	"github.com/ecodeclub/ekit/slice"
 	"github.com/ecodeclub/ekit/syncx"
 	"github.com/gotomicro/ego/client/egrpc"
 	"github.com/gotomicro/ego/core/elog"
@@ -31,17 +32,20 @@ type service struct {
 	bizID2Config syncx.Map[int64, *domain.CallbackConfig]
 	clients      *grpc.Clients[clientv1.CallbackServiceClient]
 	repo         repository.CallbackLogRepository
+	resultRepo   repository.ReceiverResultRepository
 	logger       *elog.Component
 }
 
 func NewService(
 	configSvc config.BusinessConfigService,
 	repo repository.CallbackLogRepository,
+	resultRepo repository.ReceiverResultRepository,
 ) Service {
 	return &service{
 		configSvc:    configSvc,
 		bizID2Config: syncx.Map[int64, *domain.CallbackConfig]{},
 		repo:         repo,
+		resultRepo:   resultRepo,
 		clients: grpc.NewClients(func(conn *egrpc.Component) clientv1.CallbackServiceClient {
 			return clientv1.NewCallbackServiceClient(conn)
 		}),
@@ -140,7 +144,20 @@ func (c *service) sendCallback(ctx context.Context, notification domain.Notifica
 		// 业务方未提供配置
 		return nil, fmt.Errorf("%w", errs.ErrConfigNotFound)
 	}
-	return c.clients.Get(cfg.ServiceName).HandleNotificationResult(ctx, c.buildRequest(notification))
+	return c.clients.Get(cfg.ServiceName).HandleNotificationResult(ctx, c.buildRequest(notification, c.getReceiverResults(ctx, notification.ID)))
+}
+
+// getReceiverResults 获取接收者发送结果，失败时回调中不带接收者发送结果，不影响回调本身
+func (c *service) getReceiverResults(ctx context.Context, notificationID uint64) []domain.ReceiverResult {
+	results, err := c.resultRepo.FindByNotificationIDs(ctx, []uint64{notificationID})
+	if err != nil {
+		c.logger.Warn("获取接收者发送结果失败",
+			elog.FieldKey("NotificationID"),
+			elog.FieldValueAny(notificationID),
+			elog.FieldErr(err))
+		return nil
+	}
+	return results[notificationID]
 }
 
 func (c *service) SendCallbackByNotification(ctx context.Context, notification domain.Notification) error {
@@ -166,7 +183,7 @@ func (c *service) getConfig(ctx context.Context, bizID int64) (*domain.CallbackC
 	return bizConfig.CallbackConfig, nil
 }
 
-func (c *service) buildRequest(notification domain.Notification) *clientv1.HandleNotificationResultRequest {
+func (c *service) buildRequest(notification domain.Notification, results []domain.ReceiverResult) *clientv1.HandleNotificationResultRequest {
 	templateParams := make(map[string]string)
 	if notification.Template.Params != nil {
 		templateParams = notification.Template.Params
@@ -184,7 +201,16 @@ func (c *service) buildRequest(notification domain.Notification) *clientv1.Handl
 		},
 		Result: &notificationv1.SendNotificationResponse{
 			NotificationId: notification.ID,
-			Status:         c.getStatus(notification),
+			Status:         c.getStatus(notification.Status),
+			ReceiverResults: slice.Map(results, func(_ int, src domain.ReceiverResult) *notificationv1.ReceiverResult {
+				return &notificationv1.ReceiverResult{
+					Receiver:     src.Receiver,
+					Status:       c.getStatus(src.Status),
+					Provider:     src.Provider,
+					ErrorCode:    src.ErrCode,
+					ErrorMessage: src.ErrMessage,
+				}
+			}),
 		},
 	}
 }
@@ -204,9 +230,9 @@ func (c *service) getChannel(notification domain.Notification) notificationv1.Ch
 	return channel
 }
 
-func (c *service) getStatus(notification domain.Notification) notificationv1.SendStatus {
+func (c *service) getStatus(sendStatus domain.SendStatus) notificationv1.SendStatus {
 	var status notificationv1.SendStatus
-	switch notification.Status {
+	switch sendStatus {
 	case domain.SendStatusSucceeded:
 		status = notificationv1.SendStatus_SUCCEEDED
 	case domain.SendStatusFailed:
@@ -217,6 +243,10 @@ func (c *service) getStatus(notification domain.Notification) notificationv1.Sen
 		status = notificationv1.SendStatus_CANCELED
 	case domain.SendStatusPending:
 		status = notificationv1.SendStatus_PENDING
+	case domain.SendStatusDelivered:
+		status = notificationv1.SendStatus_DELIVERED
+	case domain.SendStatusUndelivered:
+		status = notificationv1.SendStatus_UNDELIVERED
 	case domain.SendStatusSending:
 		status = notificationv1.SendStatus_SEND_STATUS_UNSPECIFIED
 	default:
@@ -123,3 +123,47 @@ func (c *MockServiceGetByKeysCall) DoAndReturn(f func(context.Context, int64, ..
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// GetReceiverResults mocks base method.
+func (m *MockService) GetReceiverResults(ctx context.Context, ids ...uint64) (map[uint64][]domain.ReceiverResult, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range ids {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReceiverResults", varargs...)
+	ret0, _ := ret[0].(map[uint64][]domain.ReceiverResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReceiverResults indicates an expected call of GetReceiverResults.
+func (mr *MockServiceMockRecorder) GetReceiverResults(ctx any, ids ...any) *MockServiceGetReceiverResultsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, ids...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceiverResults", reflect.TypeOf((*MockService)(nil).GetReceiverResults), varargs...)
+	return &MockServiceGetReceiverResultsCall{Call: call}
+}
+
+// MockServiceGetReceiverResultsCall wrap *gomock.Call
+type MockServiceGetReceiverResultsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceGetReceiverResultsCall) Return(arg0 map[uint64][]domain.ReceiverResult, arg1 error) *MockServiceGetReceiverResultsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceGetReceiverResultsCall) Do(f func(context.Context, ...uint64) (map[uint64][]domain.ReceiverResult, error)) *MockServiceGetReceiverResultsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceGetReceiverResultsCall) DoAndReturn(f func(context.Context, ...uint64) (map[uint64][]domain.ReceiverResult, error)) *MockServiceGetReceiverResultsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -19,17 +19,21 @@ type Service interface {
 	FindReadyNotifications(ctx context.Context, offset, limit int) ([]domain.Notification, error)
 	// GetByKeys 根据业务ID和业务内唯一标识获取通知列表
 	GetByKeys(ctx context.Context, bizID int64, keys ...string) ([]domain.Notification, error)
+	// GetReceiverResults 获取通知每个接收者的发送结果，按通知ID分组
+	GetReceiverResults(ctx context.Context, ids ...uint64) (map[uint64][]domain.ReceiverResult, error)
 }
 
 // notificationService 通知服务实现
 type notificationService struct {
-	repo repository.NotificationRepository
+	repo       repository.NotificationRepository
+	resultRepo repository.ReceiverResultRepository
 }
 
 // NewNotificationService 创建通知服务实例
-func NewNotificationService(repo repository.NotificationRepository) Service {
+func NewNotificationService(repo repository.NotificationRepository, resultRepo repository.ReceiverResultRepository) Service {
 	return &notificationService{
-		repo: repo,
+		repo:       repo,
+		resultRepo: resultRepo,
 	}
 }
 
@@ -50,3 +54,12 @@ func (s *notificationService) GetByKeys(ctx context.Context, bizID int64, keys .
 	}
 	return notifications, nil
 }
+
+// GetReceiverResults 获取通知每个接收者的发送结果，按通知ID分组
+func (s *notificationService) GetReceiverResults(ctx context.Context, ids ...uint64) (map[uint64][]domain.ReceiverResult, error) {
+	results, err := s.resultRepo.FindByNotificationIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("获取接收者发送结果失败: %w", err)
+	}
+	return results, nil
+}
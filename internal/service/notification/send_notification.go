@@ -3,6 +3,7 @@ package notification
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	idgen "gitee.com/flycash/notification-platform/internal/pkg/id_generator"
 
@@ -33,6 +34,9 @@ type sendService struct {
 	templateSvc     manage.ChannelTemplateService
 	idGenerator     *idgen.Generator
 	sendStrategy    sendstrategy.SendStrategy
+
+	// versions 已审核通过的模版版本，key 为版本ID
+	versions sync.Map
 }
 
 // NewSendService 创建执行器实例
@@ -55,6 +59,9 @@ func (e *sendService) SendNotification(ctx context.Context, n domain.Notificatio
 	if err := n.Validate(); err != nil {
 		return resp, err
 	}
+	if err := e.validateTemplateParams(ctx, n); err != nil {
+		return resp, err
+	}
 
 	// 生成通知ID，后续考虑分库分表
 	id := e.idGenerator.GenerateID(n.BizID, n.Key)
@@ -75,6 +82,9 @@ func (e *sendService) SendNotificationAsync(ctx context.Context, n domain.Notifi
 	if err := n.Validate(); err != nil {
 		return domain.SendResponse{}, err
 	}
+	if err := e.validateTemplateParams(ctx, n); err != nil {
+		return domain.SendResponse{}, err
+	}
 	// 生成通知ID
 	id := e.idGenerator.GenerateID(n.BizID, n.Key)
 	n.ID = uint64(id)
@@ -104,6 +114,9 @@ func (e *sendService) BatchSendNotifications(ctx context.Context, notifications
 		id := e.idGenerator.GenerateID(n.BizID, n.Key)
 		notifications[i].ID = uint64(id)
 	}
+	if err := e.validateTemplateParams(ctx, notifications...); err != nil {
+		return domain.BatchSendResponse{}, err
+	}
 
 	// 发送通知，这里有一个隐含的假设，就是发送策略必须是相同的。
 	results, err := e.sendStrategy.BatchSend(ctx, notifications)
@@ -134,6 +147,9 @@ func (e *sendService) BatchSendNotificationsAsync(ctx context.Context, notificat
 		ids = append(ids, uint64(id))
 		notifications[i].ReplaceAsyncImmediate()
 	}
+	if err := e.validateTemplateParams(ctx, notifications...); err != nil {
+		return domain.BatchSendAsyncResponse{}, err
+	}
 
 	// 发送通知，隐含假设这一批的发送策略是一样的。
 	_, err := e.sendStrategy.BatchSend(ctx, notifications)
@@ -144,3 +160,52 @@ func (e *sendService) BatchSendNotificationsAsync(ctx context.Context, notificat
 		NotificationIDs: ids,
 	}, nil
 }
+
+// validateTemplateParams 按模版版本的参数定义校验参数，避免参数缺失或者拼写错误到供应商拒绝时才发现
+// 没有指定版本时使用已发布的版本，同一批通知中相同的模版只查询一次
+func (e *sendService) validateTemplateParams(ctx context.Context, notifications ...domain.Notification) error {
+	templates := make(map[int64]domain.ChannelTemplate, 1)
+	for i := range notifications {
+		n := notifications[i]
+		version, err := e.templateVersion(ctx, n, templates)
+		if err != nil {
+			return err
+		}
+		if version == nil {
+			return fmt.Errorf("%w: %w: 模版ID = %d, 版本ID = %d",
+				errs.ErrInvalidParameter, errs.ErrTemplateVersionNotFound, n.Template.ID, n.Template.VersionID)
+		}
+		if err := version.ValidateParams(n.Template.Params); err != nil {
+			return fmt.Errorf("%w, Key = %s", err, n.Key)
+		}
+	}
+	return nil
+}
+
+// templateVersion 查询通知使用的模版版本
+// 审核通过的版本不能再修改，按版本ID缓存，指定了版本的通知（接口层已经确定了版本）不需要每次查询模版
+func (e *sendService) templateVersion(ctx context.Context, n domain.Notification, templates map[int64]domain.ChannelTemplate) (*domain.ChannelTemplateVersion, error) {
+	if n.Template.VersionID != 0 {
+		// 版本必须属于通知的模版，不属于时按模版查询，查不到版本
+		if v, ok := e.versions.Load(n.Template.VersionID); ok && v.(*domain.ChannelTemplateVersion).ChannelTemplateID == n.Template.ID {
+			return v.(*domain.ChannelTemplateVersion), nil
+		}
+	}
+	tmpl, ok := templates[n.Template.ID]
+	if !ok {
+		var err error
+		tmpl, err = e.templateSvc.GetTemplateByID(ctx, n.Template.ID)
+		if err != nil {
+			return nil, err
+		}
+		templates[n.Template.ID] = tmpl
+	}
+	version := tmpl.ActiveVersionFor(n.Locale)
+	if n.Template.VersionID != 0 {
+		version = tmpl.GetVersion(n.Template.VersionID)
+	}
+	if version != nil && version.AuditStatus.IsApproved() {
+		e.versions.Store(version.ID, version)
+	}
+	return version, nil
+}
@@ -0,0 +1,52 @@
+//go:build unit
+
+package notification
+
+import (
+	"testing"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	templatemocks "gitee.com/flycash/notification-platform/internal/service/template/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSendService_validateTemplateParams(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tmpl := domain.ChannelTemplate{
+		ID:              1,
+		ActiveVersionID: 11,
+		Versions: []domain.ChannelTemplateVersion{
+			{
+				ID:                11,
+				ChannelTemplateID: 1,
+				AuditStatus:       domain.AuditStatusApproved,
+				Params:            []domain.TemplateParam{{Name: "code", Type: domain.TemplateParamTypeString, Required: true}},
+			},
+		},
+	}
+	templateSvc := templatemocks.NewMockChannelTemplateService(ctrl)
+	// 审核通过的版本缓存后不再查询模版
+	templateSvc.EXPECT().GetTemplateByID(gomock.Any(), int64(1)).Return(tmpl, nil).Times(1)
+	// 版本不属于通知的模版时按模版查询
+	templateSvc.EXPECT().GetTemplateByID(gomock.Any(), int64(2)).Return(domain.ChannelTemplate{ID: 2}, nil).Times(1)
+
+	svc := &sendService{templateSvc: templateSvc}
+	n := domain.Notification{
+		Key:      "key",
+		Template: domain.Template{ID: 1, VersionID: 11, Params: map[string]string{"code": "123456"}},
+	}
+	require.NoError(t, svc.validateTemplateParams(t.Context(), n))
+	require.NoError(t, svc.validateTemplateParams(t.Context(), n))
+
+	n.Template.Params = map[string]string{}
+	assert.ErrorIs(t, svc.validateTemplateParams(t.Context(), n), errs.ErrInvalidTemplateParams)
+
+	n.Template.ID = 2
+	assert.ErrorIs(t, svc.validateTemplateParams(t.Context(), n), errs.ErrTemplateVersionNotFound)
+}
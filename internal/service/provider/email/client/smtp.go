@@ -0,0 +1,362 @@
+package client
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"html"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	schemeSMTP  = "smtp"
+	schemeSMTPS = "smtps"
+
+	defaultSMTPPort  = "587"
+	defaultSMTPSPort = "465"
+
+	defaultDialTimeout = 10 * time.Second
+	base64LineLength   = 76
+)
+
+var (
+	_ Client = (*SMTP)(nil)
+
+	htmlTagRegexp    = regexp.MustCompile(`(?s)<(script|style)[^>]*>.*?</(script|style)>|<[^>]+>`)
+	blankLinesRegexp = regexp.MustCompile(`\n\s*\n+`)
+)
+
+// SMTP 基于 SMTP 协议的邮件客户端
+// endpoint 形如 smtps://smtp.example.com:465 表示直接使用 TLS 连接，
+// smtp://smtp.example.com:587 表示在服务端支持时使用 STARTTLS 升级连接
+type SMTP struct {
+	host        string
+	addr        string
+	implicitTLS bool
+	username    string
+	password    string
+	tlsConfig   *tls.Config
+	dialTimeout time.Duration
+}
+
+// NewSMTP 创建 SMTP 客户端，username 和 password 为空表示不需要认证
+func NewSMTP(endpoint, username, password string) (*SMTP, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Hostname() == "" {
+		return nil, fmt.Errorf("%w: 非法的 SMTP 地址 %s", ErrInvalidParameter, endpoint)
+	}
+	port := u.Port()
+	implicitTLS := false
+	switch u.Scheme {
+	case schemeSMTPS:
+		implicitTLS = true
+		if port == "" {
+			port = defaultSMTPSPort
+		}
+	case schemeSMTP:
+		if port == "" {
+			port = defaultSMTPPort
+		}
+	default:
+		return nil, fmt.Errorf("%w: 不支持的协议 %s", ErrInvalidParameter, u.Scheme)
+	}
+	return &SMTP{
+		host:        u.Hostname(),
+		addr:        net.JoinHostPort(u.Hostname(), port),
+		implicitTLS: implicitTLS,
+		username:    username,
+		password:    password,
+		tlsConfig:   &tls.Config{ServerName: u.Hostname(), MinVersion: tls.VersionTLS12},
+		dialTimeout: defaultDialTimeout,
+	}, nil
+}
+
+func (s *SMTP) Send(req SendReq) (SendResp, error) {
+	from, err := mail.ParseAddress(req.From)
+	if err != nil {
+		return SendResp{}, fmt.Errorf("%w: 非法的发件人 %s", ErrInvalidParameter, req.From)
+	}
+	if len(req.To) == 0 {
+		return SendResp{}, fmt.Errorf("%w: 收件人不能为空", ErrInvalidParameter)
+	}
+	if req.ReplyTo != "" {
+		if _, err = mail.ParseAddress(req.ReplyTo); err != nil {
+			return SendResp{}, fmt.Errorf("%w: 非法的回复地址 %s", ErrInvalidParameter, req.ReplyTo)
+		}
+	}
+
+	c, err := s.dial()
+	if err != nil {
+		return SendResp{}, fmt.Errorf("%w: %w", ErrSendFailed, err)
+	}
+	defer c.Close()
+
+	resp := SendResp{Receivers: make(map[string]SendRespStatus, len(req.To))}
+	for _, to := range req.To {
+		messageID := s.newMessageID(from.Address)
+		msg, err1 := buildMessage(from, to, messageID, req)
+		if err1 != nil {
+			// 只影响这一个收件人
+			resp.Receivers[to] = SendRespStatus{MessageID: messageID, Message: err1.Error()}
+			continue
+		}
+		if err1 = s.sendOne(c, from.Address, to, msg); err1 != nil {
+			resp.Receivers[to] = toSendRespStatus(messageID, err1)
+			// 重置会话，继续发送给下一个收件人；重置失败时返回已经发送的结果，没有结果的收件人没有发送
+			if err2 := c.Reset(); err2 != nil {
+				return resp, fmt.Errorf("%w: %w", ErrSendFailed, err2)
+			}
+			continue
+		}
+		resp.Receivers[to] = SendRespStatus{MessageID: messageID, Code: OK}
+	}
+	_ = c.Quit()
+	return resp, nil
+}
+
+func (s *SMTP) dial() (*smtp.Client, error) {
+	var (
+		conn net.Conn
+		err  error
+	)
+	dialer := &net.Dialer{Timeout: s.dialTimeout}
+	if s.implicitTLS {
+		conn, err = tls.DialWithDialer(dialer, "tcp", s.addr, s.tlsConfig)
+	} else {
+		conn, err = dialer.Dial("tcp", s.addr)
+	}
+	if err != nil {
+		return nil, err
+	}
+	c, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	if !s.implicitTLS {
+		if ok, _ := c.Extension("STARTTLS"); ok {
+			if err = c.StartTLS(s.tlsConfig); err != nil {
+				_ = c.Close()
+				return nil, err
+			}
+		}
+	}
+	if s.username != "" {
+		if err = c.Auth(smtp.PlainAuth("", s.username, s.password, s.host)); err != nil {
+			_ = c.Close()
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+func (s *SMTP) sendOne(c *smtp.Client, from, to string, msg []byte) error {
+	if err := c.Mail(from); err != nil {
+		return err
+	}
+	if err := c.Rcpt(to); err != nil {
+		return err
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err = w.Write(msg); err != nil {
+		_ = w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+func (s *SMTP) newMessageID(from string) string {
+	const randomBytes = 8
+	b := make([]byte, randomBytes)
+	_, _ = rand.Read(b)
+	domain := s.host
+	if idx := strings.LastIndex(from, "@"); idx >= 0 {
+		domain = from[idx+1:]
+	}
+	return fmt.Sprintf("<%d.%s@%s>", time.Now().UnixNano(), hex.EncodeToString(b), domain)
+}
+
+func toSendRespStatus(messageID string, err error) SendRespStatus {
+	var tpErr *textproto.Error
+	if errors.As(err, &tpErr) {
+		return SendRespStatus{MessageID: messageID, Code: strconv.Itoa(tpErr.Code), Message: tpErr.Msg}
+	}
+	return SendRespStatus{MessageID: messageID, Code: "UNKNOWN", Message: err.Error()}
+}
+
+// mimePart 邮件中的一个 MIME 部分
+type mimePart struct {
+	header textproto.MIMEHeader
+	body   []byte
+}
+
+// buildMessage 构造邮件内容，结构为
+//
+//	multipart/mixed（有附件时）
+//	├── multipart/related（有内嵌资源时）
+//	│   ├── multipart/alternative
+//	│   │   ├── text/plain
+//	│   │   └── text/html
+//	│   └── 内嵌资源
+//	└── 附件
+func buildMessage(from *mail.Address, to, messageID string, req SendReq) ([]byte, error) {
+	text := req.Text
+	if text == "" {
+		text = htmlToText(req.HTML)
+	}
+	parts := []mimePart{textPart("text/plain; charset=UTF-8", text)}
+	if req.HTML != "" {
+		parts = append(parts, textPart("text/html; charset=UTF-8", req.HTML))
+	}
+	body, err := multipartOf("alternative", parts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var inlines, attachments []mimePart
+	for i := range req.Attachments {
+		if req.Attachments[i].IsInline() {
+			inlines = append(inlines, attachmentPart(req.Attachments[i]))
+		} else {
+			attachments = append(attachments, attachmentPart(req.Attachments[i]))
+		}
+	}
+	if len(inlines) > 0 {
+		if body, err = multipartOf("related", append([]mimePart{body}, inlines...)...); err != nil {
+			return nil, err
+		}
+	}
+	if len(attachments) > 0 {
+		if body, err = multipartOf("mixed", append([]mimePart{body}, attachments...)...); err != nil {
+			return nil, err
+		}
+	}
+
+	headers := [][2]string{
+		{"From", from.String()},
+		{"To", to},
+	}
+	if req.ReplyTo != "" {
+		headers = append(headers, [2]string{"Reply-To", req.ReplyTo})
+	}
+	headers = append(headers,
+		[2]string{"Subject", mime.BEncoding.Encode("UTF-8", req.Subject)},
+		[2]string{"Date", time.Now().Format(time.RFC1123Z)},
+		[2]string{"Message-ID", messageID},
+		[2]string{"MIME-Version", "1.0"},
+		[2]string{"Content-Type", body.header.Get("Content-Type")},
+	)
+	buf := &bytes.Buffer{}
+	for _, h := range headers {
+		if err = writeHeader(buf, h[0], h[1]); err != nil {
+			return nil, err
+		}
+	}
+	buf.WriteString("\r\n")
+	buf.Write(body.body)
+	return buf.Bytes(), nil
+}
+
+// writeHeader 头部的值不能包含换行，否则可以注入额外的头部
+func writeHeader(buf *bytes.Buffer, key, value string) error {
+	if strings.ContainsAny(value, "\r\n") {
+		return fmt.Errorf("%w: 邮件头 %s 包含换行", ErrInvalidParameter, key)
+	}
+	buf.WriteString(key + ": " + value + "\r\n")
+	return nil
+}
+
+func multipartOf(subtype string, parts ...mimePart) (mimePart, error) {
+	buf := &bytes.Buffer{}
+	w := multipart.NewWriter(buf)
+	for i := range parts {
+		pw, err := w.CreatePart(parts[i].header)
+		if err != nil {
+			return mimePart{}, err
+		}
+		if _, err = pw.Write(parts[i].body); err != nil {
+			return mimePart{}, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return mimePart{}, err
+	}
+	return mimePart{
+		header: textproto.MIMEHeader{
+			"Content-Type": {fmt.Sprintf("multipart/%s; boundary=%q", subtype, w.Boundary())},
+		},
+		body: buf.Bytes(),
+	}, nil
+}
+
+func textPart(contentType, content string) mimePart {
+	buf := &bytes.Buffer{}
+	qp := quotedprintable.NewWriter(buf)
+	// 写入内存不会出错
+	_, _ = qp.Write([]byte(content))
+	_ = qp.Close()
+	return mimePart{
+		header: textproto.MIMEHeader{
+			"Content-Type":              {contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		},
+		body: buf.Bytes(),
+	}
+}
+
+func attachmentPart(a Attachment) mimePart {
+	contentType := a.ContentType
+	if contentType == "" {
+		contentType = mime.TypeByExtension(filepath.Ext(a.Filename))
+	}
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	filename := mime.QEncoding.Encode("UTF-8", a.Filename)
+	header := textproto.MIMEHeader{
+		"Content-Type":              {fmt.Sprintf("%s; name=%q", contentType, filename)},
+		"Content-Transfer-Encoding": {"base64"},
+	}
+	if a.IsInline() {
+		header.Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", filename))
+		header.Set("Content-ID", "<"+a.ContentID+">")
+	} else {
+		header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	}
+
+	buf := &bytes.Buffer{}
+	encoded := base64.StdEncoding.EncodeToString(a.Content)
+	for len(encoded) > base64LineLength {
+		buf.WriteString(encoded[:base64LineLength] + "\r\n")
+		encoded = encoded[base64LineLength:]
+	}
+	buf.WriteString(encoded + "\r\n")
+	return mimePart{header: header, body: buf.Bytes()}
+}
+
+// htmlToText 简单地去掉 HTML 标签，作为纯文本正文
+func htmlToText(content string) string {
+	text := htmlTagRegexp.ReplaceAllString(content, "")
+	text = html.UnescapeString(text)
+	text = blankLinesRegexp.ReplaceAllString(text, "\n\n")
+	return strings.TrimSpace(text)
+}
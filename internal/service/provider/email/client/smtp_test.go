@@ -0,0 +1,268 @@
+//go:build unit
+
+package client
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSMTPServer 一个极简的 SMTP 服务器，用于测试
+type fakeSMTPServer struct {
+	listener net.Listener
+	// rejected 中的收件人会在 RCPT 阶段被拒绝
+	rejected map[string]bool
+
+	mu       sync.Mutex
+	messages map[string]string
+}
+
+func newFakeSMTPServer(t *testing.T, rejected ...string) *fakeSMTPServer {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := &fakeSMTPServer{
+		listener: l,
+		rejected: make(map[string]bool),
+		messages: make(map[string]string),
+	}
+	for _, r := range rejected {
+		s.rejected[r] = true
+	}
+	go s.serve()
+	t.Cleanup(func() { _ = l.Close() })
+	return s
+}
+
+func (s *fakeSMTPServer) endpoint() string {
+	return "smtp://" + s.listener.Addr().String()
+}
+
+func (s *fakeSMTPServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *fakeSMTPServer) handle(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	reply := func(line string) {
+		_, _ = io.WriteString(conn, line+"\r\n")
+	}
+	reply("220 fake ESMTP")
+	var rcpt string
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		cmd := strings.ToUpper(strings.TrimSpace(line))
+		switch {
+		case strings.HasPrefix(cmd, "EHLO"):
+			reply("250-fake")
+			reply("250 8BITMIME")
+		case strings.HasPrefix(cmd, "MAIL FROM"), strings.HasPrefix(cmd, "RSET"):
+			reply("250 OK")
+		case strings.HasPrefix(cmd, "RCPT TO"):
+			rcpt = strings.Trim(strings.TrimSpace(line)[len("RCPT TO:"):], "<>")
+			if s.rejected[rcpt] {
+				reply("550 mailbox unavailable")
+				continue
+			}
+			reply("250 OK")
+		case cmd == "DATA":
+			reply("354 go ahead")
+			var sb strings.Builder
+			for {
+				l, err1 := r.ReadString('\n')
+				if err1 != nil {
+					return
+				}
+				if l == ".\r\n" {
+					break
+				}
+				sb.WriteString(l)
+			}
+			s.mu.Lock()
+			s.messages[rcpt] = sb.String()
+			s.mu.Unlock()
+			reply("250 OK queued")
+		case cmd == "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 not implemented")
+		}
+	}
+}
+
+func (s *fakeSMTPServer) message(to string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.messages[to]
+}
+
+func TestNewSMTP(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		endpoint    string
+		wantAddr    string
+		implicitTLS bool
+		assertErr   assert.ErrorAssertionFunc
+	}{
+		{
+			name:        "smtps默认端口",
+			endpoint:    "smtps://smtp.example.com",
+			wantAddr:    "smtp.example.com:465",
+			implicitTLS: true,
+			assertErr:   assert.NoError,
+		},
+		{
+			name:      "smtp默认端口",
+			endpoint:  "smtp://smtp.example.com",
+			wantAddr:  "smtp.example.com:587",
+			assertErr: assert.NoError,
+		},
+		{
+			name:      "指定端口",
+			endpoint:  "smtp://localhost:1025",
+			wantAddr:  "localhost:1025",
+			assertErr: assert.NoError,
+		},
+		{
+			name:     "不支持的协议",
+			endpoint: "http://smtp.example.com",
+			assertErr: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorIs(t, err, ErrInvalidParameter)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c, err := NewSMTP(tt.endpoint, "", "")
+			tt.assertErr(t, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tt.wantAddr, c.addr)
+			assert.Equal(t, tt.implicitTLS, c.implicitTLS)
+		})
+	}
+}
+
+func TestSMTP_Send(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeSMTPServer(t, "bad@example.com")
+	c, err := NewSMTP(server.endpoint(), "", "")
+	require.NoError(t, err)
+
+	resp, err := c.Send(SendReq{
+		From:    "通知平台 <noreply@example.com>",
+		ReplyTo: "support@example.com",
+		To:      []string{"good@example.com", "bad@example.com"},
+		Subject: "你好，Tom",
+		HTML:    `<p>验证码 <b>123456</b></p><img src="cid:logo">`,
+		Attachments: []Attachment{
+			{Filename: "logo.png", ContentID: "logo", Content: []byte("png")},
+			{Filename: "report.txt", Content: []byte("report")},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, OK, resp.Receivers["good@example.com"].Code)
+	assert.NotEmpty(t, resp.Receivers["good@example.com"].MessageID)
+	assert.Equal(t, "550", resp.Receivers["bad@example.com"].Code)
+
+	msg, err := mail.ReadMessage(strings.NewReader(server.message("good@example.com")))
+	require.NoError(t, err)
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "你好，Tom", subject)
+	assert.Equal(t, "support@example.com", msg.Header.Get("Reply-To"))
+	assert.Equal(t, resp.Receivers["good@example.com"].MessageID, msg.Header.Get("Message-ID"))
+
+	// mixed -> related + 附件
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/mixed", mediaType)
+	parts := readParts(t, msg.Body, params["boundary"])
+	require.Len(t, parts, 2)
+	assert.Contains(t, parts[1].Header.Get("Content-Disposition"), "attachment")
+
+	// related -> alternative + 内嵌资源
+	mediaType, params, err = mime.ParseMediaType(parts[0].Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/related", mediaType)
+	related := readParts(t, strings.NewReader(parts[0].body), params["boundary"])
+	require.Len(t, related, 2)
+	assert.Equal(t, "<logo>", related[1].Header.Get("Content-ID"))
+
+	// alternative -> text + html
+	mediaType, params, err = mime.ParseMediaType(related[0].Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/alternative", mediaType)
+	alternative := readParts(t, strings.NewReader(related[0].body), params["boundary"])
+	require.Len(t, alternative, 2)
+	assert.Equal(t, "验证码 123456", alternative[0].body)
+	assert.Contains(t, alternative[1].body, "<b>123456</b>")
+}
+
+func TestSMTP_SendHeaderInjection(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewSMTP("smtp://127.0.0.1:1", "", "")
+	require.NoError(t, err)
+	_, err = c.Send(SendReq{
+		From:    "noreply@example.com",
+		ReplyTo: "support@example.com\r\nBcc: victim@example.com",
+		To:      []string{"good@example.com"},
+	})
+	assert.ErrorIs(t, err, ErrInvalidParameter)
+
+	// 收件人等其他头部同样不能包含换行
+	_, err = buildMessage(&mail.Address{Address: "noreply@example.com"}, "good@example.com\r\nBcc: victim@example.com", "id", SendReq{})
+	assert.ErrorIs(t, err, ErrInvalidParameter)
+}
+
+type partWithHeader struct {
+	Header textproto.MIMEHeader
+	body   string
+}
+
+func readParts(t *testing.T, r io.Reader, boundary string) []partWithHeader {
+	t.Helper()
+	mr := multipart.NewReader(r, boundary)
+	var res []partWithHeader
+	for {
+		p, err := mr.NextPart()
+		if errors.Is(err, io.EOF) {
+			return res
+		}
+		require.NoError(t, err)
+		body, err := io.ReadAll(p)
+		require.NoError(t, err)
+		res = append(res, partWithHeader{Header: p.Header, body: string(body)})
+	}
+}
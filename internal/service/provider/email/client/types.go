@@ -0,0 +1,59 @@
+package client
+
+import (
+	"errors"
+)
+
+// 通用错误定义
+var (
+	ErrSendFailed       = errors.New("发送邮件失败")
+	ErrInvalidParameter = errors.New("参数无效")
+)
+
+// Client 邮件客户端接口 (抽象)
+//
+//go:generate mockgen -source=./types.go -destination=./mocks/email.mock.go -package=emailmocks -typed Client
+type Client interface {
+	// Send 发送邮件，每个收件人单独发送一封
+	// 中途失败时同时返回错误和已经发送的收件人结果，SendResp 中没有的收件人没有发送
+	Send(req SendReq) (SendResp, error)
+}
+
+// SendReq 发送邮件请求参数
+type SendReq struct {
+	From        string       // 发件人地址，可以是 "名称 <addr>" 格式
+	ReplyTo     string       // 回复地址，为空表示不设置
+	To          []string     // 收件人地址
+	Subject     string       // 邮件主题
+	HTML        string       // HTML 正文
+	Text        string       // 纯文本正文，为空时从 HTML 正文生成
+	Attachments []Attachment // 附件
+}
+
+// Attachment 邮件附件
+type Attachment struct {
+	Filename    string // 附件文件名
+	ContentType string // MIME类型，为空时根据文件名推断
+	ContentID   string // 内嵌资源ID，不为空时作为内嵌资源
+	Content     []byte // 附件内容
+}
+
+// IsInline 是否是内嵌资源
+func (a Attachment) IsInline() bool {
+	return a.ContentID != ""
+}
+
+// SendResp 发送邮件响应参数
+type SendResp struct {
+	Receivers map[string]SendRespStatus // 键是收件人地址
+}
+
+type SendRespStatus struct {
+	MessageID string // 邮件的 Message-ID
+	Code      string // OK 表示成功，否则为 SMTP 错误码
+	Message   string
+}
+
+const (
+	OK = "OK"
+)
@@ -0,0 +1,155 @@
+package email
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/service/provider"
+	"gitee.com/flycash/notification-platform/internal/service/provider/email/client"
+	"gitee.com/flycash/notification-platform/internal/service/template/manage"
+	"gitee.com/flycash/notification-platform/internal/service/template/render"
+)
+
+// maxAttachmentSize 单个附件的最大字节数
+const maxAttachmentSize = 10 << 20
+
+// emailProvider 邮件供应商
+type emailProvider struct {
+	name        string
+	templateSvc manage.ChannelTemplateService
+	renderer    render.Service
+	client      client.Client
+	httpClient  *http.Client
+}
+
+// NewEmailProvider 邮件供应商
+func NewEmailProvider(name string, templateSvc manage.ChannelTemplateService, renderer render.Service, client client.Client, httpClient *http.Client) provider.Provider {
+	return &emailProvider{
+		name:        name,
+		templateSvc: templateSvc,
+		renderer:    renderer,
+		client:      client,
+		httpClient:  httpClient,
+	}
+}
+
+// Send 发送邮件，模版版本的 Signature 作为发件人，Content 渲染后作为 HTML 正文
+func (p *emailProvider) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
+	tmpl, err := p.templateSvc.GetTemplateByIDAndProviderInfo(ctx, notification.Template.ID, notification.Locale, p.name, domain.ChannelEmail, domain.TemplateRegionDomestic)
+	if err != nil {
+		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
+	}
+
+	activeVersion := tmpl.ActiveVersionFor(notification.Locale)
+	if activeVersion == nil {
+		return domain.SendResponse{}, fmt.Errorf("%w: 无已发布模版", errs.ErrSendNotificationFailed)
+	}
+
+	rendered, err := p.renderer.Render(ctx, tmpl, *activeVersion, notification.Template.Params)
+	if err != nil {
+		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
+	}
+
+	attachments, err := p.fetchAttachments(ctx, activeVersion.Attachments)
+	if err != nil {
+		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
+	}
+
+	resp, err := p.client.Send(client.SendReq{
+		From:        activeVersion.Signature,
+		ReplyTo:     activeVersion.ReplyTo,
+		To:          notification.Receivers,
+		Subject:     rendered.Subject,
+		HTML:        rendered.Content,
+		Attachments: attachments,
+	})
+	if err != nil && len(resp.Receivers) == 0 {
+		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
+	}
+
+	// 每个收件人单独发送，部分收件人失败时只返回失败的收件人，由渠道换供应商重试，避免已经收到的收件人重复收到
+	results := p.receiverResults(notification, resp, err)
+	if !slices.ContainsFunc(results, func(r domain.ReceiverResult) bool { return !r.IsFailed() }) {
+		last := results[len(results)-1]
+		return domain.SendResponse{}, fmt.Errorf("%w: Code = %s, Message = %s", errs.ErrSendNotificationFailed, last.ErrCode, last.ErrMessage)
+	}
+	return domain.SendResponse{
+		NotificationID:  notification.ID,
+		Status:          domain.SendStatusSucceeded,
+		ReceiverResults: results,
+	}, nil
+}
+
+// receiverResults 每个收件人一条结果，中途失败时没有发送的收件人视为失败
+func (p *emailProvider) receiverResults(notification domain.Notification, resp client.SendResp, sendErr error) []domain.ReceiverResult {
+	results := make([]domain.ReceiverResult, 0, len(notification.Receivers))
+	for _, r := range notification.Receivers {
+		result := domain.ReceiverResult{
+			NotificationID: notification.ID,
+			Receiver:       r,
+			Provider:       p.name,
+			Status:         domain.SendStatusSucceeded,
+		}
+		status, ok := resp.Receivers[r]
+		switch {
+		case !ok:
+			result.Status = domain.SendStatusFailed
+			result.ErrMessage = "没有发送"
+			if sendErr != nil {
+				result.ErrMessage = sendErr.Error()
+			}
+		case !strings.EqualFold(status.Code, client.OK):
+			result.Status = domain.SendStatusFailed
+			result.ErrCode = status.Code
+			result.ErrMessage = status.Message
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+func (p *emailProvider) fetchAttachments(ctx context.Context, attachments []domain.EmailAttachment) ([]client.Attachment, error) {
+	res := make([]client.Attachment, 0, len(attachments))
+	for i := range attachments {
+		content, err := p.download(ctx, attachments[i].URL)
+		if err != nil {
+			return nil, fmt.Errorf("下载附件 %s 失败: %w", attachments[i].Filename, err)
+		}
+		res = append(res, client.Attachment{
+			Filename:    attachments[i].Filename,
+			ContentType: attachments[i].ContentType,
+			ContentID:   attachments[i].ContentID,
+			Content:     content,
+		})
+	}
+	return res, nil
+}
+
+func (p *emailProvider) download(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("响应状态码 %d", resp.StatusCode)
+	}
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxAttachmentSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(content) > maxAttachmentSize {
+		return nil, fmt.Errorf("附件超过 %d 字节", maxAttachmentSize)
+	}
+	return content, nil
+}
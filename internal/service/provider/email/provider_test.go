@@ -0,0 +1,203 @@
+//go:build unit
+
+package email
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/service/provider/email/client"
+	emailmocks "gitee.com/flycash/notification-platform/internal/service/provider/email/client/mocks"
+	templatemocks "gitee.com/flycash/notification-platform/internal/service/template/mocks"
+	"gitee.com/flycash/notification-platform/internal/service/template/render"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestEmailProvider_Send(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/logo.png" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte("png"))
+	}))
+	t.Cleanup(server.Close)
+
+	testNotification := domain.Notification{
+		ID:      uint64(12345),
+		Channel: domain.ChannelEmail,
+		Template: domain.Template{
+			ID:        1,
+			VersionID: 1,
+			Params:    map[string]string{"name": "Tom", "code": "123456"},
+		},
+		Receivers: []string{"tom@example.com"},
+	}
+
+	newTemplate := func(attachments ...domain.EmailAttachment) domain.ChannelTemplate {
+		version := domain.ChannelTemplateVersion{
+			ID:                1,
+			ChannelTemplateID: testNotification.Template.ID,
+			Name:              "验证码邮件",
+			Signature:         "通知平台 <noreply@example.com>",
+			Content:           "<p>${name}，您的验证码是：${code}</p>",
+			Subject:           "${name}，您的验证码",
+			ReplyTo:           "support@example.com",
+			Attachments:       attachments,
+			AuditStatus:       domain.AuditStatusApproved,
+		}
+		return domain.ChannelTemplate{
+			ID:              testNotification.Template.ID,
+			Channel:         domain.ChannelEmail,
+			Versions:        []domain.ChannelTemplateVersion{version},
+			ActiveVersionID: version.ID,
+		}
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(templateSvc *templatemocks.MockChannelTemplateService, cli *emailmocks.MockClient)
+		wantErr   error
+	}{
+		{
+			name: "获取模板失败",
+			setupMock: func(templateSvc *templatemocks.MockChannelTemplateService, _ *emailmocks.MockClient) {
+				templateSvc.EXPECT().
+					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, "", "mailpit", domain.ChannelEmail, domain.TemplateRegionDomestic).
+					Return(domain.ChannelTemplate{}, errors.New("获取模板失败"))
+			},
+			wantErr: errs.ErrSendNotificationFailed,
+		},
+		{
+			name: "无已发布模版",
+			setupMock: func(templateSvc *templatemocks.MockChannelTemplateService, _ *emailmocks.MockClient) {
+				templateSvc.EXPECT().
+					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, "", "mailpit", domain.ChannelEmail, domain.TemplateRegionDomestic).
+					Return(domain.ChannelTemplate{ID: testNotification.Template.ID, Channel: domain.ChannelEmail}, nil)
+			},
+			wantErr: errs.ErrSendNotificationFailed,
+		},
+		{
+			name: "下载附件失败",
+			setupMock: func(templateSvc *templatemocks.MockChannelTemplateService, _ *emailmocks.MockClient) {
+				templateSvc.EXPECT().
+					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, "", "mailpit", domain.ChannelEmail, domain.TemplateRegionDomestic).
+					Return(newTemplate(domain.EmailAttachment{Filename: "a.pdf", URL: server.URL + "/a.pdf"}), nil)
+			},
+			wantErr: errs.ErrSendNotificationFailed,
+		},
+		{
+			name: "收件人被拒绝",
+			setupMock: func(templateSvc *templatemocks.MockChannelTemplateService, cli *emailmocks.MockClient) {
+				templateSvc.EXPECT().
+					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, "", "mailpit", domain.ChannelEmail, domain.TemplateRegionDomestic).
+					Return(newTemplate(), nil)
+				cli.EXPECT().Send(gomock.Any()).Return(client.SendResp{
+					Receivers: map[string]client.SendRespStatus{
+						"tom@example.com": {Code: "550", Message: "mailbox unavailable"},
+					},
+				}, nil)
+			},
+			wantErr: errs.ErrSendNotificationFailed,
+		},
+		{
+			name: "发送成功",
+			setupMock: func(templateSvc *templatemocks.MockChannelTemplateService, cli *emailmocks.MockClient) {
+				templateSvc.EXPECT().
+					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, "", "mailpit", domain.ChannelEmail, domain.TemplateRegionDomestic).
+					Return(newTemplate(domain.EmailAttachment{Filename: "logo.png", URL: server.URL + "/logo.png", ContentID: "logo"}), nil)
+				cli.EXPECT().Send(client.SendReq{
+					From:    "通知平台 <noreply@example.com>",
+					ReplyTo: "support@example.com",
+					To:      testNotification.Receivers,
+					Subject: "Tom，您的验证码",
+					HTML:    "<p>Tom，您的验证码是：123456</p>",
+					Attachments: []client.Attachment{
+						{Filename: "logo.png", ContentID: "logo", Content: []byte("png")},
+					},
+				}).Return(client.SendResp{
+					Receivers: map[string]client.SendRespStatus{
+						"tom@example.com": {MessageID: "<1@example.com>", Code: client.OK},
+					},
+				}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTemplateSvc := templatemocks.NewMockChannelTemplateService(ctrl)
+			mockClient := emailmocks.NewMockClient(ctrl)
+			tt.setupMock(mockTemplateSvc, mockClient)
+			// 没有公共片段
+			mockTemplateSvc.EXPECT().GetTemplatesByOwner(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+			p := NewEmailProvider("mailpit", mockTemplateSvc, render.NewService(mockTemplateSvc), mockClient, server.Client())
+			resp, err := p.Send(context.Background(), testNotification)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, testNotification.ID, resp.NotificationID)
+			assert.Equal(t, domain.SendStatusSucceeded, resp.Status)
+		})
+	}
+}
+
+func TestEmailProvider_SendPartialFailure(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	n := domain.Notification{
+		ID:        12345,
+		Channel:   domain.ChannelEmail,
+		Template:  domain.Template{ID: 1, VersionID: 1},
+		Receivers: []string{"a@example.com", "b@example.com", "c@example.com"},
+	}
+	templateSvc := templatemocks.NewMockChannelTemplateService(ctrl)
+	templateSvc.EXPECT().GetTemplateByIDAndProviderInfo(gomock.Any(), int64(1), "", "mailpit", domain.ChannelEmail, domain.TemplateRegionDomestic).
+		Return(domain.ChannelTemplate{
+			ID:              1,
+			Channel:         domain.ChannelEmail,
+			ActiveVersionID: 1,
+			Versions: []domain.ChannelTemplateVersion{
+				{ID: 1, ChannelTemplateID: 1, Signature: "noreply@example.com", Content: "<p>hi</p>", Subject: "hi"},
+			},
+		}, nil)
+	templateSvc.EXPECT().GetTemplatesByOwner(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	// b 被拒绝后重置会话失败，c 没有发送
+	cli := emailmocks.NewMockClient(ctrl)
+	cli.EXPECT().Send(gomock.Any()).Return(client.SendResp{
+		Receivers: map[string]client.SendRespStatus{
+			"a@example.com": {MessageID: "<1@example.com>", Code: client.OK},
+			"b@example.com": {MessageID: "<2@example.com>", Code: "550", Message: "mailbox unavailable"},
+		},
+	}, errors.New("mock reset error"))
+
+	p := NewEmailProvider("mailpit", templateSvc, render.NewService(templateSvc), cli, http.DefaultClient)
+	resp, err := p.Send(t.Context(), n)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.SendStatusSucceeded, resp.Status)
+	// 只有失败的收件人需要换供应商重试
+	assert.Equal(t, []string{"b@example.com", "c@example.com"}, resp.FailedReceivers())
+	assert.Equal(t, []domain.ReceiverResult{
+		{NotificationID: 12345, Receiver: "a@example.com", Provider: "mailpit", Status: domain.SendStatusSucceeded},
+		{NotificationID: 12345, Receiver: "b@example.com", Provider: "mailpit", Status: domain.SendStatusFailed, ErrCode: "550", ErrMessage: "mailbox unavailable"},
+		{NotificationID: 12345, Receiver: "c@example.com", Provider: "mailpit", Status: domain.SendStatusFailed, ErrMessage: "mock reset error"},
+	}, resp.ReceiverResults)
+}
@@ -0,0 +1,67 @@
+package inapp
+
+import (
+	"context"
+	"fmt"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/service/inbox"
+	"gitee.com/flycash/notification-platform/internal/service/provider"
+	"gitee.com/flycash/notification-platform/internal/service/template/manage"
+	"gitee.com/flycash/notification-platform/internal/service/template/render"
+)
+
+// inAppProvider 站内信供应商，平台自身即为供应商，渲染后直接写入收件箱
+type inAppProvider struct {
+	name        string
+	templateSvc manage.ChannelTemplateService
+	renderer    render.Service
+	inboxSvc    inbox.Service
+}
+
+// NewInAppProvider 站内信供应商
+func NewInAppProvider(name string, templateSvc manage.ChannelTemplateService, renderer render.Service, inboxSvc inbox.Service) provider.Provider {
+	return &inAppProvider{
+		name:        name,
+		templateSvc: templateSvc,
+		renderer:    renderer,
+		inboxSvc:    inboxSvc,
+	}
+}
+
+// Send 投递站内信，模版版本的 Subject 作为标题，每个接收者（用户ID）一条
+func (p *inAppProvider) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
+	tmpl, err := p.templateSvc.GetTemplateByIDAndProviderInfo(ctx, notification.Template.ID, notification.Locale, p.name, domain.ChannelInApp, domain.TemplateRegionDomestic)
+	if err != nil {
+		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
+	}
+
+	activeVersion := tmpl.ActiveVersionFor(notification.Locale)
+	if activeVersion == nil {
+		return domain.SendResponse{}, fmt.Errorf("%w: 无已发布模版", errs.ErrSendNotificationFailed)
+	}
+
+	rendered, err := p.renderer.Render(ctx, tmpl, *activeVersion, notification.Template.Params)
+	if err != nil {
+		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
+	}
+	msgs := make([]domain.InboxMessage, 0, len(notification.Receivers))
+	for _, userID := range notification.Receivers {
+		msgs = append(msgs, domain.InboxMessage{
+			BizID:          notification.BizID,
+			UserID:         userID,
+			NotificationID: notification.ID,
+			Title:          rendered.Subject,
+			Content:        rendered.Content,
+		})
+	}
+	if err = p.inboxSvc.Deliver(ctx, msgs); err != nil {
+		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
+	}
+
+	return domain.SendResponse{
+		NotificationID: notification.ID,
+		Status:         domain.SendStatusSucceeded,
+	}, nil
+}
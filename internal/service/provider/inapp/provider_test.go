@@ -0,0 +1,132 @@
+//go:build unit
+
+package inapp
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	inboxmocks "gitee.com/flycash/notification-platform/internal/service/inbox/mocks"
+	templatemocks "gitee.com/flycash/notification-platform/internal/service/template/mocks"
+	"gitee.com/flycash/notification-platform/internal/service/template/render"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestInAppProvider_Send(t *testing.T) {
+	t.Parallel()
+
+	testNotification := domain.Notification{
+		ID:      uint64(12345),
+		BizID:   1,
+		Channel: domain.ChannelInApp,
+		Template: domain.Template{
+			ID:        1,
+			VersionID: 1,
+			Params:    map[string]string{"order": "A001"},
+		},
+		Receivers: []string{"user-1", "user-2"},
+	}
+
+	version := domain.ChannelTemplateVersion{
+		ID:                1,
+		ChannelTemplateID: testNotification.Template.ID,
+		Name:              "发货通知",
+		Subject:           "订单${order}已发货",
+		Content:           "您的订单${order}已发货，请注意查收",
+		AuditStatus:       domain.AuditStatusApproved,
+	}
+	testTemplate := domain.ChannelTemplate{
+		ID:              testNotification.Template.ID,
+		Channel:         domain.ChannelInApp,
+		Versions:        []domain.ChannelTemplateVersion{version},
+		ActiveVersionID: version.ID,
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(templateSvc *templatemocks.MockChannelTemplateService, inboxSvc *inboxmocks.MockService)
+		wantErr   error
+	}{
+		{
+			name: "获取模板失败",
+			setupMock: func(templateSvc *templatemocks.MockChannelTemplateService, _ *inboxmocks.MockService) {
+				templateSvc.EXPECT().
+					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, "", "inbox", domain.ChannelInApp, domain.TemplateRegionDomestic).
+					Return(domain.ChannelTemplate{}, errors.New("获取模板失败"))
+			},
+			wantErr: errs.ErrSendNotificationFailed,
+		},
+		{
+			name: "无已发布模版",
+			setupMock: func(templateSvc *templatemocks.MockChannelTemplateService, _ *inboxmocks.MockService) {
+				templateSvc.EXPECT().
+					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, "", "inbox", domain.ChannelInApp, domain.TemplateRegionDomestic).
+					Return(domain.ChannelTemplate{ID: testNotification.Template.ID, Channel: domain.ChannelInApp}, nil)
+			},
+			wantErr: errs.ErrSendNotificationFailed,
+		},
+		{
+			name: "写入收件箱失败",
+			setupMock: func(templateSvc *templatemocks.MockChannelTemplateService, inboxSvc *inboxmocks.MockService) {
+				templateSvc.EXPECT().
+					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, "", "inbox", domain.ChannelInApp, domain.TemplateRegionDomestic).
+					Return(testTemplate, nil)
+				inboxSvc.EXPECT().Deliver(gomock.Any(), gomock.Any()).Return(errors.New("mock db error"))
+			},
+			wantErr: errs.ErrSendNotificationFailed,
+		},
+		{
+			name: "投递成功",
+			setupMock: func(templateSvc *templatemocks.MockChannelTemplateService, inboxSvc *inboxmocks.MockService) {
+				templateSvc.EXPECT().
+					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, "", "inbox", domain.ChannelInApp, domain.TemplateRegionDomestic).
+					Return(testTemplate, nil)
+				inboxSvc.EXPECT().Deliver(gomock.Any(), []domain.InboxMessage{
+					{
+						BizID:          1,
+						UserID:         "user-1",
+						NotificationID: testNotification.ID,
+						Title:          "订单A001已发货",
+						Content:        "您的订单A001已发货，请注意查收",
+					},
+					{
+						BizID:          1,
+						UserID:         "user-2",
+						NotificationID: testNotification.ID,
+						Title:          "订单A001已发货",
+						Content:        "您的订单A001已发货，请注意查收",
+					},
+				}).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTemplateSvc := templatemocks.NewMockChannelTemplateService(ctrl)
+			mockInboxSvc := inboxmocks.NewMockService(ctrl)
+			tt.setupMock(mockTemplateSvc, mockInboxSvc)
+			// 没有公共片段
+			mockTemplateSvc.EXPECT().GetTemplatesByOwner(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+			p := NewInAppProvider("inbox", mockTemplateSvc, render.NewService(mockTemplateSvc), mockInboxSvc)
+			resp, err := p.Send(context.Background(), testNotification)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, testNotification.ID, resp.NotificationID)
+			assert.Equal(t, domain.SendStatusSucceeded, resp.Status)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package limit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/pkg/ratelimit"
+	"gitee.com/flycash/notification-platform/internal/service/provider"
+	"github.com/gotomicro/ego/core/elog"
+	"github.com/redis/go-redis/v9"
+)
+
+var _ provider.Provider = (*Provider)(nil)
+
+// Provider 为供应商添加分布式限流的装饰器，按供应商表中的 QPSLimit 和 DailyLimit 在所有节点间共享计数
+// 每个接收者占用一个额度，达到上限时返回 errs.ErrRateLimited，渠道会继续尝试下一个供应商
+type Provider struct {
+	provider     provider.Provider
+	name         string
+	qpsLimiter   ratelimit.CountLimiter
+	dailyLimiter ratelimit.CountLimiter
+	logger       *elog.Component
+}
+
+// NewProvider 创建一个新的带有限流的供应商
+// name 用作限流键，需要区分渠道和供应商，例如 SMS:aliyun
+func NewProvider(p provider.Provider, name string, qpsLimiter, dailyLimiter ratelimit.CountLimiter) *Provider {
+	return &Provider{
+		provider:     p,
+		name:         name,
+		qpsLimiter:   qpsLimiter,
+		dailyLimiter: dailyLimiter,
+		logger:       elog.DefaultLogger.With(elog.FieldComponent("provider.limit")),
+	}
+}
+
+// NewRedisProvider 按供应商表中的 QPSLimit 和 DailyLimit 创建基于 Redis 的限流供应商，每日额度在本地时间零点重置
+func NewRedisProvider(p provider.Provider, cmd redis.Cmdable, entity domain.Provider) *Provider {
+	return NewProvider(p,
+		fmt.Sprintf("provider:%s:%s", entity.Channel, entity.Name),
+		ratelimit.NewRedisFixedWindowLimiter(cmd, time.Second, entity.QPSLimit),
+		ratelimit.NewRedisFixedWindowLimiter(cmd, 24*time.Hour, entity.DailyLimit),
+	)
+}
+
+func (p *Provider) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
+	n := max(len(notification.Receivers), 1)
+	// 先占用当天额度，当天额度用完时不会再占用每秒的额度
+	dailyKey := p.name + ":daily"
+	acquired, err := p.acquire(ctx, p.dailyLimiter, dailyKey, n)
+	if err != nil {
+		return domain.SendResponse{}, err
+	}
+	if _, err = p.acquire(ctx, p.qpsLimiter, p.name+":qps", n); err != nil {
+		// 被每秒上限拒绝的请求不会发送，归还当天额度
+		if acquired {
+			p.release(ctx, p.dailyLimiter, dailyKey, n)
+		}
+		return domain.SendResponse{}, err
+	}
+	return p.provider.Send(ctx, notification)
+}
+
+// acquire 占用 n 个额度，返回是否实际占用了额度
+func (p *Provider) acquire(ctx context.Context, limiter ratelimit.CountLimiter, key string, n int) (bool, error) {
+	limited, err := limiter.LimitN(ctx, key, n)
+	if err != nil {
+		// Redis 故障时放行，由供应商自身的限流兜底
+		p.logger.Warn("供应商限流检查失败", elog.String("key", key), elog.FieldErr(err))
+		return false, nil
+	}
+	if limited {
+		return false, fmt.Errorf("%w: %s", errs.ErrRateLimited, key)
+	}
+	return true, nil
+}
+
+func (p *Provider) release(ctx context.Context, limiter ratelimit.CountLimiter, key string, n int) {
+	if err := limiter.Release(ctx, key, n); err != nil {
+		p.logger.Warn("归还供应商额度失败", elog.String("key", key), elog.FieldErr(err))
+	}
+}
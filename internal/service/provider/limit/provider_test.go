@@ -0,0 +1,91 @@
+//go:build unit
+
+package limit
+
+import (
+	"errors"
+	"testing"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	limitmocks "gitee.com/flycash/notification-platform/internal/pkg/ratelimit/mocks"
+	providermocks "gitee.com/flycash/notification-platform/internal/service/provider/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestProvider_Send(t *testing.T) {
+	t.Parallel()
+
+	const name = "provider:SMS:aliyun"
+	testNotification := domain.Notification{ID: 12345, Channel: domain.ChannelSMS, Receivers: []string{"13800138000", "13900139000"}}
+	succeeded := domain.SendResponse{NotificationID: testNotification.ID, Status: domain.SendStatusSucceeded}
+
+	tests := []struct {
+		name      string
+		setupMock func(p *providermocks.MockProvider, qps, daily *limitmocks.MockCountLimiter)
+		wantResp  domain.SendResponse
+		wantErr   error
+	}{
+		{
+			name: "未达到上限，每个接收者占用一个额度",
+			setupMock: func(p *providermocks.MockProvider, qps, daily *limitmocks.MockCountLimiter) {
+				daily.EXPECT().LimitN(gomock.Any(), name+":daily", 2).Return(false, nil)
+				qps.EXPECT().LimitN(gomock.Any(), name+":qps", 2).Return(false, nil)
+				p.EXPECT().Send(gomock.Any(), testNotification).Return(succeeded, nil)
+			},
+			wantResp: succeeded,
+		},
+		{
+			name: "达到当天上限，不占用每秒额度",
+			setupMock: func(_ *providermocks.MockProvider, _, daily *limitmocks.MockCountLimiter) {
+				daily.EXPECT().LimitN(gomock.Any(), name+":daily", 2).Return(true, nil)
+			},
+			wantErr: errs.ErrRateLimited,
+		},
+		{
+			name: "达到每秒上限，归还当天额度",
+			setupMock: func(_ *providermocks.MockProvider, qps, daily *limitmocks.MockCountLimiter) {
+				daily.EXPECT().LimitN(gomock.Any(), name+":daily", 2).Return(false, nil)
+				qps.EXPECT().LimitN(gomock.Any(), name+":qps", 2).Return(true, nil)
+				daily.EXPECT().Release(gomock.Any(), name+":daily", 2).Return(nil)
+			},
+			wantErr: errs.ErrRateLimited,
+		},
+		{
+			name: "当天额度检查失败时放行，没有占用的额度不归还",
+			setupMock: func(_ *providermocks.MockProvider, qps, daily *limitmocks.MockCountLimiter) {
+				daily.EXPECT().LimitN(gomock.Any(), name+":daily", 2).Return(false, errors.New("mock redis error"))
+				qps.EXPECT().LimitN(gomock.Any(), name+":qps", 2).Return(true, nil)
+			},
+			wantErr: errs.ErrRateLimited,
+		},
+		{
+			name: "限流检查失败时放行",
+			setupMock: func(p *providermocks.MockProvider, qps, daily *limitmocks.MockCountLimiter) {
+				daily.EXPECT().LimitN(gomock.Any(), name+":daily", 2).Return(false, nil)
+				qps.EXPECT().LimitN(gomock.Any(), name+":qps", 2).Return(false, errors.New("mock redis error"))
+				p.EXPECT().Send(gomock.Any(), testNotification).Return(succeeded, nil)
+			},
+			wantResp: succeeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockProvider := providermocks.NewMockProvider(ctrl)
+			qps := limitmocks.NewMockCountLimiter(ctrl)
+			daily := limitmocks.NewMockCountLimiter(ctrl)
+			tt.setupMock(mockProvider, qps, daily)
+
+			p := NewProvider(mockProvider, name, qps, daily)
+			resp, err := p.Send(t.Context(), testNotification)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantResp, resp)
+		})
+	}
+}
@@ -0,0 +1,251 @@
+package loadbalancer
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/service/provider"
+	"gitee.com/flycash/notification-platform/internal/service/provider/pricing"
+	"gitee.com/flycash/notification-platform/internal/service/provider/registry"
+	templatesvc "gitee.com/flycash/notification-platform/internal/service/template/manage"
+	"github.com/gotomicro/ego/core/elog"
+)
+
+var (
+	_ provider.Selector        = (*costSelector)(nil)
+	_ provider.SelectorBuilder = (*CostSelectorBuilder)(nil)
+	_ provider.Provider        = (*pricedProvider)(nil)
+	_ registry.Updater         = (*CostSelectorBuilder)(nil)
+)
+
+// CostSelectorBuilder 按价格选择供应商，优先使用最便宜的健康供应商
+// 价格按渠道、国家码和模版的业务类型从价格表中查找，接收者来自多个国家时按各国家的单价计算总价，
+// 有国家没有配置价格的供应商排在最后
+// 达到限额的供应商会返回 errs.ErrRateLimited，渠道随后会尝试下一个更贵的供应商
+type CostSelectorBuilder struct {
+	channel     domain.Channel
+	pricingSvc  pricing.Service
+	templateSvc templatesvc.ChannelTemplateService
+	// preferReliability 验证码优先选择近期失败最少的供应商，价格其次
+	preferReliability bool
+	breaker           BreakerConfig
+
+	refresher *refresher
+
+	mu        sync.RWMutex
+	providers map[string]*mprovider
+	prices    domain.PriceTable
+
+	// businessTypes 模版ID到业务类型的缓存，模版的业务类型创建后不会修改
+	businessTypes sync.Map
+	logger        *elog.Component
+}
+
+// NewCostSelectorBuilder providers 的 key 为供应商名称，与价格表中的 Provider 一致
+func NewCostSelectorBuilder(
+	channel domain.Channel,
+	providers map[string]provider.Provider,
+	pricingSvc pricing.Service,
+	templateSvc templatesvc.ChannelTemplateService,
+	preferReliability bool,
+	refreshInterval time.Duration,
+	breaker BreakerConfig,
+) *CostSelectorBuilder {
+	return &CostSelectorBuilder{
+		channel:           channel,
+		pricingSvc:        pricingSvc,
+		templateSvc:       templateSvc,
+		preferReliability: preferReliability,
+		breaker:           breaker,
+		providers:         newMproviders(channel, providers, breaker),
+		refresher:         newRefresher(refreshInterval),
+		logger:            elog.DefaultLogger.With(elog.FieldComponent("loadbalancer")),
+	}
+}
+
+// Build 不访问数据库，到了刷新时间时在后台重新加载价格表
+func (b *CostSelectorBuilder) Build() (provider.Selector, error) {
+	b.refresher.refresh(b.loadPrices)
+	return &costSelector{builder: b}, nil
+}
+
+// UpdateProviders 替换供应商，正在进行的发送继续使用旧的供应商
+func (b *CostSelectorBuilder) UpdateProviders(providers map[string]provider.Provider) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.providers = updateMproviders(b.channel, b.providers, providers, b.breaker)
+}
+
+// loadPrices 重新加载价格表，加载失败时继续使用旧价格
+func (b *CostSelectorBuilder) loadPrices(ctx context.Context) {
+	prices, err := b.pricingSvc.GetPriceTable(ctx, b.channel)
+	if err != nil {
+		b.logger.Warn("加载供应商价格失败，继续使用旧价格", elog.FieldErr(err))
+		return
+	}
+	b.mu.Lock()
+	b.prices = prices
+	b.mu.Unlock()
+}
+
+// costCandidate 一次发送中按顺序尝试的供应商
+type costCandidate struct {
+	name string
+	mp   *mprovider
+	// prices 接收者所在国家的单价，key 为国家码
+	prices   map[string]domain.ProviderPrice
+	total    int64
+	priced   bool
+	failures int
+}
+
+// rank 按价格（验证码按可靠性）对供应商排序，同时返回每个接收者的国家码
+func (b *CostSelectorBuilder) rank(ctx context.Context, notification domain.Notification) (candidates []costCandidate, countryCodes map[string]string, businessType domain.BusinessType) {
+	b.mu.RLock()
+	prices, providers := b.prices, b.providers
+	b.mu.RUnlock()
+
+	businessType = b.businessType(ctx, notification.Template.ID)
+	countryCodes = make(map[string]string, len(notification.Receivers))
+	quantities := make(map[string]int64)
+	for _, r := range notification.Receivers {
+		code := prices.CountryCode(r)
+		countryCodes[r] = code
+		quantities[code]++
+	}
+	candidates = make([]costCandidate, 0, len(providers))
+	for name, mp := range providers {
+		c := costCandidate{
+			name:     name,
+			mp:       mp,
+			prices:   make(map[string]domain.ProviderPrice, len(quantities)),
+			priced:   true,
+			failures: mp.getFailed(),
+		}
+		for code, quantity := range quantities {
+			price, ok := prices.Lookup(name, code, businessType)
+			c.prices[code] = price
+			c.total += price.UnitPrice * quantity
+			c.priced = c.priced && ok
+		}
+		candidates = append(candidates, c)
+	}
+
+	totalPrice := func(c costCandidate) int64 {
+		if !c.priced {
+			return math.MaxInt64
+		}
+		return c.total
+	}
+	byReliability := b.preferReliability && businessType == domain.BusinessTypeVerificationCode
+	slices.SortFunc(candidates, func(x, y costCandidate) int {
+		byPrice := cmp.Compare(totalPrice(x), totalPrice(y))
+		byFailures := cmp.Compare(x.failures, y.failures)
+		if byReliability {
+			return cmp.Or(byFailures, byPrice, cmp.Compare(x.name, y.name))
+		}
+		return cmp.Or(byPrice, byFailures, cmp.Compare(x.name, y.name))
+	})
+	return candidates, countryCodes, businessType
+}
+
+// businessType 查询模版的业务类型，查询失败时按未知类型处理，只使用通用价格
+func (b *CostSelectorBuilder) businessType(ctx context.Context, templateID int64) domain.BusinessType {
+	if v, ok := b.businessTypes.Load(templateID); ok {
+		return v.(domain.BusinessType)
+	}
+	tmpl, err := b.templateSvc.GetTemplateByID(ctx, templateID)
+	if err != nil {
+		b.logger.Warn("查询模版业务类型失败", elog.Int64("TemplateID", templateID), elog.FieldErr(err))
+		return 0
+	}
+	b.businessTypes.Store(templateID, tmpl.BusinessType)
+	return tmpl.BusinessType
+}
+
+// costSelector 单次发送的选择器，第一次调用 Next 时确定尝试顺序
+type costSelector struct {
+	builder      *CostSelectorBuilder
+	ranked       bool
+	candidates   []costCandidate
+	countryCodes map[string]string
+	businessType domain.BusinessType
+	idx          int
+}
+
+func (s *costSelector) Next(ctx context.Context, notification domain.Notification) (provider.Provider, error) {
+	if !s.ranked {
+		s.candidates, s.countryCodes, s.businessType = s.builder.rank(ctx, notification)
+		s.ranked = true
+	}
+	for s.idx < len(s.candidates) {
+		c := s.candidates[s.idx]
+		s.idx++
+		if !c.mp.isHealthy() {
+			continue
+		}
+		return &pricedProvider{
+			mprovider: c.mp,
+			svc:       s.builder.pricingSvc,
+			cost: domain.SendCost{
+				Provider:     c.name,
+				Channel:      s.builder.channel,
+				BusinessType: s.businessType,
+			},
+			prices:       c.prices,
+			countryCodes: s.countryCodes,
+			logger:       s.builder.logger,
+		}, nil
+	}
+	return nil, fmt.Errorf("%w", errs.ErrNoAvailableProvider)
+}
+
+// pricedProvider 发送成功后按国家码分别记录本次使用的价格
+type pricedProvider struct {
+	*mprovider
+	svc  pricing.Service
+	cost domain.SendCost
+	// prices 各国家的单价，countryCodes 每个接收者的国家码
+	prices       map[string]domain.ProviderPrice
+	countryCodes map[string]string
+	logger       *elog.Component
+}
+
+func (p *pricedProvider) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
+	resp, err := p.mprovider.Send(ctx, notification)
+	if err != nil {
+		return resp, err
+	}
+	// 供应商拒绝的接收者不计费，没有返回结果的接收者视为发送成功
+	failed := resp.FailedReceivers()
+	quantities := make(map[string]int64)
+	for _, r := range notification.Receivers {
+		if !slices.Contains(failed, r) {
+			quantities[p.countryCodes[r]]++
+		}
+	}
+	for _, code := range slices.Sorted(maps.Keys(quantities)) {
+		cost := p.cost
+		cost.NotificationID = notification.ID
+		cost.BizID = notification.BizID
+		cost.CountryCode = code
+		cost.UnitPrice = p.prices[code].UnitPrice
+		cost.Quantity = quantities[code]
+		// 费用记录失败不影响发送结果
+		if err1 := p.svc.RecordCost(ctx, cost); err1 != nil {
+			p.logger.Warn("记录发送费用失败",
+				elog.FieldKey("NotificationID"),
+				elog.FieldValueAny(notification.ID),
+				elog.FieldErr(err1))
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,286 @@
+//go:build unit
+
+package loadbalancer
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/service/provider"
+	providermocks "gitee.com/flycash/notification-platform/internal/service/provider/mocks"
+	pricingmocks "gitee.com/flycash/notification-platform/internal/service/provider/pricing/mocks"
+	templatemocks "gitee.com/flycash/notification-platform/internal/service/template/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var testPriceTable = domain.PriceTable{
+	{Provider: "aliyun", Channel: domain.ChannelSMS, UnitPrice: 450},
+	{Provider: "aliyun", Channel: domain.ChannelSMS, CountryCode: "1", UnitPrice: 600},
+	{Provider: "tencentcloud", Channel: domain.ChannelSMS, UnitPrice: 400},
+	{Provider: "tencentcloud", Channel: domain.ChannelSMS, CountryCode: "1", UnitPrice: 800},
+	{Provider: "tencentcloud", Channel: domain.ChannelSMS, BusinessType: domain.BusinessTypePromotion, UnitPrice: 500},
+}
+
+// costNames 构建一次选择器并返回依次选出的所有供应商名称
+func costNames(t *testing.T, b *CostSelectorBuilder, n domain.Notification) []string {
+	t.Helper()
+	selector, err := b.Build()
+	require.NoError(t, err)
+	// 价格表在后台加载，等待加载完成后再排序
+	b.refresher.wg.Wait()
+	var names []string
+	for {
+		p, err1 := selector.Next(t.Context(), n)
+		if errors.Is(err1, errs.ErrNoAvailableProvider) {
+			return names
+		}
+		require.NoError(t, err1)
+		names = append(names, p.(*pricedProvider).Provider.(*MockHealthAwareProvider).name)
+	}
+}
+
+func TestCostSelectorBuilder_Rank(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		businessType domain.BusinessType
+		receivers    []string
+		// 预先标记失败次数的供应商
+		failures          map[string]int
+		preferReliability bool
+		want              []string
+	}{
+		{
+			name:         "通用价格最便宜的优先",
+			businessType: domain.BusinessTypeNotification,
+			receivers:    []string{"13800138000"},
+			want:         []string{"tencentcloud", "aliyun", "huawei"},
+		},
+		{
+			name:         "按业务类型的价格选择",
+			businessType: domain.BusinessTypePromotion,
+			receivers:    []string{"13800138000"},
+			want:         []string{"aliyun", "tencentcloud", "huawei"},
+		},
+		{
+			name:         "按国家码的价格选择",
+			businessType: domain.BusinessTypeNotification,
+			receivers:    []string{"+14155550100"},
+			want:         []string{"aliyun", "tencentcloud", "huawei"},
+		},
+		{
+			// aliyun 450*3+600=1950，tencentcloud 400*3+800=2000
+			name:         "按所有接收者的总价选择",
+			businessType: domain.BusinessTypeNotification,
+			receivers:    []string{"13800138000", "13900139000", "13700137000", "+14155550100"},
+			want:         []string{"aliyun", "tencentcloud", "huawei"},
+		},
+		{
+			name:              "验证码优先选择失败少的供应商",
+			businessType:      domain.BusinessTypeVerificationCode,
+			receivers:         []string{"13800138000"},
+			failures:          map[string]int{"tencentcloud": 3},
+			preferReliability: true,
+			want:              []string{"aliyun", "huawei", "tencentcloud"},
+		},
+		{
+			name:         "关闭可靠性优先时验证码也按价格选择",
+			businessType: domain.BusinessTypeVerificationCode,
+			receivers:    []string{"13800138000"},
+			failures:     map[string]int{"tencentcloud": 3},
+			want:         []string{"tencentcloud", "aliyun", "huawei"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pricingSvc := pricingmocks.NewMockService(ctrl)
+			pricingSvc.EXPECT().GetPriceTable(gomock.Any(), domain.ChannelSMS).Return(testPriceTable, nil)
+			templateSvc := templatemocks.NewMockChannelTemplateService(ctrl)
+			templateSvc.EXPECT().GetTemplateByID(gomock.Any(), int64(1)).
+				Return(domain.ChannelTemplate{ID: 1, BusinessType: tt.businessType}, nil)
+
+			b := NewCostSelectorBuilder(domain.ChannelSMS, newWeightedTestProviders("aliyun", "tencentcloud", "huawei"),
+				pricingSvc, templateSvc, tt.preferReliability, time.Hour, BreakerConfig{BufferLen: 10})
+			for name, cnt := range tt.failures {
+				for i := 0; i < cnt; i++ {
+					b.providers[name].markFail()
+				}
+			}
+			got := costNames(t, b, domain.Notification{
+				Receivers: tt.receivers,
+				Template:  domain.Template{ID: 1},
+			})
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCostSelectorBuilder_SkipUnhealthy(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pricingSvc := pricingmocks.NewMockService(ctrl)
+	pricingSvc.EXPECT().GetPriceTable(gomock.Any(), domain.ChannelSMS).Return(testPriceTable, nil)
+	templateSvc := templatemocks.NewMockChannelTemplateService(ctrl)
+	// 查询模版失败时按通用价格选择
+	templateSvc.EXPECT().GetTemplateByID(gomock.Any(), int64(1)).
+		Return(domain.ChannelTemplate{}, errors.New("mock error")).Times(2)
+
+	b := NewCostSelectorBuilder(domain.ChannelSMS, newWeightedTestProviders("aliyun", "tencentcloud"),
+		pricingSvc, templateSvc, true, time.Hour, BreakerConfig{BufferLen: 10})
+	b.providers["tencentcloud"].block(time.Hour)
+	n := domain.Notification{Receivers: []string{"13800138000"}, Template: domain.Template{ID: 1}}
+	assert.Equal(t, []string{"aliyun"}, costNames(t, b, n))
+
+	b.providers["aliyun"].block(time.Hour)
+	assert.Empty(t, costNames(t, b, n))
+}
+
+func TestCostSelectorBuilder_RecordCost(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pricingSvc := pricingmocks.NewMockService(ctrl)
+	pricingSvc.EXPECT().GetPriceTable(gomock.Any(), domain.ChannelSMS).Return(testPriceTable, nil)
+	pricingSvc.EXPECT().RecordCost(gomock.Any(), domain.SendCost{
+		NotificationID: 123,
+		BizID:          456,
+		Provider:       "tencentcloud",
+		Channel:        domain.ChannelSMS,
+		CountryCode:    domain.DefaultCountryCode,
+		BusinessType:   domain.BusinessTypePromotion,
+		UnitPrice:      500,
+		Quantity:       2,
+	}).Return(errors.New("mock error"))
+	templateSvc := templatemocks.NewMockChannelTemplateService(ctrl)
+	templateSvc.EXPECT().GetTemplateByID(gomock.Any(), int64(1)).
+		Return(domain.ChannelTemplate{ID: 1, BusinessType: domain.BusinessTypePromotion}, nil)
+
+	b := NewCostSelectorBuilder(domain.ChannelSMS, newWeightedTestProviders("tencentcloud"),
+		pricingSvc, templateSvc, true, time.Hour, BreakerConfig{BufferLen: 10})
+	selector, err := b.Build()
+	require.NoError(t, err)
+	b.refresher.wg.Wait()
+	n := domain.Notification{
+		ID:        123,
+		BizID:     456,
+		Receivers: []string{"13800138000", "13900139000"},
+		Template:  domain.Template{ID: 1},
+	}
+	p, err := selector.Next(t.Context(), n)
+	require.NoError(t, err)
+	// 记录费用失败不影响发送结果
+	resp, err := p.Send(t.Context(), n)
+	require.NoError(t, err)
+	assert.Equal(t, domain.SendStatusSucceeded, resp.Status)
+}
+
+func TestCostSelectorBuilder_RecordCostPartialFailure(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	n := domain.Notification{
+		ID:        123,
+		BizID:     456,
+		Receivers: []string{"13800138000", "13900139000", "13700137000"},
+		Template:  domain.Template{ID: 1},
+	}
+	// 供应商拒绝了一个号码，只有两个号码计费
+	mockProvider := providermocks.NewMockProvider(ctrl)
+	mockProvider.EXPECT().Send(gomock.Any(), n).Return(domain.SendResponse{
+		NotificationID: 123,
+		Status:         domain.SendStatusSucceeded,
+		ReceiverResults: []domain.ReceiverResult{
+			{Receiver: "13800138000", Status: domain.SendStatusSucceeded},
+			{Receiver: "13900139000", Status: domain.SendStatusFailed, ErrCode: "isv.MOBILE_NUMBER_ILLEGAL"},
+		},
+	}, nil)
+	pricingSvc := pricingmocks.NewMockService(ctrl)
+	pricingSvc.EXPECT().GetPriceTable(gomock.Any(), domain.ChannelSMS).Return(testPriceTable, nil)
+	pricingSvc.EXPECT().RecordCost(gomock.Any(), domain.SendCost{
+		NotificationID: 123,
+		BizID:          456,
+		Provider:       "tencentcloud",
+		Channel:        domain.ChannelSMS,
+		CountryCode:    domain.DefaultCountryCode,
+		BusinessType:   domain.BusinessTypeNotification,
+		UnitPrice:      400,
+		Quantity:       2,
+	}).Return(nil)
+	templateSvc := templatemocks.NewMockChannelTemplateService(ctrl)
+	templateSvc.EXPECT().GetTemplateByID(gomock.Any(), int64(1)).
+		Return(domain.ChannelTemplate{ID: 1, BusinessType: domain.BusinessTypeNotification}, nil)
+
+	b := NewCostSelectorBuilder(domain.ChannelSMS, map[string]provider.Provider{"tencentcloud": mockProvider},
+		pricingSvc, templateSvc, true, time.Hour, BreakerConfig{BufferLen: 10})
+	selector, err := b.Build()
+	require.NoError(t, err)
+	b.refresher.wg.Wait()
+	p, err := selector.Next(t.Context(), n)
+	require.NoError(t, err)
+	_, err = p.Send(t.Context(), n)
+	require.NoError(t, err)
+}
+
+func TestCostSelectorBuilder_RecordCostByCountry(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	n := domain.Notification{
+		ID:        123,
+		BizID:     456,
+		Receivers: []string{"13800138000", "+14155550100", "13900139000"},
+		Template:  domain.Template{ID: 1},
+	}
+	pricingSvc := pricingmocks.NewMockService(ctrl)
+	pricingSvc.EXPECT().GetPriceTable(gomock.Any(), domain.ChannelSMS).Return(testPriceTable, nil)
+	// 每个国家码按各自的单价记录一条费用
+	pricingSvc.EXPECT().RecordCost(gomock.Any(), domain.SendCost{
+		NotificationID: 123,
+		BizID:          456,
+		Provider:       "aliyun",
+		Channel:        domain.ChannelSMS,
+		CountryCode:    "1",
+		BusinessType:   domain.BusinessTypeNotification,
+		UnitPrice:      600,
+		Quantity:       1,
+	}).Return(nil)
+	pricingSvc.EXPECT().RecordCost(gomock.Any(), domain.SendCost{
+		NotificationID: 123,
+		BizID:          456,
+		Provider:       "aliyun",
+		Channel:        domain.ChannelSMS,
+		CountryCode:    domain.DefaultCountryCode,
+		BusinessType:   domain.BusinessTypeNotification,
+		UnitPrice:      450,
+		Quantity:       2,
+	}).Return(nil)
+	templateSvc := templatemocks.NewMockChannelTemplateService(ctrl)
+	templateSvc.EXPECT().GetTemplateByID(gomock.Any(), int64(1)).
+		Return(domain.ChannelTemplate{ID: 1, BusinessType: domain.BusinessTypeNotification}, nil)
+
+	b := NewCostSelectorBuilder(domain.ChannelSMS, newWeightedTestProviders("aliyun"),
+		pricingSvc, templateSvc, true, time.Hour, BreakerConfig{BufferLen: 10})
+	selector, err := b.Build()
+	require.NoError(t, err)
+	b.refresher.wg.Wait()
+	p, err := selector.Next(t.Context(), n)
+	require.NoError(t, err)
+	_, err = p.Send(t.Context(), n)
+	require.NoError(t, err)
+}
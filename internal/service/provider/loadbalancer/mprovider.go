@@ -2,53 +2,129 @@ package loadbalancer
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"math/bits"
-	"sync"
 	"sync/atomic"
 	"time"
 
 	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/pkg/circuitbreaker"
 	"gitee.com/flycash/notification-platform/internal/service/provider"
+	"github.com/gotomicro/ego/core/elog"
 )
 
 const (
 	defaultNumberLen   = 64  // Number of bits in uint64
+	defaultBufferLen   = 10  // 默认缓冲区长度
 	defaultFailPercent = 0.1 // Default threshold percentage for failures
 
 	// Bit manipulation constants
 	bitsPerUint64      = 64
-	bitsPerUint64Shift = 6    // log2(64), used for division by 64
-	bitMask            = 63   // 2^6 - 1, used for modulo 64
-	initialHealth      = true // Initial health status
-	recoverSecond      = 3
+	bitsPerUint64Shift = 6  // log2(64), used for division by 64
+	bitMask            = 63 // 2^6 - 1, used for modulo 64
+
+	// fallbackOpenDuration 熔断器不可用时，本地暂停使用供应商的时长
+	fallbackOpenDuration = 30 * time.Second
 )
 
+// BreakerConfig 供应商熔断配置
+type BreakerConfig struct {
+	// BufferLen 统计失败率的滑动窗口长度，单位为64个请求
+	BufferLen int
+	// FailPercent 窗口内失败比例超过该值时打开熔断器
+	FailPercent float64
+	// Breaker 熔断状态，多实例部署时使用 circuitbreaker.RedisCircuitBreaker 共享状态，为 nil 时使用本地熔断器
+	Breaker circuitbreaker.CircuitBreaker
+}
+
+func (c BreakerConfig) withDefaults() BreakerConfig {
+	if c.BufferLen <= 0 {
+		c.BufferLen = defaultBufferLen
+	}
+	if c.FailPercent <= 0 || c.FailPercent > 1 {
+		c.FailPercent = defaultFailPercent
+	}
+	if c.Breaker == nil {
+		c.Breaker = circuitbreaker.NewLocalCircuitBreaker(circuitbreaker.Config{})
+	}
+	return c
+}
+
+// newMproviders providers 的 key 为供应商名称，同一渠道的供应商共用一个熔断器
+func newMproviders(channel domain.Channel, providers map[string]provider.Provider, cfg BreakerConfig) map[string]*mprovider {
+	cfg = cfg.withDefaults()
+	mproviders := make(map[string]*mprovider, len(providers))
+	for name, p := range providers {
+		mp := newMprovider(fmt.Sprintf("provider:%s:%s", channel, name), p, cfg)
+		mproviders[name] = &mp
+	}
+	return mproviders
+}
+
+// updateMproviders 替换供应商，没有变化的供应商沿用原来的 mprovider，保留本地的失败率统计
+func updateMproviders(
+	channel domain.Channel,
+	old map[string]*mprovider,
+	providers map[string]provider.Provider,
+	cfg BreakerConfig,
+) map[string]*mprovider {
+	cfg = cfg.withDefaults()
+	mproviders := make(map[string]*mprovider, len(providers))
+	for name, p := range providers {
+		if mp, ok := old[name]; ok && mp.Provider == p {
+			mproviders[name] = mp
+			continue
+		}
+		mp := newMprovider(fmt.Sprintf("provider:%s:%s", channel, name), p, cfg)
+		mproviders[name] = &mp
+	}
+	return mproviders
+}
+
+// mprovider 带熔断的供应商
+// 本地用比特环统计失败率，超过阈值时打开熔断器；熔断状态保存在 CircuitBreaker 中，
+// 打开到期后进入半开状态，只放行少量探测请求，探测全部成功后关闭，失败则以更长的时长重新打开
 type mprovider struct {
 	provider.Provider
-	healthy       *atomic.Bool
+	key     string
+	breaker circuitbreaker.CircuitBreaker
+	// blockedUntil 本地缓存的熔断到期时间（毫秒），选择器据此跳过熔断中的供应商，避免每次选择都访问熔断器
+	blockedUntil  *atomic.Int64
 	ringBuffer    []uint64 // 比特环（滑动窗口存储）
 	reqCount      uint64   // 请求数量
 	bufferLen     int      // 滑动窗口长度
 	bitCnt        uint64   // 比特位总数
 	failThreshold int
-	mu            *sync.RWMutex
+	logger        *elog.Component
 }
 
 func (s *mprovider) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
+	decision, err := s.breaker.Allow(ctx, s.key)
+	if err != nil {
+		// 熔断器不可用时放行，由本地的失败率统计兜底
+		s.logger.Warn("查询熔断状态失败", elog.String("Key", s.key), elog.FieldErr(err))
+		decision = circuitbreaker.Decision{Allowed: true, State: circuitbreaker.StateClosed}
+	}
+	if !decision.Allowed {
+		s.block(decision.RetryAfter)
+		return domain.SendResponse{}, fmt.Errorf("%w: %s", errs.ErrCircuitBreaker, s.key)
+	}
+
 	res, err := s.Provider.Send(ctx, notification)
+	if errors.Is(err, errs.ErrRateLimited) {
+		// 达到供应商限额不代表供应商出了问题，不计入失败
+		return res, err
+	}
+	if decision.State == circuitbreaker.StateHalfOpen {
+		s.reportProbe(ctx, err == nil)
+		return res, err
+	}
 	if err != nil {
 		s.markFail()
-		v := s.getFailed()
-		if v > s.failThreshold {
-			if s.healthy.CompareAndSwap(true, false) {
-				const waitTime = time.Minute
-				time.AfterFunc(waitTime, func() {
-					s.healthy.Store(true)
-					s.mu.Lock()
-					s.ringBuffer = make([]uint64, s.bufferLen)
-					s.mu.Unlock()
-				})
-			}
+		if s.getFailed() > s.failThreshold {
+			s.trip(ctx)
 		}
 	} else {
 		s.markSuccess()
@@ -56,18 +132,51 @@ func (s *mprovider) Send(ctx context.Context, notification domain.Notification)
 	return res, err
 }
 
-func newMprovider(pro provider.Provider, bufferLen int) mprovider {
-	health := &atomic.Bool{}
-	health.Store(initialHealth)
-	bitCnt := uint64(defaultNumberLen) * uint64(bufferLen)
+// trip 失败率超过阈值，打开熔断器并清空比特环，恢复后重新统计
+func (s *mprovider) trip(ctx context.Context) {
+	d, err := s.breaker.Trip(ctx, s.key)
+	if err != nil {
+		s.logger.Warn("打开熔断器失败，本地暂停使用供应商", elog.String("Key", s.key), elog.FieldErr(err))
+		d = fallbackOpenDuration
+	} else {
+		s.logger.Warn("供应商失败率过高，打开熔断器", elog.String("Key", s.key), elog.Duration("OpenDuration", d))
+	}
+	for i := range s.ringBuffer {
+		atomic.StoreUint64(&s.ringBuffer[i], 0)
+	}
+	s.block(d)
+}
+
+// reportProbe 上报半开状态下探测请求的结果
+func (s *mprovider) reportProbe(ctx context.Context, success bool) {
+	d, err := s.breaker.Report(ctx, s.key, success)
+	if err != nil {
+		s.logger.Warn("上报探测结果失败", elog.String("Key", s.key), elog.FieldErr(err))
+		return
+	}
+	if d > 0 {
+		s.logger.Warn("供应商探测失败，重新打开熔断器", elog.String("Key", s.key), elog.Duration("OpenDuration", d))
+		s.block(d)
+	}
+}
+
+func (s *mprovider) block(d time.Duration) {
+	s.blockedUntil.Store(time.Now().Add(d).UnixMilli())
+}
+
+func newMprovider(key string, pro provider.Provider, cfg BreakerConfig) mprovider {
+	cfg = cfg.withDefaults()
+	bitCnt := uint64(defaultNumberLen) * uint64(cfg.BufferLen)
 	return mprovider{
 		Provider:      pro,
-		healthy:       health,
-		bufferLen:     bufferLen,
-		ringBuffer:    make([]uint64, bufferLen),
+		key:           key,
+		breaker:       cfg.Breaker,
+		blockedUntil:  &atomic.Int64{},
+		bufferLen:     cfg.BufferLen,
+		ringBuffer:    make([]uint64, cfg.BufferLen),
 		bitCnt:        bitCnt,
-		mu:            &sync.RWMutex{},
-		failThreshold: int(float64(bitCnt) * defaultFailPercent),
+		failThreshold: int(float64(bitCnt) * cfg.FailPercent),
+		logger:        elog.DefaultLogger.With(elog.FieldComponent("loadbalancer")),
 	}
 }
 
@@ -102,6 +211,7 @@ func (s *mprovider) getFailed() int {
 	return failCount
 }
 
+// isHealthy 本地缓存的熔断状态，其他实例打开的熔断器在第一次 Send 时同步到本地
 func (s *mprovider) isHealthy() bool {
-	return s.healthy.Load()
+	return time.Now().UnixMilli() >= s.blockedUntil.Load()
 }
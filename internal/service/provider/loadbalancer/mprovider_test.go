@@ -0,0 +1,197 @@
+//go:build unit
+
+package loadbalancer
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/pkg/circuitbreaker"
+	cbmocks "gitee.com/flycash/notification-platform/internal/pkg/circuitbreaker/mocks"
+	"gitee.com/flycash/notification-platform/internal/service/provider"
+	providermocks "gitee.com/flycash/notification-platform/internal/service/provider/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMprovider_Send(t *testing.T) {
+	t.Parallel()
+	const key = "provider:SMS:aliyun"
+	sendErr := errors.New("mock error")
+
+	tests := []struct {
+		name string
+		// 发送前已经失败的次数
+		failed       int
+		newProvider  func(ctrl *gomock.Controller) provider.Provider
+		newBreaker   func(ctrl *gomock.Controller) circuitbreaker.CircuitBreaker
+		wantErr      error
+		wantHealthy  bool
+		wantFailures int
+	}{
+		{
+			name: "熔断器打开时不调用供应商",
+			newProvider: func(ctrl *gomock.Controller) provider.Provider {
+				return providermocks.NewMockProvider(ctrl)
+			},
+			newBreaker: func(ctrl *gomock.Controller) circuitbreaker.CircuitBreaker {
+				b := cbmocks.NewMockCircuitBreaker(ctrl)
+				b.EXPECT().Allow(gomock.Any(), key).
+					Return(circuitbreaker.Decision{State: circuitbreaker.StateOpen, RetryAfter: time.Minute}, nil)
+				return b
+			},
+			wantErr:     errs.ErrCircuitBreaker,
+			wantHealthy: false,
+		},
+		{
+			name: "熔断器不可用时放行",
+			newProvider: func(ctrl *gomock.Controller) provider.Provider {
+				p := providermocks.NewMockProvider(ctrl)
+				p.EXPECT().Send(gomock.Any(), gomock.Any()).Return(domain.SendResponse{}, nil)
+				return p
+			},
+			newBreaker: func(ctrl *gomock.Controller) circuitbreaker.CircuitBreaker {
+				b := cbmocks.NewMockCircuitBreaker(ctrl)
+				b.EXPECT().Allow(gomock.Any(), key).Return(circuitbreaker.Decision{}, errors.New("redis error"))
+				return b
+			},
+			wantHealthy: true,
+		},
+		{
+			name:   "失败率超过阈值时打开熔断器并清空比特环",
+			failed: 64,
+			newProvider: func(ctrl *gomock.Controller) provider.Provider {
+				p := providermocks.NewMockProvider(ctrl)
+				p.EXPECT().Send(gomock.Any(), gomock.Any()).Return(domain.SendResponse{}, sendErr)
+				return p
+			},
+			newBreaker: func(ctrl *gomock.Controller) circuitbreaker.CircuitBreaker {
+				b := cbmocks.NewMockCircuitBreaker(ctrl)
+				b.EXPECT().Allow(gomock.Any(), key).
+					Return(circuitbreaker.Decision{Allowed: true, State: circuitbreaker.StateClosed}, nil)
+				b.EXPECT().Trip(gomock.Any(), key).Return(time.Minute, nil)
+				return b
+			},
+			wantErr:      sendErr,
+			wantHealthy:  false,
+			wantFailures: 0,
+		},
+		{
+			name:   "失败率没有超过阈值",
+			failed: 10,
+			newProvider: func(ctrl *gomock.Controller) provider.Provider {
+				p := providermocks.NewMockProvider(ctrl)
+				p.EXPECT().Send(gomock.Any(), gomock.Any()).Return(domain.SendResponse{}, sendErr)
+				return p
+			},
+			newBreaker: func(ctrl *gomock.Controller) circuitbreaker.CircuitBreaker {
+				b := cbmocks.NewMockCircuitBreaker(ctrl)
+				b.EXPECT().Allow(gomock.Any(), key).
+					Return(circuitbreaker.Decision{Allowed: true, State: circuitbreaker.StateClosed}, nil)
+				return b
+			},
+			wantErr:      sendErr,
+			wantHealthy:  true,
+			wantFailures: 11,
+		},
+		{
+			name: "半开状态探测成功",
+			newProvider: func(ctrl *gomock.Controller) provider.Provider {
+				p := providermocks.NewMockProvider(ctrl)
+				p.EXPECT().Send(gomock.Any(), gomock.Any()).Return(domain.SendResponse{}, nil)
+				return p
+			},
+			newBreaker: func(ctrl *gomock.Controller) circuitbreaker.CircuitBreaker {
+				b := cbmocks.NewMockCircuitBreaker(ctrl)
+				b.EXPECT().Allow(gomock.Any(), key).
+					Return(circuitbreaker.Decision{Allowed: true, State: circuitbreaker.StateHalfOpen}, nil)
+				b.EXPECT().Report(gomock.Any(), key, true).Return(time.Duration(0), nil)
+				return b
+			},
+			wantHealthy: true,
+		},
+		{
+			name: "半开状态探测失败时重新打开",
+			newProvider: func(ctrl *gomock.Controller) provider.Provider {
+				p := providermocks.NewMockProvider(ctrl)
+				p.EXPECT().Send(gomock.Any(), gomock.Any()).Return(domain.SendResponse{}, sendErr)
+				return p
+			},
+			newBreaker: func(ctrl *gomock.Controller) circuitbreaker.CircuitBreaker {
+				b := cbmocks.NewMockCircuitBreaker(ctrl)
+				b.EXPECT().Allow(gomock.Any(), key).
+					Return(circuitbreaker.Decision{Allowed: true, State: circuitbreaker.StateHalfOpen}, nil)
+				b.EXPECT().Report(gomock.Any(), key, false).Return(2*time.Minute, nil)
+				return b
+			},
+			wantErr:     sendErr,
+			wantHealthy: false,
+		},
+		{
+			name: "半开状态被限流不上报探测结果",
+			newProvider: func(ctrl *gomock.Controller) provider.Provider {
+				p := providermocks.NewMockProvider(ctrl)
+				p.EXPECT().Send(gomock.Any(), gomock.Any()).Return(domain.SendResponse{}, errs.ErrRateLimited)
+				return p
+			},
+			newBreaker: func(ctrl *gomock.Controller) circuitbreaker.CircuitBreaker {
+				b := cbmocks.NewMockCircuitBreaker(ctrl)
+				b.EXPECT().Allow(gomock.Any(), key).
+					Return(circuitbreaker.Decision{Allowed: true, State: circuitbreaker.StateHalfOpen}, nil)
+				return b
+			},
+			wantErr:     errs.ErrRateLimited,
+			wantHealthy: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// 窗口共 640 个请求，10% 的阈值为 64 次失败
+			mp := newMprovider(key, tt.newProvider(ctrl), BreakerConfig{
+				BufferLen:   10,
+				FailPercent: 0.1,
+				Breaker:     tt.newBreaker(ctrl),
+			})
+			for i := 0; i < tt.failed; i++ {
+				mp.markFail()
+			}
+			_, err := mp.Send(t.Context(), domain.Notification{})
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantHealthy, mp.isHealthy())
+			assert.Equal(t, tt.wantFailures, mp.getFailed())
+		})
+	}
+}
+
+func TestMprovider_SharedLocalBreaker(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	failing := providermocks.NewMockProvider(ctrl)
+	failing.EXPECT().Send(gomock.Any(), gomock.Any()).Return(domain.SendResponse{}, errors.New("mock error")).Times(2)
+	breaker := circuitbreaker.NewLocalCircuitBreaker(circuitbreaker.Config{OpenDuration: time.Minute})
+	// 两个实例共享熔断状态，一个实例打开熔断器后另一个实例也不再调用供应商
+	cfg := BreakerConfig{BufferLen: 1, FailPercent: 1.0 / 64, Breaker: breaker}
+	mp1 := newMprovider("provider:SMS:aliyun", failing, cfg)
+	mp2 := newMprovider("provider:SMS:aliyun", failing, cfg)
+
+	for i := 0; i < 2; i++ {
+		_, err := mp1.Send(t.Context(), domain.Notification{})
+		assert.NotErrorIs(t, err, errs.ErrCircuitBreaker)
+	}
+	assert.False(t, mp1.isHealthy())
+
+	assert.True(t, mp2.isHealthy())
+	_, err := mp2.Send(t.Context(), domain.Notification{})
+	assert.ErrorIs(t, err, errs.ErrCircuitBreaker)
+	assert.False(t, mp2.isHealthy())
+}
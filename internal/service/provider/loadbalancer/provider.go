@@ -3,6 +3,7 @@ package loadbalancer
 import (
 	"context"
 	"errors"
+	"strconv"
 	"sync"
 	"sync/atomic"
 
@@ -29,14 +30,12 @@ type Provider struct {
 //   - providers: 基础provider列表
 //   - bufferLen: 健康状态监控的环形缓冲区长度，用于异常检测
 func NewProvider(providers []provider.Provider, bufferLen int) *Provider {
-	if bufferLen <= 0 {
-		bufferLen = 10 // 默认缓冲区长度
-	}
-
+	// 同一组供应商共用一个本地熔断器，按下标区分
+	cfg := BreakerConfig{BufferLen: bufferLen}.withDefaults()
 	// 预分配足够的容量避免扩容
 	mproviders := make([]*mprovider, 0, len(providers))
-	for _, p := range providers {
-		mp := newMprovider(p, bufferLen)
+	for i, p := range providers {
+		mp := newMprovider(strconv.Itoa(i), p, cfg)
 		mproviders = append(mproviders, &mp)
 	}
 
@@ -67,7 +66,7 @@ func (p *Provider) Send(ctx context.Context, notification domain.Notification) (
 
 		// 由于providers长度不变，可以安全地直接访问
 		pro := providers[idx]
-		if pro != nil && pro.healthy.Load() {
+		if pro != nil && pro.isHealthy() {
 			// 使用健康的provider发送通知
 			resp, err := pro.Send(ctx, notification)
 			if err == nil {
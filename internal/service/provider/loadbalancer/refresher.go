@@ -0,0 +1,52 @@
+package loadbalancer
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	defaultRefreshInterval = 30 * time.Second
+	refreshTimeout         = 3 * time.Second
+)
+
+// refresher 按固定间隔在后台刷新选择器依赖的配置，调用方不等待加载，始终使用已经加载的配置
+// 同一时间只有一个刷新在进行
+type refresher struct {
+	interval time.Duration
+	loadTime atomic.Int64
+	loading  atomic.Bool
+	wg       sync.WaitGroup
+}
+
+func newRefresher(interval time.Duration) *refresher {
+	if interval <= 0 {
+		interval = defaultRefreshInterval
+	}
+	return &refresher{interval: interval}
+}
+
+// refresh 到了刷新时间就启动一个后台刷新，立即返回，不会阻塞发送
+func (r *refresher) refresh(load func(ctx context.Context)) {
+	if time.Since(time.UnixMilli(r.loadTime.Load())) < r.interval || !r.loading.CompareAndSwap(false, true) {
+		return
+	}
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		defer r.loading.Store(false)
+		// 加载失败时也推迟下次加载，避免数据库故障时反复查询
+		defer r.loadTime.Store(time.Now().UnixMilli())
+
+		ctx, cancel := context.WithTimeout(context.Background(), refreshTimeout)
+		defer cancel()
+		load(ctx)
+	}()
+}
+
+// reset 下次调用 refresh 时立即刷新
+func (r *refresher) reset() {
+	r.loadTime.Store(0)
+}
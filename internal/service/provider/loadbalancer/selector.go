@@ -2,6 +2,7 @@ package loadbalancer
 
 import (
 	"context"
+	"strconv"
 	"sync"
 	"sync/atomic"
 
@@ -16,14 +17,12 @@ type Selector struct {
 }
 
 func NewSelector(providers []provider.Provider, bufferLen int) *Selector {
-	if bufferLen <= 0 {
-		bufferLen = 10 // 默认缓冲区长度
-	}
-
+	// 同一组供应商共用一个本地熔断器，按下标区分
+	cfg := BreakerConfig{BufferLen: bufferLen}.withDefaults()
 	// 预分配足够的容量避免扩容
 	mproviders := make([]*mprovider, 0, len(providers))
-	for _, p := range providers {
-		mp := newMprovider(p, bufferLen)
+	for i, p := range providers {
+		mp := newMprovider(strconv.Itoa(i), p, cfg)
 		mproviders = append(mproviders, &mp)
 	}
 
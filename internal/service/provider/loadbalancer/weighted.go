@@ -0,0 +1,231 @@
+package loadbalancer
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/service/provider"
+	"gitee.com/flycash/notification-platform/internal/service/provider/manage"
+	"gitee.com/flycash/notification-platform/internal/service/provider/registry"
+	"github.com/gotomicro/ego/core/elog"
+)
+
+var (
+	_ provider.Selector        = (*weightedSelector)(nil)
+	_ provider.SelectorBuilder = (*WeightedSelectorBuilder)(nil)
+	_ registry.Updater         = (*WeightedSelectorBuilder)(nil)
+)
+
+// Algorithm 加权选择算法
+type Algorithm string
+
+const (
+	// AlgorithmSmoothRoundRobin 平滑加权轮询，流量严格按权重比例分配且分布均匀
+	AlgorithmSmoothRoundRobin Algorithm = "smooth_round_robin"
+	// AlgorithmRandom 加权随机，多实例部署时不需要共享轮询状态
+	AlgorithmRandom Algorithm = "random"
+	// AlgorithmCost 按价格选择供应商，由 CostSelectorBuilder 实现
+	AlgorithmCost Algorithm = "cost"
+)
+
+// weightedNode 参与加权选择的供应商
+type weightedNode struct {
+	name    string
+	mp      *mprovider
+	weight  int
+	current int // 平滑加权轮询的当前权重
+}
+
+// WeightedSelectorBuilder 按供应商表中的权重选择供应商
+// 权重定期在后台从供应商表重新加载，Build 只读取已经加载的权重，发生变化时重建选择状态，
+// 熔断状态保存在 mprovider 中，不会因为重建而丢失
+// 供应商本身可以通过 UpdateProviders 在运行时替换
+type WeightedSelectorBuilder struct {
+	channel     domain.Channel
+	providerSvc manage.Service
+	algorithm   Algorithm
+	breaker     BreakerConfig
+
+	refresher *refresher
+	mu        sync.Mutex
+	providers map[string]*mprovider
+	nodes     []*weightedNode
+	weights   map[string]int
+
+	logger *elog.Component
+}
+
+// NewWeightedSelectorBuilder providers 的 key 为供应商名称，与供应商表中的 Name 一致
+// 首次加载权重前所有供应商权重相同，表中不存在或者已禁用的供应商不会被选中
+func NewWeightedSelectorBuilder(
+	channel domain.Channel,
+	providers map[string]provider.Provider,
+	providerSvc manage.Service,
+	algorithm Algorithm,
+	refreshInterval time.Duration,
+	breaker BreakerConfig,
+) *WeightedSelectorBuilder {
+	if algorithm != AlgorithmRandom {
+		algorithm = AlgorithmSmoothRoundRobin
+	}
+	mproviders := newMproviders(channel, providers, breaker)
+	weights := make(map[string]int, len(providers))
+	for name := range providers {
+		weights[name] = 1
+	}
+	b := &WeightedSelectorBuilder{
+		channel:     channel,
+		providerSvc: providerSvc,
+		algorithm:   algorithm,
+		breaker:     breaker,
+		refresher:   newRefresher(refreshInterval),
+		providers:   mproviders,
+		logger:      elog.DefaultLogger.With(elog.FieldComponent("loadbalancer")),
+	}
+	b.rebuild(weights)
+	return b
+}
+
+// Build 不访问数据库，到了刷新时间时在后台重新加载权重
+func (b *WeightedSelectorBuilder) Build() (provider.Selector, error) {
+	b.refresher.refresh(b.loadWeights)
+	return &weightedSelector{builder: b, tried: make(map[string]struct{})}, nil
+}
+
+// UpdateProviders 替换供应商，新增的供应商在重新加载权重之前权重为1，正在进行的发送继续使用旧的供应商
+func (b *WeightedSelectorBuilder) UpdateProviders(providers map[string]provider.Provider) {
+	b.mu.Lock()
+	b.providers = updateMproviders(b.channel, b.providers, providers, b.breaker)
+	weights := make(map[string]int, len(providers))
+	for name := range providers {
+		weight, ok := b.weights[name]
+		if !ok {
+			weight = 1
+		}
+		weights[name] = weight
+	}
+	b.rebuildLocked(weights)
+	b.mu.Unlock()
+	// 尽快加载新增供应商的权重
+	b.refresher.reset()
+}
+
+// loadWeights 重新加载权重，加载失败时继续使用旧权重
+func (b *WeightedSelectorBuilder) loadWeights(ctx context.Context) {
+	entities, err := b.providerSvc.GetByChannel(ctx, b.channel)
+	if err != nil {
+		b.logger.Warn("加载供应商权重失败，继续使用旧权重", elog.FieldErr(err))
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	weights := make(map[string]int, len(b.providers))
+	for i := range entities {
+		if _, ok := b.providers[entities[i].Name]; ok && entities[i].Status != domain.ProviderStatusInactive {
+			weights[entities[i].Name] = entities[i].Weight
+		}
+	}
+	if !maps.Equal(weights, b.weights) {
+		b.logger.Info("供应商权重变化，重建选择器",
+			elog.String("Channel", b.channel.String()),
+			elog.Any("Weights", weights))
+		b.rebuildLocked(weights)
+	}
+}
+
+func (b *WeightedSelectorBuilder) rebuild(weights map[string]int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.rebuildLocked(weights)
+}
+
+// rebuildLocked 调用方需要持有 mu
+func (b *WeightedSelectorBuilder) rebuildLocked(weights map[string]int) {
+	nodes := make([]*weightedNode, 0, len(weights))
+	for name, weight := range weights {
+		if weight <= 0 {
+			continue
+		}
+		nodes = append(nodes, &weightedNode{name: name, mp: b.providers[name], weight: weight})
+	}
+	// 固定顺序，权重相同时的选择结果可预期
+	slices.SortFunc(nodes, func(x, y *weightedNode) int {
+		return strings.Compare(x.name, y.name)
+	})
+	b.nodes = nodes
+	b.weights = weights
+}
+
+// next 选出一个没有尝试过的健康供应商
+// 第一次选择按算法分配流量，发送失败后的重试按权重从高到低（加权随机时仍然随机）选择剩余的供应商
+func (b *WeightedSelectorBuilder) next(tried map[string]struct{}) (*weightedNode, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	total := 0
+	candidates := make([]*weightedNode, 0, len(b.nodes))
+	for _, n := range b.nodes {
+		if _, ok := tried[n.name]; ok || !n.mp.isHealthy() {
+			continue
+		}
+		candidates = append(candidates, n)
+		total += n.weight
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("%w", errs.ErrNoAvailableProvider)
+	}
+
+	switch {
+	case b.algorithm == AlgorithmRandom:
+		r := rand.IntN(total)
+		for _, n := range candidates {
+			if r < n.weight {
+				return n, nil
+			}
+			r -= n.weight
+		}
+		return candidates[len(candidates)-1], nil
+	case len(tried) == 0:
+		// 平滑加权轮询：当前权重都加上各自的权重，选出最大的，再减去总权重
+		var best *weightedNode
+		for _, n := range candidates {
+			n.current += n.weight
+			if best == nil || n.current > best.current {
+				best = n
+			}
+		}
+		best.current -= total
+		return best, nil
+	default:
+		best := candidates[0]
+		for _, n := range candidates[1:] {
+			if n.weight > best.weight {
+				best = n
+			}
+		}
+		return best, nil
+	}
+}
+
+// weightedSelector 单次发送的选择器，记录已经尝试过的供应商
+type weightedSelector struct {
+	builder *WeightedSelectorBuilder
+	tried   map[string]struct{}
+}
+
+func (s *weightedSelector) Next(_ context.Context, _ domain.Notification) (provider.Provider, error) {
+	n, err := s.builder.next(s.tried)
+	if err != nil {
+		return nil, err
+	}
+	s.tried[n.name] = struct{}{}
+	return n.mp, nil
+}
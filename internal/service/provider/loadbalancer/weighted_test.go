@@ -0,0 +1,304 @@
+//go:build unit
+
+package loadbalancer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/service/provider"
+	providermocks "gitee.com/flycash/notification-platform/internal/service/provider/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newWeightedTestProviders(names ...string) map[string]provider.Provider {
+	providers := make(map[string]provider.Provider, len(names))
+	for _, name := range names {
+		providers[name] = NewMockHealthAwareProvider(name, false)
+	}
+	return providers
+}
+
+// nextName 构建一次选择器并返回前 n 次选择的供应商名称
+// 权重在后台加载，等待 Build 触发的加载完成后再选择，保证结果可预期
+func nextName(t *testing.T, b *WeightedSelectorBuilder, n int) []string {
+	t.Helper()
+	selector, err := b.Build()
+	require.NoError(t, err)
+	b.refresher.wg.Wait()
+	names := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		p, err1 := selector.Next(t.Context(), domain.Notification{})
+		require.NoError(t, err1)
+		names = append(names, p.(*mprovider).Provider.(*MockHealthAwareProvider).name)
+	}
+	return names
+}
+
+func TestWeightedSelectorBuilder_SmoothRoundRobin(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		providers []domain.Provider
+		// 连续构建选择器时第一次选中的供应商
+		want []string
+	}{
+		{
+			name: "按权重平滑分配",
+			providers: []domain.Provider{
+				{Name: "aliyun", Weight: 4, Status: domain.ProviderStatusActive},
+				{Name: "tencentcloud", Weight: 1, Status: domain.ProviderStatusActive},
+			},
+			want: []string{"aliyun", "aliyun", "tencentcloud", "aliyun", "aliyun", "aliyun", "aliyun", "tencentcloud", "aliyun", "aliyun"},
+		},
+		{
+			name: "权重相同时轮流选择",
+			providers: []domain.Provider{
+				{Name: "aliyun", Weight: 1, Status: domain.ProviderStatusActive},
+				{Name: "tencentcloud", Weight: 1, Status: domain.ProviderStatusActive},
+			},
+			want: []string{"aliyun", "tencentcloud", "aliyun", "tencentcloud"},
+		},
+		{
+			name: "表中没有的供应商不参与选择",
+			providers: []domain.Provider{
+				{Name: "tencentcloud", Weight: 3, Status: domain.ProviderStatusActive},
+			},
+			want: []string{"tencentcloud", "tencentcloud", "tencentcloud"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svc := providermocks.NewMockService(ctrl)
+			svc.EXPECT().GetByChannel(gomock.Any(), domain.ChannelSMS).Return(tt.providers, nil)
+
+			b := NewWeightedSelectorBuilder(domain.ChannelSMS, newWeightedTestProviders("aliyun", "tencentcloud"),
+				svc, AlgorithmSmoothRoundRobin, time.Hour, BreakerConfig{BufferLen: 10})
+			got := make([]string, 0, len(tt.want))
+			for range tt.want {
+				got = append(got, nextName(t, b, 1)...)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWeightedSelectorBuilder_Failover(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc := providermocks.NewMockService(ctrl)
+	svc.EXPECT().GetByChannel(gomock.Any(), domain.ChannelSMS).Return([]domain.Provider{
+		{Name: "a", Weight: 1, Status: domain.ProviderStatusActive},
+		{Name: "b", Weight: 5, Status: domain.ProviderStatusActive},
+		{Name: "c", Weight: 3, Status: domain.ProviderStatusActive},
+	}, nil)
+
+	b := NewWeightedSelectorBuilder(domain.ChannelSMS, newWeightedTestProviders("a", "b", "c"),
+		svc, AlgorithmSmoothRoundRobin, time.Hour, BreakerConfig{BufferLen: 10})
+	// 第一次按平滑加权轮询选择，之后按权重从高到低尝试剩余的供应商
+	assert.Equal(t, []string{"b", "c", "a"}, nextName(t, b, 3))
+
+	selector, err := b.Build()
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		_, err = selector.Next(t.Context(), domain.Notification{})
+		require.NoError(t, err)
+	}
+	_, err = selector.Next(t.Context(), domain.Notification{})
+	assert.ErrorIs(t, err, errs.ErrNoAvailableProvider)
+}
+
+func TestWeightedSelectorBuilder_SkipUnhealthy(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc := providermocks.NewMockService(ctrl)
+	svc.EXPECT().GetByChannel(gomock.Any(), domain.ChannelSMS).Return([]domain.Provider{
+		{Name: "aliyun", Weight: 9, Status: domain.ProviderStatusActive},
+		{Name: "tencentcloud", Weight: 1, Status: domain.ProviderStatusActive},
+	}, nil)
+
+	b := NewWeightedSelectorBuilder(domain.ChannelSMS, newWeightedTestProviders("aliyun", "tencentcloud"),
+		svc, AlgorithmSmoothRoundRobin, time.Hour, BreakerConfig{BufferLen: 10})
+	b.providers["aliyun"].block(time.Hour)
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, []string{"tencentcloud"}, nextName(t, b, 1))
+	}
+}
+
+func TestWeightedSelectorBuilder_Reload(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc := providermocks.NewMockService(ctrl)
+	gomock.InOrder(
+		svc.EXPECT().GetByChannel(gomock.Any(), domain.ChannelSMS).Return([]domain.Provider{
+			{Name: "aliyun", Weight: 1, Status: domain.ProviderStatusActive},
+			{Name: "tencentcloud", Weight: 0, Status: domain.ProviderStatusActive},
+		}, nil),
+		// 加载失败时沿用旧权重
+		svc.EXPECT().GetByChannel(gomock.Any(), domain.ChannelSMS).Return(nil, errors.New("mock error")),
+		svc.EXPECT().GetByChannel(gomock.Any(), domain.ChannelSMS).Return([]domain.Provider{
+			{Name: "aliyun", Weight: 1, Status: domain.ProviderStatusInactive},
+			{Name: "tencentcloud", Weight: 1, Status: domain.ProviderStatusActive},
+		}, nil),
+	)
+
+	b := NewWeightedSelectorBuilder(domain.ChannelSMS, newWeightedTestProviders("aliyun", "tencentcloud"),
+		svc, AlgorithmSmoothRoundRobin, time.Hour, BreakerConfig{BufferLen: 10})
+	assert.Equal(t, []string{"aliyun"}, nextName(t, b, 1))
+
+	b.refresher.loadTime.Store(0)
+	assert.Equal(t, []string{"aliyun"}, nextName(t, b, 1))
+
+	b.refresher.loadTime.Store(0)
+	assert.Equal(t, []string{"tencentcloud"}, nextName(t, b, 1))
+	// 没有到刷新时间，不会重新加载
+	assert.Equal(t, []string{"tencentcloud"}, nextName(t, b, 1))
+}
+
+func TestWeightedSelectorBuilder_UpdateProviders(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc := providermocks.NewMockService(ctrl)
+	gomock.InOrder(
+		svc.EXPECT().GetByChannel(gomock.Any(), domain.ChannelSMS).Return([]domain.Provider{
+			{Name: "aliyun", Weight: 1, Status: domain.ProviderStatusActive},
+		}, nil),
+		svc.EXPECT().GetByChannel(gomock.Any(), domain.ChannelSMS).Return([]domain.Provider{
+			{Name: "aliyun", Weight: 1, Status: domain.ProviderStatusActive},
+			{Name: "huawei", Weight: 3, Status: domain.ProviderStatusActive},
+		}, nil),
+		svc.EXPECT().GetByChannel(gomock.Any(), domain.ChannelSMS).Return([]domain.Provider{
+			{Name: "huawei", Weight: 3, Status: domain.ProviderStatusActive},
+		}, nil),
+	)
+
+	providers := newWeightedTestProviders("aliyun")
+	b := NewWeightedSelectorBuilder(domain.ChannelSMS, providers,
+		svc, AlgorithmSmoothRoundRobin, time.Hour, BreakerConfig{BufferLen: 10})
+	selector, err := b.Build()
+	require.NoError(t, err)
+	b.refresher.wg.Wait()
+	inFlight, err := selector.Next(t.Context(), domain.Notification{})
+	require.NoError(t, err)
+	b.providers["aliyun"].markFail()
+
+	// 新增供应商，没有变化的供应商保留失败率统计
+	providers["huawei"] = NewMockHealthAwareProvider("huawei", false)
+	b.UpdateProviders(providers)
+	assert.Equal(t, 1, b.providers["aliyun"].getFailed())
+	// 立即加载新增供应商的权重
+	got := make([]string, 0, 4)
+	for range 4 {
+		got = append(got, nextName(t, b, 1)...)
+	}
+	assert.Equal(t, []string{"huawei", "aliyun", "huawei", "huawei"}, got)
+
+	// 移除供应商，已经选出的供应商仍然可以完成发送
+	b.UpdateProviders(newWeightedTestProviders("huawei"))
+	_, err = inFlight.Send(t.Context(), domain.Notification{})
+	require.NoError(t, err)
+	for range 3 {
+		assert.Equal(t, []string{"huawei"}, nextName(t, b, 1))
+	}
+}
+
+func TestWeightedSelectorBuilder_Random(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc := providermocks.NewMockService(ctrl)
+	svc.EXPECT().GetByChannel(gomock.Any(), domain.ChannelSMS).Return([]domain.Provider{
+		{Name: "aliyun", Weight: 8, Status: domain.ProviderStatusActive},
+		{Name: "tencentcloud", Weight: 2, Status: domain.ProviderStatusActive},
+	}, nil)
+
+	b := NewWeightedSelectorBuilder(domain.ChannelSMS, newWeightedTestProviders("aliyun", "tencentcloud"),
+		svc, AlgorithmRandom, time.Hour, BreakerConfig{BufferLen: 10})
+	const total = 10000
+	cnt := map[string]int{}
+	for i := 0; i < total; i++ {
+		cnt[nextName(t, b, 1)[0]]++
+	}
+	// 8:2 的权重，允许一定的随机误差
+	assert.InDelta(t, 0.8, float64(cnt["aliyun"])/total, 0.03)
+	// 重试时依然能选到剩余的供应商
+	assert.ElementsMatch(t, []string{"aliyun", "tencentcloud"}, nextName(t, b, 2))
+}
+
+func TestWeightedSelectorBuilder_RateLimitedStaysHealthy(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	limited := providermocks.NewMockProvider(ctrl)
+	limited.EXPECT().Send(gomock.Any(), gomock.Any()).
+		Return(domain.SendResponse{}, fmt.Errorf("%w: mock", errs.ErrRateLimited)).AnyTimes()
+	svc := providermocks.NewMockService(ctrl)
+	svc.EXPECT().GetByChannel(gomock.Any(), domain.ChannelSMS).Return([]domain.Provider{
+		{Name: "aliyun", Weight: 1, Status: domain.ProviderStatusActive},
+	}, nil)
+
+	b := NewWeightedSelectorBuilder(domain.ChannelSMS, map[string]provider.Provider{"aliyun": limited},
+		svc, AlgorithmSmoothRoundRobin, time.Hour, BreakerConfig{BufferLen: 1})
+	for i := 0; i < 100; i++ {
+		selector, err := b.Build()
+		require.NoError(t, err)
+		b.refresher.wg.Wait()
+		p, err := selector.Next(t.Context(), domain.Notification{})
+		require.NoError(t, err)
+		_, err = p.Send(t.Context(), domain.Notification{})
+		assert.ErrorIs(t, err, errs.ErrRateLimited)
+	}
+	// 达到限额不计入失败，供应商仍然健康
+	assert.True(t, b.providers["aliyun"].isHealthy())
+	assert.Zero(t, b.providers["aliyun"].getFailed())
+}
+
+func TestWeightedSelectorBuilder_BuildNotBlocked(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	release := make(chan struct{})
+	svc := providermocks.NewMockService(ctrl)
+	svc.EXPECT().GetByChannel(gomock.Any(), domain.ChannelSMS).DoAndReturn(
+		func(context.Context, domain.Channel) ([]domain.Provider, error) {
+			<-release
+			return []domain.Provider{{Name: "tencentcloud", Weight: 1, Status: domain.ProviderStatusActive}}, nil
+		})
+
+	b := NewWeightedSelectorBuilder(domain.ChannelSMS, newWeightedTestProviders("aliyun", "tencentcloud"),
+		svc, AlgorithmSmoothRoundRobin, time.Hour, BreakerConfig{BufferLen: 10})
+	// 加载权重还没有完成，Build 不等待，继续使用已经加载的权重
+	selector, err := b.Build()
+	require.NoError(t, err)
+	p, err := selector.Next(t.Context(), domain.Notification{})
+	require.NoError(t, err)
+	assert.Equal(t, "aliyun", p.(*mprovider).Provider.(*MockHealthAwareProvider).name)
+
+	close(release)
+	b.refresher.wg.Wait()
+	assert.Equal(t, []string{"tencentcloud"}, nextName(t, b, 1))
+}
@@ -6,6 +6,7 @@ import (
 
 	"gitee.com/flycash/notification-platform/internal/domain"
 	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/pkg/secret"
 	"gitee.com/flycash/notification-platform/internal/repository"
 )
 
@@ -15,12 +16,24 @@ import (
 type Service interface {
 	// Create 创建供应商
 	Create(ctx context.Context, provider domain.Provider) (domain.Provider, error)
-	// Update 更新供应商
+	// Update 更新供应商，密钥为空时保留原来的密钥，不能传入查询接口返回的脱敏值
 	Update(ctx context.Context, provider domain.Provider) error
 	// GetByID 根据ID获取供应商
 	GetByID(ctx context.Context, id int64) (domain.Provider, error)
 	// GetByChannel 获取指定渠道的所有供应商
 	GetByChannel(ctx context.Context, channel domain.Channel) ([]domain.Provider, error)
+	// List 获取所有供应商，包括禁用的供应商，channel 为空表示所有渠道
+	List(ctx context.Context, channel domain.Channel) ([]domain.Provider, error)
+	// Activate 启用供应商
+	Activate(ctx context.Context, id int64) error
+	// Deactivate 禁用供应商
+	Deactivate(ctx context.Context, id int64) error
+	// UpdateWeight 更新权重
+	UpdateWeight(ctx context.Context, id int64, weight int) error
+	// UpdateLimits 更新每秒和每日请求数限制
+	UpdateLimits(ctx context.Context, id int64, qpsLimit, dailyLimit int) error
+	// Delete 删除供应商
+	Delete(ctx context.Context, id int64) error
 }
 
 // providerService 供应商服务实现
@@ -35,17 +48,42 @@ func NewProviderService(repo repository.ProviderRepository) Service {
 	}
 }
 
-// Create 创建供应商
+// Create 创建供应商，没有指定状态时默认启用
 func (s *providerService) Create(ctx context.Context, provider domain.Provider) (domain.Provider, error) {
+	if provider.Status == "" {
+		provider.Status = domain.ProviderStatusActive
+	}
 	if err := provider.Validate(); err != nil {
 		return domain.Provider{}, err
 	}
 	return s.repo.Create(ctx, provider)
 }
 
-// Update 更新供应商
+// Update 更新供应商，状态和密钥为空时保持不变
+// 查询接口返回的密钥是脱敏的，原样写回会覆盖真实的密钥，所以拒绝脱敏值
 func (s *providerService) Update(ctx context.Context, provider domain.Provider) error {
-	if err := provider.Validate(); err != nil {
+	for _, v := range []string{provider.APIKey, provider.APISecret, provider.CallbackToken} {
+		if secret.IsMasked(v) {
+			return fmt.Errorf("%w: 密钥不能是脱敏后的值，不修改密钥时留空", errs.ErrInvalidParameter)
+		}
+	}
+	old, err := s.GetByID(ctx, provider.ID)
+	if err != nil {
+		return err
+	}
+	if provider.APIKey == "" {
+		provider.APIKey = old.APIKey
+	}
+	if provider.APISecret == "" {
+		provider.APISecret = old.APISecret
+	}
+	if provider.CallbackToken == "" {
+		provider.CallbackToken = old.CallbackToken
+	}
+	if provider.Status == "" {
+		provider.Status = old.Status
+	}
+	if err = provider.Validate(); err != nil {
 		return err
 	}
 	return s.repo.Update(ctx, provider)
@@ -66,3 +104,61 @@ func (s *providerService) GetByChannel(ctx context.Context, channel domain.Chann
 	}
 	return s.repo.FindByChannel(ctx, channel)
 }
+
+// List 获取所有供应商，包括禁用的供应商
+func (s *providerService) List(ctx context.Context, channel domain.Channel) ([]domain.Provider, error) {
+	if channel != "" && !channel.IsValid() {
+		return nil, fmt.Errorf("%w: 不支持的渠道类型", errs.ErrInvalidParameter)
+	}
+	return s.repo.List(ctx, channel)
+}
+
+// Activate 启用供应商
+func (s *providerService) Activate(ctx context.Context, id int64) error {
+	return s.updateStatus(ctx, id, domain.ProviderStatusActive)
+}
+
+// Deactivate 禁用供应商
+func (s *providerService) Deactivate(ctx context.Context, id int64) error {
+	return s.updateStatus(ctx, id, domain.ProviderStatusInactive)
+}
+
+func (s *providerService) updateStatus(ctx context.Context, id int64, status domain.ProviderStatus) error {
+	if id <= 0 {
+		return fmt.Errorf("%w: 供应商ID必须大于0", errs.ErrInvalidParameter)
+	}
+	return s.repo.UpdateStatus(ctx, id, status)
+}
+
+// UpdateWeight 更新权重
+func (s *providerService) UpdateWeight(ctx context.Context, id int64, weight int) error {
+	if id <= 0 {
+		return fmt.Errorf("%w: 供应商ID必须大于0", errs.ErrInvalidParameter)
+	}
+	if weight <= 0 {
+		return fmt.Errorf("%w: 权重不能小于等于0", errs.ErrInvalidParameter)
+	}
+	return s.repo.UpdateWeight(ctx, id, weight)
+}
+
+// UpdateLimits 更新每秒和每日请求数限制
+func (s *providerService) UpdateLimits(ctx context.Context, id int64, qpsLimit, dailyLimit int) error {
+	if id <= 0 {
+		return fmt.Errorf("%w: 供应商ID必须大于0", errs.ErrInvalidParameter)
+	}
+	if qpsLimit <= 0 {
+		return fmt.Errorf("%w: 每秒请求数限制不能小于等于0", errs.ErrInvalidParameter)
+	}
+	if dailyLimit <= 0 {
+		return fmt.Errorf("%w: 每日请求数限制不能小于等于0", errs.ErrInvalidParameter)
+	}
+	return s.repo.UpdateLimits(ctx, id, qpsLimit, dailyLimit)
+}
+
+// Delete 删除供应商
+func (s *providerService) Delete(ctx context.Context, id int64) error {
+	if id <= 0 {
+		return fmt.Errorf("%w: 供应商ID必须大于0", errs.ErrInvalidParameter)
+	}
+	return s.repo.Delete(ctx, id)
+}
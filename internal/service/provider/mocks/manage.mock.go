@@ -41,6 +41,44 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// Activate mocks base method.
+func (m *MockService) Activate(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Activate", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Activate indicates an expected call of Activate.
+func (mr *MockServiceMockRecorder) Activate(ctx, id any) *MockServiceActivateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Activate", reflect.TypeOf((*MockService)(nil).Activate), ctx, id)
+	return &MockServiceActivateCall{Call: call}
+}
+
+// MockServiceActivateCall wrap *gomock.Call
+type MockServiceActivateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceActivateCall) Return(arg0 error) *MockServiceActivateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceActivateCall) Do(f func(context.Context, int64) error) *MockServiceActivateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceActivateCall) DoAndReturn(f func(context.Context, int64) error) *MockServiceActivateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Create mocks base method.
 func (m *MockService) Create(ctx context.Context, provider domain.Provider) (domain.Provider, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +118,82 @@ func (c *MockServiceCreateCall) DoAndReturn(f func(context.Context, domain.Provi
 	return c
 }
 
+// Deactivate mocks base method.
+func (m *MockService) Deactivate(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deactivate", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Deactivate indicates an expected call of Deactivate.
+func (mr *MockServiceMockRecorder) Deactivate(ctx, id any) *MockServiceDeactivateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deactivate", reflect.TypeOf((*MockService)(nil).Deactivate), ctx, id)
+	return &MockServiceDeactivateCall{Call: call}
+}
+
+// MockServiceDeactivateCall wrap *gomock.Call
+type MockServiceDeactivateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceDeactivateCall) Return(arg0 error) *MockServiceDeactivateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceDeactivateCall) Do(f func(context.Context, int64) error) *MockServiceDeactivateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceDeactivateCall) DoAndReturn(f func(context.Context, int64) error) *MockServiceDeactivateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Delete mocks base method.
+func (m *MockService) Delete(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockServiceMockRecorder) Delete(ctx, id any) *MockServiceDeleteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockService)(nil).Delete), ctx, id)
+	return &MockServiceDeleteCall{Call: call}
+}
+
+// MockServiceDeleteCall wrap *gomock.Call
+type MockServiceDeleteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceDeleteCall) Return(arg0 error) *MockServiceDeleteCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceDeleteCall) Do(f func(context.Context, int64) error) *MockServiceDeleteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceDeleteCall) DoAndReturn(f func(context.Context, int64) error) *MockServiceDeleteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetByChannel mocks base method.
 func (m *MockService) GetByChannel(ctx context.Context, channel domain.Channel) ([]domain.Provider, error) {
 	m.ctrl.T.Helper()
@@ -122,7 +236,7 @@ func (c *MockServiceGetByChannelCall) DoAndReturn(f func(context.Context, domain
 // GetByID mocks base method.
 func (m *MockService) GetByID(ctx context.Context, id int64) (domain.Provider, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTemplateByID", ctx, id)
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
 	ret0, _ := ret[0].(domain.Provider)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
@@ -131,7 +245,7 @@ func (m *MockService) GetByID(ctx context.Context, id int64) (domain.Provider, e
 // GetByID indicates an expected call of GetByID.
 func (mr *MockServiceMockRecorder) GetByID(ctx, id any) *MockServiceGetByIDCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateByID", reflect.TypeOf((*MockService)(nil).GetByID), ctx, id)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockService)(nil).GetByID), ctx, id)
 	return &MockServiceGetByIDCall{Call: call}
 }
 
@@ -158,6 +272,45 @@ func (c *MockServiceGetByIDCall) DoAndReturn(f func(context.Context, int64) (dom
 	return c
 }
 
+// List mocks base method.
+func (m *MockService) List(ctx context.Context, channel domain.Channel) ([]domain.Provider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, channel)
+	ret0, _ := ret[0].([]domain.Provider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockServiceMockRecorder) List(ctx, channel any) *MockServiceListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockService)(nil).List), ctx, channel)
+	return &MockServiceListCall{Call: call}
+}
+
+// MockServiceListCall wrap *gomock.Call
+type MockServiceListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceListCall) Return(arg0 []domain.Provider, arg1 error) *MockServiceListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceListCall) Do(f func(context.Context, domain.Channel) ([]domain.Provider, error)) *MockServiceListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceListCall) DoAndReturn(f func(context.Context, domain.Channel) ([]domain.Provider, error)) *MockServiceListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Update mocks base method.
 func (m *MockService) Update(ctx context.Context, provider domain.Provider) error {
 	m.ctrl.T.Helper()
@@ -195,3 +348,79 @@ func (c *MockServiceUpdateCall) DoAndReturn(f func(context.Context, domain.Provi
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// UpdateLimits mocks base method.
+func (m *MockService) UpdateLimits(ctx context.Context, id int64, qpsLimit, dailyLimit int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLimits", ctx, id, qpsLimit, dailyLimit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLimits indicates an expected call of UpdateLimits.
+func (mr *MockServiceMockRecorder) UpdateLimits(ctx, id, qpsLimit, dailyLimit any) *MockServiceUpdateLimitsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLimits", reflect.TypeOf((*MockService)(nil).UpdateLimits), ctx, id, qpsLimit, dailyLimit)
+	return &MockServiceUpdateLimitsCall{Call: call}
+}
+
+// MockServiceUpdateLimitsCall wrap *gomock.Call
+type MockServiceUpdateLimitsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceUpdateLimitsCall) Return(arg0 error) *MockServiceUpdateLimitsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceUpdateLimitsCall) Do(f func(context.Context, int64, int, int) error) *MockServiceUpdateLimitsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceUpdateLimitsCall) DoAndReturn(f func(context.Context, int64, int, int) error) *MockServiceUpdateLimitsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateWeight mocks base method.
+func (m *MockService) UpdateWeight(ctx context.Context, id int64, weight int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWeight", ctx, id, weight)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWeight indicates an expected call of UpdateWeight.
+func (mr *MockServiceMockRecorder) UpdateWeight(ctx, id, weight any) *MockServiceUpdateWeightCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWeight", reflect.TypeOf((*MockService)(nil).UpdateWeight), ctx, id, weight)
+	return &MockServiceUpdateWeightCall{Call: call}
+}
+
+// MockServiceUpdateWeightCall wrap *gomock.Call
+type MockServiceUpdateWeightCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceUpdateWeightCall) Return(arg0 error) *MockServiceUpdateWeightCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceUpdateWeightCall) Do(f func(context.Context, int64, int) error) *MockServiceUpdateWeightCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceUpdateWeightCall) DoAndReturn(f func(context.Context, int64, int) error) *MockServiceUpdateWeightCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
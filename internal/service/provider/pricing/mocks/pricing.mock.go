@@ -0,0 +1,157 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./pricing.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./pricing.go -destination=./mocks/pricing.mock.go -package=pricingmocks -typed Service
+//
+
+// Package pricingmocks is a generated GoMock package.
+package pricingmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "gitee.com/flycash/notification-platform/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// GetPriceTable mocks base method.
+func (m *MockService) GetPriceTable(ctx context.Context, channel domain.Channel) (domain.PriceTable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceTable", ctx, channel)
+	ret0, _ := ret[0].(domain.PriceTable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceTable indicates an expected call of GetPriceTable.
+func (mr *MockServiceMockRecorder) GetPriceTable(ctx, channel any) *MockServiceGetPriceTableCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceTable", reflect.TypeOf((*MockService)(nil).GetPriceTable), ctx, channel)
+	return &MockServiceGetPriceTableCall{Call: call}
+}
+
+// MockServiceGetPriceTableCall wrap *gomock.Call
+type MockServiceGetPriceTableCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceGetPriceTableCall) Return(arg0 domain.PriceTable, arg1 error) *MockServiceGetPriceTableCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceGetPriceTableCall) Do(f func(context.Context, domain.Channel) (domain.PriceTable, error)) *MockServiceGetPriceTableCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceGetPriceTableCall) DoAndReturn(f func(context.Context, domain.Channel) (domain.PriceTable, error)) *MockServiceGetPriceTableCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RecordCost mocks base method.
+func (m *MockService) RecordCost(ctx context.Context, cost domain.SendCost) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordCost", ctx, cost)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordCost indicates an expected call of RecordCost.
+func (mr *MockServiceMockRecorder) RecordCost(ctx, cost any) *MockServiceRecordCostCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordCost", reflect.TypeOf((*MockService)(nil).RecordCost), ctx, cost)
+	return &MockServiceRecordCostCall{Call: call}
+}
+
+// MockServiceRecordCostCall wrap *gomock.Call
+type MockServiceRecordCostCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceRecordCostCall) Return(arg0 error) *MockServiceRecordCostCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceRecordCostCall) Do(f func(context.Context, domain.SendCost) error) *MockServiceRecordCostCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceRecordCostCall) DoAndReturn(f func(context.Context, domain.SendCost) error) *MockServiceRecordCostCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SavePrice mocks base method.
+func (m *MockService) SavePrice(ctx context.Context, price domain.ProviderPrice) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePrice", ctx, price)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePrice indicates an expected call of SavePrice.
+func (mr *MockServiceMockRecorder) SavePrice(ctx, price any) *MockServiceSavePriceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePrice", reflect.TypeOf((*MockService)(nil).SavePrice), ctx, price)
+	return &MockServiceSavePriceCall{Call: call}
+}
+
+// MockServiceSavePriceCall wrap *gomock.Call
+type MockServiceSavePriceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceSavePriceCall) Return(arg0 error) *MockServiceSavePriceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceSavePriceCall) Do(f func(context.Context, domain.ProviderPrice) error) *MockServiceSavePriceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceSavePriceCall) DoAndReturn(f func(context.Context, domain.ProviderPrice) error) *MockServiceSavePriceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
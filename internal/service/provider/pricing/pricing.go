@@ -0,0 +1,49 @@
+package pricing
+
+import (
+	"context"
+	"fmt"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/repository"
+)
+
+// Service 供应商价格服务
+//
+//go:generate mockgen -source=./pricing.go -destination=./mocks/pricing.mock.go -package=pricingmocks -typed Service
+type Service interface {
+	// SavePrice 新增或者更新供应商单价
+	SavePrice(ctx context.Context, price domain.ProviderPrice) error
+	// GetPriceTable 获取渠道内所有供应商的价格表
+	GetPriceTable(ctx context.Context, channel domain.Channel) (domain.PriceTable, error)
+	// RecordCost 记录发送费用
+	RecordCost(ctx context.Context, cost domain.SendCost) error
+}
+
+type service struct {
+	repo repository.PricingRepository
+}
+
+func NewService(repo repository.PricingRepository) Service {
+	return &service{repo: repo}
+}
+
+func (s *service) SavePrice(ctx context.Context, price domain.ProviderPrice) error {
+	if err := price.Validate(); err != nil {
+		return err
+	}
+	return s.repo.SavePrice(ctx, price)
+}
+
+func (s *service) GetPriceTable(ctx context.Context, channel domain.Channel) (domain.PriceTable, error) {
+	if !channel.IsValid() {
+		return nil, fmt.Errorf("%w: 不支持的渠道类型", errs.ErrInvalidParameter)
+	}
+	return s.repo.FindPricesByChannel(ctx, channel)
+}
+
+func (s *service) RecordCost(ctx context.Context, cost domain.SendCost) error {
+	cost.Amount = cost.UnitPrice * cost.Quantity
+	return s.repo.CreateCost(ctx, cost)
+}
@@ -0,0 +1,195 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/service/provider"
+	"gitee.com/flycash/notification-platform/internal/service/provider/manage"
+	"github.com/gotomicro/ego/core/elog"
+)
+
+const (
+	defaultRefreshInterval = 30 * time.Second
+	refreshTimeout         = 5 * time.Second
+)
+
+// Factory 根据供应商记录创建供应商实例，通常按供应商名称选择 client.Client 的实现
+type Factory func(entity domain.Provider) (provider.Provider, error)
+
+// Updater 支持在运行时替换供应商的 SelectorBuilder
+// providers 为渠道当前所有可用的供应商，没有变化的供应商与上一次是同一个实例
+type Updater interface {
+	UpdateProviders(providers map[string]provider.Provider)
+}
+
+// Registry 供应商注册中心
+// 定期从供应商表加载启用的供应商，新增、修改或者禁用供应商后重新创建发生变化的供应商，
+// 再通知订阅的 SelectorBuilder 原子替换，已经选出的供应商继续完成正在进行的发送
+type Registry struct {
+	providerSvc manage.Service
+	interval    time.Duration
+
+	mu       sync.Mutex
+	channels map[domain.Channel]*channelProviders
+
+	logger *elog.Component
+}
+
+// channelProviders 一个渠道的供应商
+type channelProviders struct {
+	factory   Factory
+	updaters  []Updater
+	entities  map[string]domain.Provider
+	providers map[string]provider.Provider
+}
+
+// NewRegistry 创建供应商注册中心，interval 为重新加载供应商表的间隔
+func NewRegistry(providerSvc manage.Service, interval time.Duration) *Registry {
+	if interval <= 0 {
+		interval = defaultRefreshInterval
+	}
+	return &Registry{
+		providerSvc: providerSvc,
+		interval:    interval,
+		channels:    make(map[domain.Channel]*channelProviders),
+		logger:      elog.DefaultLogger.With(elog.FieldComponent("provider.registry")),
+	}
+}
+
+// Register 注册渠道并立即加载一次，返回渠道当前的供应商，用于创建 SelectorBuilder
+func (r *Registry) Register(ctx context.Context, channel domain.Channel, factory Factory) (map[string]provider.Provider, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	cp := &channelProviders{
+		factory:   factory,
+		entities:  make(map[string]domain.Provider),
+		providers: make(map[string]provider.Provider),
+	}
+	if _, err := r.reload(ctx, channel, cp); err != nil {
+		return nil, err
+	}
+	r.channels[channel] = cp
+	return maps.Clone(cp.providers), nil
+}
+
+// Subscribe 订阅渠道的供应商变化
+func (r *Registry) Subscribe(channel domain.Channel, updater Updater) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if cp, ok := r.channels[channel]; ok {
+		cp.updaters = append(cp.updaters, updater)
+	}
+}
+
+// NewProvider 使用渠道注册的 Factory 创建一个独立的供应商实例，不影响选择器中的供应商，用于测试发送
+func (r *Registry) NewProvider(entity domain.Provider) (provider.Provider, error) {
+	r.mu.Lock()
+	cp, ok := r.channels[entity.Channel]
+	r.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: 渠道 %s 没有注册到供应商注册中心", errs.ErrUnsupportedProvider, entity.Channel)
+	}
+	return cp.factory(entity)
+}
+
+// Start 定期重新加载所有渠道的供应商，直到 ctx 结束
+func (r *Registry) Start(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.Refresh(ctx)
+		}
+	}
+}
+
+// Refresh 重新加载所有渠道的供应商，加载失败的渠道继续使用旧的供应商
+func (r *Registry) Refresh(ctx context.Context) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for channel, cp := range r.channels {
+		ctx1, cancel := context.WithTimeout(ctx, refreshTimeout)
+		changed, err := r.reload(ctx1, channel, cp)
+		cancel()
+		if err != nil {
+			r.logger.Warn("加载供应商失败，继续使用旧的供应商",
+				elog.String("Channel", channel.String()),
+				elog.FieldErr(err))
+			continue
+		}
+		if !changed {
+			continue
+		}
+		providers := maps.Clone(cp.providers)
+		r.logger.Info("供应商发生变化，替换选择器中的供应商",
+			elog.String("Channel", channel.String()),
+			elog.Any("Providers", slices.Sorted(maps.Keys(providers))))
+		for _, u := range cp.updaters {
+			u.UpdateProviders(providers)
+		}
+	}
+}
+
+// reload 重新加载渠道的供应商，只重新创建发生变化的供应商，返回是否有变化
+func (r *Registry) reload(ctx context.Context, channel domain.Channel, cp *channelProviders) (bool, error) {
+	entities, err := r.providerSvc.GetByChannel(ctx, channel)
+	if err != nil {
+		return false, err
+	}
+	newEntities := make(map[string]domain.Provider, len(entities))
+	newProviders := make(map[string]provider.Provider, len(entities))
+	changed := false
+	for i := range entities {
+		entity := entities[i]
+		if entity.Status == domain.ProviderStatusInactive {
+			continue
+		}
+		newEntities[entity.Name] = entity
+		if old, ok := cp.entities[entity.Name]; ok && !needsRecreate(old, entity) {
+			newProviders[entity.Name] = cp.providers[entity.Name]
+			continue
+		}
+		p, err1 := cp.factory(entity)
+		if err1 != nil {
+			// 单个供应商创建失败不影响其他供应商，修复后下次加载时重试
+			r.logger.Warn("创建供应商失败",
+				elog.String("Channel", channel.String()),
+				elog.String("Provider", entity.Name),
+				elog.FieldErr(err1))
+			if old, ok := cp.providers[entity.Name]; ok {
+				newEntities[entity.Name] = cp.entities[entity.Name]
+				newProviders[entity.Name] = old
+			} else {
+				delete(newEntities, entity.Name)
+			}
+			continue
+		}
+		newProviders[entity.Name] = p
+		changed = true
+	}
+	// 被删除或者禁用的供应商
+	for name := range cp.providers {
+		if _, ok := newProviders[name]; !ok {
+			changed = true
+		}
+	}
+	cp.entities = newEntities
+	cp.providers = newProviders
+	return changed, nil
+}
+
+// needsRecreate 只有权重变化时不需要重新创建，权重由 SelectorBuilder 自行加载
+func needsRecreate(old, cur domain.Provider) bool {
+	old.Weight, cur.Weight = 0, 0
+	return old != cur
+}
@@ -0,0 +1,132 @@
+//go:build unit
+
+package registry
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/service/provider"
+	providermocks "gitee.com/flycash/notification-platform/internal/service/provider/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// fakeProvider 记录创建时使用的供应商记录
+type fakeProvider struct {
+	provider.Provider
+	entity domain.Provider
+}
+
+// recordUpdater 记录每次收到的供应商
+type recordUpdater struct {
+	mu      sync.Mutex
+	updates []map[string]provider.Provider
+}
+
+func (u *recordUpdater) UpdateProviders(providers map[string]provider.Provider) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.updates = append(u.updates, providers)
+}
+
+func newFactory(created *int, failed map[string]bool) Factory {
+	return func(entity domain.Provider) (provider.Provider, error) {
+		if failed[entity.Name] {
+			return nil, errors.New("mock error")
+		}
+		*created++
+		return &fakeProvider{entity: entity}, nil
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	aliyun := domain.Provider{Name: "aliyun", Channel: domain.ChannelSMS, APIKey: "key", Weight: 1, Status: domain.ProviderStatusActive}
+	tencent := domain.Provider{Name: "tencentcloud", Channel: domain.ChannelSMS, APIKey: "key", Weight: 1, Status: domain.ProviderStatusActive}
+	svc := providermocks.NewMockService(ctrl)
+	gomock.InOrder(
+		svc.EXPECT().GetByChannel(gomock.Any(), domain.ChannelSMS).Return([]domain.Provider{aliyun}, nil),
+		// 只修改权重，不需要重新创建
+		svc.EXPECT().GetByChannel(gomock.Any(), domain.ChannelSMS).Return([]domain.Provider{
+			func() domain.Provider { p := aliyun; p.Weight = 10; return p }(),
+		}, nil),
+		// 新增供应商
+		svc.EXPECT().GetByChannel(gomock.Any(), domain.ChannelSMS).Return([]domain.Provider{aliyun, tencent}, nil),
+		// 加载失败时继续使用旧的供应商
+		svc.EXPECT().GetByChannel(gomock.Any(), domain.ChannelSMS).Return(nil, errors.New("mock error")),
+		// 修改密钥并禁用供应商
+		svc.EXPECT().GetByChannel(gomock.Any(), domain.ChannelSMS).Return([]domain.Provider{
+			func() domain.Provider { p := aliyun; p.APIKey = "new-key"; return p }(),
+			func() domain.Provider { p := tencent; p.Status = domain.ProviderStatusInactive; return p }(),
+		}, nil),
+	)
+
+	var created int
+	r := NewRegistry(svc, 0)
+	providers, err := r.Register(t.Context(), domain.ChannelSMS, newFactory(&created, nil))
+	require.NoError(t, err)
+	require.Len(t, providers, 1)
+	first := providers["aliyun"]
+	updater := &recordUpdater{}
+	r.Subscribe(domain.ChannelSMS, updater)
+
+	r.Refresh(t.Context())
+	assert.Empty(t, updater.updates)
+	assert.Equal(t, 1, created)
+
+	r.Refresh(t.Context())
+	require.Len(t, updater.updates, 1)
+	assert.Len(t, updater.updates[0], 2)
+	// 没有变化的供应商是同一个实例
+	assert.Same(t, first, updater.updates[0]["aliyun"])
+	assert.Equal(t, 2, created)
+
+	r.Refresh(t.Context())
+	assert.Len(t, updater.updates, 1)
+
+	r.Refresh(t.Context())
+	require.Len(t, updater.updates, 2)
+	assert.Len(t, updater.updates[1], 1)
+	assert.NotSame(t, first, updater.updates[1]["aliyun"])
+	assert.Equal(t, "new-key", updater.updates[1]["aliyun"].(*fakeProvider).entity.APIKey)
+}
+
+func TestRegistry_FactoryFailed(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	aliyun := domain.Provider{Name: "aliyun", Channel: domain.ChannelSMS, APIKey: "key", Status: domain.ProviderStatusActive}
+	huawei := domain.Provider{Name: "huawei", Channel: domain.ChannelSMS, APIKey: "key", Status: domain.ProviderStatusActive}
+	svc := providermocks.NewMockService(ctrl)
+	gomock.InOrder(
+		svc.EXPECT().GetByChannel(gomock.Any(), domain.ChannelSMS).Return([]domain.Provider{aliyun, huawei}, nil),
+		svc.EXPECT().GetByChannel(gomock.Any(), domain.ChannelSMS).Return([]domain.Provider{
+			func() domain.Provider { p := aliyun; p.APIKey = "bad-key"; return p }(),
+			huawei,
+		}, nil),
+	)
+
+	var created int
+	failed := map[string]bool{"huawei": true}
+	r := NewRegistry(svc, 0)
+	providers, err := r.Register(t.Context(), domain.ChannelSMS, newFactory(&created, failed))
+	require.NoError(t, err)
+	// 不支持的供应商被跳过
+	assert.Len(t, providers, 1)
+	updater := &recordUpdater{}
+	r.Subscribe(domain.ChannelSMS, updater)
+
+	// 重新创建失败时继续使用旧的供应商
+	failed["aliyun"] = true
+	r.Refresh(t.Context())
+	assert.Empty(t, updater.updates)
+	assert.Equal(t, 1, created)
+}
@@ -0,0 +1,135 @@
+package sandbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/service/provider"
+	"gitee.com/flycash/notification-platform/internal/service/template/manage"
+	"gitee.com/flycash/notification-platform/internal/service/template/render"
+)
+
+// ProviderName 供应商记录使用该名称时创建沙箱供应商
+const ProviderName = domain.SandboxProviderName
+
+var errSimulatedFailure = errors.New("沙箱模拟发送失败")
+
+// Provider 沙箱供应商，不调用真实的供应商，只渲染模版并保存消息，可以模拟发送耗时和失败
+type Provider struct {
+	name        string
+	channel     domain.Channel
+	templateSvc manage.ChannelTemplateService
+	renderer    render.Service
+	svc         Service
+	cfg         Config
+}
+
+var _ provider.Provider = (*Provider)(nil)
+
+func NewProvider(name string, channel domain.Channel, templateSvc manage.ChannelTemplateService, renderer render.Service, svc Service, cfg Config) *Provider {
+	return &Provider{
+		name:        name,
+		channel:     channel,
+		templateSvc: templateSvc,
+		renderer:    renderer,
+		svc:         svc,
+		cfg:         cfg,
+	}
+}
+
+func (p *Provider) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
+	msg, err := p.render(ctx, notification)
+	if err != nil {
+		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
+	}
+
+	latency := p.latency()
+	if latency > 0 {
+		timer := time.NewTimer(latency)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, ctx.Err())
+		case <-timer.C:
+		}
+	}
+	msg.Latency = latency.Milliseconds()
+	msg.Ctime = time.Now().UnixMilli()
+
+	var sendErr error
+	if p.cfg.FailureRate > 0 && rand.Float64() < p.cfg.FailureRate {
+		sendErr = errSimulatedFailure
+		msg.Status = domain.SendStatusFailed
+		msg.ErrMessage = sendErr.Error()
+	}
+	// 失败的发送同样保存，方便断言重试和降级的行为
+	if err = p.svc.Capture(ctx, msg); err != nil {
+		return domain.SendResponse{}, fmt.Errorf("%w: 保存沙箱消息失败 %w", errs.ErrSendNotificationFailed, err)
+	}
+	if sendErr != nil {
+		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, sendErr)
+	}
+
+	results := make([]domain.ReceiverResult, 0, len(notification.Receivers))
+	for _, r := range notification.Receivers {
+		results = append(results, domain.ReceiverResult{
+			NotificationID: notification.ID,
+			Receiver:       r,
+			Provider:       p.name,
+			Status:         domain.SendStatusSucceeded,
+		})
+	}
+	return domain.SendResponse{
+		NotificationID:  notification.ID,
+		Status:          domain.SendStatusSucceeded,
+		ReceiverResults: results,
+	}, nil
+}
+
+// render 使用通知指定的模版版本渲染，没有指定时使用通知语言对应的已发布版本
+func (p *Provider) render(ctx context.Context, notification domain.Notification) (domain.CapturedMessage, error) {
+	tmpl, err := p.templateSvc.GetTemplateByID(ctx, notification.Template.ID)
+	if err != nil {
+		return domain.CapturedMessage{}, err
+	}
+	version := tmpl.ActiveVersionFor(notification.Locale)
+	if notification.Template.VersionID != 0 {
+		version = tmpl.GetVersion(notification.Template.VersionID)
+	}
+	if version == nil {
+		return domain.CapturedMessage{}, fmt.Errorf("%w: templateID=%d, versionID=%d",
+			errs.ErrTemplateNotFound, notification.Template.ID, notification.Template.VersionID)
+	}
+	rendered, err := p.renderer.Render(ctx, tmpl, *version, notification.Template.Params)
+	if err != nil {
+		return domain.CapturedMessage{}, err
+	}
+
+	return domain.CapturedMessage{
+		ID:                fmt.Sprintf("%d-%d", notification.ID, time.Now().UnixNano()),
+		BizID:             notification.BizID,
+		NotificationID:    notification.ID,
+		Key:               notification.Key,
+		Channel:           p.channel,
+		Provider:          p.name,
+		Receivers:         notification.Receivers,
+		TemplateID:        notification.Template.ID,
+		TemplateVersionID: version.ID,
+		Params:            notification.Template.Params,
+		Subject:           rendered.Subject,
+		Content:           rendered.Content,
+		Status:            domain.SendStatusSucceeded,
+	}, nil
+}
+
+func (p *Provider) latency() time.Duration {
+	if p.cfg.MaxLatency <= p.cfg.MinLatency {
+		return p.cfg.MinLatency
+	}
+	return p.cfg.MinLatency + rand.N(p.cfg.MaxLatency-p.cfg.MinLatency)
+}
@@ -0,0 +1,230 @@
+//go:build unit
+
+package sandbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/repository"
+	"gitee.com/flycash/notification-platform/internal/repository/cache/local"
+	templatemocks "gitee.com/flycash/notification-platform/internal/service/template/mocks"
+	"gitee.com/flycash/notification-platform/internal/service/template/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestProvider_Send(t *testing.T) {
+	t.Parallel()
+
+	testNotification := domain.Notification{
+		ID:        12345,
+		BizID:     1,
+		Key:       "key-1",
+		Channel:   domain.ChannelEmail,
+		Receivers: []string{"tom@example.com", "jerry@example.com"},
+		Template: domain.Template{
+			ID:     1,
+			Params: map[string]string{"name": "Tom", "code": "123456"},
+		},
+	}
+	testTemplate := domain.ChannelTemplate{
+		ID:      1,
+		Channel: domain.ChannelEmail,
+		Versions: []domain.ChannelTemplateVersion{
+			{ID: 1, Subject: "旧版本", Content: "旧版本 ${code}"},
+			{ID: 2, Subject: "${name}，您的验证码", Content: "<p>您的验证码是：${code}</p>"},
+		},
+		ActiveVersionID: 2,
+	}
+
+	tests := []struct {
+		name         string
+		cfg          Config
+		notification func() domain.Notification
+		setupMock    func(templateSvc *templatemocks.MockChannelTemplateService)
+		wantErr      error
+		wantMessage  domain.CapturedMessage
+		wantCaptured bool
+	}{
+		{
+			name: "使用已发布的版本渲染",
+			cfg:  Config{Enabled: true},
+			notification: func() domain.Notification {
+				return testNotification
+			},
+			setupMock: func(templateSvc *templatemocks.MockChannelTemplateService) {
+				templateSvc.EXPECT().GetTemplateByID(gomock.Any(), int64(1)).Return(testTemplate, nil)
+			},
+			wantMessage: domain.CapturedMessage{
+				BizID:             1,
+				NotificationID:    12345,
+				Key:               "key-1",
+				Channel:           domain.ChannelEmail,
+				Provider:          ProviderName,
+				Receivers:         []string{"tom@example.com", "jerry@example.com"},
+				TemplateID:        1,
+				TemplateVersionID: 2,
+				Params:            map[string]string{"name": "Tom", "code": "123456"},
+				Subject:           "Tom，您的验证码",
+				Content:           "<p>您的验证码是：123456</p>",
+				Status:            domain.SendStatusSucceeded,
+			},
+			wantCaptured: true,
+		},
+		{
+			name: "使用指定的版本渲染",
+			cfg:  Config{Enabled: true},
+			notification: func() domain.Notification {
+				n := testNotification
+				n.Template.VersionID = 1
+				return n
+			},
+			setupMock: func(templateSvc *templatemocks.MockChannelTemplateService) {
+				templateSvc.EXPECT().GetTemplateByID(gomock.Any(), int64(1)).Return(testTemplate, nil)
+			},
+			wantMessage: domain.CapturedMessage{
+				BizID:             1,
+				NotificationID:    12345,
+				Key:               "key-1",
+				Channel:           domain.ChannelEmail,
+				Provider:          ProviderName,
+				Receivers:         []string{"tom@example.com", "jerry@example.com"},
+				TemplateID:        1,
+				TemplateVersionID: 1,
+				Params:            map[string]string{"name": "Tom", "code": "123456"},
+				Subject:           "旧版本",
+				Content:           "旧版本 123456",
+				Status:            domain.SendStatusSucceeded,
+			},
+			wantCaptured: true,
+		},
+		{
+			name: "模拟失败时同样保存",
+			cfg:  Config{Enabled: true, FailureRate: 1},
+			notification: func() domain.Notification {
+				return testNotification
+			},
+			setupMock: func(templateSvc *templatemocks.MockChannelTemplateService) {
+				templateSvc.EXPECT().GetTemplateByID(gomock.Any(), int64(1)).Return(testTemplate, nil)
+			},
+			wantErr: errs.ErrSendNotificationFailed,
+			wantMessage: domain.CapturedMessage{
+				BizID:             1,
+				NotificationID:    12345,
+				Key:               "key-1",
+				Channel:           domain.ChannelEmail,
+				Provider:          ProviderName,
+				Receivers:         []string{"tom@example.com", "jerry@example.com"},
+				TemplateID:        1,
+				TemplateVersionID: 2,
+				Params:            map[string]string{"name": "Tom", "code": "123456"},
+				Subject:           "Tom，您的验证码",
+				Content:           "<p>您的验证码是：123456</p>",
+				Status:            domain.SendStatusFailed,
+				ErrMessage:        errSimulatedFailure.Error(),
+			},
+			wantCaptured: true,
+		},
+		{
+			name: "获取模版失败",
+			cfg:  Config{Enabled: true},
+			notification: func() domain.Notification {
+				return testNotification
+			},
+			setupMock: func(templateSvc *templatemocks.MockChannelTemplateService) {
+				templateSvc.EXPECT().GetTemplateByID(gomock.Any(), int64(1)).Return(domain.ChannelTemplate{}, errors.New("mock error"))
+			},
+			wantErr: errs.ErrSendNotificationFailed,
+		},
+		{
+			name: "指定的版本不存在",
+			cfg:  Config{Enabled: true},
+			notification: func() domain.Notification {
+				n := testNotification
+				n.Template.VersionID = 3
+				return n
+			},
+			setupMock: func(templateSvc *templatemocks.MockChannelTemplateService) {
+				templateSvc.EXPECT().GetTemplateByID(gomock.Any(), int64(1)).Return(testTemplate, nil)
+			},
+			wantErr: errs.ErrTemplateNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			templateSvc := templatemocks.NewMockChannelTemplateService(ctrl)
+			tt.setupMock(templateSvc)
+			templateSvc.EXPECT().GetTemplatesByOwner(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+			svc := NewService(repository.NewSandboxRepository(local.NewSandboxCache()), tt.cfg)
+			p := NewProvider(ProviderName, domain.ChannelEmail, templateSvc, render.NewService(templateSvc), svc, tt.cfg)
+
+			resp, err := p.Send(t.Context(), tt.notification())
+			assert.ErrorIs(t, err, tt.wantErr)
+			if err == nil {
+				assert.Equal(t, domain.SendStatusSucceeded, resp.Status)
+				assert.Len(t, resp.ReceiverResults, len(testNotification.Receivers))
+			}
+
+			msgs, total, err := svc.List(t.Context(), domain.CapturedMessageQuery{BizID: 1})
+			require.NoError(t, err)
+			if !tt.wantCaptured {
+				assert.Zero(t, total)
+				return
+			}
+			require.Len(t, msgs, 1)
+			assert.NotEmpty(t, msgs[0].ID)
+			assert.NotZero(t, msgs[0].Ctime)
+			msgs[0].ID, msgs[0].Ctime = "", 0
+			assert.Equal(t, tt.wantMessage, msgs[0])
+		})
+	}
+}
+
+func TestProvider_SendLatency(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	templateSvc := templatemocks.NewMockChannelTemplateService(ctrl)
+	templateSvc.EXPECT().GetTemplateByID(gomock.Any(), int64(1)).Return(domain.ChannelTemplate{
+		ID:              1,
+		Versions:        []domain.ChannelTemplateVersion{{ID: 1, Content: "内容"}},
+		ActiveVersionID: 1,
+	}, nil).Times(2)
+	templateSvc.EXPECT().GetTemplatesByOwner(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	cfg := Config{Enabled: true, MinLatency: 50 * time.Millisecond, MaxLatency: 60 * time.Millisecond}
+	svc := NewService(repository.NewSandboxRepository(local.NewSandboxCache()), cfg)
+	p := NewProvider(ProviderName, domain.ChannelSMS, templateSvc, render.NewService(templateSvc), svc, cfg)
+	n := domain.Notification{ID: 1, BizID: 1, Receivers: []string{"13800138000"}, Template: domain.Template{ID: 1}}
+
+	start := time.Now()
+	_, err := p.Send(t.Context(), n)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), cfg.MinLatency)
+	msgs, _, err := svc.List(t.Context(), domain.CapturedMessageQuery{BizID: 1})
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	assert.GreaterOrEqual(t, msgs[0].Latency, cfg.MinLatency.Milliseconds())
+
+	// 超时时不保存
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+	_, err = p.Send(ctx, n)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	_, total, err := svc.List(t.Context(), domain.CapturedMessageQuery{BizID: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+}
@@ -0,0 +1,121 @@
+package sandbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/repository"
+)
+
+const (
+	defaultRetention = 24 * time.Hour
+	defaultLimit     = 20
+	maxLimit         = 100
+)
+
+// Config 沙箱供应商配置，只应该在测试环境开启
+type Config struct {
+	Enabled bool `yaml:"enabled"`
+	// Store 可选 redis、memory，memory 只适合单实例
+	Store string `yaml:"store"`
+	// Retention 消息默认保留时长
+	Retention time.Duration `yaml:"retention"`
+	// Retentions 按业务ID覆盖保留时长
+	Retentions map[int64]time.Duration `yaml:"retentions"`
+	// MinLatency MaxLatency 模拟的发送耗时范围
+	MinLatency time.Duration `yaml:"minLatency"`
+	MaxLatency time.Duration `yaml:"maxLatency"`
+	// FailureRate 模拟的失败率，取值 [0, 1]
+	FailureRate float64 `yaml:"failureRate"`
+}
+
+// RetentionOf 业务的消息保留时长
+func (c Config) RetentionOf(bizID int64) time.Duration {
+	if r, ok := c.Retentions[bizID]; ok && r > 0 {
+		return r
+	}
+	if c.Retention > 0 {
+		return c.Retention
+	}
+	return defaultRetention
+}
+
+// Service 沙箱消息服务，保存沙箱供应商捕获的消息并提供查询
+type Service interface {
+	// Enabled 是否开启了沙箱供应商
+	Enabled() bool
+	// Capture 保存捕获的消息
+	Capture(ctx context.Context, msg domain.CapturedMessage) error
+	// List 按条件分页查询业务的消息，按捕获时间倒序，返回满足条件的总数
+	List(ctx context.Context, query domain.CapturedMessageQuery) ([]domain.CapturedMessage, int, error)
+	// Get 获取单条消息
+	Get(ctx context.Context, bizID int64, id string) (domain.CapturedMessage, error)
+	// Clear 清空业务的全部消息
+	Clear(ctx context.Context, bizID int64) error
+}
+
+type service struct {
+	repo repository.SandboxRepository
+	cfg  Config
+}
+
+func NewService(repo repository.SandboxRepository, cfg Config) Service {
+	return &service{
+		repo: repo,
+		cfg:  cfg,
+	}
+}
+
+func (s *service) Enabled() bool {
+	return s.cfg.Enabled
+}
+
+func (s *service) Capture(ctx context.Context, msg domain.CapturedMessage) error {
+	return s.repo.Save(ctx, msg, s.cfg.RetentionOf(msg.BizID))
+}
+
+func (s *service) List(ctx context.Context, query domain.CapturedMessageQuery) ([]domain.CapturedMessage, int, error) {
+	if query.BizID <= 0 {
+		return nil, 0, fmt.Errorf("%w: BizID = %d", errs.ErrInvalidParameter, query.BizID)
+	}
+	if query.Offset < 0 {
+		return nil, 0, fmt.Errorf("%w: Offset = %d", errs.ErrInvalidParameter, query.Offset)
+	}
+	limit := query.Limit
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	limit = min(limit, maxLimit)
+
+	msgs, err := s.repo.Find(ctx, query.BizID)
+	if err != nil {
+		return nil, 0, err
+	}
+	matched := make([]domain.CapturedMessage, 0, len(msgs))
+	for i := range msgs {
+		if query.Match(msgs[i]) {
+			matched = append(matched, msgs[i])
+		}
+	}
+	total := len(matched)
+	start := min(query.Offset, total)
+	end := min(start+limit, total)
+	return matched[start:end], total, nil
+}
+
+func (s *service) Get(ctx context.Context, bizID int64, id string) (domain.CapturedMessage, error) {
+	if bizID <= 0 || id == "" {
+		return domain.CapturedMessage{}, fmt.Errorf("%w: BizID = %d, ID = %q", errs.ErrInvalidParameter, bizID, id)
+	}
+	return s.repo.Get(ctx, bizID, id)
+}
+
+func (s *service) Clear(ctx context.Context, bizID int64) error {
+	if bizID <= 0 {
+		return fmt.Errorf("%w: BizID = %d", errs.ErrInvalidParameter, bizID)
+	}
+	return s.repo.Clear(ctx, bizID)
+}
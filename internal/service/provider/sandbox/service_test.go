@@ -0,0 +1,156 @@
+//go:build unit
+
+package sandbox
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/repository"
+	"gitee.com/flycash/notification-platform/internal/repository/cache/local"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_List(t *testing.T) {
+	t.Parallel()
+
+	svc := NewService(repository.NewSandboxRepository(local.NewSandboxCache()), Config{Enabled: true})
+	for i := 1; i <= 5; i++ {
+		channel := domain.ChannelSMS
+		if i%2 == 0 {
+			channel = domain.ChannelEmail
+		}
+		require.NoError(t, svc.Capture(t.Context(), domain.CapturedMessage{
+			ID:             fmt.Sprintf("%d", i),
+			BizID:          1,
+			NotificationID: uint64(i),
+			Key:            fmt.Sprintf("key-%d", i),
+			Channel:        channel,
+			Receivers:      []string{fmt.Sprintf("receiver-%d", i)},
+			Ctime:          int64(i),
+		}))
+	}
+	// 其他业务的消息
+	require.NoError(t, svc.Capture(t.Context(), domain.CapturedMessage{ID: "6", BizID: 2, Channel: domain.ChannelSMS}))
+
+	ids := func(msgs []domain.CapturedMessage) []string {
+		res := make([]string, 0, len(msgs))
+		for _, msg := range msgs {
+			res = append(res, msg.ID)
+		}
+		return res
+	}
+
+	tests := []struct {
+		name      string
+		query     domain.CapturedMessageQuery
+		wantIDs   []string
+		wantTotal int
+		wantErr   error
+	}{
+		{
+			name:      "按捕获时间倒序",
+			query:     domain.CapturedMessageQuery{BizID: 1},
+			wantIDs:   []string{"5", "4", "3", "2", "1"},
+			wantTotal: 5,
+		},
+		{
+			name:      "分页",
+			query:     domain.CapturedMessageQuery{BizID: 1, Offset: 1, Limit: 2},
+			wantIDs:   []string{"4", "3"},
+			wantTotal: 5,
+		},
+		{
+			name:      "超出范围",
+			query:     domain.CapturedMessageQuery{BizID: 1, Offset: 10},
+			wantIDs:   []string{},
+			wantTotal: 5,
+		},
+		{
+			name:      "按渠道过滤",
+			query:     domain.CapturedMessageQuery{BizID: 1, Channel: domain.ChannelEmail},
+			wantIDs:   []string{"4", "2"},
+			wantTotal: 2,
+		},
+		{
+			name:      "按接收者过滤",
+			query:     domain.CapturedMessageQuery{BizID: 1, Receiver: "receiver-3"},
+			wantIDs:   []string{"3"},
+			wantTotal: 1,
+		},
+		{
+			name:      "按通知ID和Key过滤",
+			query:     domain.CapturedMessageQuery{BizID: 1, NotificationID: 2, Key: "key-2"},
+			wantIDs:   []string{"2"},
+			wantTotal: 1,
+		},
+		{
+			name:    "非法的业务ID",
+			query:   domain.CapturedMessageQuery{},
+			wantErr: errs.ErrInvalidParameter,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			msgs, total, err := svc.List(t.Context(), tt.query)
+			assert.ErrorIs(t, err, tt.wantErr)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tt.wantIDs, ids(msgs))
+			assert.Equal(t, tt.wantTotal, total)
+		})
+	}
+}
+
+func TestService_GetAndClear(t *testing.T) {
+	t.Parallel()
+
+	svc := NewService(repository.NewSandboxRepository(local.NewSandboxCache()), Config{Enabled: true})
+	msg := domain.CapturedMessage{ID: "1", BizID: 1, Content: "内容"}
+	require.NoError(t, svc.Capture(t.Context(), msg))
+
+	got, err := svc.Get(t.Context(), 1, "1")
+	require.NoError(t, err)
+	assert.Equal(t, msg, got)
+
+	// 不能查询其他业务的消息
+	_, err = svc.Get(t.Context(), 2, "1")
+	assert.ErrorIs(t, err, errs.ErrCapturedMessageNotFound)
+
+	require.NoError(t, svc.Clear(t.Context(), 1))
+	_, err = svc.Get(t.Context(), 1, "1")
+	assert.ErrorIs(t, err, errs.ErrCapturedMessageNotFound)
+}
+
+func TestService_Retention(t *testing.T) {
+	t.Parallel()
+
+	cfg := Config{
+		Enabled:    true,
+		Retention:  time.Hour,
+		Retentions: map[int64]time.Duration{2: 50 * time.Millisecond},
+	}
+	assert.Equal(t, time.Hour, cfg.RetentionOf(1))
+	assert.Equal(t, 50*time.Millisecond, cfg.RetentionOf(2))
+	assert.Equal(t, defaultRetention, Config{}.RetentionOf(1))
+
+	svc := NewService(repository.NewSandboxRepository(local.NewSandboxCache()), cfg)
+	require.NoError(t, svc.Capture(t.Context(), domain.CapturedMessage{ID: "1", BizID: 1}))
+	require.NoError(t, svc.Capture(t.Context(), domain.CapturedMessage{ID: "2", BizID: 2}))
+	time.Sleep(100 * time.Millisecond)
+
+	_, total, err := svc.List(t.Context(), domain.CapturedMessageQuery{BizID: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	_, total, err = svc.List(t.Context(), domain.CapturedMessageQuery{BizID: 2})
+	require.NoError(t, err)
+	assert.Zero(t, total)
+}
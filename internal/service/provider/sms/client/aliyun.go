@@ -3,8 +3,10 @@ package client
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
+	"gitee.com/flycash/notification-platform/internal/pkg/receiver"
 	openapi "github.com/alibabacloud-go/darabonba-openapi/v2/client"
 	dysmsapi "github.com/alibabacloud-go/dysmsapi-20170525/v4/client"
 	"github.com/alibabacloud-go/tea/tea"
@@ -18,16 +20,19 @@ var (
 		TemplateTypeMarketing:     TemplateTypeNotification,
 		TemplateTypeInternational: TemplateTypeVerification,
 	}
-	_ Client = (*AliyunSMS)(nil)
+	_ Client         = (*AliyunSMS)(nil)
+	_ CallbackParser = (*AliyunSMS)(nil)
 )
 
 // AliyunSMS 阿里云短信实现
 type AliyunSMS struct {
 	client *dysmsapi.Client
+	// callbackToken 校验推送令牌
+	callbackToken string
 }
 
-// NewAliyunSMS 创建阿里云短信实例
-func NewAliyunSMS(regionID, accessKeyID, accessKeySecret string) (*AliyunSMS, error) {
+// NewAliyunSMS 创建阿里云短信实例，callbackToken 为空时拒绝所有推送
+func NewAliyunSMS(regionID, accessKeyID, accessKeySecret, callbackToken string) (*AliyunSMS, error) {
 	config := &openapi.Config{
 		AccessKeyId:     tea.String(accessKeyID),
 		AccessKeySecret: tea.String(accessKeySecret),
@@ -39,12 +44,17 @@ func NewAliyunSMS(regionID, accessKeyID, accessKeySecret string) (*AliyunSMS, er
 	if err != nil {
 		return nil, err
 	}
-	return &AliyunSMS{client: client}, nil
+	return &AliyunSMS{client: client, callbackToken: callbackToken}, nil
 }
 
 func (a *AliyunSMS) CreateTemplate(req CreateTemplateReq) (CreateTemplateResp, error) {
 	// https://help.aliyun.com/zh/sms/developer-reference/api-dysmsapi-2017-05-25-createsmstemplate?spm=a2c4g.11186623.help-menu-44282.d_4_2_4_2_0.18706b6bAOg39L&scm=20140722.H_2807431._.OR_help-T_cn~zh-V_1
-	templateType, ok := platformTemplateType2Aliyun[req.TemplateType]
+	platformType := req.TemplateType
+	if req.International {
+		// 阿里云国际/港澳台短信是单独的模版类型
+		platformType = TemplateTypeInternational
+	}
+	templateType, ok := platformTemplateType2Aliyun[platformType]
 	if !ok {
 		return CreateTemplateResp{}, fmt.Errorf("%w: 模版类型非法", ErrInvalidParameter)
 	}
@@ -173,8 +183,13 @@ func (a *AliyunSMS) handleResponse(response *dysmsapi.QuerySmsTemplateListRespon
 }
 
 func (a *AliyunSMS) getAuditStatus(template *dysmsapi.QuerySmsTemplateListResponseBodySmsTemplateList) AuditStatus {
+	return a.auditStatus(*template.AuditStatus)
+}
+
+// auditStatus 查询接口和审核结果推送使用相同的审核状态
+func (a *AliyunSMS) auditStatus(status string) AuditStatus {
 	var auditStatus AuditStatus
-	switch *template.AuditStatus {
+	switch status {
 	case "AUDIT_STATE_PASS":
 		auditStatus = AuditStatusApproved
 	case "AUDIT_STATE_NOT_PASS":
@@ -198,7 +213,7 @@ func (a *AliyunSMS) Send(req SendReq) (SendResp, error) {
 		if i > 0 {
 			phoneNumbers += ","
 		}
-		phoneNumbers += phone
+		phoneNumbers += a.phoneNumber(phone)
 	}
 
 	templateParam := ""
@@ -235,12 +250,173 @@ func (a *AliyunSMS) Send(req SendReq) (SendResp, error) {
 	// 阿里云短信发送接口不返回每个手机号的状态，只返回整体状态
 	// 所以这里为每个手机号设置相同的状态
 	for _, phone := range req.PhoneNumbers {
-		// 去掉可能的+86前缀
-		cleanPhone := strings.TrimPrefix(phone, "+86")
-		result.PhoneNumbers[cleanPhone] = SendRespStatus{
+		result.PhoneNumbers[receiver.NormalizePhone(phone)] = SendRespStatus{
 			Code:    *response.Body.Code,
 			Message: *response.Body.Message,
+			BizID:   tea.StringValue(response.Body.BizId),
 		}
 	}
 	return result, nil
 }
+
+func (a *AliyunSMS) QuerySendDetails(req QuerySendDetailsReq) (QuerySendDetailsResp, error) {
+	// https://help.aliyun.com/zh/sms/developer-reference/api-dysmsapi-2017-05-25-querysenddetails
+	if req.PhoneNumber == "" || req.SendDate == "" {
+		return QuerySendDetailsResp{}, fmt.Errorf("%w: %v", ErrInvalidParameter, "手机号码和发送日期不能为空")
+	}
+
+	// 分页大小取值范围 1~50
+	const maxPageSize = 50
+	pageSize := min(max(req.PageSize, 1), maxPageSize)
+	currentPage := max(req.CurrentPage, 1)
+	request := &dysmsapi.QuerySendDetailsRequest{
+		PhoneNumber: tea.String(a.phoneNumber(req.PhoneNumber)),
+		SendDate:    tea.String(req.SendDate),
+		PageSize:    tea.Int64(int64(pageSize)),
+		CurrentPage: tea.Int64(int64(currentPage)),
+	}
+	if req.BizID != "" {
+		request.BizId = tea.String(req.BizID)
+	}
+
+	response, err := a.client.QuerySendDetails(request)
+	if err != nil {
+		return QuerySendDetailsResp{}, fmt.Errorf("%w: %w", ErrQuerySendDetails, err)
+	}
+
+	if response.Body == nil || response.Body.Code == nil || !strings.EqualFold(*response.Body.Code, OK) {
+		return QuerySendDetailsResp{}, fmt.Errorf("%w: %v", ErrQuerySendDetails, "响应异常")
+	}
+
+	totalCount, _ := strconv.Atoi(tea.StringValue(response.Body.TotalCount))
+	result := QuerySendDetailsResp{
+		RequestID:  tea.StringValue(response.Body.RequestId),
+		TotalCount: totalCount,
+	}
+	if response.Body.SmsSendDetailDTOs == nil {
+		return result, nil
+	}
+	for _, dto := range response.Body.SmsSendDetailDTOs.SmsSendDetailDTO {
+		result.SmsSendDetailDTOs = append(result.SmsSendDetailDTOs, SendDetail{
+			PhoneNum:     tea.StringValue(dto.PhoneNum),
+			SendStatus:   int(tea.Int64Value(dto.SendStatus)),
+			Content:      tea.StringValue(dto.Content),
+			TemplateCode: tea.StringValue(dto.TemplateCode),
+			SendDate:     tea.StringValue(dto.SendDate),
+			ReceiveDate:  tea.StringValue(dto.ReceiveDate),
+			ErrCode:      tea.StringValue(dto.ErrCode),
+			OutID:        tea.StringValue(dto.OutId),
+		})
+	}
+	return result, nil
+}
+
+// aliyunStatusReport 阿里云 SmsReport 推送的单条状态报告
+type aliyunStatusReport struct {
+	PhoneNumber string `json:"phone_number"`
+	Success     bool   `json:"success"`
+	ErrCode     string `json:"err_code"`
+	BizID       string `json:"biz_id"`
+}
+
+// aliyunUpstreamReply 阿里云 SmsUp 推送的单条上行短信
+type aliyunUpstreamReply struct {
+	PhoneNumber string `json:"phone_number"`
+	SendTime    string `json:"send_time"`
+	Content     string `json:"content"`
+	SignName    string `json:"sign_name"`
+	DestCode    string `json:"dest_code"`
+}
+
+// aliyunTemplateAuditResult 阿里云 TemplateSmsReport 推送的单条模版审核结果
+type aliyunTemplateAuditResult struct {
+	TemplateCode string               `json:"template_code"`
+	AuditStatus  string               `json:"audit_status"`
+	Reason       aliyunTemplateReason `json:"reason"`
+}
+
+// aliyunTemplateReason 审核未通过的原因，旧版推送中是字符串，新版推送中是对象
+type aliyunTemplateReason struct {
+	RejectInfo string `json:"reject_info"`
+}
+
+func (r *aliyunTemplateReason) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		return json.Unmarshal(data, &r.RejectInfo)
+	}
+	type reason aliyunTemplateReason
+	return json.Unmarshal(data, (*reason)(r))
+}
+
+func (a *AliyunSMS) VerifyCallback(token string) error {
+	return verifyToken(a.callbackToken, token)
+}
+
+func (a *AliyunSMS) ParseStatusReports(body []byte) ([]StatusReport, error) {
+	// https://help.aliyun.com/zh/sms/developer-reference/configure-delivery-receipts-1
+	var reports []aliyunStatusReport
+	if err := json.Unmarshal(body, &reports); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCallback, err)
+	}
+	result := make([]StatusReport, 0, len(reports))
+	for i := range reports {
+		result = append(result, StatusReport{
+			PhoneNumber: receiver.NormalizePhone(reports[i].PhoneNumber),
+			BizID:       reports[i].BizID,
+			Success:     reports[i].Success,
+			ErrCode:     reports[i].ErrCode,
+		})
+	}
+	return result, nil
+}
+
+func (a *AliyunSMS) ParseUpstreamReplies(body []byte) ([]UpstreamReply, error) {
+	var replies []aliyunUpstreamReply
+	if err := json.Unmarshal(body, &replies); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCallback, err)
+	}
+	result := make([]UpstreamReply, 0, len(replies))
+	for i := range replies {
+		result = append(result, UpstreamReply{
+			PhoneNumber: receiver.NormalizePhone(replies[i].PhoneNumber),
+			Content:     replies[i].Content,
+			SignName:    replies[i].SignName,
+			ExtendCode:  replies[i].DestCode,
+			ReplyTime:   parseCallbackTime(replies[i].SendTime),
+		})
+	}
+	return result, nil
+}
+
+func (a *AliyunSMS) ParseTemplateAuditResults(body []byte) ([]TemplateAuditResult, error) {
+	// https://help.aliyun.com/zh/sms/developer-reference/configure-delivery-receipts-1
+	var results []aliyunTemplateAuditResult
+	if err := json.Unmarshal(body, &results); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCallback, err)
+	}
+	result := make([]TemplateAuditResult, 0, len(results))
+	for i := range results {
+		result = append(result, TemplateAuditResult{
+			TemplateID:  results[i].TemplateCode,
+			AuditStatus: a.auditStatus(results[i].AuditStatus),
+			Reason:      results[i].Reason.RejectInfo,
+		})
+	}
+	return result, nil
+}
+
+func (a *AliyunSMS) CallbackAck() any {
+	return map[string]any{"code": 0, "msg": "成功"}
+}
+
+// phoneNumber 阿里云国内短信使用不带国家码的手机号，国际/港澳台短信使用国家码加号码，不带 + 和 00
+func (a *AliyunSMS) phoneNumber(phone string) string {
+	p, err := receiver.ParsePhone(phone)
+	if err != nil {
+		return phone
+	}
+	if p.IsDomestic() {
+		return p.Number
+	}
+	return p.CountryCode + p.Number
+}
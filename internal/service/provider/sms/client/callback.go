@@ -0,0 +1,81 @@
+package client
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// QueryCallbackToken 推送地址中携带推送令牌的查询参数
+	QueryCallbackToken = "token"
+
+	// callbackTimeLayout 阿里云、腾讯云推送中的时间格式
+	callbackTimeLayout = "2006-01-02 15:04:05"
+)
+
+var (
+	ErrInvalidCallbackToken = errors.New("推送令牌无效")
+	ErrInvalidCallback      = errors.New("推送内容无效")
+)
+
+// StatusReport 供应商推送的短信状态报告
+type StatusReport struct {
+	PhoneNumber string // 平台内统一格式的手机号，中国大陆手机号不带国家码
+	BizID       string // 发送回执 ID, 阿里云为biz_id，腾讯云为sid
+	Success     bool   // 是否送达
+	ErrCode     string // 运营商错误码
+}
+
+// UpstreamReply 用户回复的上行短信
+type UpstreamReply struct {
+	PhoneNumber string // 平台内统一格式的手机号，中国大陆手机号不带国家码
+	Content     string // 回复内容
+	SignName    string // 回复的短信签名
+	ExtendCode  string // 扩展码
+	ReplyTime   int64  // 回复时间，毫秒
+}
+
+// TemplateAuditResult 供应商推送的模版审核结果
+type TemplateAuditResult struct {
+	TemplateID  string      // 供应商侧模版ID，与提交审核时返回的ID一致
+	AuditStatus AuditStatus // 审核状态
+	Reason      string      // 拒绝原因
+}
+
+// CallbackParser 解析供应商推送的状态报告、上行短信和模版审核结果
+type CallbackParser interface {
+	// VerifyCallback 校验推送地址中携带的推送令牌
+	VerifyCallback(token string) error
+	// ParseStatusReports 解析状态报告
+	ParseStatusReports(body []byte) ([]StatusReport, error)
+	// ParseUpstreamReplies 解析上行短信
+	ParseUpstreamReplies(body []byte) ([]UpstreamReply, error)
+	// ParseTemplateAuditResults 解析模版审核结果
+	ParseTemplateAuditResults(body []byte) ([]TemplateAuditResult, error)
+	// CallbackAck 处理成功后返回给供应商的响应体
+	CallbackAck() any
+}
+
+// verifyToken 阿里云、腾讯云的状态报告、上行短信和模版审核推送都不带签名，
+// 平台为每个供应商生成推送令牌，配置在供应商控制台的推送地址中，收到推送时比较令牌。
+// 没有配置推送令牌的供应商拒绝所有推送，只能依靠轮询获取结果
+func verifyToken(expected, actual string) error {
+	if expected == "" {
+		return fmt.Errorf("%w: 供应商没有配置推送令牌", ErrInvalidCallbackToken)
+	}
+	if subtle.ConstantTimeCompare([]byte(expected), []byte(actual)) != 1 {
+		return fmt.Errorf("%w: 令牌不匹配", ErrInvalidCallbackToken)
+	}
+	return nil
+}
+
+// parseCallbackTime 解析推送中的时间，格式非法时返回0
+func parseCallbackTime(value string) int64 {
+	t, err := time.ParseInLocation(callbackTimeLayout, value, time.Local)
+	if err != nil {
+		return 0
+	}
+	return t.UnixMilli()
+}
@@ -0,0 +1,129 @@
+//go:build unit
+
+package client
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyToken(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		expected string
+		actual   string
+		wantErr  error
+	}{
+		{
+			name:     "令牌正确",
+			expected: "callback-token",
+			actual:   "callback-token",
+		},
+		{
+			name:     "缺少令牌",
+			expected: "callback-token",
+			wantErr:  ErrInvalidCallbackToken,
+		},
+		{
+			name:     "令牌不匹配",
+			expected: "callback-token",
+			actual:   "other-token",
+			wantErr:  ErrInvalidCallbackToken,
+		},
+		{
+			name:     "供应商没有配置令牌",
+			expected: "",
+			actual:   "",
+			wantErr:  ErrInvalidCallbackToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.ErrorIs(t, verifyToken(tt.expected, tt.actual), tt.wantErr)
+		})
+	}
+}
+
+// readFixture 读取供应商文档中的推送示例，阿里云、腾讯云的推送都不带签名请求头
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	body, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	return body
+}
+
+func TestAliyunSMS_ParseCallback(t *testing.T) {
+	t.Parallel()
+
+	a := &AliyunSMS{}
+	reports, err := a.ParseStatusReports(readFixture(t, "aliyun_sms_report.json"))
+	require.NoError(t, err)
+	assert.Equal(t, []StatusReport{
+		{PhoneNumber: "13800138000", BizID: "932702304080415357^0", Success: true, ErrCode: "DELIVERED"},
+		{PhoneNumber: "13800138001", BizID: "932702304080415358^0", Success: false, ErrCode: "MK:0001"},
+	}, reports)
+
+	replies, err := a.ParseUpstreamReplies(readFixture(t, "aliyun_sms_up.json"))
+	require.NoError(t, err)
+	sendTime, err := time.ParseInLocation(callbackTimeLayout, "2025-05-20 10:01:00", time.Local)
+	require.NoError(t, err)
+	assert.Equal(t, []UpstreamReply{
+		{PhoneNumber: "13800138000", Content: "TD", SignName: "阿里云短信测试", ExtendCode: "1234", ReplyTime: sendTime.UnixMilli()},
+	}, replies)
+
+	results, err := a.ParseTemplateAuditResults(readFixture(t, "aliyun_template_sms_report.json"))
+	require.NoError(t, err)
+	assert.Equal(t, []TemplateAuditResult{
+		{TemplateID: "SMS_20375****1", AuditStatus: AuditStatusApproved},
+		{TemplateID: "SMS_20375****2", AuditStatus: AuditStatusRejected, Reason: "签名不匹配"},
+	}, results)
+
+	// 旧版推送中 reason 是字符串
+	results, err = a.ParseTemplateAuditResults([]byte(`[{"template_code":"SMS_1","audit_status":"AUDIT_STATE_NOT_PASS","reason":"签名不匹配"}]`))
+	require.NoError(t, err)
+	assert.Equal(t, []TemplateAuditResult{
+		{TemplateID: "SMS_1", AuditStatus: AuditStatusRejected, Reason: "签名不匹配"},
+	}, results)
+
+	_, err = a.ParseStatusReports([]byte(`{`))
+	assert.ErrorIs(t, err, ErrInvalidCallback)
+}
+
+func TestTencentCloudSMS_ParseCallback(t *testing.T) {
+	t.Parallel()
+
+	tc := &TencentCloudSMS{}
+	reports, err := tc.ParseStatusReports(readFixture(t, "tencent_sms_report.json"))
+	require.NoError(t, err)
+	assert.Equal(t, []StatusReport{
+		{PhoneNumber: "13800138000", BizID: "2019:538884*********", Success: true, ErrCode: "DELIVRD"},
+		{PhoneNumber: "13800138001", BizID: "2019:538884*********2", Success: false, ErrCode: "MN:0001"},
+	}, reports)
+
+	replies, err := tc.ParseUpstreamReplies(readFixture(t, "tencent_sms_reply.json"))
+	require.NoError(t, err)
+	assert.Equal(t, []UpstreamReply{
+		{PhoneNumber: "13800138000", Content: "退订", SignName: "腾讯云", ExtendCode: "01", ReplyTime: 1747706460000},
+	}, replies)
+
+	results, err := tc.ParseTemplateAuditResults(readFixture(t, "tencent_template_status.json"))
+	require.NoError(t, err)
+	assert.Equal(t, []TemplateAuditResult{
+		{TemplateID: "123456", AuditStatus: AuditStatusRejected, Reason: "签名不匹配"},
+	}, results)
+
+	_, err = tc.ParseTemplateAuditResults([]byte(`{"template_id":123456,"status_code":3}`))
+	assert.ErrorIs(t, err, ErrInvalidCallback)
+
+	_, err = tc.ParseUpstreamReplies([]byte(`[`))
+	assert.ErrorIs(t, err, ErrInvalidCallback)
+}
@@ -0,0 +1,51 @@
+package client
+
+import (
+	"maps"
+	"sync"
+)
+
+// Clients 按供应商名称保存的SMS客户端
+// 供应商注册中心创建或者移除供应商时同步更新，模版提审、回执对账和推送回调都使用最新的客户端
+type Clients struct {
+	mu      sync.RWMutex
+	clients map[string]Client
+}
+
+// NewClients clients 的 key 为供应商名称
+func NewClients(clients map[string]Client) *Clients {
+	cs := maps.Clone(clients)
+	if cs == nil {
+		cs = make(map[string]Client)
+	}
+	return &Clients{clients: cs}
+}
+
+// Get 获取供应商的客户端
+func (c *Clients) Get(name string) (Client, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	cli, ok := c.clients[name]
+	return cli, ok
+}
+
+// Set 设置供应商的客户端，已有的客户端会被替换
+func (c *Clients) Set(name string, cli Client) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.clients[name] = cli
+}
+
+// Retain 只保留 names 中的供应商，被删除或者禁用的供应商的客户端会被移除
+func (c *Clients) Retain(names []string) {
+	keep := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		keep[name] = struct{}{}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	maps.DeleteFunc(c.clients, func(name string, _ Client) bool {
+		_, ok := keep[name]
+		return !ok
+	})
+}
@@ -0,0 +1,32 @@
+//go:build unit
+
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClients(t *testing.T) {
+	t.Parallel()
+
+	aliyun := &AliyunSMS{}
+	cs := NewClients(map[string]Client{"aliyun": aliyun})
+	_, ok := cs.Get("tencentcloud")
+	assert.False(t, ok)
+
+	// 注册中心新增供应商
+	tencent := &TencentCloudSMS{}
+	cs.Set("tencentcloud", tencent)
+	got, ok := cs.Get("tencentcloud")
+	assert.True(t, ok)
+	assert.Same(t, tencent, got)
+
+	// 注册中心禁用了阿里云
+	cs.Retain([]string{"tencentcloud"})
+	_, ok = cs.Get("aliyun")
+	assert.False(t, ok)
+	_, ok = cs.Get("tencentcloud")
+	assert.True(t, ok)
+}
@@ -118,6 +118,45 @@ func (c *MockClientCreateTemplateCall) DoAndReturn(f func(client.CreateTemplateR
 	return c
 }
 
+// QuerySendDetails mocks base method.
+func (m *MockClient) QuerySendDetails(req client.QuerySendDetailsReq) (client.QuerySendDetailsResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuerySendDetails", req)
+	ret0, _ := ret[0].(client.QuerySendDetailsResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuerySendDetails indicates an expected call of QuerySendDetails.
+func (mr *MockClientMockRecorder) QuerySendDetails(req any) *MockClientQuerySendDetailsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuerySendDetails", reflect.TypeOf((*MockClient)(nil).QuerySendDetails), req)
+	return &MockClientQuerySendDetailsCall{Call: call}
+}
+
+// MockClientQuerySendDetailsCall wrap *gomock.Call
+type MockClientQuerySendDetailsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClientQuerySendDetailsCall) Return(arg0 client.QuerySendDetailsResp, arg1 error) *MockClientQuerySendDetailsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClientQuerySendDetailsCall) Do(f func(client.QuerySendDetailsReq) (client.QuerySendDetailsResp, error)) *MockClientQuerySendDetailsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClientQuerySendDetailsCall) DoAndReturn(f func(client.QuerySendDetailsReq) (client.QuerySendDetailsResp, error)) *MockClientQuerySendDetailsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Send mocks base method.
 func (m *MockClient) Send(req client.SendReq) (client.SendResp, error) {
 	m.ctrl.T.Helper()
@@ -1,10 +1,12 @@
 package client
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
-	"strings"
+	"time"
 
+	"gitee.com/flycash/notification-platform/internal/pkg/receiver"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/profile"
 	sms "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sms/v20210111"
@@ -23,16 +25,18 @@ var auditStatusMapping = map[int64]AuditStatus{
 type TencentCloudSMS struct {
 	client *sms.Client
 	appID  *string // 短信 appID
+	// callbackToken 校验推送令牌
+	callbackToken string
 }
 
-// NewTencentCloudSMS 创建腾讯云短信客户端
-func NewTencentCloudSMS(regionID, secretID, secretKey, appID string) (*TencentCloudSMS, error) {
+// NewTencentCloudSMS 创建腾讯云短信客户端，callbackToken 为空时拒绝所有推送
+func NewTencentCloudSMS(regionID, secretID, secretKey, appID, callbackToken string) (*TencentCloudSMS, error) {
 	client, err := sms.NewClient(common.NewCredential(secretID, secretKey), regionID, profile.NewClientProfile()) // 区域
 	if err != nil {
 		return nil, err
 	}
 	appIDPtr := &appID
-	return &TencentCloudSMS{client: client, appID: appIDPtr}, nil
+	return &TencentCloudSMS{client: client, appID: appIDPtr, callbackToken: callbackToken}, nil
 }
 
 func (t *TencentCloudSMS) CreateTemplate(req CreateTemplateReq) (CreateTemplateResp, error) {
@@ -49,6 +53,9 @@ func (t *TencentCloudSMS) CreateTemplate(req CreateTemplateReq) (CreateTemplateR
 	request.SmsType = &smsTypeUint64
 	// 是否国际/港澳台短信： 0：表示国内短信。 1：表示国际/港澳台短信。 示例值：0
 	internationalUint64 := uint64(0)
+	if req.International {
+		internationalUint64 = 1
+	}
 	request.International = &internationalUint64
 	// 模板备注，例如申请原因，使用场景等。示例值：业务验证码
 	request.Remark = &req.Remark
@@ -70,6 +77,9 @@ func (t *TencentCloudSMS) BatchQueryTemplateStatus(req BatchQueryTemplateStatusR
 	request := sms.NewDescribeSmsTemplateListRequest()
 
 	international := uint64(0) // 默认国内短信
+	if req.International {
+		international = 1
+	}
 	request.International = &international
 
 	request.TemplateIdSet = make([]*uint64, len(req.TemplateIDs))
@@ -122,12 +132,7 @@ func (t *TencentCloudSMS) Send(req SendReq) (SendResp, error) {
 	*/
 	phoneNumPtrs := make([]*string, len(req.PhoneNumbers))
 	for i := range req.PhoneNumbers {
-		// 如果手机号不是以+开头，则添加+86前缀（中国大陆）
-		fullPhoneNum := req.PhoneNumbers[i]
-		if !strings.HasPrefix(req.PhoneNumbers[i], "+") {
-			fullPhoneNum = "+86" + req.PhoneNumbers[i]
-		}
-		phoneNumPtr := fullPhoneNum
+		phoneNumPtr := t.e164(req.PhoneNumbers[i])
 		phoneNumPtrs[i] = &phoneNumPtr
 	}
 	request.PhoneNumberSet = phoneNumPtrs
@@ -166,10 +171,169 @@ func (t *TencentCloudSMS) Send(req SendReq) (SendResp, error) {
 	}
 	for i := range response.Response.SendStatusSet {
 		status := response.Response.SendStatusSet[i]
-		result.PhoneNumbers[strings.TrimPrefix(*status.PhoneNumber, "+86")] = SendRespStatus{
+		result.PhoneNumbers[receiver.NormalizePhone(*status.PhoneNumber)] = SendRespStatus{
 			Code:    *status.Code,
 			Message: *status.Message,
+			BizID:   valueOf(status.SerialNo),
 		}
 	}
 	return result, nil
 }
+
+// reportStatusMapping 腾讯侧回执状态转换为内部发送状态
+var reportStatusMapping = map[string]SendStatus{
+	"SUCCESS": SendStatusSuccess,
+	"FAIL":    SendStatusFailed,
+}
+
+func (t *TencentCloudSMS) QuerySendDetails(req QuerySendDetailsReq) (QuerySendDetailsResp, error) {
+	// https://cloud.tencent.com/document/product/382/55970
+	if req.PhoneNumber == "" {
+		return QuerySendDetailsResp{}, fmt.Errorf("%w: 手机号码不能为空", ErrInvalidParameter)
+	}
+
+	request := sms.NewPullSmsSendStatusByPhoneNumberRequest()
+	phoneNumber := t.e164(req.PhoneNumber)
+	request.PhoneNumber = &phoneNumber
+	request.SmsSdkAppId = t.appID
+	// 拉取起止时间，UNIX 时间戳（秒），最大跨度为7天
+	beginTime, endTime := uint64(req.BeginTime), uint64(req.EndTime)
+	request.BeginTime = &beginTime
+	request.EndTime = &endTime
+	// 偏移量和最大拉取条数，Limit 最大值为100
+	const maxLimit = 100
+	limit := min(max(req.Limit, 1), maxLimit)
+	request.Offset = &req.Offset
+	request.Limit = &limit
+
+	response, err := t.client.PullSmsSendStatusByPhoneNumber(request)
+	if err != nil {
+		return QuerySendDetailsResp{}, fmt.Errorf("%w: %w", ErrQuerySendDetails, err)
+	}
+
+	result := QuerySendDetailsResp{
+		RequestID: valueOf(response.Response.RequestId),
+	}
+	for _, status := range response.Response.PullSmsSendStatusSet {
+		// 腾讯云按号码拉取，用 SerialNo 过滤出指定的那次发送
+		if req.BizID != "" && valueOf(status.SerialNo) != req.BizID {
+			continue
+		}
+		sendStatus := int(reportStatusMapping[valueOf(status.ReportStatus)])
+		result.SmsSendDetailDTOs = append(result.SmsSendDetailDTOs, SendDetail{
+			PhoneNum:        receiver.NormalizePhone(valueOf(status.PhoneNumber)),
+			SendStatus:      sendStatus,
+			ErrCode:         valueOf(status.Description),
+			SerialNo:        valueOf(status.SerialNo),
+			ReportStatus:    sendStatus,
+			UserReceiveTime: strconv.FormatUint(valueOf(status.UserReceiveTime), 10),
+		})
+	}
+	result.TotalCount = len(result.SmsSendDetailDTOs)
+	return result, nil
+}
+
+// tencentStatusReport 腾讯云推送的单条状态报告
+type tencentStatusReport struct {
+	Mobile       string `json:"mobile"`
+	ReportStatus string `json:"report_status"`
+	ErrMsg       string `json:"errmsg"`
+	SID          string `json:"sid"`
+}
+
+// tencentUpstreamReply 腾讯云推送的上行短信，每次推送一条
+type tencentUpstreamReply struct {
+	Extend string `json:"extend"`
+	Mobile string `json:"mobile"`
+	Sign   string `json:"sign"`
+	Text   string `json:"text"`
+	Time   int64  `json:"time"`
+}
+
+// tencentTemplateAuditResult 腾讯云推送的模版审核结果，每次推送一条
+type tencentTemplateAuditResult struct {
+	TemplateID  uint64 `json:"template_id"`
+	StatusCode  int64  `json:"status_code"`
+	ReviewReply string `json:"review_reply"`
+}
+
+func (t *TencentCloudSMS) VerifyCallback(token string) error {
+	return verifyToken(t.callbackToken, token)
+}
+
+func (t *TencentCloudSMS) ParseStatusReports(body []byte) ([]StatusReport, error) {
+	// https://cloud.tencent.com/document/product/382/52077
+	var reports []tencentStatusReport
+	if err := json.Unmarshal(body, &reports); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCallback, err)
+	}
+	result := make([]StatusReport, 0, len(reports))
+	for i := range reports {
+		result = append(result, StatusReport{
+			PhoneNumber: receiver.NormalizePhone(reports[i].Mobile),
+			BizID:       reports[i].SID,
+			Success:     reportStatusMapping[reports[i].ReportStatus] == SendStatusSuccess,
+			ErrCode:     reports[i].ErrMsg,
+		})
+	}
+	return result, nil
+}
+
+func (t *TencentCloudSMS) ParseUpstreamReplies(body []byte) ([]UpstreamReply, error) {
+	var reply tencentUpstreamReply
+	if err := json.Unmarshal(body, &reply); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCallback, err)
+	}
+	return []UpstreamReply{
+		{
+			PhoneNumber: receiver.NormalizePhone(reply.Mobile),
+			Content:     reply.Text,
+			SignName:    reply.Sign,
+			ExtendCode:  reply.Extend,
+			ReplyTime:   time.Unix(reply.Time, 0).UnixMilli(),
+		},
+	}, nil
+}
+
+func (t *TencentCloudSMS) ParseTemplateAuditResults(body []byte) ([]TemplateAuditResult, error) {
+	var result tencentTemplateAuditResult
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCallback, err)
+	}
+	if result.TemplateID == 0 {
+		return nil, fmt.Errorf("%w: 缺少模版ID", ErrInvalidCallback)
+	}
+	status, ok := auditStatusMapping[result.StatusCode]
+	if !ok {
+		return nil, fmt.Errorf("%w: 未知审核状态 %d", ErrInvalidCallback, result.StatusCode)
+	}
+	return []TemplateAuditResult{
+		{
+			TemplateID:  strconv.FormatUint(result.TemplateID, 10),
+			AuditStatus: status,
+			Reason:      result.ReviewReply,
+		},
+	}, nil
+}
+
+func (t *TencentCloudSMS) CallbackAck() any {
+	return map[string]any{"result": 0, "errmsg": "OK"}
+}
+
+// valueOf 腾讯云 SDK 的字段都是指针，为 nil 时返回零值
+func valueOf[T any](ptr *T) T {
+	var zero T
+	if ptr == nil {
+		return zero
+	}
+	return *ptr
+}
+
+// e164 腾讯云要求手机号采用 E.164 格式，无法解析的号码原样交给腾讯云校验
+func (t *TencentCloudSMS) e164(phone string) string {
+	p, err := receiver.ParsePhone(phone)
+	if err != nil {
+		return phone
+	}
+	return p.E164()
+}
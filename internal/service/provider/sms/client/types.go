@@ -76,6 +76,8 @@ type Client interface {
 	BatchQueryTemplateStatus(req BatchQueryTemplateStatusReq) (BatchQueryTemplateStatusResp, error)
 	// Send 发送短信
 	Send(req SendReq) (SendResp, error)
+	// QuerySendDetails 查询单个号码的发送详情（回执）
+	QuerySendDetails(req QuerySendDetailsReq) (QuerySendDetailsResp, error)
 }
 
 // CreateTemplateReq 创建短信模板请求参数
@@ -84,6 +86,7 @@ type CreateTemplateReq struct {
 	TemplateContent string       // 模板内容
 	TemplateType    TemplateType // 短信类型
 	Remark          string       // 备注
+	International   bool         // 是否国际/港澳台短信模版
 }
 
 // CreateTemplateResp 创建短信模板响应参数
@@ -94,7 +97,8 @@ type CreateTemplateResp struct {
 
 // BatchQueryTemplateStatusReq 批量查询短信模板状态请求参数
 type BatchQueryTemplateStatusReq struct {
-	TemplateIDs []string // 模板 ID, 阿里云、腾讯云共用
+	TemplateIDs   []string // 模板 ID, 阿里云、腾讯云共用
+	International bool     // 是否国际/港澳台短信模版，仅腾讯云使用
 }
 
 // BatchQueryTemplateStatusResp 批量查询短信模板状态响应参数
@@ -121,12 +125,13 @@ type SendReq struct {
 // SendResp 发送短信响应参数
 type SendResp struct {
 	RequestID    string                    // 请求 ID,      阿里云、腾讯云共用
-	PhoneNumbers map[string]SendRespStatus // 去掉+86后的手机号
+	PhoneNumbers map[string]SendRespStatus // 平台内统一格式的手机号，中国大陆手机号不带国家码
 }
 
 type SendRespStatus struct {
 	Code    string
 	Message string
+	BizID   string // 发送回执 ID, 阿里云为BizId（整个请求共用），腾讯云为每个号码的SerialNo
 }
 
 // QuerySendDetailsReq 查询短信发送详情请求参数
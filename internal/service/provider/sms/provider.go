@@ -2,9 +2,12 @@ package sms
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
+	"gitee.com/flycash/notification-platform/internal/pkg/receiver"
 	"gitee.com/flycash/notification-platform/internal/service/provider"
 	"gitee.com/flycash/notification-platform/internal/service/provider/sms/client"
 
@@ -32,15 +35,74 @@ func NewSMSProvider(name string, templateSvc manage.ChannelTemplateService, clie
 }
 
 // Send 发送短信
+// 腾讯云和阿里云的境外手机号都需要使用国际/港澳台短信模版，境内和境外手机号分别使用对应地区的模版发送
 func (p *smsProvider) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
-	tmpl, err := p.templateSvc.GetTemplateByIDAndProviderInfo(ctx, notification.Template.ID, p.name, domain.ChannelSMS)
+	var (
+		sendErr  error
+		receipts []domain.SendReceipt
+		results  = make([]domain.ReceiverResult, 0, len(notification.Receivers))
+	)
+	domestic, international := p.groupByRegion(notification.Receivers)
+	groups := []struct {
+		region    domain.TemplateRegion
+		receivers []string
+	}{
+		{region: domain.TemplateRegionDomestic, receivers: domestic},
+		{region: domain.TemplateRegionInternational, receivers: international},
+	}
+	for _, g := range groups {
+		if len(g.receivers) == 0 {
+			continue
+		}
+		group := notification
+		group.Receivers = g.receivers
+		groupResults, groupReceipts, err := p.sendGroup(ctx, group, g.region)
+		results = append(results, groupResults...)
+		receipts = append(receipts, groupReceipts...)
+		if err != nil && !errors.Is(err, errs.ErrNoInternationalTemplate) {
+			sendErr = err
+		}
+	}
+
+	// 部分手机号失败时只返回失败的接收者，由渠道换供应商重试，全部失败时才算发送失败
+	succeeded := slices.ContainsFunc(results, func(r domain.ReceiverResult) bool { return !r.IsFailed() })
+	retryable := slices.DeleteFunc(slices.Clone(results), func(r domain.ReceiverResult) bool { return !r.IsFailed() || r.Final })
+	switch {
+	case succeeded || len(results) == 0:
+		return domain.SendResponse{
+			NotificationID:  notification.ID,
+			Status:          domain.SendStatusSucceeded,
+			Receipts:        receipts,
+			ReceiverResults: results,
+		}, nil
+	case len(retryable) == 0:
+		// 只剩没有国际/港澳台模版的境外手机号，换供应商也无法发送
+		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, errs.ErrNoInternationalTemplate)
+	case sendErr != nil:
+		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, sendErr)
+	default:
+		last := retryable[len(retryable)-1]
+		return domain.SendResponse{}, fmt.Errorf("%w: Code = %s, Message = %s", errs.ErrSendNotificationFailed, last.ErrCode, last.ErrMessage)
+	}
+}
+
+// sendGroup 使用指定地区的模版发送一组手机号，所有供应商都没有国际/港澳台模版时这一组不再重试
+func (p *smsProvider) sendGroup(ctx context.Context, notification domain.Notification, region domain.TemplateRegion) ([]domain.ReceiverResult, []domain.SendReceipt, error) {
+	tmpl, err := p.templateSvc.GetTemplateByIDAndProviderInfo(ctx, notification.Template.ID, notification.Locale, p.name, domain.ChannelSMS, region)
 	if err != nil {
-		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
+		results := p.failedResults(notification, err)
+		if errors.Is(err, errs.ErrNoInternationalTemplate) {
+			for i := range results {
+				results[i].Final = true
+			}
+		}
+		return results, nil, err
 	}
 
-	activeVersion := tmpl.ActiveVersion()
+	activeVersion := tmpl.ActiveVersionFor(notification.Locale)
 	if activeVersion == nil {
-		return domain.SendResponse{}, fmt.Errorf("%w: 无已发布模版", errs.ErrSendNotificationFailed)
+		err = errors.New("无已发布模版")
+		return p.failedResults(notification, err), nil, err
 	}
 
 	const first = 0
@@ -51,17 +113,79 @@ func (p *smsProvider) Send(ctx context.Context, notification domain.Notification
 		TemplateParam: notification.Template.Params,
 	})
 	if err != nil {
-		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
+		return p.failedResults(notification, err), nil, err
 	}
+	return p.receiverResults(notification, resp), p.receipts(notification, resp), nil
+}
 
-	for _, status := range resp.PhoneNumbers {
-		if !strings.EqualFold(status.Code, "OK") {
-			return domain.SendResponse{}, fmt.Errorf("%w: Code = %s, Message = %s", errs.ErrSendNotificationFailed, status.Code, status.Message)
+// groupByRegion 把手机号分为境内和境外两组，无法解析的号码归入境内，交给供应商校验
+func (p *smsProvider) groupByRegion(receivers []string) (domestic, international []string) {
+	for _, r := range receivers {
+		phone, err := receiver.ParsePhone(r)
+		if err == nil && !phone.IsDomestic() {
+			international = append(international, r)
+			continue
 		}
+		domestic = append(domestic, r)
 	}
+	return domestic, international
+}
 
-	return domain.SendResponse{
-		NotificationID: notification.ID,
-		Status:         domain.SendStatusSucceeded,
-	}, nil
+// failedResults 请求供应商失败时，这一批接收者全部失败
+func (p *smsProvider) failedResults(notification domain.Notification, err error) []domain.ReceiverResult {
+	results := make([]domain.ReceiverResult, 0, len(notification.Receivers))
+	for _, r := range notification.Receivers {
+		results = append(results, domain.ReceiverResult{
+			NotificationID: notification.ID,
+			Receiver:       r,
+			Provider:       p.name,
+			Status:         domain.SendStatusFailed,
+			ErrMessage:     err.Error(),
+		})
+	}
+	return results
+}
+
+// receiverResults 每个接收者一条结果，供应商没有返回的手机号视为失败
+func (p *smsProvider) receiverResults(notification domain.Notification, resp client.SendResp) []domain.ReceiverResult {
+	results := make([]domain.ReceiverResult, 0, len(notification.Receivers))
+	for _, r := range notification.Receivers {
+		result := domain.ReceiverResult{
+			NotificationID: notification.ID,
+			Receiver:       r,
+			Provider:       p.name,
+			Status:         domain.SendStatusSucceeded,
+		}
+		status, ok := resp.PhoneNumbers[receiver.NormalizePhone(r)]
+		switch {
+		case !ok:
+			result.Status = domain.SendStatusFailed
+			result.ErrMessage = "供应商没有返回该手机号的发送结果"
+		case !strings.EqualFold(status.Code, "OK"):
+			result.Status = domain.SendStatusFailed
+			result.ErrCode = status.Code
+			result.ErrMessage = status.Message
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// receipts 每个接收者一条回执，供应商没有返回回执ID的无法对账
+func (p *smsProvider) receipts(notification domain.Notification, resp client.SendResp) []domain.SendReceipt {
+	receipts := make([]domain.SendReceipt, 0, len(notification.Receivers))
+	for _, r := range notification.Receivers {
+		status, ok := resp.PhoneNumbers[receiver.NormalizePhone(r)]
+		if !ok || status.BizID == "" || !strings.EqualFold(status.Code, "OK") {
+			continue
+		}
+		receipts = append(receipts, domain.SendReceipt{
+			NotificationID: notification.ID,
+			Provider:       p.name,
+			Receiver:       r,
+			RequestID:      resp.RequestID,
+			ReceiptID:      status.BizID,
+		})
+	}
+	return receipts
 }
@@ -69,9 +69,10 @@ func TestSmsProvider_Send(t *testing.T) {
 	}
 
 	tests := []struct {
-		name      string
-		setupMock func(ctrl *gomock.Controller, provider *smsProvider)
-		wantErr   error
+		name         string
+		setupMock    func(ctrl *gomock.Controller, provider *smsProvider)
+		wantErr      error
+		wantReceipts []domain.SendReceipt
 	}{
 		{
 			name: "获取模板失败",
@@ -80,7 +81,7 @@ func TestSmsProvider_Send(t *testing.T) {
 
 				// 模拟获取模板失败
 				mockTemplateSvc.EXPECT().
-					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, "aliyun", domain.ChannelSMS).
+					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, "", "aliyun", domain.ChannelSMS, domain.TemplateRegionDomestic).
 					Return(domain.ChannelTemplate{}, fmt.Errorf("%w: 供应商%d", ErrGetTemplateFailed, 1))
 			},
 			wantErr: errs.ErrSendNotificationFailed,
@@ -92,7 +93,7 @@ func TestSmsProvider_Send(t *testing.T) {
 
 				// 模拟返回没有活跃版本的模板
 				mockTemplateSvc.EXPECT().
-					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, "aliyun", domain.ChannelSMS).
+					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, "", "aliyun", domain.ChannelSMS, domain.TemplateRegionDomestic).
 					Return(domain.ChannelTemplate{
 						ID:       testNotification.Template.ID,
 						Channel:  domain.ChannelSMS,
@@ -128,7 +129,7 @@ func TestSmsProvider_Send(t *testing.T) {
 				}
 
 				mockTemplateSvc.EXPECT().
-					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, "aliyun", domain.ChannelSMS).
+					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, "", "aliyun", domain.ChannelSMS, domain.TemplateRegionDomestic).
 					Return(domain.ChannelTemplate{
 						ID:              testNotification.Template.ID,
 						Channel:         domain.ChannelSMS,
@@ -175,7 +176,7 @@ func TestSmsProvider_Send(t *testing.T) {
 				}
 
 				mockTemplateSvc.EXPECT().
-					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, "aliyun", domain.ChannelSMS).
+					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, "", "aliyun", domain.ChannelSMS, domain.TemplateRegionDomestic).
 					Return(domain.ChannelTemplate{
 						ID:              testNotification.Template.ID,
 						Channel:         domain.ChannelSMS,
@@ -229,7 +230,7 @@ func TestSmsProvider_Send(t *testing.T) {
 				}
 
 				mockTemplateSvc.EXPECT().
-					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, "aliyun", domain.ChannelSMS).
+					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, "", "aliyun", domain.ChannelSMS, domain.TemplateRegionDomestic).
 					Return(domain.ChannelTemplate{
 						ID:              testNotification.Template.ID,
 						Channel:         domain.ChannelSMS,
@@ -246,15 +247,26 @@ func TestSmsProvider_Send(t *testing.T) {
 						TemplateParam: testNotification.Template.Params,
 					}).
 					Return(client.SendResp{
+						RequestID: "request-id",
 						PhoneNumbers: map[string]client.SendRespStatus{
 							"13800138000": {
 								Code:    "OK",
 								Message: "发送成功",
+								BizID:   "biz-id",
 							},
 						},
 					}, nil)
 			},
 			wantErr: nil,
+			wantReceipts: []domain.SendReceipt{
+				{
+					NotificationID: testNotification.ID,
+					Provider:       "aliyun",
+					Receiver:       "13800138000",
+					RequestID:      "request-id",
+					ReceiptID:      "biz-id",
+				},
+			},
 		},
 	}
 
@@ -289,6 +301,156 @@ func TestSmsProvider_Send(t *testing.T) {
 			assert.NoError(t, err)
 			assert.Equal(t, testNotification.ID, resp.NotificationID)
 			assert.Equal(t, domain.SendStatusSucceeded, resp.Status)
+			assert.Equal(t, tt.wantReceipts, resp.Receipts)
 		})
 	}
 }
+
+func TestSmsProvider_SendPartialFailure(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	notification := domain.Notification{
+		ID:        1,
+		BizID:     1,
+		Receivers: []string{"13800138000", "+8613800138001", "13800138002"},
+		Channel:   domain.ChannelSMS,
+		Template:  domain.Template{ID: 1, Params: map[string]string{"code": "123456"}},
+	}
+	activeVersion := domain.ChannelTemplateVersion{
+		ID:        1,
+		Signature: "测试签名",
+		Providers: []domain.ChannelTemplateProvider{{ProviderName: "aliyun", ProviderTemplateID: "SMS_123456"}},
+	}
+	mockTemplateSvc := templatemocks.NewMockChannelTemplateService(ctrl)
+	mockTemplateSvc.EXPECT().
+		GetTemplateByIDAndProviderInfo(gomock.Any(), int64(1), "", "aliyun", domain.ChannelSMS, domain.TemplateRegionDomestic).
+		Return(domain.ChannelTemplate{
+			ID:              1,
+			Versions:        []domain.ChannelTemplateVersion{activeVersion},
+			ActiveVersionID: activeVersion.ID,
+		}, nil)
+	mockClient := smsmocks.NewMockClient(ctrl)
+	mockClient.EXPECT().Send(gomock.Any()).Return(client.SendResp{
+		RequestID: "request-id",
+		PhoneNumbers: map[string]client.SendRespStatus{
+			"13800138000": {Code: "OK", BizID: "biz-id"},
+			"13800138001": {Code: "isv.MOBILE_NUMBER_ILLEGAL", Message: "非法手机号"},
+			// 13800138002 没有返回结果
+		},
+	}, nil)
+
+	provider := NewSMSProvider("aliyun", mockTemplateSvc, mockClient)
+	resp, err := provider.Send(t.Context(), notification)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.SendStatusSucceeded, resp.Status)
+	assert.Equal(t, []domain.SendReceipt{
+		{NotificationID: 1, Provider: "aliyun", Receiver: "13800138000", RequestID: "request-id", ReceiptID: "biz-id"},
+	}, resp.Receipts)
+	assert.Equal(t, []domain.ReceiverResult{
+		{NotificationID: 1, Receiver: "13800138000", Provider: "aliyun", Status: domain.SendStatusSucceeded},
+		{
+			NotificationID: 1, Receiver: "+8613800138001", Provider: "aliyun", Status: domain.SendStatusFailed,
+			ErrCode: "isv.MOBILE_NUMBER_ILLEGAL", ErrMessage: "非法手机号",
+		},
+		{
+			NotificationID: 1, Receiver: "13800138002", Provider: "aliyun", Status: domain.SendStatusFailed,
+			ErrMessage: "供应商没有返回该手机号的发送结果",
+		},
+	}, resp.ReceiverResults)
+	assert.Equal(t, []string{"+8613800138001", "13800138002"}, resp.FailedReceivers())
+}
+
+func TestSmsProvider_SendByRegion(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	notification := domain.Notification{
+		ID:        1,
+		BizID:     1,
+		Receivers: []string{"+85251234567", "13800138000", "+14155552671"},
+		Channel:   domain.ChannelSMS,
+		Template:  domain.Template{ID: 1, Params: map[string]string{"code": "123456"}},
+	}
+	templateOf := func(providerTemplateID string, region domain.TemplateRegion) domain.ChannelTemplate {
+		version := domain.ChannelTemplateVersion{
+			ID:        1,
+			Signature: "测试签名",
+			Providers: []domain.ChannelTemplateProvider{{ProviderName: "tencent", ProviderTemplateID: providerTemplateID, Region: region}},
+		}
+		return domain.ChannelTemplate{ID: 1, Versions: []domain.ChannelTemplateVersion{version}, ActiveVersionID: version.ID}
+	}
+	mockTemplateSvc := templatemocks.NewMockChannelTemplateService(ctrl)
+	mockClient := smsmocks.NewMockClient(ctrl)
+	provider := NewSMSProvider("tencent", mockTemplateSvc, mockClient)
+
+	// 境内手机号使用国内模版，境外手机号使用国际/港澳台模版
+	mockTemplateSvc.EXPECT().
+		GetTemplateByIDAndProviderInfo(gomock.Any(), int64(1), "", "tencent", domain.ChannelSMS, domain.TemplateRegionDomestic).
+		Return(templateOf("123456", domain.TemplateRegionDomestic), nil).Times(2)
+	mockTemplateSvc.EXPECT().
+		GetTemplateByIDAndProviderInfo(gomock.Any(), int64(1), "", "tencent", domain.ChannelSMS, domain.TemplateRegionInternational).
+		Return(templateOf("654321", domain.TemplateRegionInternational), nil)
+	mockClient.EXPECT().Send(client.SendReq{
+		PhoneNumbers:  []string{"13800138000"},
+		SignName:      "测试签名",
+		TemplateID:    "123456",
+		TemplateParam: notification.Template.Params,
+	}).Return(client.SendResp{
+		RequestID:    "request-id",
+		PhoneNumbers: map[string]client.SendRespStatus{"13800138000": {Code: "OK", BizID: "serial-no"}},
+	}, nil).Times(2)
+	mockClient.EXPECT().Send(client.SendReq{
+		PhoneNumbers:  []string{"+85251234567", "+14155552671"},
+		SignName:      "测试签名",
+		TemplateID:    "654321",
+		TemplateParam: notification.Template.Params,
+	}).Return(client.SendResp{
+		RequestID: "request-id-2",
+		PhoneNumbers: map[string]client.SendRespStatus{
+			"+85251234567": {Code: "OK", BizID: "serial-no-2"},
+			"+14155552671": {Code: "OK", BizID: "serial-no-3"},
+		},
+	}, nil)
+
+	resp, err := provider.Send(t.Context(), notification)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.SendReceipt{
+		{NotificationID: 1, Provider: "tencent", Receiver: "13800138000", RequestID: "request-id", ReceiptID: "serial-no"},
+		{NotificationID: 1, Provider: "tencent", Receiver: "+85251234567", RequestID: "request-id-2", ReceiptID: "serial-no-2"},
+		{NotificationID: 1, Provider: "tencent", Receiver: "+14155552671", RequestID: "request-id-2", ReceiptID: "serial-no-3"},
+	}, resp.Receipts)
+	assert.Empty(t, resp.FailedReceivers())
+
+	// 所有供应商都没有国际/港澳台模版时境外手机号失败，并且不再换供应商重试
+	noTemplateErr := fmt.Errorf("%w: versionID=1", errs.ErrNoInternationalTemplate)
+	mockTemplateSvc.EXPECT().
+		GetTemplateByIDAndProviderInfo(gomock.Any(), int64(1), "", "tencent", domain.ChannelSMS, domain.TemplateRegionInternational).
+		Return(domain.ChannelTemplate{}, noTemplateErr).Times(2)
+	resp, err = provider.Send(t.Context(), notification)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.ReceiverResult{
+		{NotificationID: 1, Receiver: "13800138000", Provider: "tencent", Status: domain.SendStatusSucceeded},
+		{NotificationID: 1, Receiver: "+85251234567", Provider: "tencent", Status: domain.SendStatusFailed, ErrMessage: noTemplateErr.Error(), Final: true},
+		{NotificationID: 1, Receiver: "+14155552671", Provider: "tencent", Status: domain.SendStatusFailed, ErrMessage: noTemplateErr.Error(), Final: true},
+	}, resp.ReceiverResults)
+	assert.Empty(t, resp.RetryableReceivers())
+
+	// 全部是境外手机号时不请求供应商，发送失败
+	notification.Receivers = []string{"+85251234567"}
+	_, err = provider.Send(t.Context(), notification)
+	assert.ErrorIs(t, err, errs.ErrSendNotificationFailed)
+	assert.ErrorIs(t, err, errs.ErrNoInternationalTemplate)
+
+	// 只是当前供应商没有国际/港澳台模版时交给其他供应商重试
+	mockTemplateSvc.EXPECT().
+		GetTemplateByIDAndProviderInfo(gomock.Any(), int64(1), "", "tencent", domain.ChannelSMS, domain.TemplateRegionInternational).
+		Return(domain.ChannelTemplate{}, errs.ErrProviderNotFound)
+	_, err = provider.Send(t.Context(), notification)
+	assert.ErrorIs(t, err, errs.ErrProviderNotFound)
+	assert.NotErrorIs(t, err, errs.ErrNoInternationalTemplate)
+}
@@ -0,0 +1,75 @@
+package testsend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/service/provider"
+	"gitee.com/flycash/notification-platform/internal/service/provider/manage"
+)
+
+// Service 只通过指定供应商发送测试通知，用于接入新供应商或者修改密钥后验证配置
+type Service interface {
+	// Send 发送测试通知，不经过负载均衡和熔断，禁用的供应商也可以测试
+	Send(ctx context.Context, req domain.ProviderTestSend) (domain.SendResponse, error)
+}
+
+// Factory 按供应商记录创建独立的供应商实例，通常是 registry.Registry
+type Factory interface {
+	NewProvider(entity domain.Provider) (provider.Provider, error)
+}
+
+// Template 渠道的测试模版，模版需要在各供应商审核通过
+type Template struct {
+	ID     int64             `yaml:"id"`
+	Params map[string]string `yaml:"params"`
+}
+
+type service struct {
+	providerSvc manage.Service
+	factory     Factory
+	templates   map[domain.Channel]Template
+}
+
+// NewService templates 为各渠道的测试模版，请求没有指定模版时使用
+func NewService(providerSvc manage.Service, factory Factory, templates map[domain.Channel]Template) Service {
+	return &service{
+		providerSvc: providerSvc,
+		factory:     factory,
+		templates:   templates,
+	}
+}
+
+func (s *service) Send(ctx context.Context, req domain.ProviderTestSend) (domain.SendResponse, error) {
+	receiver := strings.TrimSpace(req.Receiver)
+	if receiver == "" {
+		return domain.SendResponse{}, fmt.Errorf("%w: 接收者不能为空", errs.ErrInvalidParameter)
+	}
+	entity, err := s.providerSvc.GetByID(ctx, req.ProviderID)
+	if err != nil {
+		return domain.SendResponse{}, err
+	}
+	tmpl := Template{ID: req.TemplateID, Params: req.Params}
+	if tmpl.ID == 0 {
+		tmpl = s.templates[entity.Channel]
+	}
+	if tmpl.ID <= 0 {
+		return domain.SendResponse{}, fmt.Errorf("%w: 渠道 %s 没有配置测试模版", errs.ErrInvalidParameter, entity.Channel)
+	}
+	p, err := s.factory.NewProvider(entity)
+	if err != nil {
+		return domain.SendResponse{}, err
+	}
+	return p.Send(ctx, domain.Notification{
+		Key:       fmt.Sprintf("provider-test-%d", entity.ID),
+		Receivers: []string{receiver},
+		Channel:   entity.Channel,
+		Template: domain.Template{
+			ID:     tmpl.ID,
+			Params: tmpl.Params,
+		},
+	})
+}
@@ -0,0 +1,121 @@
+//go:build unit
+
+package testsend
+
+import (
+	"testing"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/service/provider"
+	providermocks "gitee.com/flycash/notification-platform/internal/service/provider/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// factoryFunc 把函数适配为 Factory
+type factoryFunc func(entity domain.Provider) (provider.Provider, error)
+
+func (f factoryFunc) NewProvider(entity domain.Provider) (provider.Provider, error) {
+	return f(entity)
+}
+
+func TestService_Send(t *testing.T) {
+	t.Parallel()
+
+	aliyun := domain.Provider{ID: 1, Name: "aliyun", Channel: domain.ChannelSMS, Status: domain.ProviderStatusInactive}
+	templates := map[domain.Channel]Template{
+		domain.ChannelSMS: {ID: 100, Params: map[string]string{"code": "123456"}},
+	}
+
+	testCases := []struct {
+		name     string
+		req      domain.ProviderTestSend
+		mock     func(ctrl *gomock.Controller) (*providermocks.MockService, provider.Provider)
+		wantResp domain.SendResponse
+		wantErr  error
+	}{
+		{
+			name: "使用配置的测试模版",
+			req:  domain.ProviderTestSend{ProviderID: 1, Receiver: " 13800138000 "},
+			mock: func(ctrl *gomock.Controller) (*providermocks.MockService, provider.Provider) {
+				svc := providermocks.NewMockService(ctrl)
+				svc.EXPECT().GetByID(gomock.Any(), int64(1)).Return(aliyun, nil)
+				p := providermocks.NewMockProvider(ctrl)
+				p.EXPECT().Send(gomock.Any(), domain.Notification{
+					Key:       "provider-test-1",
+					Receivers: []string{"13800138000"},
+					Channel:   domain.ChannelSMS,
+					Template:  domain.Template{ID: 100, Params: map[string]string{"code": "123456"}},
+				}).Return(domain.SendResponse{Status: domain.SendStatusSucceeded}, nil)
+				return svc, p
+			},
+			wantResp: domain.SendResponse{Status: domain.SendStatusSucceeded},
+		},
+		{
+			name: "使用请求中的模版",
+			req:  domain.ProviderTestSend{ProviderID: 1, Receiver: "13800138000", TemplateID: 200},
+			mock: func(ctrl *gomock.Controller) (*providermocks.MockService, provider.Provider) {
+				svc := providermocks.NewMockService(ctrl)
+				svc.EXPECT().GetByID(gomock.Any(), int64(1)).Return(aliyun, nil)
+				p := providermocks.NewMockProvider(ctrl)
+				p.EXPECT().Send(gomock.Any(), gomock.Cond(func(x any) bool {
+					n := x.(domain.Notification)
+					return n.Template.ID == 200 && n.Template.Params == nil
+				})).Return(domain.SendResponse{Status: domain.SendStatusSucceeded}, nil)
+				return svc, p
+			},
+			wantResp: domain.SendResponse{Status: domain.SendStatusSucceeded},
+		},
+		{
+			name: "渠道没有配置测试模版",
+			req:  domain.ProviderTestSend{ProviderID: 2, Receiver: "a@example.com"},
+			mock: func(ctrl *gomock.Controller) (*providermocks.MockService, provider.Provider) {
+				svc := providermocks.NewMockService(ctrl)
+				svc.EXPECT().GetByID(gomock.Any(), int64(2)).
+					Return(domain.Provider{ID: 2, Name: "smtp", Channel: domain.ChannelEmail}, nil)
+				return svc, nil
+			},
+			wantErr: errs.ErrInvalidParameter,
+		},
+		{
+			name: "接收者为空",
+			req:  domain.ProviderTestSend{ProviderID: 1},
+			mock: func(ctrl *gomock.Controller) (*providermocks.MockService, provider.Provider) {
+				return providermocks.NewMockService(ctrl), nil
+			},
+			wantErr: errs.ErrInvalidParameter,
+		},
+		{
+			name: "供应商不存在",
+			req:  domain.ProviderTestSend{ProviderID: 3, Receiver: "13800138000"},
+			mock: func(ctrl *gomock.Controller) (*providermocks.MockService, provider.Provider) {
+				svc := providermocks.NewMockService(ctrl)
+				svc.EXPECT().GetByID(gomock.Any(), int64(3)).Return(domain.Provider{}, errs.ErrProviderNotFound)
+				return svc, nil
+			},
+			wantErr: errs.ErrProviderNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			providerSvc, p := tc.mock(ctrl)
+			svc := NewService(providerSvc, factoryFunc(func(entity domain.Provider) (provider.Provider, error) {
+				assert.Equal(t, tc.req.ProviderID, entity.ID)
+				return p, nil
+			}), templates)
+
+			resp, err := svc.Send(t.Context(), tc.req)
+			assert.ErrorIs(t, err, tc.wantErr)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.wantResp, resp)
+		})
+	}
+}
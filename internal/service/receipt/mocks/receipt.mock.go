@@ -0,0 +1,156 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./receipt.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./receipt.go -destination=./mocks/receipt.mock.go -package=receiptmocks -typed Service
+//
+
+// Package receiptmocks is a generated GoMock package.
+package receiptmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "gitee.com/flycash/notification-platform/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// Record mocks base method.
+func (m *MockService) Record(ctx context.Context, receipts []domain.SendReceipt) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", ctx, receipts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockServiceMockRecorder) Record(ctx, receipts any) *MockServiceRecordCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockService)(nil).Record), ctx, receipts)
+	return &MockServiceRecordCall{Call: call}
+}
+
+// MockServiceRecordCall wrap *gomock.Call
+type MockServiceRecordCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceRecordCall) Return(arg0 error) *MockServiceRecordCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceRecordCall) Do(f func(context.Context, []domain.SendReceipt) error) *MockServiceRecordCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceRecordCall) DoAndReturn(f func(context.Context, []domain.SendReceipt) error) *MockServiceRecordCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Report mocks base method.
+func (m *MockService) Report(ctx context.Context, reports []domain.SendReceipt) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Report", ctx, reports)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Report indicates an expected call of Report.
+func (mr *MockServiceMockRecorder) Report(ctx, reports any) *MockServiceReportCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Report", reflect.TypeOf((*MockService)(nil).Report), ctx, reports)
+	return &MockServiceReportCall{Call: call}
+}
+
+// MockServiceReportCall wrap *gomock.Call
+type MockServiceReportCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceReportCall) Return(arg0 error) *MockServiceReportCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceReportCall) Do(f func(context.Context, []domain.SendReceipt) error) *MockServiceReportCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceReportCall) DoAndReturn(f func(context.Context, []domain.SendReceipt) error) *MockServiceReportCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Settle mocks base method.
+func (m *MockService) Settle(ctx context.Context, receipt domain.SendReceipt) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Settle", ctx, receipt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Settle indicates an expected call of Settle.
+func (mr *MockServiceMockRecorder) Settle(ctx, receipt any) *MockServiceSettleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Settle", reflect.TypeOf((*MockService)(nil).Settle), ctx, receipt)
+	return &MockServiceSettleCall{Call: call}
+}
+
+// MockServiceSettleCall wrap *gomock.Call
+type MockServiceSettleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceSettleCall) Return(arg0 error) *MockServiceSettleCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceSettleCall) Do(f func(context.Context, domain.SendReceipt) error) *MockServiceSettleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceSettleCall) DoAndReturn(f func(context.Context, domain.SendReceipt) error) *MockServiceSettleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
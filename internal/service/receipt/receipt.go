@@ -0,0 +1,143 @@
+package receipt
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/repository"
+	"gitee.com/flycash/notification-platform/internal/service/notification/callback"
+	"github.com/gotomicro/ego/core/elog"
+)
+
+// Service 供应商回执服务
+//
+//go:generate mockgen -source=./receipt.go -destination=./mocks/receipt.mock.go -package=receiptmocks -typed Service
+type Service interface {
+	// Record 记录供应商受理成功后返回的回执ID，等待对账
+	Record(ctx context.Context, receipts []domain.SendReceipt) error
+	// Settle 更新单条回执，通知的全部回执都有结果后把通知更新为 DELIVERED 或 UNDELIVERED 并再次回调业务方
+	Settle(ctx context.Context, receipt domain.SendReceipt) error
+	// Report 处理供应商推送的状态报告，按 Provider、ReceiptID 和 Receiver 找到回执后更新，与对账任务互不影响
+	Report(ctx context.Context, reports []domain.SendReceipt) error
+}
+
+// firstQueryDelay 运营商回执通常在发送后数秒到数分钟内返回，第一次查询适当延后
+const firstQueryDelay = time.Minute
+
+type service struct {
+	repo             repository.SendReceiptRepository
+	notificationRepo repository.NotificationRepository
+	callbackSvc      callback.Service
+	logger           *elog.Component
+}
+
+func NewService(
+	repo repository.SendReceiptRepository,
+	notificationRepo repository.NotificationRepository,
+	callbackSvc callback.Service,
+) Service {
+	return &service{
+		repo:             repo,
+		notificationRepo: notificationRepo,
+		callbackSvc:      callbackSvc,
+		logger:           elog.DefaultLogger.With(elog.FieldComponent("receipt")),
+	}
+}
+
+func (s *service) Record(ctx context.Context, receipts []domain.SendReceipt) error {
+	if len(receipts) == 0 {
+		return nil
+	}
+	nextQueryTime := time.Now().Add(firstQueryDelay).UnixMilli()
+	for i := range receipts {
+		receipts[i].NextQueryTime = nextQueryTime
+	}
+	return s.repo.BatchCreate(ctx, receipts)
+}
+
+func (s *service) Settle(ctx context.Context, receipt domain.SendReceipt) error {
+	updated, err := s.repo.Update(ctx, receipt)
+	if err != nil {
+		return err
+	}
+	// 回执已经被别的节点处理过，或者仍在等待回执
+	if !updated || receipt.Status.IsPending() {
+		return nil
+	}
+	return s.settleNotification(ctx, receipt.NotificationID)
+}
+
+// settleNotification 全部回执都有结果后更新通知状态，任一接收者未送达即为 UNDELIVERED
+func (s *service) settleNotification(ctx context.Context, notificationID uint64) error {
+	receipts, err := s.repo.FindByNotificationID(ctx, notificationID)
+	if err != nil {
+		return err
+	}
+	status := domain.SendStatusDelivered
+	for i := range receipts {
+		if receipts[i].Status.IsPending() {
+			return nil
+		}
+		if receipts[i].Status == domain.SendReceiptStatusUndelivered {
+			status = domain.SendStatusUndelivered
+		}
+	}
+
+	n, err := s.notificationRepo.GetByID(ctx, notificationID)
+	if err != nil {
+		return err
+	}
+	// 只有发送成功的通知才会进入对账
+	if n.Status != domain.SendStatusSucceeded {
+		return nil
+	}
+	n.Status = status
+	err = s.notificationRepo.CASStatus(ctx, n)
+	if errors.Is(err, errs.ErrNotificationVersionMismatch) {
+		// 最后两条回执同时有结果时，只需要一个节点更新并回调
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info("通知回执对账完成",
+		elog.FieldKey("NotificationID"),
+		elog.FieldValueAny(notificationID),
+		elog.FieldKey("Status"),
+		elog.FieldValueAny(status))
+	return s.callbackSvc.SendCallbackByNotification(ctx, n)
+}
+
+func (s *service) Report(ctx context.Context, reports []domain.SendReceipt) error {
+	var errList []error
+	for i := range reports {
+		report := reports[i]
+		found, err := s.repo.FindByReceiptID(ctx, report.Provider, report.ReceiptID, report.Receiver)
+		if errors.Is(err, errs.ErrSendReceiptNotFound) {
+			// 不是平台发出的短信，或者回执还没有写入，交给对账任务兜底
+			s.logger.Warn("状态报告找不到对应回执",
+				elog.FieldKey("ReceiptID"),
+				elog.FieldValueAny(report.ReceiptID))
+			continue
+		}
+		if err != nil {
+			errList = append(errList, err)
+			continue
+		}
+		// 对账任务或者重复推送已经处理过
+		if !found.Status.IsPending() {
+			continue
+		}
+		found.Status = report.Status
+		found.ErrCode = report.ErrCode
+		if err = s.Settle(ctx, found); err != nil {
+			errList = append(errList, err)
+		}
+	}
+	// 返回错误时供应商会重新推送
+	return errors.Join(errList...)
+}
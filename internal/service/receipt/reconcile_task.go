@@ -0,0 +1,143 @@
+package receipt
+
+import (
+	"context"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/pkg/loopjob"
+	"gitee.com/flycash/notification-platform/internal/pkg/retry"
+	"gitee.com/flycash/notification-platform/internal/pkg/sharding"
+	"gitee.com/flycash/notification-platform/internal/repository"
+	"gitee.com/flycash/notification-platform/internal/service/provider/sms/client"
+	"github.com/gotomicro/ego/core/elog"
+	"github.com/meoying/dlock-go"
+)
+
+// ErrCodeReceiptTimeout 超过最大查询次数仍未拿到回执时记录的错误码
+const ErrCodeReceiptTimeout = "RECEIPT_TIMEOUT"
+
+// ReconcileTask 回执对账任务，按分表轮询到期的等待中回执，向供应商查询实际送达结果
+type ReconcileTask struct {
+	dclient   dlock.Client
+	repo      repository.SendReceiptRepository
+	svc       Service
+	clients   *client.Clients
+	backoff   retry.Config
+	sem       loopjob.ResourceSemaphore
+	str       sharding.ShardingStrategy
+	batchSize int
+	logger    *elog.Component
+}
+
+// NewReconcileTask 创建回执对账任务，backoff 决定查询间隔，达到其最大重试次数后回执视为未送达
+func NewReconcileTask(dclient dlock.Client,
+	repo repository.SendReceiptRepository,
+	svc Service,
+	clients *client.Clients,
+	backoff retry.Config,
+	sem loopjob.ResourceSemaphore,
+	str sharding.ShardingStrategy,
+	batchSize int,
+) *ReconcileTask {
+	return &ReconcileTask{
+		dclient:   dclient,
+		repo:      repo,
+		svc:       svc,
+		clients:   clients,
+		backoff:   backoff,
+		sem:       sem,
+		str:       str,
+		batchSize: batchSize,
+		logger:    elog.DefaultLogger.With(elog.FieldComponent("receipt")),
+	}
+}
+
+func (t *ReconcileTask) Start(ctx context.Context) {
+	const key = "notification_receipt_reconcile"
+	lj := loopjob.NewShardingLoopJob(t.dclient, key, t.Reconcile, t.str, t.sem)
+	go lj.Run(ctx)
+}
+
+// Reconcile 处理 ctx 中 Dst 对应分表的一批回执
+func (t *ReconcileTask) Reconcile(ctx context.Context) error {
+	const defaultSleepTime = time.Second * 10
+	now := time.Now()
+	receipts, err := t.repo.FindPending(ctx, now.UnixMilli(), t.batchSize)
+	if err != nil {
+		return err
+	}
+	for i := range receipts {
+		receipt := t.query(receipts[i], now)
+		if err1 := t.svc.Settle(ctx, receipt); err1 != nil {
+			t.logger.Warn("更新回执失败",
+				elog.FieldKey("ReceiptID"),
+				elog.FieldValueAny(receipt.ReceiptID),
+				elog.FieldErr(err1))
+		}
+	}
+	// 说明到期的回执不多，可以休息一下
+	if len(receipts) < t.batchSize {
+		time.Sleep(defaultSleepTime)
+	}
+	return nil
+}
+
+// query 查询回执的送达结果，仍未拿到结果时推迟下次查询时间
+func (t *ReconcileTask) query(receipt domain.SendReceipt, now time.Time) domain.SendReceipt {
+	receipt.QueryCount++
+	status, errCode := t.queryStatus(receipt, now)
+	if status.IsPending() {
+		// 策略内部有状态，每条回执单独创建
+		backoff, _ := retry.NewRetry(t.backoff)
+		interval, ok := backoff.NextWithRetries(int32(receipt.QueryCount))
+		if !ok {
+			receipt.Status = domain.SendReceiptStatusUndelivered
+			receipt.ErrCode = ErrCodeReceiptTimeout
+			return receipt
+		}
+		receipt.NextQueryTime = now.Add(interval).UnixMilli()
+		return receipt
+	}
+	receipt.Status = status
+	receipt.ErrCode = errCode
+	return receipt
+}
+
+func (t *ReconcileTask) queryStatus(receipt domain.SendReceipt, now time.Time) (domain.SendReceiptStatus, string) {
+	cli, ok := t.clients.Get(receipt.Provider)
+	if !ok {
+		t.logger.Warn("未知的回执供应商", elog.String("Provider", receipt.Provider))
+		return domain.SendReceiptStatusPending, ""
+	}
+	sendTime := time.UnixMilli(receipt.Ctime)
+	// 阿里云按 BizId + 发送日期查询，腾讯云按号码 + 时间段拉取后按 SerialNo 过滤
+	resp, err := cli.QuerySendDetails(client.QuerySendDetailsReq{
+		PhoneNumber: receipt.Receiver,
+		BizID:       receipt.ReceiptID,
+		SendDate:    sendTime.Format("20060102"),
+		PageSize:    10,
+		CurrentPage: 1,
+		BeginTime:   sendTime.Add(-time.Minute).Unix(),
+		EndTime:     now.Unix(),
+		Limit:       10,
+	})
+	if err != nil {
+		t.logger.Warn("查询发送详情失败",
+			elog.FieldKey("ReceiptID"),
+			elog.FieldValueAny(receipt.ReceiptID),
+			elog.FieldErr(err))
+		return domain.SendReceiptStatusPending, ""
+	}
+	for i := range resp.SmsSendDetailDTOs {
+		detail := resp.SmsSendDetailDTOs[i]
+		switch client.SendStatus(detail.SendStatus) {
+		case client.SendStatusSuccess:
+			return domain.SendReceiptStatusDelivered, ""
+		case client.SendStatusFailed:
+			return domain.SendReceiptStatusUndelivered, detail.ErrCode
+		default:
+		}
+	}
+	return domain.SendReceiptStatusPending, ""
+}
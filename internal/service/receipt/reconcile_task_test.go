@@ -0,0 +1,158 @@
+//go:build unit
+
+package receipt
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/pkg/retry"
+	"gitee.com/flycash/notification-platform/internal/service/provider/sms/client"
+	smsmocks "gitee.com/flycash/notification-platform/internal/service/provider/sms/client/mocks"
+	"github.com/gotomicro/ego/core/elog"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReconcileTask_query(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 5, 20, 10, 0, 0, 0, time.Local)
+	sendTime := now.Add(-5 * time.Minute)
+	testReceipt := domain.SendReceipt{
+		ID:             1,
+		NotificationID: 12345,
+		Provider:       "aliyun",
+		Receiver:       "13800138000",
+		RequestID:      "request-id",
+		ReceiptID:      "biz-id",
+		Status:         domain.SendReceiptStatusPending,
+		Ctime:          sendTime.UnixMilli(),
+	}
+	wantReq := client.QuerySendDetailsReq{
+		PhoneNumber: "13800138000",
+		BizID:       "biz-id",
+		SendDate:    "20250520",
+		PageSize:    10,
+		CurrentPage: 1,
+		BeginTime:   sendTime.Add(-time.Minute).Unix(),
+		EndTime:     now.Unix(),
+		Limit:       10,
+	}
+	backoff := retry.Config{
+		Type: "exponential",
+		ExponentialBackoff: &retry.ExponentialBackoffConfig{
+			InitialInterval: time.Minute,
+			MaxInterval:     10 * time.Minute,
+			MaxRetries:      3,
+		},
+	}
+
+	tests := []struct {
+		name        string
+		receipt     domain.SendReceipt
+		setupMock   func(cli *smsmocks.MockClient)
+		wantStatus  domain.SendReceiptStatus
+		wantErrCode string
+		wantNext    int64
+	}{
+		{
+			name:    "已送达",
+			receipt: testReceipt,
+			setupMock: func(cli *smsmocks.MockClient) {
+				cli.EXPECT().QuerySendDetails(wantReq).Return(client.QuerySendDetailsResp{
+					SmsSendDetailDTOs: []client.SendDetail{
+						{PhoneNum: "13800138000", SendStatus: int(client.SendStatusSuccess)},
+					},
+				}, nil)
+			},
+			wantStatus: domain.SendReceiptStatusDelivered,
+		},
+		{
+			name:    "未送达",
+			receipt: testReceipt,
+			setupMock: func(cli *smsmocks.MockClient) {
+				cli.EXPECT().QuerySendDetails(wantReq).Return(client.QuerySendDetailsResp{
+					SmsSendDetailDTOs: []client.SendDetail{
+						{PhoneNum: "13800138000", SendStatus: int(client.SendStatusFailed), ErrCode: "MOBILE_NOT_ON_SERVICE"},
+					},
+				}, nil)
+			},
+			wantStatus:  domain.SendReceiptStatusUndelivered,
+			wantErrCode: "MOBILE_NOT_ON_SERVICE",
+		},
+		{
+			name:    "等待回执，推迟下次查询",
+			receipt: testReceipt,
+			setupMock: func(cli *smsmocks.MockClient) {
+				cli.EXPECT().QuerySendDetails(wantReq).Return(client.QuerySendDetailsResp{
+					SmsSendDetailDTOs: []client.SendDetail{
+						{PhoneNum: "13800138000", SendStatus: int(client.SendStatusWaiting)},
+					},
+				}, nil)
+			},
+			wantStatus: domain.SendReceiptStatusPending,
+			wantNext:   now.Add(time.Minute).UnixMilli(),
+		},
+		{
+			name:    "查询失败，推迟下次查询",
+			receipt: testReceipt,
+			setupMock: func(cli *smsmocks.MockClient) {
+				cli.EXPECT().QuerySendDetails(wantReq).Return(client.QuerySendDetailsResp{}, errors.New("mock error"))
+			},
+			wantStatus: domain.SendReceiptStatusPending,
+			wantNext:   now.Add(time.Minute).UnixMilli(),
+		},
+		{
+			name: "超过最大查询次数",
+			receipt: func() domain.SendReceipt {
+				r := testReceipt
+				r.QueryCount = 3
+				return r
+			}(),
+			setupMock: func(cli *smsmocks.MockClient) {
+				cli.EXPECT().QuerySendDetails(wantReq).Return(client.QuerySendDetailsResp{}, nil)
+			},
+			wantStatus:  domain.SendReceiptStatusUndelivered,
+			wantErrCode: ErrCodeReceiptTimeout,
+		},
+		{
+			name: "未知供应商",
+			receipt: func() domain.SendReceipt {
+				r := testReceipt
+				r.Provider = "unknown"
+				return r
+			}(),
+			setupMock:  func(_ *smsmocks.MockClient) {},
+			wantStatus: domain.SendReceiptStatusPending,
+			wantNext:   now.Add(time.Minute).UnixMilli(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := smsmocks.NewMockClient(ctrl)
+			tt.setupMock(mockClient)
+
+			task := &ReconcileTask{
+				clients: client.NewClients(map[string]client.Client{"aliyun": mockClient}),
+				backoff: backoff,
+				logger:  elog.DefaultLogger,
+			}
+			got := task.query(tt.receipt, now)
+			assert.Equal(t, tt.receipt.QueryCount+1, got.QueryCount)
+			assert.Equal(t, tt.wantStatus, got.Status)
+			assert.Equal(t, tt.wantErrCode, got.ErrCode)
+			if tt.wantNext != 0 {
+				assert.Equal(t, tt.wantNext, got.NextQueryTime)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./reply.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./reply.go -destination=./mocks/reply.mock.go -package=replymocks -typed Service
+//
+
+// Package replymocks is a generated GoMock package.
+package replymocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "gitee.com/flycash/notification-platform/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockService) List(ctx context.Context, query domain.SmsReplyQuery) (domain.SmsReplyPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, query)
+	ret0, _ := ret[0].(domain.SmsReplyPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockServiceMockRecorder) List(ctx, query any) *MockServiceListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockService)(nil).List), ctx, query)
+	return &MockServiceListCall{Call: call}
+}
+
+// MockServiceListCall wrap *gomock.Call
+type MockServiceListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceListCall) Return(arg0 domain.SmsReplyPage, arg1 error) *MockServiceListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceListCall) Do(f func(context.Context, domain.SmsReplyQuery) (domain.SmsReplyPage, error)) *MockServiceListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceListCall) DoAndReturn(f func(context.Context, domain.SmsReplyQuery) (domain.SmsReplyPage, error)) *MockServiceListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Receive mocks base method.
+func (m *MockService) Receive(ctx context.Context, replies []domain.SmsReply) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Receive", ctx, replies)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Receive indicates an expected call of Receive.
+func (mr *MockServiceMockRecorder) Receive(ctx, replies any) *MockServiceReceiveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Receive", reflect.TypeOf((*MockService)(nil).Receive), ctx, replies)
+	return &MockServiceReceiveCall{Call: call}
+}
+
+// MockServiceReceiveCall wrap *gomock.Call
+type MockServiceReceiveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceReceiveCall) Return(arg0 error) *MockServiceReceiveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceReceiveCall) Do(f func(context.Context, []domain.SmsReply) error) *MockServiceReceiveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceReceiveCall) DoAndReturn(f func(context.Context, []domain.SmsReply) error) *MockServiceReceiveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,113 @@
+package reply
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/repository"
+	"github.com/gotomicro/ego/core/elog"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// Service 上行短信服务
+//
+//go:generate mockgen -source=./reply.go -destination=./mocks/reply.mock.go -package=replymocks -typed Service
+type Service interface {
+	// Receive 保存供应商推送的上行短信，按接收者最近一次收到的短信关联到通知和业务方
+	Receive(ctx context.Context, replies []domain.SmsReply) error
+	// List 游标分页查询业务方收到的上行短信
+	List(ctx context.Context, query domain.SmsReplyQuery) (domain.SmsReplyPage, error)
+}
+
+type service struct {
+	repo             repository.SmsReplyRepository
+	receiptRepo      repository.SendReceiptRepository
+	notificationRepo repository.NotificationRepository
+	logger           *elog.Component
+}
+
+func NewService(
+	repo repository.SmsReplyRepository,
+	receiptRepo repository.SendReceiptRepository,
+	notificationRepo repository.NotificationRepository,
+) Service {
+	return &service{
+		repo:             repo,
+		receiptRepo:      receiptRepo,
+		notificationRepo: notificationRepo,
+		logger:           elog.DefaultLogger.With(elog.FieldComponent("reply")),
+	}
+}
+
+func (s *service) Receive(ctx context.Context, replies []domain.SmsReply) error {
+	for i := range replies {
+		if err := s.attach(ctx, &replies[i]); err != nil {
+			return err
+		}
+	}
+	return s.repo.BatchCreate(ctx, replies)
+}
+
+// attach 关联上行短信所属的通知和业务方，关联不到时照常保存
+func (s *service) attach(ctx context.Context, reply *domain.SmsReply) error {
+	receipt, err := s.receiptRepo.FindLatestByReceiver(ctx, reply.Provider, reply.PhoneNumber)
+	if errors.Is(err, errs.ErrSendReceiptNotFound) {
+		s.logger.Warn("上行短信找不到对应的下行短信",
+			elog.String("Provider", reply.Provider),
+			elog.String("PhoneNumber", reply.PhoneNumber))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	n, err := s.notificationRepo.GetByID(ctx, receipt.NotificationID)
+	if errors.Is(err, errs.ErrNotificationNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	reply.NotificationID = n.ID
+	reply.BizID = n.BizID
+	return nil
+}
+
+func (s *service) List(ctx context.Context, query domain.SmsReplyQuery) (domain.SmsReplyPage, error) {
+	if query.BizID <= 0 {
+		return domain.SmsReplyPage{}, fmt.Errorf("%w: BizID = %d", errs.ErrInvalidParameter, query.BizID)
+	}
+	if query.Cursor < 0 {
+		return domain.SmsReplyPage{}, fmt.Errorf("%w: Cursor = %d", errs.ErrInvalidParameter, query.Cursor)
+	}
+	if query.Limit <= 0 {
+		query.Limit = defaultPageSize
+	}
+	if query.Limit > maxPageSize {
+		query.Limit = maxPageSize
+	}
+
+	// 多查一条用于判断是否还有下一页
+	pageSize := query.Limit
+	query.Limit++
+	replies, err := s.repo.List(ctx, query)
+	if err != nil {
+		return domain.SmsReplyPage{}, err
+	}
+
+	page := domain.SmsReplyPage{Replies: replies}
+	if len(replies) > pageSize {
+		page.Replies = replies[:pageSize]
+		page.HasMore = true
+	}
+	if len(page.Replies) > 0 {
+		page.NextCursor = page.Replies[len(page.Replies)-1].ID
+	}
+	return page, nil
+}
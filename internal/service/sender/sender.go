@@ -7,10 +7,12 @@ import (
 	"sync"
 
 	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
 	"gitee.com/flycash/notification-platform/internal/repository"
 	"gitee.com/flycash/notification-platform/internal/service/channel"
 	configsvc "gitee.com/flycash/notification-platform/internal/service/config"
 	"gitee.com/flycash/notification-platform/internal/service/notification/callback"
+	"gitee.com/flycash/notification-platform/internal/service/receipt"
 	"github.com/ecodeclub/ekit/pool"
 	"github.com/gotomicro/ego/core/elog"
 )
@@ -28,8 +30,10 @@ type NotificationSender interface {
 // sender 通知发送器实现
 type sender struct {
 	repo        repository.NotificationRepository
+	resultRepo  repository.ReceiverResultRepository
 	configSvc   configsvc.BusinessConfigService
 	callbackSvc callback.Service
+	receiptSvc  receipt.Service
 	channel     channel.Channel
 	taskPool    pool.TaskPool
 
@@ -39,15 +43,19 @@ type sender struct {
 // NewSender 创建通知发送器
 func NewSender(
 	repo repository.NotificationRepository,
+	resultRepo repository.ReceiverResultRepository,
 	configSvc configsvc.BusinessConfigService,
 	callbackSvc callback.Service,
+	receiptSvc receipt.Service,
 	channel channel.Channel,
 	taskPool pool.TaskPool,
 ) NotificationSender {
 	return &sender{
 		repo:        repo,
+		resultRepo:  resultRepo,
 		configSvc:   configSvc,
 		callbackSvc: callbackSvc,
+		receiptSvc:  receiptSvc,
 		channel:     channel,
 		taskPool:    taskPool,
 		logger:      elog.DefaultLogger,
@@ -59,7 +67,8 @@ func (d *sender) Send(ctx context.Context, notification domain.Notification) (do
 	resp := domain.SendResponse{
 		NotificationID: notification.ID,
 	}
-	_, err := d.channel.Send(ctx, notification)
+	sendResp, err := d.channel.Send(ctx, notification)
+	resp.ReceiverResults = d.receiverResults(notification, sendResp, err)
 	if err != nil {
 		d.logger.Error("发送失败 %w", elog.FieldErr(err))
 		resp.Status = domain.SendStatusFailed
@@ -69,6 +78,7 @@ func (d *sender) Send(ctx context.Context, notification domain.Notification) (do
 	} else {
 		resp.Status = domain.SendStatusSucceeded
 		notification.Status = domain.SendStatusSucceeded
+		notification.DeliveredChannel = sendResp.DeliveredChannel
 		err = d.repo.MarkSuccess(ctx, notification)
 	}
 
@@ -77,6 +87,11 @@ func (d *sender) Send(ctx context.Context, notification domain.Notification) (do
 		return domain.SendResponse{}, err
 	}
 
+	if notification.Status == domain.SendStatusSucceeded {
+		d.recordReceipts(ctx, sendResp.Receipts)
+	}
+	d.recordReceiverResults(ctx, resp.ReceiverResults)
+
 	// 得到准确的发送结果，发起回调，发送成功和失败都应该回调
 
 	_ = d.callbackSvc.SendCallbackByNotification(ctx, notification)
@@ -90,9 +105,9 @@ func (d *sender) BatchSend(ctx context.Context, notifications []domain.Notificat
 		return nil, nil
 	}
 
-	// 并发发送通知
-	var succeedMu, failedMu sync.Mutex
-	var succeed, failed []domain.SendResponse
+	// 并发发送通知，每个任务只写自己下标的结果，不需要加锁
+	responses := make([]domain.SendResponse, len(notifications))
+	receiptsByIndex := make([][]domain.SendReceipt, len(notifications))
 
 	var wg sync.WaitGroup
 	wg.Add(len(notifications))
@@ -100,24 +115,19 @@ func (d *sender) BatchSend(ctx context.Context, notifications []domain.Notificat
 		n := notifications[i]
 		err := d.taskPool.Submit(ctx, pool.TaskFunc(func(ctx context.Context) error {
 			defer wg.Done()
-			_, err := d.channel.Send(ctx, n)
+			sendResp, err := d.channel.Send(ctx, n)
+			resp := domain.SendResponse{
+				NotificationID:  n.ID,
+				Status:          domain.SendStatusSucceeded,
+				ReceiverResults: d.receiverResults(n, sendResp, err),
+			}
 			if err != nil {
-				resp := domain.SendResponse{
-					NotificationID: n.ID,
-					Status:         domain.SendStatusFailed,
-				}
-				failedMu.Lock()
-				failed = append(failed, resp)
-				failedMu.Unlock()
+				resp.Status = domain.SendStatusFailed
 			} else {
-				resp := domain.SendResponse{
-					NotificationID: n.ID,
-					Status:         domain.SendStatusSucceeded,
-				}
-				succeedMu.Lock()
-				succeed = append(succeed, resp)
-				succeedMu.Unlock()
+				resp.DeliveredChannel = sendResp.DeliveredChannel
+				receiptsByIndex[i] = sendResp.Receipts
 			}
+			responses[i] = resp
 			log.Printf("submit notification[%d] = %#v\n", i, n)
 			return nil
 		}))
@@ -131,6 +141,19 @@ func (d *sender) BatchSend(ctx context.Context, notifications []domain.Notificat
 	}
 	wg.Wait()
 
+	var succeed, failed []domain.SendResponse
+	var receipts []domain.SendReceipt
+	var results []domain.ReceiverResult
+	for i := range responses {
+		if responses[i].Status == domain.SendStatusSucceeded {
+			succeed = append(succeed, responses[i])
+		} else {
+			failed = append(failed, responses[i])
+		}
+		receipts = append(receipts, receiptsByIndex[i]...)
+		results = append(results, responses[i].ReceiverResults...)
+	}
+
 	// 获取通知信息，以便获取版本号
 	allNotificationIDs := make([]uint64, 0, len(succeed)+len(failed))
 	for _, s := range succeed {
@@ -159,6 +182,9 @@ func (d *sender) BatchSend(ctx context.Context, notifications []domain.Notificat
 		return nil, err
 	}
 
+	d.recordReceipts(ctx, receipts)
+	d.recordReceiverResults(ctx, results)
+
 	// 得到准确的发送结果，发起回调，发送成功和失败都应该回调
 	_ = d.callbackSvc.SendCallbackByNotifications(ctx, append(succeedNotifications, failedNotifications...))
 
@@ -166,12 +192,67 @@ func (d *sender) BatchSend(ctx context.Context, notifications []domain.Notificat
 	return append(succeed, failed...), nil
 }
 
+// recordReceipts 记录供应商回执用于后续对账，失败只影响送达状态的确认，不影响发送结果
+func (d *sender) recordReceipts(ctx context.Context, receipts []domain.SendReceipt) {
+	if err := d.receiptSvc.Record(ctx, receipts); err != nil {
+		d.logger.Warn("记录供应商回执失败",
+			elog.FieldErr(err),
+			elog.Any("receipts", receipts),
+		)
+	}
+}
+
+// receiverResults 补全每个接收者的发送结果，供应商没有返回的接收者以整体发送结果为准。
+// 发生渠道降级时，供应商返回的是降级渠道接收者的结果，原渠道的接收者都视为发送失败
+func (d *sender) receiverResults(notification domain.Notification, sendResp domain.SendResponse, sendErr error) []domain.ReceiverResult {
+	ch := sendResp.DeliveredChannel
+	if sendErr != nil || ch == "" || ch == notification.Channel {
+		return d.fillReceiverResults(notification.ID, notification.Receivers, sendResp.ReceiverResults, sendErr)
+	}
+	fellBack := fmt.Errorf("%w: 已降级到 %s 渠道发送", errs.ErrSendNotificationFailed, ch)
+	results := d.fillReceiverResults(notification.ID, notification.Receivers, nil, fellBack)
+	return append(results, d.fillReceiverResults(notification.ID, notification.FallbackReceivers[ch], sendResp.ReceiverResults, nil)...)
+}
+
+// fillReceiverResults 按接收者补全发送结果，returned 中没有的接收者在 sendErr 为空时视为发送成功
+func (d *sender) fillReceiverResults(notificationID uint64, receivers []string, returned []domain.ReceiverResult, sendErr error) []domain.ReceiverResult {
+	returnedMap := make(map[string]domain.ReceiverResult, len(returned))
+	for _, r := range returned {
+		returnedMap[r.Receiver] = r
+	}
+	results := make([]domain.ReceiverResult, 0, len(receivers))
+	for _, receiver := range receivers {
+		r, ok := returnedMap[receiver]
+		if !ok {
+			r = domain.ReceiverResult{Receiver: receiver, Status: domain.SendStatusSucceeded}
+			if sendErr != nil {
+				r.Status = domain.SendStatusFailed
+				r.ErrMessage = sendErr.Error()
+			}
+		}
+		r.NotificationID = notificationID
+		results = append(results, r)
+	}
+	return results
+}
+
+// recordReceiverResults 记录接收者发送结果，失败只影响结果查询，不影响发送结果
+func (d *sender) recordReceiverResults(ctx context.Context, results []domain.ReceiverResult) {
+	if err := d.resultRepo.Save(ctx, results); err != nil {
+		d.logger.Warn("记录接收者发送结果失败",
+			elog.FieldErr(err),
+			elog.Any("results", results),
+		)
+	}
+}
+
 // getUpdatedNotifications 获取更新字段后的实体
 func (d *sender) getUpdatedNotifications(responses []domain.SendResponse, notificationsMap map[uint64]domain.Notification) []domain.Notification {
 	notifications := make([]domain.Notification, 0, len(responses))
 	for i := range responses {
 		if n, ok := notificationsMap[responses[i].NotificationID]; ok {
 			n.Status = responses[i].Status
+			n.DeliveredChannel = responses[i].DeliveredChannel
 			notifications = append(notifications, n)
 		}
 	}
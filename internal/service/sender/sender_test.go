@@ -0,0 +1,198 @@
+//go:build unit
+
+package sender
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/repository"
+	channelmocks "gitee.com/flycash/notification-platform/internal/service/channel/mocks"
+	"gitee.com/flycash/notification-platform/internal/service/notification/callback"
+	receiptmocks "gitee.com/flycash/notification-platform/internal/service/receipt/mocks"
+	"github.com/ecodeclub/ekit/pool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// fakeNotificationRepo 只实现 BatchSend 用到的方法
+type fakeNotificationRepo struct {
+	repository.NotificationRepository
+	succeed []domain.Notification
+	failed  []domain.Notification
+}
+
+func (f *fakeNotificationRepo) BatchGetByIDs(_ context.Context, ids []uint64) (map[uint64]domain.Notification, error) {
+	res := make(map[uint64]domain.Notification, len(ids))
+	for _, id := range ids {
+		res[id] = domain.Notification{ID: id}
+	}
+	return res, nil
+}
+
+func (f *fakeNotificationRepo) BatchUpdateStatusSucceededOrFailed(_ context.Context, succeed, failed []domain.Notification) error {
+	f.succeed, f.failed = succeed, failed
+	return nil
+}
+
+type fakeResultRepo struct {
+	repository.ReceiverResultRepository
+	results []domain.ReceiverResult
+}
+
+func (f *fakeResultRepo) Save(_ context.Context, results []domain.ReceiverResult) error {
+	f.results = append(f.results, results...)
+	return nil
+}
+
+type fakeCallbackService struct {
+	callback.Service
+}
+
+func (f *fakeCallbackService) SendCallbackByNotifications(context.Context, []domain.Notification) error {
+	return nil
+}
+
+func TestSender_BatchSend(t *testing.T) {
+	t.Parallel()
+
+	const total = 100
+	ctrl := gomock.NewController(t)
+
+	notifications := make([]domain.Notification, 0, total)
+	for i := 1; i <= total; i++ {
+		notifications = append(notifications, domain.Notification{
+			ID:        uint64(i),
+			Channel:   domain.ChannelSMS,
+			Receivers: []string{"13800138000", "13800138001"},
+		})
+	}
+
+	// 奇数成功，偶数失败，成功的通知第二个接收者被供应商拒绝
+	mockChannel := channelmocks.NewMockChannel(ctrl)
+	var mu sync.Mutex
+	sent := 0
+	mockChannel.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, n domain.Notification) (domain.SendResponse, error) {
+			mu.Lock()
+			sent++
+			mu.Unlock()
+			if n.ID%2 == 0 {
+				return domain.SendResponse{}, errors.New("mock error")
+			}
+			return domain.SendResponse{
+				NotificationID: n.ID,
+				Status:         domain.SendStatusSucceeded,
+				Receipts:       []domain.SendReceipt{{ReceiptID: "receipt"}},
+				ReceiverResults: []domain.ReceiverResult{
+					{Receiver: "13800138001", Status: domain.SendStatusFailed, ErrCode: "isv.MOBILE_NUMBER_ILLEGAL"},
+				},
+			}, nil
+		}).Times(total)
+
+	mockReceipt := receiptmocks.NewMockService(ctrl)
+	var receipts []domain.SendReceipt
+	mockReceipt.EXPECT().Record(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, rs []domain.SendReceipt) error {
+			receipts = rs
+			return nil
+		})
+
+	taskPool, err := pool.NewOnDemandBlockTaskPool(16, total)
+	require.NoError(t, err)
+	require.NoError(t, taskPool.Start())
+	t.Cleanup(func() {
+		_, _ = taskPool.ShutdownNow()
+	})
+
+	repo := &fakeNotificationRepo{}
+	resultRepo := &fakeResultRepo{}
+	s := NewSender(repo, resultRepo, nil, &fakeCallbackService{}, mockReceipt, mockChannel, taskPool)
+
+	responses, err := s.BatchSend(t.Context(), notifications)
+	require.NoError(t, err)
+	assert.Equal(t, total, sent)
+	assert.Len(t, responses, total)
+	assert.Len(t, repo.succeed, total/2)
+	assert.Len(t, repo.failed, total/2)
+	assert.Len(t, receipts, total/2)
+
+	require.Len(t, resultRepo.results, 2*total)
+	statuses := make(map[domain.SendStatus]int)
+	for _, r := range resultRepo.results {
+		statuses[r.Status]++
+	}
+	assert.Equal(t, map[domain.SendStatus]int{
+		domain.SendStatusSucceeded: total / 2,
+		domain.SendStatusFailed:    total + total/2,
+	}, statuses)
+}
+
+func TestSender_receiverResults(t *testing.T) {
+	t.Parallel()
+
+	notification := domain.Notification{
+		ID:        1,
+		Channel:   domain.ChannelSMS,
+		Receivers: []string{"13800138000", "13800138001"},
+		FallbackReceivers: map[domain.Channel][]string{
+			domain.ChannelEmail: {"a@example.com", "b@example.com"},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		sendResp domain.SendResponse
+		sendErr  error
+		want     []domain.ReceiverResult
+	}{
+		{
+			name: "没有降级",
+			sendResp: domain.SendResponse{
+				DeliveredChannel: domain.ChannelSMS,
+				ReceiverResults: []domain.ReceiverResult{
+					{Receiver: "13800138001", Status: domain.SendStatusFailed, ErrCode: "MK:0001"},
+				},
+			},
+			want: []domain.ReceiverResult{
+				{NotificationID: 1, Receiver: "13800138000", Status: domain.SendStatusSucceeded},
+				{NotificationID: 1, Receiver: "13800138001", Status: domain.SendStatusFailed, ErrCode: "MK:0001"},
+			},
+		},
+		{
+			name:    "发送失败",
+			sendErr: errors.New("mock error"),
+			want: []domain.ReceiverResult{
+				{NotificationID: 1, Receiver: "13800138000", Status: domain.SendStatusFailed, ErrMessage: "mock error"},
+				{NotificationID: 1, Receiver: "13800138001", Status: domain.SendStatusFailed, ErrMessage: "mock error"},
+			},
+		},
+		{
+			name: "降级到邮件",
+			sendResp: domain.SendResponse{
+				DeliveredChannel: domain.ChannelEmail,
+				ReceiverResults: []domain.ReceiverResult{
+					{Receiver: "b@example.com", Status: domain.SendStatusFailed, ErrCode: "550"},
+				},
+			},
+			want: []domain.ReceiverResult{
+				{NotificationID: 1, Receiver: "13800138000", Status: domain.SendStatusFailed, ErrMessage: "发送通知失败: 已降级到 EMAIL 渠道发送"},
+				{NotificationID: 1, Receiver: "13800138001", Status: domain.SendStatusFailed, ErrMessage: "发送通知失败: 已降级到 EMAIL 渠道发送"},
+				{NotificationID: 1, Receiver: "a@example.com", Status: domain.SendStatusSucceeded},
+				{NotificationID: 1, Receiver: "b@example.com", Status: domain.SendStatusFailed, ErrCode: "550"},
+			},
+		},
+	}
+
+	s := &sender{}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, s.receiverResults(notification, tc.sendResp, tc.sendErr))
+		})
+	}
+}
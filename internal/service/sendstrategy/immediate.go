@@ -46,7 +46,7 @@ func (s *ImmediateSendStrategy) Send(ctx context.Context, notification domain.No
 		return domain.SendResponse{}, fmt.Errorf("获取通知失败: %w", err)
 	}
 
-	if found.Status == domain.SendStatusSucceeded {
+	if found.Status.IsAccepted() {
 		return domain.SendResponse{
 			NotificationID: found.ID,
 			Status:         found.Status,
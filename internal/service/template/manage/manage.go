@@ -3,8 +3,12 @@ package manage
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
+	"net/mail"
 	"regexp"
+	"slices"
 	"time"
 
 	"gitee.com/flycash/notification-platform/internal/domain"
@@ -13,6 +17,8 @@ import (
 	"gitee.com/flycash/notification-platform/internal/service/audit"
 	providersvc "gitee.com/flycash/notification-platform/internal/service/provider/manage"
 	"gitee.com/flycash/notification-platform/internal/service/provider/sms/client"
+	"gitee.com/flycash/notification-platform/internal/service/template/moderation"
+	"gitee.com/flycash/notification-platform/internal/service/template/render"
 	"github.com/ecodeclub/ekit/slice"
 )
 
@@ -25,20 +31,36 @@ type ChannelTemplateService interface {
 	// GetTemplatesByOwner 获取指定所有者的模板列表
 	GetTemplatesByOwner(ctx context.Context, ownerID int64, ownerType domain.OwnerType) ([]domain.ChannelTemplate, error)
 
-	// GetTemplateByIDAndProviderInfo 根据模板ID和供应商信息获取模板
-	GetTemplateByIDAndProviderInfo(ctx context.Context, templateID int64, providerName string, channel domain.Channel) (domain.ChannelTemplate, error)
+	// GetTemplateByIDAndProviderInfo 根据模板ID和供应商信息获取模板，只包含语言对应的活跃版本，没有该语言的版本时按 domain.LocaleChain 回退
+	// 版本只包含指定地区的供应商模版，所有供应商都没有审核通过的国际/港澳台模版时返回 errs.ErrNoInternationalTemplate
+	GetTemplateByIDAndProviderInfo(ctx context.Context, templateID int64, locale, providerName string, channel domain.Channel, region domain.TemplateRegion) (domain.ChannelTemplate, error)
 
 	// GetTemplateByID 根据ID获取模板
 	GetTemplateByID(ctx context.Context, templateID int64) (domain.ChannelTemplate, error)
 
+	// GetFamilyTemplate 获取与指定模板同族的其他渠道的已发布模板，用于渠道降级
+	GetFamilyTemplate(ctx context.Context, templateID int64, channel domain.Channel) (domain.ChannelTemplate, error)
+
 	// CreateTemplate 创建模板
 	CreateTemplate(ctx context.Context, template domain.ChannelTemplate) (domain.ChannelTemplate, error)
 
 	// UpdateTemplate 更新模板
 	UpdateTemplate(ctx context.Context, template domain.ChannelTemplate) error
 
-	// PublishTemplate 发布模板
-	PublishTemplate(ctx context.Context, templateID, versionID int64) error
+	// PublishTemplate 发布模板，operatorID 为操作人ID，记录在发布历史中
+	PublishTemplate(ctx context.Context, templateID, versionID, operatorID int64) error
+
+	// RollbackTemplate 回滚到之前审核通过的版本，重新查询并确认供应商审核仍然有效后再发布
+	RollbackTemplate(ctx context.Context, templateID, versionID, operatorID int64) error
+
+	// GetPublishHistory 按时间倒序获取模版的发布历史
+	GetPublishHistory(ctx context.Context, templateID int64, offset, limit int) (records []domain.TemplatePublishRecord, total int64, err error)
+
+	// ArchiveTemplate 归档模版，归档后只能查询，不能修改、发布和发送
+	ArchiveTemplate(ctx context.Context, templateID int64) error
+
+	// DeleteTemplate 删除模版，已发布的模版需要先归档，有通知使用该模版时返回 errs.ErrTemplateInUse
+	DeleteTemplate(ctx context.Context, templateID int64) error
 
 	// 模版版本相关方法
 
@@ -48,7 +70,19 @@ type ChannelTemplateService interface {
 	// UpdateVersion 更新模板版本
 	UpdateVersion(ctx context.Context, version domain.ChannelTemplateVersion) error
 
-	// SubmitForInternalReview 提交内部审核
+	// ArchiveVersion 归档没有生效的版本，归档后不能修改、提交审核和发布
+	ArchiveVersion(ctx context.Context, versionID int64) error
+
+	// DeleteVersion 删除没有生效的版本，模版至少保留一个版本，有通知使用该版本时返回 errs.ErrTemplateInUse
+	DeleteVersion(ctx context.Context, versionID int64) error
+
+	// DiffVersions 比较同一模版的两个版本的内容、参数定义和签名等
+	DiffVersions(ctx context.Context, fromVersionID, toVersionID int64) (domain.TemplateVersionDiff, error)
+
+	// CheckVersion 执行审核前检查，只返回发现的问题，不修改审核状态
+	CheckVersion(ctx context.Context, versionID int64) (domain.ModerationResult, error)
+
+	// SubmitForInternalReview 提交内部审核，审核前检查发现明确违规时直接拒绝并返回 *domain.ModerationRejectedError
 	SubmitForInternalReview(ctx context.Context, versionID int64) error
 
 	// BatchUpdateVersionAuditStatus 批量更新版本审核状态
@@ -56,14 +90,19 @@ type ChannelTemplateService interface {
 
 	// 供应商相关方法
 
-	// BatchSubmitForProviderReview 批量提交供应商审核
-	BatchSubmitForProviderReview(ctx context.Context, versionID []int64) error
+	// BatchSubmitForProviderReview 批量提交供应商审核，只提交通过内部审核并且没有归档的版本，审核前检查发现明确违规的版本不提交，直接拒绝
+	// 按 versionIDs 的顺序返回每个版本的提交结果
+	BatchSubmitForProviderReview(ctx context.Context, versionIDs []int64) []domain.ProviderReviewResult
 
 	// GetPendingOrInReviewProviders 获取未审核或审核中的供应商关联
 	GetPendingOrInReviewProviders(ctx context.Context, offset, limit int, utime int64) (providers []domain.ChannelTemplateProvider, total int64, err error)
 
 	// BatchQueryAndUpdateProviderAuditInfo 批量查询并更新供应商审核信息
 	BatchQueryAndUpdateProviderAuditInfo(ctx context.Context, providers []domain.ChannelTemplateProvider) error
+
+	// ReceiveProviderAuditResults 处理供应商推送的审核结果，按供应商侧模版ID更新审核中的供应商关联，
+	// results 只需要 ProviderTemplateID、AuditStatus 和 RejectReason
+	ReceiveProviderAuditResults(ctx context.Context, providerName string, results []domain.ChannelTemplateProvider) error
 }
 
 // templateService 实现了ChannelTemplateService接口，提供模板管理的具体实现
@@ -71,7 +110,9 @@ type templateService struct {
 	repo        repository.ChannelTemplateRepository
 	providerSvc providersvc.Service
 	auditSvc    audit.Service
-	smsClients  map[string]client.Client
+	smsClients  *client.Clients
+	// moderator 审核前检查
+	moderator moderation.Checker
 }
 
 // NewChannelTemplateService 创建模板服务实例
@@ -79,13 +120,15 @@ func NewChannelTemplateService(
 	repo repository.ChannelTemplateRepository,
 	providerSvc providersvc.Service,
 	auditSvc audit.Service,
-	smsClients map[string]client.Client,
+	smsClients *client.Clients,
+	moderator moderation.Checker,
 ) ChannelTemplateService {
 	return &templateService{
 		repo:        repo,
 		providerSvc: providerSvc,
 		auditSvc:    auditSvc,
 		smsClients:  smsClients,
+		moderator:   moderator,
 	}
 }
 
@@ -109,7 +152,7 @@ func (t *templateService) GetTemplatesByOwner(ctx context.Context, ownerID int64
 	return templates, nil
 }
 
-func (t *templateService) GetTemplateByIDAndProviderInfo(ctx context.Context, templateID int64, providerName string, channel domain.Channel) (domain.ChannelTemplate, error) {
+func (t *templateService) GetTemplateByIDAndProviderInfo(ctx context.Context, templateID int64, locale, providerName string, channel domain.Channel, region domain.TemplateRegion) (domain.ChannelTemplate, error) {
 	// 1. 获取模板基本信息
 	template, err := t.repo.GetTemplateByID(ctx, templateID)
 	if err != nil {
@@ -120,8 +163,12 @@ func (t *templateService) GetTemplateByIDAndProviderInfo(ctx context.Context, te
 		return domain.ChannelTemplate{}, fmt.Errorf("%w: templateID=%d", errs.ErrTemplateNotFound, templateID)
 	}
 
+	if template.Archived() {
+		return domain.ChannelTemplate{}, fmt.Errorf("%w: templateID=%d", errs.ErrTemplateArchived, templateID)
+	}
+
 	// 2. 获取指定的版本信息
-	version, err := t.repo.GetTemplateVersionByID(ctx, template.ActiveVersionID)
+	version, err := t.repo.GetTemplateVersionByID(ctx, template.ActiveVersionIDFor(locale))
 	if err != nil {
 		return domain.ChannelTemplate{}, err
 	}
@@ -135,9 +182,25 @@ func (t *templateService) GetTemplateByIDAndProviderInfo(ctx context.Context, te
 	if err != nil {
 		return domain.ChannelTemplate{}, err
 	}
+	providers = slices.DeleteFunc(providers, func(p domain.ChannelTemplateProvider) bool {
+		return p.Region != region
+	})
+
+	if len(providers) == 0 && region.IsInternational() {
+		// 其他供应商有国际/港澳台模版时可以换供应商发送
+		approved, err1 := t.repo.GetApprovedProvidersByTemplateIDAndVersionID(ctx, templateID, version.ID)
+		if err1 != nil {
+			return domain.ChannelTemplate{}, err1
+		}
+		if !slices.ContainsFunc(approved, func(p domain.ChannelTemplateProvider) bool {
+			return p.ProviderChannel == channel && p.Region.IsInternational()
+		}) {
+			return domain.ChannelTemplate{}, fmt.Errorf("%w: versionID=%d", errs.ErrNoInternationalTemplate, version.ID)
+		}
+	}
 
 	if len(providers) == 0 {
-		return domain.ChannelTemplate{}, fmt.Errorf("%w: providerName=%s, channel=%s", errs.ErrProviderNotFound, providerName, channel)
+		return domain.ChannelTemplate{}, fmt.Errorf("%w: providerName=%s, channel=%s, region=%s", errs.ErrProviderNotFound, providerName, channel, region)
 	}
 
 	// 4. 组装完整模板
@@ -151,6 +214,32 @@ func (t *templateService) GetTemplateByID(ctx context.Context, templateID int64)
 	return t.repo.GetTemplateByID(ctx, templateID)
 }
 
+// GetFamilyTemplate 同一拥有者下同名的模板视为同一模板族，每个渠道各有一个
+func (t *templateService) GetFamilyTemplate(ctx context.Context, templateID int64, channel domain.Channel) (domain.ChannelTemplate, error) {
+	template, err := t.repo.GetTemplateByID(ctx, templateID)
+	if err != nil {
+		return domain.ChannelTemplate{}, err
+	}
+	if template.ID == 0 {
+		return domain.ChannelTemplate{}, fmt.Errorf("%w: templateID=%d", errs.ErrTemplateNotFound, templateID)
+	}
+	if template.Channel == channel {
+		return template, nil
+	}
+
+	templates, err := t.repo.GetTemplatesByOwner(ctx, template.OwnerID, template.OwnerType)
+	if err != nil {
+		return domain.ChannelTemplate{}, fmt.Errorf("获取模板列表失败: %w", err)
+	}
+	for i := range templates {
+		if templates[i].Name == template.Name && templates[i].Channel == channel &&
+			templates[i].HasPublished() && !templates[i].Archived() {
+			return templates[i], nil
+		}
+	}
+	return domain.ChannelTemplate{}, fmt.Errorf("%w: templateID=%d 没有 %s 渠道的已发布模板", errs.ErrTemplateNotFound, templateID, channel)
+}
+
 func (t *templateService) CreateTemplate(ctx context.Context, template domain.ChannelTemplate) (domain.ChannelTemplate, error) {
 	// 参数校验
 	if err := template.Validate(); err != nil {
@@ -174,6 +263,9 @@ func (t *templateService) CreateTemplate(ctx context.Context, template domain.Ch
 		Content:           "模版变量使用${code}格式，也可以没有变量",
 		Remark:            "模版使用场景或者用途说明，有利于供应商审核通过",
 	}
+	if err := t.fillParams(&version); err != nil {
+		return domain.ChannelTemplate{}, err
+	}
 
 	createdVersion, err := t.repo.CreateTemplateVersion(ctx, version)
 	if err != nil {
@@ -188,16 +280,20 @@ func (t *templateService) CreateTemplate(ctx context.Context, template domain.Ch
 	if len(providers) == 0 {
 		return domain.ChannelTemplate{}, fmt.Errorf("%w: 渠道 %s 没有可用的供应商，联系管理员配置供应商", errs.ErrCreateTemplateFailed, template.Channel)
 	}
-	templateProviders := make([]domain.ChannelTemplateProvider, 0, len(providers))
-	for i := range providers {
-		templateProvider := domain.ChannelTemplateProvider{
-			TemplateID:        createdTemplate.ID,
-			TemplateVersionID: createdVersion.ID,
-			ProviderID:        providers[i].ID,
-			ProviderName:      providers[i].Name,
-			ProviderChannel:   providers[i].Channel,
+	regions := t.regions(template)
+	templateProviders := make([]domain.ChannelTemplateProvider, 0, len(regions)*len(providers))
+	for _, region := range regions {
+		for i := range providers {
+			templateProvider := domain.ChannelTemplateProvider{
+				TemplateID:        createdTemplate.ID,
+				TemplateVersionID: createdVersion.ID,
+				ProviderID:        providers[i].ID,
+				ProviderName:      providers[i].Name,
+				ProviderChannel:   providers[i].Channel,
+				Region:            region,
+			}
+			templateProviders = append(templateProviders, templateProvider)
 		}
-		templateProviders = append(templateProviders, templateProvider)
 	}
 	createdProviders, err := t.repo.BatchCreateTemplateProviders(ctx, templateProviders)
 	if err != nil {
@@ -210,6 +306,14 @@ func (t *templateService) CreateTemplate(ctx context.Context, template domain.Ch
 	return createdTemplate, nil
 }
 
+// regions 模版需要在供应商侧申请的地区，只有短信区分国内和国际/港澳台模版
+func (t *templateService) regions(template domain.ChannelTemplate) []domain.TemplateRegion {
+	if template.Channel.IsSMS() && template.International {
+		return []domain.TemplateRegion{domain.TemplateRegionDomestic, domain.TemplateRegionInternational}
+	}
+	return []domain.TemplateRegion{domain.TemplateRegionDomestic}
+}
+
 // UpdateTemplate 更新模版的基础信息
 func (t *templateService) UpdateTemplate(ctx context.Context, template domain.ChannelTemplate) error {
 	if template.Name == "" {
@@ -224,6 +328,7 @@ func (t *templateService) UpdateTemplate(ctx context.Context, template domain.Ch
 		return fmt.Errorf("%w: 业务类型", errs.ErrInvalidParameter)
 	}
 
+	// 已归档的模版由存储层返回 errs.ErrTemplateArchived
 	if err := t.repo.UpdateTemplate(ctx, template); err != nil {
 		return fmt.Errorf("%w: %w", errs.ErrUpdateTemplateFailed, err)
 	}
@@ -231,54 +336,221 @@ func (t *templateService) UpdateTemplate(ctx context.Context, template domain.Ch
 	return nil
 }
 
-func (t *templateService) PublishTemplate(ctx context.Context, templateID, versionID int64) error {
+func (t *templateService) PublishTemplate(ctx context.Context, templateID, versionID, operatorID int64) error {
+	_, version, err := t.checkPublishable(ctx, templateID, versionID)
+	if err != nil {
+		return err
+	}
+
+	// 检查是否有通过供应商审核的记录
+	providers, err := t.repo.GetApprovedProvidersByTemplateIDAndVersionID(ctx, templateID, versionID)
+	if err != nil {
+		return err
+	}
+	if len(providers) == 0 {
+		return fmt.Errorf("%w", errs.ErrTemplateVersionNotApprovedByPlatform)
+	}
+
+	// 设置活跃版本，其他语言的版本只修改该语言的活跃版本
+	err = t.repo.SetTemplateActiveVersion(ctx, domain.TemplatePublishRecord{
+		TemplateID: templateID,
+		VersionID:  versionID,
+		OperatorID: operatorID,
+		Action:     domain.TemplatePublishActionPublish,
+		Locale:     version.Locale,
+	})
+	if err != nil {
+		return fmt.Errorf("发布模版失败: %w", err)
+	}
+	return nil
+}
+
+// checkPublishable 检查模版和版本存在、没有归档、版本属于该模板并且已通过内部审核
+func (t *templateService) checkPublishable(ctx context.Context, templateID, versionID int64) (domain.ChannelTemplate, domain.ChannelTemplateVersion, error) {
 	if templateID <= 0 {
-		return fmt.Errorf("%w: 模板ID必须大于0", errs.ErrInvalidParameter)
+		return domain.ChannelTemplate{}, domain.ChannelTemplateVersion{}, fmt.Errorf("%w: 模板ID必须大于0", errs.ErrInvalidParameter)
 	}
 
 	if versionID <= 0 {
-		return fmt.Errorf("%w: 版本ID必须大于0", errs.ErrInvalidParameter)
+		return domain.ChannelTemplate{}, domain.ChannelTemplateVersion{}, fmt.Errorf("%w: 版本ID必须大于0", errs.ErrInvalidParameter)
+	}
+
+	template, err := t.repo.GetTemplateByID(ctx, templateID)
+	if err != nil {
+		return domain.ChannelTemplate{}, domain.ChannelTemplateVersion{}, err
+	}
+	if template.Archived() {
+		return domain.ChannelTemplate{}, domain.ChannelTemplateVersion{}, fmt.Errorf("%w: templateID=%d", errs.ErrTemplateArchived, templateID)
 	}
 
 	// 检查版本是否存在并且已通过内部审核
 	version, err := t.repo.GetTemplateVersionByID(ctx, versionID)
 	if err != nil {
-		return err
+		return domain.ChannelTemplate{}, domain.ChannelTemplateVersion{}, err
 	}
 
 	// 确认版本属于该模板
 	if version.ChannelTemplateID != templateID {
-		return fmt.Errorf("%w: %w", errs.ErrInvalidParameter, errs.ErrTemplateAndVersionMisMatch)
+		return domain.ChannelTemplate{}, domain.ChannelTemplateVersion{}, fmt.Errorf("%w: %w", errs.ErrInvalidParameter, errs.ErrTemplateAndVersionMisMatch)
+	}
+
+	if version.Archived() {
+		return domain.ChannelTemplate{}, domain.ChannelTemplateVersion{}, fmt.Errorf("%w: versionID=%d", errs.ErrTemplateArchived, versionID)
 	}
 
 	// 检查版本是否通过内部审核
 	if version.AuditStatus != domain.AuditStatusApproved {
-		return fmt.Errorf("%w: %w: 版本ID", errs.ErrInvalidParameter, errs.ErrTemplateVersionNotApprovedByPlatform)
+		return domain.ChannelTemplate{}, domain.ChannelTemplateVersion{}, fmt.Errorf("%w: %w: 版本ID", errs.ErrInvalidParameter, errs.ErrTemplateVersionNotApprovedByPlatform)
 	}
+	return template, version, nil
+}
 
-	// 检查是否有通过供应商审核的记录
+func (t *templateService) RollbackTemplate(ctx context.Context, templateID, versionID, operatorID int64) error {
+	template, version, err := t.checkPublishable(ctx, templateID, versionID)
+	if err != nil {
+		return err
+	}
+
+	activeVersionID := template.ActiveVersionID
+	if version.Locale != "" {
+		activeVersionID = template.ActiveLocaleVersions[version.Locale]
+	}
+	if activeVersionID == versionID {
+		return fmt.Errorf("%w: 版本 %d 已经是当前生效的版本", errs.ErrInvalidParameter, versionID)
+	}
+
+	// 供应商可能在发布之后撤销了审核，回滚前重新查询供应商侧的审核状态
 	providers, err := t.repo.GetApprovedProvidersByTemplateIDAndVersionID(ctx, templateID, versionID)
 	if err != nil {
 		return err
 	}
+	if err = t.BatchQueryAndUpdateProviderAuditInfo(ctx, providers); err != nil {
+		return err
+	}
+	providers, err = t.repo.GetApprovedProvidersByTemplateIDAndVersionID(ctx, templateID, versionID)
+	if err != nil {
+		return err
+	}
 	if len(providers) == 0 {
-		return fmt.Errorf("%w", errs.ErrTemplateVersionNotApprovedByPlatform)
+		return fmt.Errorf("%w: %w: 版本ID %d", errs.ErrInvalidParameter, errs.ErrTemplateVersionNotApprovedByProvider, versionID)
 	}
 
-	// 设置活跃版本
-	err = t.repo.SetTemplateActiveVersion(ctx, templateID, versionID)
+	err = t.repo.SetTemplateActiveVersion(ctx, domain.TemplatePublishRecord{
+		TemplateID: templateID,
+		VersionID:  versionID,
+		OperatorID: operatorID,
+		Action:     domain.TemplatePublishActionRollback,
+		Locale:     version.Locale,
+	})
 	if err != nil {
-		return fmt.Errorf("发布模版失败: %w", err)
+		return fmt.Errorf("回滚模版失败: %w", err)
 	}
 	return nil
 }
 
+func (t *templateService) GetPublishHistory(ctx context.Context, templateID int64, offset, limit int) (records []domain.TemplatePublishRecord, total int64, err error) {
+	const (
+		defaultLimit = 20
+		maxLimit     = 100
+	)
+	if templateID <= 0 {
+		return nil, 0, fmt.Errorf("%w: 模板ID必须大于0", errs.ErrInvalidParameter)
+	}
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	return t.repo.GetPublishRecords(ctx, templateID, max(offset, 0), min(limit, maxLimit))
+}
+
+func (t *templateService) ArchiveTemplate(ctx context.Context, templateID int64) error {
+	if templateID <= 0 {
+		return fmt.Errorf("%w: 模板ID必须大于0", errs.ErrInvalidParameter)
+	}
+	// 确认模版存在
+	if _, err := t.repo.GetTemplateByID(ctx, templateID); err != nil {
+		return err
+	}
+	return t.repo.ArchiveTemplate(ctx, templateID)
+}
+
+func (t *templateService) DeleteTemplate(ctx context.Context, templateID int64) error {
+	if templateID <= 0 {
+		return fmt.Errorf("%w: 模板ID必须大于0", errs.ErrInvalidParameter)
+	}
+	template, err := t.repo.GetTemplateByID(ctx, templateID)
+	if err != nil {
+		return err
+	}
+	// 归档后不能再发送，避免删除的同时有新的通知使用该模版
+	if template.HasPublished() && !template.Archived() {
+		return fmt.Errorf("%w: 已发布的模版需要先归档", errs.ErrInvalidOperation)
+	}
+	return t.repo.DeleteTemplate(ctx, templateID)
+}
+
 // 模版版本相关方法
 
 func (t *templateService) ForkVersion(ctx context.Context, versionID int64) (domain.ChannelTemplateVersion, error) {
 	return t.repo.ForkTemplateVersion(ctx, versionID)
 }
 
+func (t *templateService) ArchiveVersion(ctx context.Context, versionID int64) error {
+	template, version, err := t.getTemplateAndVersion(ctx, versionID)
+	if err != nil {
+		return err
+	}
+	if template.IsActiveVersion(version.ID) {
+		return fmt.Errorf("%w: 版本 %d 是当前生效的版本，先发布其他版本", errs.ErrInvalidOperation, versionID)
+	}
+	return t.repo.ArchiveTemplateVersion(ctx, versionID)
+}
+
+func (t *templateService) DeleteVersion(ctx context.Context, versionID int64) error {
+	template, version, err := t.getTemplateAndVersion(ctx, versionID)
+	if err != nil {
+		return err
+	}
+	// 新版本只能基于已有版本创建
+	if len(template.Versions) <= 1 {
+		return fmt.Errorf("%w: 模版至少保留一个版本", errs.ErrInvalidOperation)
+	}
+	// 生效中的版本由存储层在事务内检查
+	return t.repo.DeleteTemplateVersion(ctx, template.ID, version.ID)
+}
+
+func (t *templateService) getTemplateAndVersion(ctx context.Context, versionID int64) (domain.ChannelTemplate, domain.ChannelTemplateVersion, error) {
+	if versionID <= 0 {
+		return domain.ChannelTemplate{}, domain.ChannelTemplateVersion{}, fmt.Errorf("%w: 版本ID必须大于0", errs.ErrInvalidParameter)
+	}
+	version, err := t.repo.GetTemplateVersionByID(ctx, versionID)
+	if err != nil {
+		return domain.ChannelTemplate{}, domain.ChannelTemplateVersion{}, err
+	}
+	template, err := t.repo.GetTemplateByID(ctx, version.ChannelTemplateID)
+	if err != nil {
+		return domain.ChannelTemplate{}, domain.ChannelTemplateVersion{}, err
+	}
+	return template, version, nil
+}
+
+func (t *templateService) DiffVersions(ctx context.Context, fromVersionID, toVersionID int64) (domain.TemplateVersionDiff, error) {
+	if fromVersionID <= 0 || toVersionID <= 0 {
+		return domain.TemplateVersionDiff{}, fmt.Errorf("%w: 版本ID必须大于0", errs.ErrInvalidParameter)
+	}
+	from, err := t.repo.GetTemplateVersionByID(ctx, fromVersionID)
+	if err != nil {
+		return domain.TemplateVersionDiff{}, err
+	}
+	to, err := t.repo.GetTemplateVersionByID(ctx, toVersionID)
+	if err != nil {
+		return domain.TemplateVersionDiff{}, err
+	}
+	if from.ChannelTemplateID != to.ChannelTemplateID {
+		return domain.TemplateVersionDiff{}, fmt.Errorf("%w: %w: 只能比较同一模板的版本", errs.ErrInvalidParameter, errs.ErrTemplateAndVersionMisMatch)
+	}
+	return domain.DiffTemplateVersions(from, to), nil
+}
+
 func (t *templateService) UpdateVersion(ctx context.Context, version domain.ChannelTemplateVersion) error {
 	// 参数校验
 	if version.ID <= 0 {
@@ -291,18 +563,42 @@ func (t *templateService) UpdateVersion(ctx context.Context, version domain.Chan
 		return fmt.Errorf("%w: %w", errs.ErrUpdateTemplateVersionFailed, err)
 	}
 
+	if currentVersion.Archived() {
+		return fmt.Errorf("%w: versionID=%d", errs.ErrTemplateArchived, version.ID)
+	}
+
 	// 检查版本状态，只有PENDING或REJECTED状态的版本才能修改
 	if currentVersion.AuditStatus != domain.AuditStatusPending && currentVersion.AuditStatus != domain.AuditStatusRejected {
 		return fmt.Errorf("%w: %w: 只有待审核或拒绝状态的版本可以修改", errs.ErrUpdateTemplateVersionFailed, errs.ErrInvalidOperation)
 	}
 
+	locale, err := domain.NormalizeLocale(version.Locale)
+	if err != nil {
+		return err
+	}
+
+	// 回复地址原样写入邮件头，必须是合法的邮件地址
+	if version.ReplyTo != "" {
+		if _, err = mail.ParseAddress(version.ReplyTo); err != nil {
+			return fmt.Errorf("%w: 非法的回复地址 %s", errs.ErrInvalidParameter, version.ReplyTo)
+		}
+	}
+
 	// 允许更新部分字段
 	updateVersion := domain.ChannelTemplateVersion{
-		ID:        version.ID,
-		Name:      version.Name,
-		Signature: version.Signature,
-		Content:   version.Content,
-		Remark:    version.Remark,
+		ID:          version.ID,
+		Name:        version.Name,
+		Signature:   version.Signature,
+		Content:     version.Content,
+		Remark:      version.Remark,
+		Subject:     version.Subject,
+		ReplyTo:     version.ReplyTo,
+		Attachments: version.Attachments,
+		Params:      version.Params,
+		Locale:      locale,
+	}
+	if err = t.fillParams(&updateVersion); err != nil {
+		return err
 	}
 
 	// 更新版本
@@ -313,6 +609,25 @@ func (t *templateService) UpdateVersion(ctx context.Context, version domain.Chan
 	return nil
 }
 
+// fillParams 从标题和内容中提取参数，与声明的参数定义合并
+// 只在公共片段中使用的参数无法提取，需要显式声明
+func (t *templateService) fillParams(version *domain.ChannelTemplateVersion) error {
+	for i := range version.Params {
+		if version.Params[i].Type == "" {
+			version.Params[i].Type = domain.TemplateParamTypeString
+		}
+		if err := version.Params[i].Validate(); err != nil {
+			return err
+		}
+	}
+	names, err := render.ExtractParams(version.Subject, version.Content)
+	if err != nil {
+		return fmt.Errorf("%w: 模版语法错误 %w", errs.ErrInvalidParameter, err)
+	}
+	version.Params = domain.MergeTemplateParams(version.Params, names)
+	return nil
+}
+
 func (t *templateService) BatchUpdateVersionAuditStatus(ctx context.Context, versions []domain.ChannelTemplateVersion) error {
 	if len(versions) == 0 {
 		return nil
@@ -344,13 +659,23 @@ func (t *templateService) SubmitForInternalReview(ctx context.Context, versionID
 		return fmt.Errorf("%w: %w", errs.ErrSubmitVersionForInternalReviewFailed, err)
 	}
 
+	if template.Archived() || version.Archived() {
+		return fmt.Errorf("%w: versionID=%d", errs.ErrTemplateArchived, versionID)
+	}
+
+	// 审核前检查，明确违规的直接拒绝，其他问题随审核内容交给审核人判断
+	result := t.moderator.Check(ctx, template, version)
+	if result.Blocked() {
+		return t.rejectByModeration(ctx, version, result)
+	}
+
 	// 获取版本关联的供应商
 	providers, err := t.repo.GetProvidersByTemplateIDAndVersionID(ctx, template.ID, version.ID)
 	if err != nil {
 		return fmt.Errorf("%w: %w", errs.ErrSubmitVersionForInternalReviewFailed, err)
 	}
 
-	content, err := t.getJSONAuditContent(template, version, providers)
+	content, err := t.getJSONAuditContent(template, version, providers, result.Findings)
 	if err != nil {
 		return fmt.Errorf("%w: %w", errs.ErrSubmitVersionForInternalReviewFailed, err)
 	}
@@ -383,7 +708,40 @@ func (t *templateService) SubmitForInternalReview(ctx context.Context, versionID
 	return nil
 }
 
-func (t *templateService) getJSONAuditContent(template domain.ChannelTemplate, version domain.ChannelTemplateVersion, providers []domain.ChannelTemplateProvider) (string, error) {
+// rejectByModeration 审核前检查发现明确违规，不创建审核记录，直接拒绝
+func (t *templateService) rejectByModeration(ctx context.Context, version domain.ChannelTemplateVersion, result domain.ModerationResult) error {
+	now := time.Now().Unix()
+	err := t.repo.BatchUpdateTemplateVersionAuditInfo(ctx, []domain.ChannelTemplateVersion{
+		{
+			ID:                       version.ID,
+			AuditStatus:              domain.AuditStatusRejected,
+			AuditTime:                now,
+			RejectReason:             result.RejectReason(),
+			LastReviewSubmissionTime: now,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("%w: 更新版本审核状态失败: %w", errs.ErrSubmitVersionForInternalReviewFailed, err)
+	}
+	return &domain.ModerationRejectedError{Findings: result.Findings}
+}
+
+func (t *templateService) CheckVersion(ctx context.Context, versionID int64) (domain.ModerationResult, error) {
+	if versionID <= 0 {
+		return domain.ModerationResult{}, fmt.Errorf("%w: 版本ID必须大于0", errs.ErrInvalidParameter)
+	}
+	version, err := t.repo.GetTemplateVersionByID(ctx, versionID)
+	if err != nil {
+		return domain.ModerationResult{}, err
+	}
+	template, err := t.repo.GetTemplateByID(ctx, version.ChannelTemplateID)
+	if err != nil {
+		return domain.ModerationResult{}, err
+	}
+	return t.moderator.Check(ctx, template, version), nil
+}
+
+func (t *templateService) getJSONAuditContent(template domain.ChannelTemplate, version domain.ChannelTemplateVersion, providers []domain.ChannelTemplateProvider, findings []domain.ModerationFinding) (string, error) {
 	content := domain.AuditContent{
 		OwnerID:      template.OwnerID,
 		OwnerType:    template.OwnerType.String(),
@@ -398,6 +756,7 @@ func (t *templateService) getJSONAuditContent(template domain.ChannelTemplate, v
 		ProviderNames: slice.Map(providers, func(_ int, src domain.ChannelTemplateProvider) string {
 			return src.ProviderName
 		}),
+		Findings: findings,
 	}
 	b, err := json.Marshal(content)
 	if err != nil {
@@ -408,11 +767,15 @@ func (t *templateService) getJSONAuditContent(template domain.ChannelTemplate, v
 
 // 供应商相关方法
 
-func (t *templateService) BatchSubmitForProviderReview(ctx context.Context, versionIDs []int64) error {
+func (t *templateService) BatchSubmitForProviderReview(ctx context.Context, versionIDs []int64) []domain.ProviderReviewResult {
+	results := make([]domain.ProviderReviewResult, 0, len(versionIDs))
 	for i := range versionIDs {
-		_ = t.submitForProviderReview(ctx, versionIDs[i])
+		results = append(results, domain.ProviderReviewResult{
+			VersionID: versionIDs[i],
+			Err:       t.submitForProviderReview(ctx, versionIDs[i]),
+		})
 	}
-	return nil
+	return results
 }
 
 func (t *templateService) submitForProviderReview(ctx context.Context, versionID int64) error {
@@ -422,28 +785,106 @@ func (t *templateService) submitForProviderReview(ctx context.Context, versionID
 		return err
 	}
 
+	// 只有通过内部审核的版本才能提交给供应商
+	if version.AuditStatus != domain.AuditStatusApproved {
+		return fmt.Errorf("%w: versionID=%d", errs.ErrTemplateVersionNotApprovedByPlatform, versionID)
+	}
+
 	// 获取模板信息
 	template, err := t.repo.GetTemplateByID(ctx, version.ChannelTemplateID)
 	if err != nil {
 		return fmt.Errorf("%w: %w", errs.ErrSubmitVersionForProviderReviewFailed, err)
 	}
 
+	if template.Archived() || version.Archived() {
+		return fmt.Errorf("%w: versionID=%d", errs.ErrTemplateArchived, versionID)
+	}
+
 	// 获取供应商关联信息
 	providers, err := t.repo.GetProvidersByTemplateIDAndVersionID(ctx, template.ID, versionID)
 	if err != nil {
 		return fmt.Errorf("%w: %w", errs.ErrSubmitVersionForProviderReviewFailed, err)
 	}
+	providers, err = t.addInternationalProviders(ctx, template, providers)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errs.ErrSubmitVersionForProviderReviewFailed, err)
+	}
 
+	// 内部审核通过后规则可能已经调整，提交前再检查一次，明确违规的不再提交给供应商
+	result := t.moderator.Check(ctx, template, version)
+	if result.Blocked() {
+		// 更新失败的供应商关联仍是待审核状态，和拒绝原因一起返回
+		rejectErrs := []error{&domain.ModerationRejectedError{Findings: result.Findings}}
+		for i := range providers {
+			if providers[i].AuditStatus == domain.AuditStatusPending ||
+				providers[i].AuditStatus == domain.AuditStatusRejected {
+				err1 := t.repo.UpdateTemplateProviderAuditInfo(ctx, domain.ChannelTemplateProvider{
+					ID:           providers[i].ID,
+					AuditStatus:  domain.AuditStatusRejected,
+					RejectReason: result.RejectReason(),
+				})
+				if err1 != nil {
+					rejectErrs = append(rejectErrs, fmt.Errorf("供应商 %s: 更新审核状态失败: %w", providers[i].ProviderName, err1))
+				}
+			}
+		}
+		return errors.Join(rejectErrs...)
+	}
+
+	// 单个供应商提交失败不影响其他供应商
+	var submitErrs []error
 	for i := range providers {
 		if providers[i].AuditStatus == domain.AuditStatusPending ||
 			providers[i].AuditStatus == domain.AuditStatusRejected {
-			_ = t.submit(ctx, template, version, providers[i])
+			if err1 := t.submit(ctx, template, version, providers[i]); err1 != nil {
+				submitErrs = append(submitErrs, fmt.Errorf("供应商 %s: %w", providers[i].ProviderName, err1))
+			}
 		}
 	}
-	return nil
+	return errors.Join(submitErrs...)
+}
+
+// addInternationalProviders 版本创建后才开启国际/港澳台短信的，提交审核时为每个供应商补齐国际/港澳台模版关联
+func (t *templateService) addInternationalProviders(ctx context.Context, template domain.ChannelTemplate, providers []domain.ChannelTemplateProvider) ([]domain.ChannelTemplateProvider, error) {
+	if !slices.Contains(t.regions(template), domain.TemplateRegionInternational) {
+		return providers, nil
+	}
+	international := make(map[int64]bool, len(providers))
+	for i := range providers {
+		if providers[i].Region.IsInternational() {
+			international[providers[i].ProviderID] = true
+		}
+	}
+	var missing []domain.ChannelTemplateProvider
+	for i := range providers {
+		if providers[i].Region.IsInternational() || international[providers[i].ProviderID] {
+			continue
+		}
+		missing = append(missing, domain.ChannelTemplateProvider{
+			TemplateID:        providers[i].TemplateID,
+			TemplateVersionID: providers[i].TemplateVersionID,
+			ProviderID:        providers[i].ProviderID,
+			ProviderName:      providers[i].ProviderName,
+			ProviderChannel:   providers[i].ProviderChannel,
+			Region:            domain.TemplateRegionInternational,
+		})
+	}
+	if len(missing) == 0 {
+		return providers, nil
+	}
+	created, err := t.repo.BatchCreateTemplateProviders(ctx, missing)
+	if err != nil {
+		return nil, fmt.Errorf("创建国际/港澳台模版关联失败: %w", err)
+	}
+	return append(providers, created...), nil
 }
 
 func (t *templateService) submit(ctx context.Context, template domain.ChannelTemplate, version domain.ChannelTemplateVersion, provider domain.ChannelTemplateProvider) error {
+	// 邮件由平台自行渲染后通过SMTP投递，站内信由平台直接写入收件箱，沙箱供应商只渲染并保存消息，
+	// 供应商侧都没有模版的概念，直接视为审核通过
+	if provider.ProviderChannel.IsEmail() || provider.ProviderChannel.IsInApp() || provider.IsSandbox() {
+		return t.approveWithoutProviderReview(ctx, provider)
+	}
 	// 当前仅支持SMS渠道
 	if provider.ProviderChannel != domain.ChannelSMS {
 		return nil
@@ -460,6 +901,7 @@ func (t *templateService) submit(ctx context.Context, template domain.ChannelTem
 		TemplateContent: t.replacePlaceholders(version.Content, provider),
 		TemplateType:    client.TemplateType(template.BusinessType),
 		Remark:          version.Remark,
+		International:   provider.Region.IsInternational(),
 	})
 	if err != nil {
 		return fmt.Errorf("%w: %w", errs.ErrSubmitVersionForProviderReviewFailed, err)
@@ -479,8 +921,20 @@ func (t *templateService) submit(ctx context.Context, template domain.ChannelTem
 	return nil
 }
 
+func (t *templateService) approveWithoutProviderReview(ctx context.Context, provider domain.ChannelTemplateProvider) error {
+	err := t.repo.UpdateTemplateProviderAuditInfo(ctx, domain.ChannelTemplateProvider{
+		ID:                       provider.ID,
+		AuditStatus:              domain.AuditStatusApproved,
+		LastReviewSubmissionTime: time.Now().Unix(),
+	})
+	if err != nil {
+		return fmt.Errorf("%w: 更新供应商关联失败: %w", errs.ErrSubmitVersionForProviderReviewFailed, err)
+	}
+	return nil
+}
+
 func (t *templateService) getSMSClient(providerName string) (client.Client, error) {
-	smsClient, ok := t.smsClients[providerName]
+	smsClient, ok := t.smsClients.Get(providerName)
 	if !ok {
 		return nil, fmt.Errorf("未找到对应的供应商客户端")
 	}
@@ -510,9 +964,12 @@ func (t *templateService) BatchQueryAndUpdateProviderAuditInfo(ctx context.Conte
 		return nil
 	}
 
-	// 按渠道和供应商名称分组处理
+	// 按渠道和供应商名称分组处理，沙箱供应商提交时已经审核通过，没有需要查询的模版
 	groupedProviders := make(map[domain.Channel]map[string][]domain.ChannelTemplateProvider)
 	for i := range providers {
+		if providers[i].IsSandbox() {
+			continue
+		}
 		channel := providers[i].ProviderChannel
 		name := providers[i].ProviderName
 		if _, ok := groupedProviders[channel]; !ok {
@@ -541,33 +998,82 @@ func (t *templateService) batchQueryAndUpdateSMSProvidersAuditInfo(ctx context.C
 		return fmt.Errorf("%w: %w", errs.ErrUpdateTemplateProviderAuditStatusFailed, err)
 	}
 
-	// 获取供应商侧的模版ID 和 映射关系
-	templateIDs := make([]string, 0, len(providers))
-	providerMap := make(map[string]domain.ChannelTemplateProvider, len(providers))
+	// 国内和国际/港澳台模版分开查询
+	regionProviders := make(map[domain.TemplateRegion][]domain.ChannelTemplateProvider)
 	for i := range providers {
-		templateIDs = append(templateIDs, providers[i].ProviderTemplateID)
-		providerMap[providers[i].ProviderTemplateID] = providers[i]
+		regionProviders[providers[i].Region] = append(regionProviders[providers[i].Region], providers[i])
 	}
 
-	// 批量查询模版状态
-	results, err := smsClient.BatchQueryTemplateStatus(client.BatchQueryTemplateStatusReq{
-		TemplateIDs: templateIDs,
-	})
+	var updates []domain.ChannelTemplateProvider
+	for region, ps := range regionProviders {
+		// 获取供应商侧的模版ID 和 映射关系
+		templateIDs := make([]string, 0, len(ps))
+		providerMap := make(map[string]domain.ChannelTemplateProvider, len(ps))
+		for i := range ps {
+			templateIDs = append(templateIDs, ps[i].ProviderTemplateID)
+			providerMap[ps[i].ProviderTemplateID] = ps[i]
+		}
+
+		// 批量查询模版状态
+		results, err1 := smsClient.BatchQueryTemplateStatus(client.BatchQueryTemplateStatusReq{
+			TemplateIDs:   templateIDs,
+			International: region.IsInternational(),
+		})
+		if err1 != nil {
+			return fmt.Errorf("%w: %w", errs.ErrUpdateTemplateProviderAuditStatusFailed, err1)
+		}
+
+		// 更新对应的状态信息
+		for i := range results.Results {
+			p, ok := providerMap[results.Results[i].TemplateID]
+			if !ok {
+				continue
+			}
+			p.RequestID = results.Results[i].RequestID
+			p.AuditStatus = results.Results[i].AuditStatus.ToDomain()
+			p.RejectReason = results.Results[i].Reason
+			updates = append(updates, p)
+		}
+	}
+	return t.repo.BatchUpdateTemplateProvidersAuditInfo(ctx, updates)
+}
+
+func (t *templateService) ReceiveProviderAuditResults(ctx context.Context, providerName string, results []domain.ChannelTemplateProvider) error {
+	resultMap := make(map[string]domain.ChannelTemplateProvider, len(results))
+	for i := range results {
+		if results[i].ProviderTemplateID != "" {
+			resultMap[results[i].ProviderTemplateID] = results[i]
+		}
+	}
+	if len(resultMap) == 0 {
+		return nil
+	}
+
+	providers, err := t.repo.GetProvidersByProviderTemplateIDs(ctx, providerName, slices.Collect(maps.Keys(resultMap)))
 	if err != nil {
 		return fmt.Errorf("%w: %w", errs.ErrUpdateTemplateProviderAuditStatusFailed, err)
 	}
 
-	// 更新对应的状态信息
-	updates := make([]domain.ChannelTemplateProvider, 0, len(results.Results))
-	for i := range results.Results {
-		p, ok := providerMap[results.Results[i].TemplateID]
-		if !ok {
+	// 只更新审核中的关联，重复推送或者轮询已经拿到结果的忽略；
+	// 找不到关联的推送（例如模版已经删除）也忽略，避免供应商一直重试
+	updates := make([]domain.ChannelTemplateProvider, 0, len(providers))
+	for i := range providers {
+		result := resultMap[providers[i].ProviderTemplateID]
+		if providers[i].AuditStatus != domain.AuditStatusInReview ||
+			result.AuditStatus == domain.AuditStatusInReview {
 			continue
 		}
-		p.RequestID = results.Results[i].RequestID
-		p.AuditStatus = results.Results[i].AuditStatus.ToDomain()
-		p.RejectReason = results.Results[i].Reason
-		updates = append(updates, p)
+		updates = append(updates, domain.ChannelTemplateProvider{
+			ID:           providers[i].ID,
+			AuditStatus:  result.AuditStatus,
+			RejectReason: result.RejectReason,
+		})
 	}
-	return t.repo.BatchUpdateTemplateProvidersAuditInfo(ctx, updates)
+	if len(updates) == 0 {
+		return nil
+	}
+	if err = t.repo.BatchUpdateTemplateProvidersAuditInfo(ctx, updates); err != nil {
+		return fmt.Errorf("%w: %w", errs.ErrUpdateTemplateProviderAuditStatusFailed, err)
+	}
+	return nil
 }
@@ -41,6 +41,82 @@ func (m *MockChannelTemplateService) EXPECT() *MockChannelTemplateServiceMockRec
 	return m.recorder
 }
 
+// ArchiveTemplate mocks base method.
+func (m *MockChannelTemplateService) ArchiveTemplate(ctx context.Context, templateID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveTemplate", ctx, templateID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArchiveTemplate indicates an expected call of ArchiveTemplate.
+func (mr *MockChannelTemplateServiceMockRecorder) ArchiveTemplate(ctx, templateID any) *MockChannelTemplateServiceArchiveTemplateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveTemplate", reflect.TypeOf((*MockChannelTemplateService)(nil).ArchiveTemplate), ctx, templateID)
+	return &MockChannelTemplateServiceArchiveTemplateCall{Call: call}
+}
+
+// MockChannelTemplateServiceArchiveTemplateCall wrap *gomock.Call
+type MockChannelTemplateServiceArchiveTemplateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockChannelTemplateServiceArchiveTemplateCall) Return(arg0 error) *MockChannelTemplateServiceArchiveTemplateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockChannelTemplateServiceArchiveTemplateCall) Do(f func(context.Context, int64) error) *MockChannelTemplateServiceArchiveTemplateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockChannelTemplateServiceArchiveTemplateCall) DoAndReturn(f func(context.Context, int64) error) *MockChannelTemplateServiceArchiveTemplateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ArchiveVersion mocks base method.
+func (m *MockChannelTemplateService) ArchiveVersion(ctx context.Context, versionID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveVersion", ctx, versionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArchiveVersion indicates an expected call of ArchiveVersion.
+func (mr *MockChannelTemplateServiceMockRecorder) ArchiveVersion(ctx, versionID any) *MockChannelTemplateServiceArchiveVersionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveVersion", reflect.TypeOf((*MockChannelTemplateService)(nil).ArchiveVersion), ctx, versionID)
+	return &MockChannelTemplateServiceArchiveVersionCall{Call: call}
+}
+
+// MockChannelTemplateServiceArchiveVersionCall wrap *gomock.Call
+type MockChannelTemplateServiceArchiveVersionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockChannelTemplateServiceArchiveVersionCall) Return(arg0 error) *MockChannelTemplateServiceArchiveVersionCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockChannelTemplateServiceArchiveVersionCall) Do(f func(context.Context, int64) error) *MockChannelTemplateServiceArchiveVersionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockChannelTemplateServiceArchiveVersionCall) DoAndReturn(f func(context.Context, int64) error) *MockChannelTemplateServiceArchiveVersionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // BatchQueryAndUpdateProviderAuditInfo mocks base method.
 func (m *MockChannelTemplateService) BatchQueryAndUpdateProviderAuditInfo(ctx context.Context, providers []domain.ChannelTemplateProvider) error {
 	m.ctrl.T.Helper()
@@ -80,17 +156,17 @@ func (c *MockChannelTemplateServiceBatchQueryAndUpdateProviderAuditInfoCall) DoA
 }
 
 // BatchSubmitForProviderReview mocks base method.
-func (m *MockChannelTemplateService) BatchSubmitForProviderReview(ctx context.Context, versionID []int64) error {
+func (m *MockChannelTemplateService) BatchSubmitForProviderReview(ctx context.Context, versionIDs []int64) []domain.ProviderReviewResult {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BatchSubmitForProviderReview", ctx, versionID)
-	ret0, _ := ret[0].(error)
+	ret := m.ctrl.Call(m, "BatchSubmitForProviderReview", ctx, versionIDs)
+	ret0, _ := ret[0].([]domain.ProviderReviewResult)
 	return ret0
 }
 
 // BatchSubmitForProviderReview indicates an expected call of BatchSubmitForProviderReview.
-func (mr *MockChannelTemplateServiceMockRecorder) BatchSubmitForProviderReview(ctx, versionID any) *MockChannelTemplateServiceBatchSubmitForProviderReviewCall {
+func (mr *MockChannelTemplateServiceMockRecorder) BatchSubmitForProviderReview(ctx, versionIDs any) *MockChannelTemplateServiceBatchSubmitForProviderReviewCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchSubmitForProviderReview", reflect.TypeOf((*MockChannelTemplateService)(nil).BatchSubmitForProviderReview), ctx, versionID)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchSubmitForProviderReview", reflect.TypeOf((*MockChannelTemplateService)(nil).BatchSubmitForProviderReview), ctx, versionIDs)
 	return &MockChannelTemplateServiceBatchSubmitForProviderReviewCall{Call: call}
 }
 
@@ -100,19 +176,19 @@ type MockChannelTemplateServiceBatchSubmitForProviderReviewCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockChannelTemplateServiceBatchSubmitForProviderReviewCall) Return(arg0 error) *MockChannelTemplateServiceBatchSubmitForProviderReviewCall {
+func (c *MockChannelTemplateServiceBatchSubmitForProviderReviewCall) Return(arg0 []domain.ProviderReviewResult) *MockChannelTemplateServiceBatchSubmitForProviderReviewCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockChannelTemplateServiceBatchSubmitForProviderReviewCall) Do(f func(context.Context, []int64) error) *MockChannelTemplateServiceBatchSubmitForProviderReviewCall {
+func (c *MockChannelTemplateServiceBatchSubmitForProviderReviewCall) Do(f func(context.Context, []int64) []domain.ProviderReviewResult) *MockChannelTemplateServiceBatchSubmitForProviderReviewCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockChannelTemplateServiceBatchSubmitForProviderReviewCall) DoAndReturn(f func(context.Context, []int64) error) *MockChannelTemplateServiceBatchSubmitForProviderReviewCall {
+func (c *MockChannelTemplateServiceBatchSubmitForProviderReviewCall) DoAndReturn(f func(context.Context, []int64) []domain.ProviderReviewResult) *MockChannelTemplateServiceBatchSubmitForProviderReviewCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -155,6 +231,45 @@ func (c *MockChannelTemplateServiceBatchUpdateVersionAuditStatusCall) DoAndRetur
 	return c
 }
 
+// CheckVersion mocks base method.
+func (m *MockChannelTemplateService) CheckVersion(ctx context.Context, versionID int64) (domain.ModerationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckVersion", ctx, versionID)
+	ret0, _ := ret[0].(domain.ModerationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckVersion indicates an expected call of CheckVersion.
+func (mr *MockChannelTemplateServiceMockRecorder) CheckVersion(ctx, versionID any) *MockChannelTemplateServiceCheckVersionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckVersion", reflect.TypeOf((*MockChannelTemplateService)(nil).CheckVersion), ctx, versionID)
+	return &MockChannelTemplateServiceCheckVersionCall{Call: call}
+}
+
+// MockChannelTemplateServiceCheckVersionCall wrap *gomock.Call
+type MockChannelTemplateServiceCheckVersionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockChannelTemplateServiceCheckVersionCall) Return(arg0 domain.ModerationResult, arg1 error) *MockChannelTemplateServiceCheckVersionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockChannelTemplateServiceCheckVersionCall) Do(f func(context.Context, int64) (domain.ModerationResult, error)) *MockChannelTemplateServiceCheckVersionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockChannelTemplateServiceCheckVersionCall) DoAndReturn(f func(context.Context, int64) (domain.ModerationResult, error)) *MockChannelTemplateServiceCheckVersionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateTemplate mocks base method.
 func (m *MockChannelTemplateService) CreateTemplate(ctx context.Context, template domain.ChannelTemplate) (domain.ChannelTemplate, error) {
 	m.ctrl.T.Helper()
@@ -194,6 +309,121 @@ func (c *MockChannelTemplateServiceCreateTemplateCall) DoAndReturn(f func(contex
 	return c
 }
 
+// DeleteTemplate mocks base method.
+func (m *MockChannelTemplateService) DeleteTemplate(ctx context.Context, templateID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTemplate", ctx, templateID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTemplate indicates an expected call of DeleteTemplate.
+func (mr *MockChannelTemplateServiceMockRecorder) DeleteTemplate(ctx, templateID any) *MockChannelTemplateServiceDeleteTemplateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTemplate", reflect.TypeOf((*MockChannelTemplateService)(nil).DeleteTemplate), ctx, templateID)
+	return &MockChannelTemplateServiceDeleteTemplateCall{Call: call}
+}
+
+// MockChannelTemplateServiceDeleteTemplateCall wrap *gomock.Call
+type MockChannelTemplateServiceDeleteTemplateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockChannelTemplateServiceDeleteTemplateCall) Return(arg0 error) *MockChannelTemplateServiceDeleteTemplateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockChannelTemplateServiceDeleteTemplateCall) Do(f func(context.Context, int64) error) *MockChannelTemplateServiceDeleteTemplateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockChannelTemplateServiceDeleteTemplateCall) DoAndReturn(f func(context.Context, int64) error) *MockChannelTemplateServiceDeleteTemplateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteVersion mocks base method.
+func (m *MockChannelTemplateService) DeleteVersion(ctx context.Context, versionID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVersion", ctx, versionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVersion indicates an expected call of DeleteVersion.
+func (mr *MockChannelTemplateServiceMockRecorder) DeleteVersion(ctx, versionID any) *MockChannelTemplateServiceDeleteVersionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVersion", reflect.TypeOf((*MockChannelTemplateService)(nil).DeleteVersion), ctx, versionID)
+	return &MockChannelTemplateServiceDeleteVersionCall{Call: call}
+}
+
+// MockChannelTemplateServiceDeleteVersionCall wrap *gomock.Call
+type MockChannelTemplateServiceDeleteVersionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockChannelTemplateServiceDeleteVersionCall) Return(arg0 error) *MockChannelTemplateServiceDeleteVersionCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockChannelTemplateServiceDeleteVersionCall) Do(f func(context.Context, int64) error) *MockChannelTemplateServiceDeleteVersionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockChannelTemplateServiceDeleteVersionCall) DoAndReturn(f func(context.Context, int64) error) *MockChannelTemplateServiceDeleteVersionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DiffVersions mocks base method.
+func (m *MockChannelTemplateService) DiffVersions(ctx context.Context, fromVersionID, toVersionID int64) (domain.TemplateVersionDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffVersions", ctx, fromVersionID, toVersionID)
+	ret0, _ := ret[0].(domain.TemplateVersionDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffVersions indicates an expected call of DiffVersions.
+func (mr *MockChannelTemplateServiceMockRecorder) DiffVersions(ctx, fromVersionID, toVersionID any) *MockChannelTemplateServiceDiffVersionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffVersions", reflect.TypeOf((*MockChannelTemplateService)(nil).DiffVersions), ctx, fromVersionID, toVersionID)
+	return &MockChannelTemplateServiceDiffVersionsCall{Call: call}
+}
+
+// MockChannelTemplateServiceDiffVersionsCall wrap *gomock.Call
+type MockChannelTemplateServiceDiffVersionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockChannelTemplateServiceDiffVersionsCall) Return(arg0 domain.TemplateVersionDiff, arg1 error) *MockChannelTemplateServiceDiffVersionsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockChannelTemplateServiceDiffVersionsCall) Do(f func(context.Context, int64, int64) (domain.TemplateVersionDiff, error)) *MockChannelTemplateServiceDiffVersionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockChannelTemplateServiceDiffVersionsCall) DoAndReturn(f func(context.Context, int64, int64) (domain.TemplateVersionDiff, error)) *MockChannelTemplateServiceDiffVersionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ForkVersion mocks base method.
 func (m *MockChannelTemplateService) ForkVersion(ctx context.Context, versionID int64) (domain.ChannelTemplateVersion, error) {
 	m.ctrl.T.Helper()
@@ -233,6 +463,45 @@ func (c *MockChannelTemplateServiceForkVersionCall) DoAndReturn(f func(context.C
 	return c
 }
 
+// GetFamilyTemplate mocks base method.
+func (m *MockChannelTemplateService) GetFamilyTemplate(ctx context.Context, templateID int64, channel domain.Channel) (domain.ChannelTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFamilyTemplate", ctx, templateID, channel)
+	ret0, _ := ret[0].(domain.ChannelTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFamilyTemplate indicates an expected call of GetFamilyTemplate.
+func (mr *MockChannelTemplateServiceMockRecorder) GetFamilyTemplate(ctx, templateID, channel any) *MockChannelTemplateServiceGetFamilyTemplateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFamilyTemplate", reflect.TypeOf((*MockChannelTemplateService)(nil).GetFamilyTemplate), ctx, templateID, channel)
+	return &MockChannelTemplateServiceGetFamilyTemplateCall{Call: call}
+}
+
+// MockChannelTemplateServiceGetFamilyTemplateCall wrap *gomock.Call
+type MockChannelTemplateServiceGetFamilyTemplateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockChannelTemplateServiceGetFamilyTemplateCall) Return(arg0 domain.ChannelTemplate, arg1 error) *MockChannelTemplateServiceGetFamilyTemplateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockChannelTemplateServiceGetFamilyTemplateCall) Do(f func(context.Context, int64, domain.Channel) (domain.ChannelTemplate, error)) *MockChannelTemplateServiceGetFamilyTemplateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockChannelTemplateServiceGetFamilyTemplateCall) DoAndReturn(f func(context.Context, int64, domain.Channel) (domain.ChannelTemplate, error)) *MockChannelTemplateServiceGetFamilyTemplateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetPendingOrInReviewProviders mocks base method.
 func (m *MockChannelTemplateService) GetPendingOrInReviewProviders(ctx context.Context, offset, limit int, utime int64) ([]domain.ChannelTemplateProvider, int64, error) {
 	m.ctrl.T.Helper()
@@ -273,6 +542,46 @@ func (c *MockChannelTemplateServiceGetPendingOrInReviewProvidersCall) DoAndRetur
 	return c
 }
 
+// GetPublishHistory mocks base method.
+func (m *MockChannelTemplateService) GetPublishHistory(ctx context.Context, templateID int64, offset, limit int) ([]domain.TemplatePublishRecord, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublishHistory", ctx, templateID, offset, limit)
+	ret0, _ := ret[0].([]domain.TemplatePublishRecord)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPublishHistory indicates an expected call of GetPublishHistory.
+func (mr *MockChannelTemplateServiceMockRecorder) GetPublishHistory(ctx, templateID, offset, limit any) *MockChannelTemplateServiceGetPublishHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublishHistory", reflect.TypeOf((*MockChannelTemplateService)(nil).GetPublishHistory), ctx, templateID, offset, limit)
+	return &MockChannelTemplateServiceGetPublishHistoryCall{Call: call}
+}
+
+// MockChannelTemplateServiceGetPublishHistoryCall wrap *gomock.Call
+type MockChannelTemplateServiceGetPublishHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockChannelTemplateServiceGetPublishHistoryCall) Return(records []domain.TemplatePublishRecord, total int64, err error) *MockChannelTemplateServiceGetPublishHistoryCall {
+	c.Call = c.Call.Return(records, total, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockChannelTemplateServiceGetPublishHistoryCall) Do(f func(context.Context, int64, int, int) ([]domain.TemplatePublishRecord, int64, error)) *MockChannelTemplateServiceGetPublishHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockChannelTemplateServiceGetPublishHistoryCall) DoAndReturn(f func(context.Context, int64, int, int) ([]domain.TemplatePublishRecord, int64, error)) *MockChannelTemplateServiceGetPublishHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetTemplateByID mocks base method.
 func (m *MockChannelTemplateService) GetTemplateByID(ctx context.Context, templateID int64) (domain.ChannelTemplate, error) {
 	m.ctrl.T.Helper()
@@ -313,18 +622,18 @@ func (c *MockChannelTemplateServiceGetTemplateByIDCall) DoAndReturn(f func(conte
 }
 
 // GetTemplateByIDAndProviderInfo mocks base method.
-func (m *MockChannelTemplateService) GetTemplateByIDAndProviderInfo(ctx context.Context, templateID int64, providerName string, channel domain.Channel) (domain.ChannelTemplate, error) {
+func (m *MockChannelTemplateService) GetTemplateByIDAndProviderInfo(ctx context.Context, templateID int64, locale, providerName string, channel domain.Channel, region domain.TemplateRegion) (domain.ChannelTemplate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTemplateByIDAndProviderInfo", ctx, templateID, providerName, channel)
+	ret := m.ctrl.Call(m, "GetTemplateByIDAndProviderInfo", ctx, templateID, locale, providerName, channel, region)
 	ret0, _ := ret[0].(domain.ChannelTemplate)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetTemplateByIDAndProviderInfo indicates an expected call of GetTemplateByIDAndProviderInfo.
-func (mr *MockChannelTemplateServiceMockRecorder) GetTemplateByIDAndProviderInfo(ctx, templateID, providerName, channel any) *MockChannelTemplateServiceGetTemplateByIDAndProviderInfoCall {
+func (mr *MockChannelTemplateServiceMockRecorder) GetTemplateByIDAndProviderInfo(ctx, templateID, locale, providerName, channel, region any) *MockChannelTemplateServiceGetTemplateByIDAndProviderInfoCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateByIDAndProviderInfo", reflect.TypeOf((*MockChannelTemplateService)(nil).GetTemplateByIDAndProviderInfo), ctx, templateID, providerName, channel)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateByIDAndProviderInfo", reflect.TypeOf((*MockChannelTemplateService)(nil).GetTemplateByIDAndProviderInfo), ctx, templateID, locale, providerName, channel, region)
 	return &MockChannelTemplateServiceGetTemplateByIDAndProviderInfoCall{Call: call}
 }
 
@@ -340,13 +649,13 @@ func (c *MockChannelTemplateServiceGetTemplateByIDAndProviderInfoCall) Return(ar
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockChannelTemplateServiceGetTemplateByIDAndProviderInfoCall) Do(f func(context.Context, int64, string, domain.Channel) (domain.ChannelTemplate, error)) *MockChannelTemplateServiceGetTemplateByIDAndProviderInfoCall {
+func (c *MockChannelTemplateServiceGetTemplateByIDAndProviderInfoCall) Do(f func(context.Context, int64, string, string, domain.Channel, domain.TemplateRegion) (domain.ChannelTemplate, error)) *MockChannelTemplateServiceGetTemplateByIDAndProviderInfoCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockChannelTemplateServiceGetTemplateByIDAndProviderInfoCall) DoAndReturn(f func(context.Context, int64, string, domain.Channel) (domain.ChannelTemplate, error)) *MockChannelTemplateServiceGetTemplateByIDAndProviderInfoCall {
+func (c *MockChannelTemplateServiceGetTemplateByIDAndProviderInfoCall) DoAndReturn(f func(context.Context, int64, string, string, domain.Channel, domain.TemplateRegion) (domain.ChannelTemplate, error)) *MockChannelTemplateServiceGetTemplateByIDAndProviderInfoCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -391,17 +700,17 @@ func (c *MockChannelTemplateServiceGetTemplatesByOwnerCall) DoAndReturn(f func(c
 }
 
 // PublishTemplate mocks base method.
-func (m *MockChannelTemplateService) PublishTemplate(ctx context.Context, templateID, versionID int64) error {
+func (m *MockChannelTemplateService) PublishTemplate(ctx context.Context, templateID, versionID, operatorID int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PublishTemplate", ctx, templateID, versionID)
+	ret := m.ctrl.Call(m, "PublishTemplate", ctx, templateID, versionID, operatorID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // PublishTemplate indicates an expected call of PublishTemplate.
-func (mr *MockChannelTemplateServiceMockRecorder) PublishTemplate(ctx, templateID, versionID any) *MockChannelTemplateServicePublishTemplateCall {
+func (mr *MockChannelTemplateServiceMockRecorder) PublishTemplate(ctx, templateID, versionID, operatorID any) *MockChannelTemplateServicePublishTemplateCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishTemplate", reflect.TypeOf((*MockChannelTemplateService)(nil).PublishTemplate), ctx, templateID, versionID)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishTemplate", reflect.TypeOf((*MockChannelTemplateService)(nil).PublishTemplate), ctx, templateID, versionID, operatorID)
 	return &MockChannelTemplateServicePublishTemplateCall{Call: call}
 }
 
@@ -417,13 +726,89 @@ func (c *MockChannelTemplateServicePublishTemplateCall) Return(arg0 error) *Mock
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockChannelTemplateServicePublishTemplateCall) Do(f func(context.Context, int64, int64) error) *MockChannelTemplateServicePublishTemplateCall {
+func (c *MockChannelTemplateServicePublishTemplateCall) Do(f func(context.Context, int64, int64, int64) error) *MockChannelTemplateServicePublishTemplateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockChannelTemplateServicePublishTemplateCall) DoAndReturn(f func(context.Context, int64, int64, int64) error) *MockChannelTemplateServicePublishTemplateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReceiveProviderAuditResults mocks base method.
+func (m *MockChannelTemplateService) ReceiveProviderAuditResults(ctx context.Context, providerName string, results []domain.ChannelTemplateProvider) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReceiveProviderAuditResults", ctx, providerName, results)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReceiveProviderAuditResults indicates an expected call of ReceiveProviderAuditResults.
+func (mr *MockChannelTemplateServiceMockRecorder) ReceiveProviderAuditResults(ctx, providerName, results any) *MockChannelTemplateServiceReceiveProviderAuditResultsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReceiveProviderAuditResults", reflect.TypeOf((*MockChannelTemplateService)(nil).ReceiveProviderAuditResults), ctx, providerName, results)
+	return &MockChannelTemplateServiceReceiveProviderAuditResultsCall{Call: call}
+}
+
+// MockChannelTemplateServiceReceiveProviderAuditResultsCall wrap *gomock.Call
+type MockChannelTemplateServiceReceiveProviderAuditResultsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockChannelTemplateServiceReceiveProviderAuditResultsCall) Return(arg0 error) *MockChannelTemplateServiceReceiveProviderAuditResultsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockChannelTemplateServiceReceiveProviderAuditResultsCall) Do(f func(context.Context, string, []domain.ChannelTemplateProvider) error) *MockChannelTemplateServiceReceiveProviderAuditResultsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockChannelTemplateServiceReceiveProviderAuditResultsCall) DoAndReturn(f func(context.Context, string, []domain.ChannelTemplateProvider) error) *MockChannelTemplateServiceReceiveProviderAuditResultsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackTemplate mocks base method.
+func (m *MockChannelTemplateService) RollbackTemplate(ctx context.Context, templateID, versionID, operatorID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackTemplate", ctx, templateID, versionID, operatorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackTemplate indicates an expected call of RollbackTemplate.
+func (mr *MockChannelTemplateServiceMockRecorder) RollbackTemplate(ctx, templateID, versionID, operatorID any) *MockChannelTemplateServiceRollbackTemplateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackTemplate", reflect.TypeOf((*MockChannelTemplateService)(nil).RollbackTemplate), ctx, templateID, versionID, operatorID)
+	return &MockChannelTemplateServiceRollbackTemplateCall{Call: call}
+}
+
+// MockChannelTemplateServiceRollbackTemplateCall wrap *gomock.Call
+type MockChannelTemplateServiceRollbackTemplateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockChannelTemplateServiceRollbackTemplateCall) Return(arg0 error) *MockChannelTemplateServiceRollbackTemplateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockChannelTemplateServiceRollbackTemplateCall) Do(f func(context.Context, int64, int64, int64) error) *MockChannelTemplateServiceRollbackTemplateCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockChannelTemplateServicePublishTemplateCall) DoAndReturn(f func(context.Context, int64, int64) error) *MockChannelTemplateServicePublishTemplateCall {
+func (c *MockChannelTemplateServiceRollbackTemplateCall) DoAndReturn(f func(context.Context, int64, int64, int64) error) *MockChannelTemplateServiceRollbackTemplateCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -0,0 +1,60 @@
+package moderation
+
+import (
+	"context"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+)
+
+// Checker 提交内部审核和供应商审核前检查模版版本，提前发现会被供应商拒绝的问题
+type Checker interface {
+	Check(ctx context.Context, template domain.ChannelTemplate, version domain.ChannelTemplateVersion) domain.ModerationResult
+}
+
+// Config 审核前检查配置
+type Config struct {
+	// SensitiveWords 敏感词，命中后直接拒绝
+	SensitiveWords []string `yaml:"sensitiveWords"`
+	// WarningWords 可疑词，命中后交给审核人判断
+	WarningWords []string `yaml:"warningWords"`
+	// Signatures 允许使用的短信签名，为空时不限制
+	Signatures []string `yaml:"signatures"`
+	// URLAllowList 内容中允许出现的链接域名，包括子域名，为空时不限制
+	URLAllowList []string `yaml:"urlAllowList"`
+	// MarketingWords 营销内容关键词，用于检查业务类型是否一致，为空时使用默认的关键词
+	MarketingWords []string `yaml:"marketingWords"`
+}
+
+var defaultMarketingWords = []string{"优惠", "促销", "折扣", "满减", "限时", "抢购", "红包", "领券", "特价", "免费领"}
+
+// NewChecker 按配置组合所有内置的检查规则
+func NewChecker(cfg Config) Checker {
+	marketingWords := cfg.MarketingWords
+	if len(marketingWords) == 0 {
+		marketingWords = defaultMarketingWords
+	}
+	return NewChain(
+		NewWordChecker(ruleSensitiveWord, domain.ModerationSeverityBlock, cfg.SensitiveWords),
+		NewWordChecker(ruleWarningWord, domain.ModerationSeverityWarning, cfg.WarningWords),
+		NewSignatureChecker(cfg.Signatures),
+		NewURLChecker(cfg.URLAllowList),
+		NewBusinessTypeChecker(marketingWords),
+	)
+}
+
+// Chain 依次执行所有检查，合并发现的问题
+type Chain struct {
+	checkers []Checker
+}
+
+func NewChain(checkers ...Checker) *Chain {
+	return &Chain{checkers: checkers}
+}
+
+func (c *Chain) Check(ctx context.Context, template domain.ChannelTemplate, version domain.ChannelTemplateVersion) domain.ModerationResult {
+	var res domain.ModerationResult
+	for _, checker := range c.checkers {
+		res.Findings = append(res.Findings, checker.Check(ctx, template, version).Findings...)
+	}
+	return res
+}
@@ -0,0 +1,135 @@
+//go:build unit
+
+package moderation
+
+import (
+	"testing"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	checker := NewChecker(Config{
+		SensitiveWords: []string{"赌博"},
+		WarningWords:   []string{"贷款"},
+		Signatures:     []string{"极客时间"},
+		URLAllowList:   []string{"example.com"},
+	})
+	smsNotification := domain.ChannelTemplate{Channel: domain.ChannelSMS, BusinessType: domain.BusinessTypeNotification}
+
+	testCases := []struct {
+		name        string
+		template    domain.ChannelTemplate
+		version     domain.ChannelTemplateVersion
+		wantRules   []string
+		wantBlocked bool
+	}{
+		{
+			name:     "通过",
+			template: smsNotification,
+			version: domain.ChannelTemplateVersion{
+				Signature: "极客时间",
+				Content:   "您的订单已发货，详情见 https://m.example.com/orders",
+			},
+		},
+		{
+			name:     "敏感词",
+			template: smsNotification,
+			version: domain.ChannelTemplateVersion{
+				Signature: "极客时间",
+				Content:   "赌 博",
+			},
+			wantRules:   []string{ruleSensitiveWord},
+			wantBlocked: true,
+		},
+		{
+			name:     "可疑词",
+			template: smsNotification,
+			version: domain.ChannelTemplateVersion{
+				Signature: "极客时间",
+				Content:   "贷款审批已通过",
+			},
+			wantRules: []string{ruleWarningWord},
+		},
+		{
+			name:     "签名为空并且内容自带签名",
+			template: smsNotification,
+			version: domain.ChannelTemplateVersion{
+				Content: "【极客时间】您的订单已发货",
+			},
+			wantRules:   []string{ruleSignature, ruleSignature},
+			wantBlocked: true,
+		},
+		{
+			name:     "签名不在允许的范围内",
+			template: smsNotification,
+			version: domain.ChannelTemplateVersion{
+				Signature: "其他公司",
+				Content:   "您的订单已发货",
+			},
+			wantRules:   []string{ruleSignature},
+			wantBlocked: true,
+		},
+		{
+			name:     "邮件不检查签名",
+			template: domain.ChannelTemplate{Channel: domain.ChannelEmail, BusinessType: domain.BusinessTypeNotification},
+			version: domain.ChannelTemplateVersion{
+				Subject: "发货通知",
+				Content: "您的订单已发货",
+			},
+		},
+		{
+			name:     "链接域名不在允许的范围内",
+			template: smsNotification,
+			version: domain.ChannelTemplateVersion{
+				Signature: "极客时间",
+				Content:   "详情见 www.example.com.evil.cn/a 和 https://example.com/b",
+			},
+			wantRules:   []string{ruleURL},
+			wantBlocked: true,
+		},
+		{
+			name:     "通知模版包含营销内容",
+			template: smsNotification,
+			version: domain.ChannelTemplateVersion{
+				Signature: "极客时间",
+				Content:   "限时优惠",
+			},
+			wantRules: []string{ruleBusinessType},
+		},
+		{
+			name:     "验证码模版没有参数",
+			template: domain.ChannelTemplate{Channel: domain.ChannelSMS, BusinessType: domain.BusinessTypeVerificationCode},
+			version: domain.ChannelTemplateVersion{
+				Signature: "极客时间",
+				Content:   "您的验证码是${code}",
+			},
+			wantRules: []string{ruleBusinessType},
+		},
+		{
+			name:     "营销短信没有退订方式",
+			template: domain.ChannelTemplate{Channel: domain.ChannelSMS, BusinessType: domain.BusinessTypePromotion},
+			version: domain.ChannelTemplateVersion{
+				Signature: "极客时间",
+				Content:   "新课上线",
+			},
+			wantRules: []string{ruleBusinessType},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res := checker.Check(t.Context(), tc.template, tc.version)
+			var rules []string
+			for _, f := range res.Findings {
+				rules = append(rules, f.Rule)
+			}
+			assert.Equal(t, tc.wantRules, rules)
+			assert.Equal(t, tc.wantBlocked, res.Blocked())
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+)
+
+const (
+	ruleSensitiveWord = "sensitive_word"
+	ruleWarningWord   = "warning_word"
+	ruleSignature     = "signature"
+	ruleURL           = "url"
+	ruleBusinessType  = "business_type"
+
+	fieldContent   = "content"
+	fieldSubject   = "subject"
+	fieldSignature = "signature"
+	fieldBusiness  = "businessType"
+
+	minSignatureLength = 2
+	maxSignatureLength = 12
+)
+
+type textField struct {
+	name  string
+	label string
+	text  string
+}
+
+// textFields 需要检查的文本字段
+func textFields(version domain.ChannelTemplateVersion) []textField {
+	return []textField{
+		{name: fieldContent, label: "内容", text: version.Content},
+		{name: fieldSignature, label: "签名", text: version.Signature},
+		{name: fieldSubject, label: "标题", text: version.Subject},
+	}
+}
+
+// WordChecker 检查内容、签名和标题中是否包含指定的词
+type WordChecker struct {
+	rule     string
+	severity domain.ModerationSeverity
+	trie     *Trie
+}
+
+func NewWordChecker(rule string, severity domain.ModerationSeverity, words []string) *WordChecker {
+	return &WordChecker{rule: rule, severity: severity, trie: NewTrie(words)}
+}
+
+func (c *WordChecker) Check(_ context.Context, _ domain.ChannelTemplate, version domain.ChannelTemplateVersion) domain.ModerationResult {
+	var res domain.ModerationResult
+	for _, field := range textFields(version) {
+		for _, word := range c.trie.FindAll(field.text) {
+			res.Findings = append(res.Findings, domain.ModerationFinding{
+				Rule:     c.rule,
+				Severity: c.severity,
+				Field:    field.name,
+				Match:    word,
+				Message:  fmt.Sprintf("%s包含%s“%s”", field.label, c.describe(), word),
+			})
+		}
+	}
+	return res
+}
+
+func (c *WordChecker) describe() string {
+	if c.severity == domain.ModerationSeverityBlock {
+		return "敏感词"
+	}
+	return "可疑词"
+}
+
+// SignatureChecker 短信签名必须存在、长度符合供应商要求并且是允许的品牌签名，内容中不能自带签名
+type SignatureChecker struct {
+	signatures map[string]struct{}
+}
+
+func NewSignatureChecker(signatures []string) *SignatureChecker {
+	m := make(map[string]struct{}, len(signatures))
+	for _, s := range signatures {
+		if s = strings.TrimSpace(s); s != "" {
+			m[s] = struct{}{}
+		}
+	}
+	return &SignatureChecker{signatures: m}
+}
+
+func (c *SignatureChecker) Check(_ context.Context, template domain.ChannelTemplate, version domain.ChannelTemplateVersion) domain.ModerationResult {
+	var res domain.ModerationResult
+	if !template.Channel.IsSMS() {
+		return res
+	}
+	block := func(field, match, msg string) {
+		res.Findings = append(res.Findings, domain.ModerationFinding{
+			Rule:     ruleSignature,
+			Severity: domain.ModerationSeverityBlock,
+			Field:    field,
+			Match:    match,
+			Message:  msg,
+		})
+	}
+
+	signature := strings.TrimSpace(version.Signature)
+	switch n := utf8.RuneCountInString(signature); {
+	case n == 0:
+		block(fieldSignature, "", "短信签名不能为空")
+	case n < minSignatureLength || n > maxSignatureLength:
+		block(fieldSignature, signature, fmt.Sprintf("短信签名长度必须为%d到%d个字符", minSignatureLength, maxSignatureLength))
+	case len(c.signatures) > 0:
+		if _, ok := c.signatures[signature]; !ok {
+			block(fieldSignature, signature, fmt.Sprintf("短信签名“%s”不是允许使用的品牌签名", signature))
+		}
+	}
+
+	if strings.ContainsAny(version.Content, "【】") {
+		block(fieldContent, "【】", "短信内容中不能包含【】，签名在发送时自动添加")
+	}
+	return res
+}
+
+// urlPattern 匹配 http、https 链接以及 www. 开头的链接
+var urlPattern = regexp.MustCompile(`(?i)(?:https?://|www\.)[^\s"'<>，。；、）)]+`)
+
+// URLChecker 内容中的链接只能指向允许的域名，避免被供应商识别为钓鱼或者推广链接
+type URLChecker struct {
+	allowList []string
+}
+
+func NewURLChecker(allowList []string) *URLChecker {
+	hosts := make([]string, 0, len(allowList))
+	for _, h := range allowList {
+		if h = strings.ToLower(strings.TrimSpace(h)); h != "" {
+			hosts = append(hosts, h)
+		}
+	}
+	return &URLChecker{allowList: hosts}
+}
+
+func (c *URLChecker) Check(_ context.Context, _ domain.ChannelTemplate, version domain.ChannelTemplateVersion) domain.ModerationResult {
+	var res domain.ModerationResult
+	if len(c.allowList) == 0 {
+		return res
+	}
+	for _, link := range urlPattern.FindAllString(version.Content, -1) {
+		if c.allowed(link) {
+			continue
+		}
+		res.Findings = append(res.Findings, domain.ModerationFinding{
+			Rule:     ruleURL,
+			Severity: domain.ModerationSeverityBlock,
+			Field:    fieldContent,
+			Match:    link,
+			Message:  fmt.Sprintf("链接“%s”的域名不在允许的范围内", link),
+		})
+	}
+	return res
+}
+
+func (c *URLChecker) allowed(link string) bool {
+	if !strings.Contains(link, "://") {
+		link = "http://" + link
+	}
+	u, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+	host := strings.ToLower(u.Hostname())
+	for _, h := range c.allowList {
+		if host == h || strings.HasSuffix(host, "."+h) {
+			return true
+		}
+	}
+	return false
+}
+
+// BusinessTypeChecker 检查内容和业务类型是否一致，只是推测，发现的问题交给审核人判断
+type BusinessTypeChecker struct {
+	marketing *Trie
+}
+
+func NewBusinessTypeChecker(marketingWords []string) *BusinessTypeChecker {
+	return &BusinessTypeChecker{marketing: NewTrie(marketingWords)}
+}
+
+func (c *BusinessTypeChecker) Check(_ context.Context, template domain.ChannelTemplate, version domain.ChannelTemplateVersion) domain.ModerationResult {
+	var res domain.ModerationResult
+	warn := func(match, msg string) {
+		res.Findings = append(res.Findings, domain.ModerationFinding{
+			Rule:     ruleBusinessType,
+			Severity: domain.ModerationSeverityWarning,
+			Field:    fieldBusiness,
+			Match:    match,
+			Message:  msg,
+		})
+	}
+
+	text := version.Subject + version.Content
+	marketing := c.marketing.FindAll(text)
+	switch template.BusinessType {
+	case domain.BusinessTypeVerificationCode:
+		if len(version.Params) == 0 {
+			warn("", "验证码模版没有参数")
+		}
+		if len(marketing) > 0 {
+			warn(marketing[0], fmt.Sprintf("验证码模版包含营销内容“%s”", marketing[0]))
+		}
+	case domain.BusinessTypeNotification:
+		if len(marketing) > 0 {
+			warn(marketing[0], fmt.Sprintf("通知模版疑似包含营销内容“%s”，建议使用推广营销类型", marketing[0]))
+		}
+	case domain.BusinessTypePromotion:
+		if template.Channel.IsSMS() && !strings.Contains(text, "退订") && !strings.Contains(text, "拒收") {
+			warn("", "营销短信需要包含退订方式，如“拒收请回复R”")
+		}
+	}
+	return res
+}
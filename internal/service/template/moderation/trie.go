@@ -0,0 +1,82 @@
+package moderation
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Trie 敏感词前缀树，匹配时忽略大小写和空白字符，避免通过插入空格绕过
+type Trie struct {
+	root *node
+}
+
+type node struct {
+	children map[rune]*node
+	// word 非空表示从根到这里是一个完整的词
+	word string
+}
+
+func NewTrie(words []string) *Trie {
+	t := &Trie{root: &node{}}
+	for _, w := range words {
+		t.Add(w)
+	}
+	return t
+}
+
+// Add 添加一个词，忽略空白字符后为空的词
+func (t *Trie) Add(word string) {
+	runes := normalize(word)
+	if len(runes) == 0 {
+		return
+	}
+	cur := t.root
+	for _, r := range runes {
+		if cur.children == nil {
+			cur.children = make(map[rune]*node)
+		}
+		next, ok := cur.children[r]
+		if !ok {
+			next = &node{}
+			cur.children[r] = next
+		}
+		cur = next
+	}
+	cur.word = strings.TrimSpace(word)
+}
+
+// FindAll 返回文本中出现的所有词，按第一次出现的顺序去重
+func (t *Trie) FindAll(text string) []string {
+	runes := normalize(text)
+	var res []string
+	seen := make(map[string]struct{})
+	for i := range runes {
+		cur := t.root
+		for j := i; j < len(runes); j++ {
+			next, ok := cur.children[runes[j]]
+			if !ok {
+				break
+			}
+			cur = next
+			if cur.word == "" {
+				continue
+			}
+			if _, ok = seen[cur.word]; !ok {
+				seen[cur.word] = struct{}{}
+				res = append(res, cur.word)
+			}
+		}
+	}
+	return res
+}
+
+func normalize(text string) []rune {
+	runes := make([]rune, 0, len(text))
+	for _, r := range text {
+		if unicode.IsSpace(r) {
+			continue
+		}
+		runes = append(runes, unicode.ToLower(r))
+	}
+	return runes
+}
@@ -0,0 +1,47 @@
+//go:build unit
+
+package moderation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrie_FindAll(t *testing.T) {
+	t.Parallel()
+
+	trie := NewTrie([]string{"赌博", "代开发票", "VPN", " ", "发票"})
+	testCases := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{
+			name: "没有命中",
+			text: "您的验证码是${code}",
+		},
+		{
+			name: "命中多个并且去重",
+			text: "赌博网站，赌博，代开发票",
+			want: []string{"赌博", "代开发票", "发票"},
+		},
+		{
+			name: "忽略大小写",
+			text: "免费vpn",
+			want: []string{"VPN"},
+		},
+		{
+			name: "忽略空白字符",
+			text: "赌 \t博",
+			want: []string{"赌博"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, trie.FindAll(tc.text))
+		})
+	}
+}
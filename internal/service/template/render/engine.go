@@ -0,0 +1,212 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	texttemplate "text/template"
+	"text/template/parse"
+)
+
+// Format 渲染格式
+type Format int
+
+const (
+	// FormatText 纯文本，用于站内信和邮件标题
+	FormatText Format = iota
+	// FormatHTML 参数自动按上下文转义，用于邮件正文
+	FormatHTML
+)
+
+// maxOutputSize 单次渲染结果的最大字节数
+const maxOutputSize = 1 << 20
+
+var (
+	errOutputTooLarge = fmt.Errorf("渲染结果超过 %d 个字节", maxOutputSize)
+	// 兼容旧模版中的 ${name} 占位符
+	placeholder = regexp.MustCompile(`\$\{([^{}]+)\}`)
+)
+
+type executor interface {
+	Execute(w io.Writer, data any) error
+}
+
+// Template 编译后的模版，可以并发执行
+type Template struct {
+	exec executor
+}
+
+// Compile 编译模版，partials 为可以通过 {{template "名称" .}} 引用的公共片段或者布局
+// 解析失败的公共片段会被忽略，不影响不引用它的模版，引用时执行报错
+// 只支持 text/template 的安全子集：不能使用 call，不能对整数做 range
+func Compile(name, content string, partials map[string]string, format Format) (Template, error) {
+	var (
+		exec  executor
+		trees []*parse.Tree
+		err   error
+	)
+	if format == FormatHTML {
+		exec, trees, err = compileHTML(name, content, partials)
+	} else {
+		exec, trees, err = compileText(name, content, partials)
+	}
+	if err != nil {
+		return Template{}, err
+	}
+	for _, tree := range trees {
+		if tree == nil || tree.Root == nil {
+			continue
+		}
+		if err = checkNode(tree.Root); err != nil {
+			return Template{}, fmt.Errorf("模版 %s: %w", tree.Name, err)
+		}
+	}
+	return Template{exec: exec}, nil
+}
+
+func compileText(name, content string, partials map[string]string) (executor, []*parse.Tree, error) {
+	root := texttemplate.New(name).Funcs(funcs()).Option("missingkey=zero")
+	for pname, pcontent := range partials {
+		if pname == name {
+			continue
+		}
+		if _, err := root.New(pname).Parse(convertPlaceholders(pcontent)); err != nil {
+			continue
+		}
+	}
+	if _, err := root.Parse(convertPlaceholders(content)); err != nil {
+		return nil, nil, err
+	}
+	tmpls := root.Templates()
+	trees := make([]*parse.Tree, 0, len(tmpls))
+	for _, t := range tmpls {
+		trees = append(trees, t.Tree)
+	}
+	return root, trees, nil
+}
+
+func compileHTML(name, content string, partials map[string]string) (executor, []*parse.Tree, error) {
+	root := htmltemplate.New(name).Funcs(funcs()).Option("missingkey=zero")
+	for pname, pcontent := range partials {
+		if pname == name {
+			continue
+		}
+		if _, err := root.New(pname).Parse(convertPlaceholders(pcontent)); err != nil {
+			continue
+		}
+	}
+	if _, err := root.Parse(convertPlaceholders(content)); err != nil {
+		return nil, nil, err
+	}
+	tmpls := root.Templates()
+	trees := make([]*parse.Tree, 0, len(tmpls))
+	for _, t := range tmpls {
+		trees = append(trees, t.Tree)
+	}
+	return root, trees, nil
+}
+
+// Execute 使用参数渲染，缺失的参数渲染为空字符串
+func (t Template) Execute(params map[string]string) (string, error) {
+	if params == nil {
+		params = map[string]string{}
+	}
+	w := &limitedWriter{}
+	if err := t.exec.Execute(w, params); err != nil {
+		return "", err
+	}
+	return w.buf.String(), nil
+}
+
+// convertPlaceholders 把 ${name} 转换为 {{index . "name"}}
+func convertPlaceholders(content string) string {
+	return placeholder.ReplaceAllStringFunc(content, func(s string) string {
+		key := placeholder.FindStringSubmatch(s)[1]
+		return `{{index . ` + strconv.Quote(key) + `}}`
+	})
+}
+
+func checkNode(node parse.Node) error {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return nil
+		}
+		for _, child := range n.Nodes {
+			if err := checkNode(child); err != nil {
+				return err
+			}
+		}
+	case *parse.ActionNode:
+		return checkPipe(n.Pipe)
+	case *parse.TemplateNode:
+		return checkPipe(n.Pipe)
+	case *parse.IfNode:
+		return checkBranch(&n.BranchNode)
+	case *parse.WithNode:
+		return checkBranch(&n.BranchNode)
+	case *parse.RangeNode:
+		if isNumber(n.Pipe) {
+			return errors.New("不支持对整数做 range")
+		}
+		return checkBranch(&n.BranchNode)
+	}
+	return nil
+}
+
+func checkBranch(n *parse.BranchNode) error {
+	if err := checkPipe(n.Pipe); err != nil {
+		return err
+	}
+	if err := checkNode(n.List); err != nil {
+		return err
+	}
+	if n.ElseList != nil {
+		return checkNode(n.ElseList)
+	}
+	return nil
+}
+
+func checkPipe(pipe *parse.PipeNode) error {
+	if pipe == nil {
+		return nil
+	}
+	for _, cmd := range pipe.Cmds {
+		for _, arg := range cmd.Args {
+			switch a := arg.(type) {
+			case *parse.IdentifierNode:
+				if a.Ident == "call" {
+					return errors.New("不支持 call")
+				}
+			case *parse.PipeNode:
+				if err := checkPipe(a); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func isNumber(pipe *parse.PipeNode) bool {
+	if pipe == nil || len(pipe.Cmds) != 1 || len(pipe.Cmds[0].Args) != 1 {
+		return false
+	}
+	_, ok := pipe.Cmds[0].Args[0].(*parse.NumberNode)
+	return ok
+}
+
+type limitedWriter struct {
+	buf strings.Builder
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	if w.buf.Len()+len(p) > maxOutputSize {
+		return 0, errOutputTooLarge
+	}
+	return w.buf.Write(p)
+}
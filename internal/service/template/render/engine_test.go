@@ -0,0 +1,187 @@
+//go:build unit
+
+package render
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompile(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		content    string
+		partials   map[string]string
+		format     Format
+		params     map[string]string
+		want       string
+		wantErr    string
+		wantExeErr string
+	}{
+		{
+			name:    "兼容旧的占位符",
+			content: "您的验证码是：${code}，${minutes}分钟内有效",
+			params:  map[string]string{"code": "123456", "minutes": "5"},
+			want:    "您的验证码是：123456，5分钟内有效",
+		},
+		{
+			name:    "缺失的参数渲染为空",
+			content: "您好${name}{{.title}}",
+			want:    "您好",
+		},
+		{
+			name:    "条件",
+			content: `{{if eq .level "gold"}}尊贵的金卡会员{{else if .level}}尊敬的会员{{else}}您好{{end}}`,
+			params:  map[string]string{"level": "silver"},
+			want:    "尊敬的会员",
+		},
+		{
+			name:    "循环",
+			content: `{{range $i, $item := split .items ","}}{{if $i}}、{{end}}{{$item}}{{end}}`,
+			params:  map[string]string{"items": "苹果,香蕉"},
+			want:    "苹果、香蕉",
+		},
+		{
+			name:    "金额",
+			content: `{{money .amount}} {{money .refund}}`,
+			params:  map[string]string{"amount": "1234567.125", "refund": "-0.5"},
+			want:    "1,234,567.13 -0.50",
+		},
+		{
+			name:       "无效的金额",
+			content:    `{{money .amount}}`,
+			params:     map[string]string{"amount": "abc"},
+			wantExeErr: "无效的金额",
+		},
+		{
+			name:    "日期",
+			content: `{{date "2006年01月02日 15:04" .time}}`,
+			params:  map[string]string{"time": "2025-03-01T08:30:00+08:00"},
+			want:    "2025年03月01日 08:30",
+		},
+		{
+			name:    "默认值和大小写",
+			content: `{{.name | default "用户"}} {{upper .code}} {{trim .space}}`,
+			params:  map[string]string{"code": "abc", "space": " x "},
+			want:    "用户 ABC x",
+		},
+		{
+			name:    "HTML自动转义",
+			content: `<p>{{.name}}</p><a href="https://example.com/?q=${q}">链接</a>`,
+			format:  FormatHTML,
+			params:  map[string]string{"name": "<script>alert(1)</script>", "q": "a b&c"},
+			want:    `<p>&lt;script&gt;alert(1)&lt;/script&gt;</p><a href="https://example.com/?q=a%20b%26c">链接</a>`,
+		},
+		{
+			name:    "纯文本不转义",
+			content: `{{.name}}`,
+			params:  map[string]string{"name": "<b>Tom</b>"},
+			want:    "<b>Tom</b>",
+		},
+		{
+			name:     "公共片段",
+			content:  `{{.name}}您好，{{template "签名" .}}`,
+			partials: map[string]string{"签名": "通知平台 ${team}"},
+			params:   map[string]string{"name": "Tom", "team": "客服部"},
+			want:     "Tom您好，通知平台 客服部",
+		},
+		{
+			name:     "布局",
+			content:  `{{define "body"}}<p>{{.name}}</p>{{end}}{{template "layout" .}}`,
+			partials: map[string]string{"layout": `<html>{{template "body" .}}</html>`},
+			format:   FormatHTML,
+			params:   map[string]string{"name": "Tom & Jerry"},
+			want:     "<html><p>Tom &amp; Jerry</p></html>",
+		},
+		{
+			name:       "解析失败的公共片段在引用时报错",
+			content:    `{{template "broken" .}}`,
+			partials:   map[string]string{"broken": "{{if}}"},
+			wantExeErr: "broken",
+		},
+		{
+			name:    "解析失败",
+			content: "{{if .name}}",
+			wantErr: "unexpected EOF",
+		},
+		{
+			name:    "不支持 call",
+			content: `{{call .fn}}`,
+			wantErr: "不支持 call",
+		},
+		{
+			name:     "公共片段中不支持 call",
+			content:  `{{template "p" .}}`,
+			partials: map[string]string{"p": `{{if true}}{{call .fn}}{{end}}`},
+			wantErr:  "不支持 call",
+		},
+		{
+			name:    "不支持对整数做 range",
+			content: `{{range 100000000}}x{{end}}`,
+			wantErr: "不支持对整数做 range",
+		},
+		{
+			name:       "渲染结果过大",
+			content:    `{{range split .items ","}}{{$.big}}{{end}}`,
+			params:     map[string]string{"items": strings.Repeat(",", 20), "big": strings.Repeat("x", 100<<10)},
+			wantExeErr: "渲染结果超过",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tmpl, err := Compile("content", tc.content, tc.partials, tc.format)
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			got, err := tmpl.Execute(tc.params)
+			if tc.wantExeErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantExeErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestDate(t *testing.T) {
+	t.Parallel()
+
+	const layout = time.DateTime
+	ts := time.Date(2025, 3, 1, 8, 30, 0, 0, time.Local)
+	testCases := []struct {
+		name string
+		v    string
+		want string
+	}{
+		{name: "秒级时间戳", v: "1740789000", want: time.Unix(1740789000, 0).Format(layout)},
+		{name: "毫秒级时间戳", v: "1740789000123", want: time.UnixMilli(1740789000123).Format(layout)},
+		{name: "本地时间", v: "2025-03-01 08:30:00", want: ts.Format(layout)},
+		{name: "日期", v: "2025-03-01", want: "2025-03-01 00:00:00"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := date(layout, tc.v)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+
+	_, err := date(layout, "昨天")
+	assert.Error(t, err)
+}
@@ -0,0 +1,83 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// funcs 模版中可以使用的辅助函数，参数都是字符串
+func funcs() map[string]any {
+	return map[string]any{
+		"money":   money,
+		"date":    date,
+		"default": defaultValue,
+		"upper":   strings.ToUpper,
+		"lower":   strings.ToLower,
+		"trim":    strings.TrimSpace,
+		"split":   split,
+	}
+}
+
+// money 保留两位小数并添加千分位，如 1234.5 渲染为 1,234.50
+func money(v string) (string, error) {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(v))
+	if !ok {
+		return "", fmt.Errorf("money: 无效的金额 %q", v)
+	}
+	s := r.FloatString(2)
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	intPart, fracPart, _ := strings.Cut(s, ".")
+	var sb strings.Builder
+	for i, c := range intPart {
+		if i > 0 && (len(intPart)-i)%3 == 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteRune(c)
+	}
+	return sign + sb.String() + "." + fracPart, nil
+}
+
+// date 按 Go 的时间格式渲染，v 可以是秒级或者毫秒级时间戳、RFC3339 或者 2006-01-02 15:04:05
+// 时间戳和不带时区的时间使用本地时区
+func date(layout, v string) (string, error) {
+	v = strings.TrimSpace(v)
+	if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+		const millisThreshold = 1e12
+		if n >= millisThreshold {
+			return time.UnixMilli(n).Format(layout), nil
+		}
+		return time.Unix(n, 0).Format(layout), nil
+	}
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t.Format(layout), nil
+	}
+	for _, l := range []string{time.DateTime, time.DateOnly} {
+		if t, err := time.ParseInLocation(l, v, time.Local); err == nil {
+			return t.Format(layout), nil
+		}
+	}
+	return "", errors.New("date: 无效的时间 " + strconv.Quote(v))
+}
+
+// defaultValue 参数为空时使用默认值，如 {{.name | default "用户"}}
+func defaultValue(def, v string) string {
+	if v == "" {
+		return def
+	}
+	return v
+}
+
+// split 用于遍历列表参数，如 {{range split .items ","}}
+func split(v, sep string) []string {
+	if v == "" {
+		return nil
+	}
+	return strings.Split(v, sep)
+}
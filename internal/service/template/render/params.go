@@ -0,0 +1,116 @@
+package render
+
+import (
+	texttemplate "text/template"
+	"text/template/parse"
+)
+
+// ExtractParams 从模版内容中提取引用的参数名，按首次出现的顺序返回
+// 支持 ${name}、{{.name}}、{{$.name}} 和 {{index . "name"}}，range 和 with 内部的 . 不是参数，不提取
+// 通过 {{template "名称" .}} 引用的公共片段中的参数不提取
+func ExtractParams(contents ...string) ([]string, error) {
+	e := &extractor{seen: map[string]struct{}{}}
+	for _, content := range contents {
+		tmpl, err := texttemplate.New("extract").Funcs(funcs()).Parse(convertPlaceholders(content))
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range tmpl.Templates() {
+			if t.Tree != nil {
+				e.node(t.Tree.Root, true)
+			}
+		}
+	}
+	return e.names, nil
+}
+
+type extractor struct {
+	names []string
+	seen  map[string]struct{}
+}
+
+func (e *extractor) add(name string) {
+	if _, ok := e.seen[name]; ok {
+		return
+	}
+	e.seen[name] = struct{}{}
+	e.names = append(e.names, name)
+}
+
+// node dotIsParams 表示当前的 . 是否是参数
+func (e *extractor) node(node parse.Node, dotIsParams bool) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			e.node(child, dotIsParams)
+		}
+	case *parse.ActionNode:
+		e.pipe(n.Pipe, dotIsParams)
+	case *parse.TemplateNode:
+		e.pipe(n.Pipe, dotIsParams)
+	case *parse.IfNode:
+		e.pipe(n.Pipe, dotIsParams)
+		e.node(n.List, dotIsParams)
+		e.node(n.ElseList, dotIsParams)
+	case *parse.RangeNode:
+		e.pipe(n.Pipe, dotIsParams)
+		e.node(n.List, false)
+		e.node(n.ElseList, dotIsParams)
+	case *parse.WithNode:
+		e.pipe(n.Pipe, dotIsParams)
+		e.node(n.List, false)
+		e.node(n.ElseList, dotIsParams)
+	}
+}
+
+func (e *extractor) pipe(pipe *parse.PipeNode, dotIsParams bool) {
+	if pipe == nil {
+		return
+	}
+	for _, cmd := range pipe.Cmds {
+		e.index(cmd, dotIsParams)
+		for _, arg := range cmd.Args {
+			switch a := arg.(type) {
+			case *parse.FieldNode:
+				if dotIsParams {
+					e.add(a.Ident[0])
+				}
+			case *parse.VariableNode:
+				if a.Ident[0] == "$" && len(a.Ident) > 1 {
+					e.add(a.Ident[1])
+				}
+			case *parse.PipeNode:
+				e.pipe(a, dotIsParams)
+			}
+		}
+	}
+}
+
+// index 处理 {{index . "name"}} 和 {{index $ "name"}}
+func (e *extractor) index(cmd *parse.CommandNode, dotIsParams bool) {
+	const argNum = 3
+	if len(cmd.Args) != argNum {
+		return
+	}
+	ident, ok := cmd.Args[0].(*parse.IdentifierNode)
+	if !ok || ident.Ident != "index" {
+		return
+	}
+	key, ok := cmd.Args[2].(*parse.StringNode)
+	if !ok {
+		return
+	}
+	switch target := cmd.Args[1].(type) {
+	case *parse.DotNode:
+		if dotIsParams {
+			e.add(key.Text)
+		}
+	case *parse.VariableNode:
+		if len(target.Ident) == 1 && target.Ident[0] == "$" {
+			e.add(key.Text)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+//go:build unit
+
+package render
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractParams(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		contents []string
+		want     []string
+		wantErr  bool
+	}{
+		{
+			name:     "旧的占位符",
+			contents: []string{"您的验证码是：${code}，${minutes}分钟内有效，验证码${code}"},
+			want:     []string{"code", "minutes"},
+		},
+		{
+			name:     "字段、根变量和index",
+			contents: []string{`{{.name}}{{$.level}}{{index . "order-id"}}{{money .amount}}`},
+			want:     []string{"name", "level", "order-id", "amount"},
+		},
+		{
+			name:     "条件",
+			contents: []string{`{{if eq .level "gold"}}{{.name}}{{else}}您好{{end}}`},
+			want:     []string{"level", "name"},
+		},
+		{
+			name:     "range和with内部的点不是参数",
+			contents: []string{`{{range split .items ","}}{{.}}{{$.sep}}{{end}}{{with .title}}{{.}}{{else}}{{.fallback}}{{end}}`},
+			want:     []string{"items", "sep", "title", "fallback"},
+		},
+		{
+			name:     "多段内容去重",
+			contents: []string{"${name}的订单", "{{.name}}您好，订单${orderId}"},
+			want:     []string{"name", "orderId"},
+		},
+		{
+			name:     "公共片段中的参数不提取",
+			contents: []string{`{{template "footer" .}}`},
+		},
+		{
+			name:     "语法错误",
+			contents: []string{"{{if .name}}"},
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ExtractParams(tc.contents...)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
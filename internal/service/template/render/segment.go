@@ -0,0 +1,71 @@
+package render
+
+import (
+	"strings"
+	"unicode/utf16"
+)
+
+// Charset 短信编码
+type Charset string
+
+const (
+	CharsetGSM7 Charset = "GSM-7"
+	CharsetUCS2 Charset = "UCS-2"
+)
+
+const (
+	gsm7SingleLimit = 160
+	gsm7MultiLimit  = 153
+	ucs2SingleLimit = 70
+	ucs2MultiLimit  = 67
+)
+
+const (
+	// gsm7Basic GSM 03.38 基本字符集
+	gsm7Basic = "@£$¥èéùìòÇ\nØø\rÅåΔ_ΦΓΛΩΠΨΣΘΞÆæßÉ !\"#¤%&'()*+,-./0123456789:;<=>?" +
+		"¡ABCDEFGHIJKLMNOPQRSTUVWXYZÄÖÑÜ§¿abcdefghijklmnopqrstuvwxyzäöñüà"
+	// gsm7Extension GSM 03.38 扩展字符集，每个字符占两个单位
+	gsm7Extension = "\f^{}\\[~]|€"
+)
+
+// Segments 短信的编码、长度和拆分的条数
+// 全部是 GSM-7 字符时单条 160 个单位、长短信每条 153 个单位，扩展字符占两个单位
+// 否则使用 UCS-2，单条 70 个单位、长短信每条 67 个单位，BMP 之外的字符（如 emoji）占两个单位
+type Segments struct {
+	Charset Charset
+	Length  int
+	Count   int
+}
+
+func CountSegments(content string) Segments {
+	if length, ok := gsm7Length(content); ok {
+		return Segments{Charset: CharsetGSM7, Length: length, Count: segmentCount(length, gsm7SingleLimit, gsm7MultiLimit)}
+	}
+	length := len(utf16.Encode([]rune(content)))
+	return Segments{Charset: CharsetUCS2, Length: length, Count: segmentCount(length, ucs2SingleLimit, ucs2MultiLimit)}
+}
+
+func gsm7Length(content string) (int, bool) {
+	length := 0
+	for _, r := range content {
+		switch {
+		case strings.ContainsRune(gsm7Basic, r):
+			length++
+		case strings.ContainsRune(gsm7Extension, r):
+			length += 2
+		default:
+			return 0, false
+		}
+	}
+	return length, true
+}
+
+func segmentCount(length, singleLimit, multiLimit int) int {
+	if length == 0 {
+		return 0
+	}
+	if length <= singleLimit {
+		return 1
+	}
+	return (length + multiLimit - 1) / multiLimit
+}
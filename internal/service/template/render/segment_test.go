@@ -0,0 +1,67 @@
+//go:build unit
+
+package render
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountSegments(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		content string
+		want    Segments
+	}{
+		{
+			name: "空内容",
+			want: Segments{Charset: CharsetGSM7},
+		},
+		{
+			name:    "GSM-7单条",
+			content: strings.Repeat("a", 160),
+			want:    Segments{Charset: CharsetGSM7, Length: 160, Count: 1},
+		},
+		{
+			name:    "GSM-7长短信",
+			content: strings.Repeat("a", 161),
+			want:    Segments{Charset: CharsetGSM7, Length: 161, Count: 2},
+		},
+		{
+			name:    "GSM-7扩展字符占两个单位",
+			content: strings.Repeat("a", 158) + "€",
+			want:    Segments{Charset: CharsetGSM7, Length: 160, Count: 1},
+		},
+		{
+			name:    "UCS-2单条",
+			content: strings.Repeat("中", 70),
+			want:    Segments{Charset: CharsetUCS2, Length: 70, Count: 1},
+		},
+		{
+			name:    "UCS-2长短信",
+			content: strings.Repeat("中", 135),
+			want:    Segments{Charset: CharsetUCS2, Length: 135, Count: 3},
+		},
+		{
+			name:    "一个非GSM-7字符导致整条使用UCS-2",
+			content: "Hello 世界",
+			want:    Segments{Charset: CharsetUCS2, Length: 8, Count: 1},
+		},
+		{
+			name:    "emoji占两个单位",
+			content: "😀",
+			want:    Segments{Charset: CharsetUCS2, Length: 2, Count: 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, CountSegments(tc.content))
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package render
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	ca "github.com/patrickmn/go-cache"
+)
+
+const (
+	// cacheTTL 编译结果的缓存时长，公共片段修改后最多经过该时长生效
+	cacheTTL             = time.Minute
+	cacheCleanupInterval = 5 * time.Minute
+)
+
+// Result 渲染结果
+type Result struct {
+	Subject string
+	Content string
+}
+
+// Preview 预览结果
+type Preview struct {
+	Channel domain.Channel
+	Subject string
+	Content string
+	// SMS 短信的编码和条数，签名计入长度，其他渠道为零值
+	SMS Segments
+	// MissingParams 声明为必填或者内容中引用但是没有提供的参数
+	MissingParams []string
+}
+
+// Service 平台自行渲染的渠道（邮件、站内信）使用的模版渲染服务
+// 模版可以通过 {{template "名称" .}} 引用同一拥有者下同渠道的其他已发布模版，用作公共片段或者布局
+type Service interface {
+	// Render 渲染模版版本的标题和内容，邮件正文中的参数自动转义
+	Render(ctx context.Context, tmpl domain.ChannelTemplate, version domain.ChannelTemplateVersion, params map[string]string) (Result, error)
+	// Preview 使用示例参数渲染模版版本，草稿和已发布的版本都可以预览，versionID 为 0 时使用当前生效的版本
+	// 只渲染，不会创建通知，也不会消耗配额
+	Preview(ctx context.Context, templateID, versionID int64, params map[string]string) (Preview, error)
+}
+
+// TemplateGetter 获取模版，通常是 manage.ChannelTemplateService
+type TemplateGetter interface {
+	GetTemplateByID(ctx context.Context, templateID int64) (domain.ChannelTemplate, error)
+	GetTemplatesByOwner(ctx context.Context, ownerID int64, ownerType domain.OwnerType) ([]domain.ChannelTemplate, error)
+}
+
+type compiled struct {
+	subject Template
+	content Template
+}
+
+type service struct {
+	templateSvc TemplateGetter
+	cache       *ca.Cache
+}
+
+func NewService(templateSvc TemplateGetter) Service {
+	return &service{
+		templateSvc: templateSvc,
+		cache:       ca.New(cacheTTL, cacheCleanupInterval),
+	}
+}
+
+func (s *service) Render(ctx context.Context, tmpl domain.ChannelTemplate, version domain.ChannelTemplateVersion, params map[string]string) (Result, error) {
+	c, err := s.compile(ctx, tmpl, version)
+	if err != nil {
+		return Result{}, err
+	}
+	subject, err := c.subject.Execute(params)
+	if err != nil {
+		return Result{}, fmt.Errorf("%w: 标题 %w", errs.ErrRenderTemplateFailed, err)
+	}
+	content, err := c.content.Execute(params)
+	if err != nil {
+		return Result{}, fmt.Errorf("%w: 内容 %w", errs.ErrRenderTemplateFailed, err)
+	}
+	return Result{Subject: subject, Content: content}, nil
+}
+
+func (s *service) Preview(ctx context.Context, templateID, versionID int64, params map[string]string) (Preview, error) {
+	tmpl, err := s.templateSvc.GetTemplateByID(ctx, templateID)
+	if err != nil {
+		return Preview{}, err
+	}
+	if tmpl.ID == 0 {
+		return Preview{}, fmt.Errorf("%w: templateID=%d", errs.ErrTemplateNotFound, templateID)
+	}
+	version := tmpl.ActiveVersion()
+	if versionID != 0 {
+		version = tmpl.GetVersion(versionID)
+	}
+	if version == nil {
+		return Preview{}, fmt.Errorf("%w: templateID=%d, versionID=%d", errs.ErrTemplateVersionNotFound, templateID, versionID)
+	}
+
+	res, err := s.Render(ctx, tmpl, *version, params)
+	if err != nil {
+		return Preview{}, err
+	}
+	missing, err := s.missingParams(*version, params)
+	if err != nil {
+		return Preview{}, err
+	}
+	preview := Preview{
+		Channel:       tmpl.Channel,
+		Subject:       res.Subject,
+		Content:       res.Content,
+		MissingParams: missing,
+	}
+	if tmpl.Channel == domain.ChannelSMS {
+		text := res.Content
+		if version.Signature != "" {
+			text = "【" + version.Signature + "】" + text
+		}
+		preview.SMS = CountSegments(text)
+	}
+	return preview, nil
+}
+
+func (s *service) missingParams(version domain.ChannelTemplateVersion, params map[string]string) ([]string, error) {
+	names := make([]string, 0, len(version.Params))
+	for _, p := range version.Params {
+		if p.Required {
+			names = append(names, p.Name)
+		}
+	}
+	extracted, err := ExtractParams(version.Subject, version.Content)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errs.ErrRenderTemplateFailed, err)
+	}
+	missing := make([]string, 0)
+	for _, name := range append(names, extracted...) {
+		if params[name] == "" && !slices.Contains(missing, name) {
+			missing = append(missing, name)
+		}
+	}
+	return missing, nil
+}
+
+// compile 按版本ID缓存编译结果，未发布的版本可以修改，所以同时使用内容的哈希作为缓存键
+func (s *service) compile(ctx context.Context, tmpl domain.ChannelTemplate, version domain.ChannelTemplateVersion) (compiled, error) {
+	key := s.cacheKey(version)
+	if v, ok := s.cache.Get(key); ok {
+		return v.(compiled), nil
+	}
+
+	partials, err := s.partials(ctx, tmpl)
+	if err != nil {
+		return compiled{}, err
+	}
+	subject, err := Compile("subject", version.Subject, nil, FormatText)
+	if err != nil {
+		return compiled{}, fmt.Errorf("%w: 标题 %w", errs.ErrRenderTemplateFailed, err)
+	}
+	format := FormatText
+	if tmpl.Channel == domain.ChannelEmail {
+		format = FormatHTML
+	}
+	content, err := Compile("content", version.Content, partials, format)
+	if err != nil {
+		return compiled{}, fmt.Errorf("%w: 内容 %w", errs.ErrRenderTemplateFailed, err)
+	}
+
+	c := compiled{subject: subject, content: content}
+	s.cache.SetDefault(key, c)
+	return c, nil
+}
+
+func (s *service) cacheKey(version domain.ChannelTemplateVersion) string {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(version.Subject))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(version.Content))
+	return fmt.Sprintf("%d:%x", version.ID, h.Sum64())
+}
+
+// partials 同一拥有者下同渠道的其他已发布模版，以模版名称引用
+func (s *service) partials(ctx context.Context, tmpl domain.ChannelTemplate) (map[string]string, error) {
+	templates, err := s.templateSvc.GetTemplatesByOwner(ctx, tmpl.OwnerID, tmpl.OwnerType)
+	if err != nil {
+		return nil, fmt.Errorf("%w: 获取公共片段失败 %w", errs.ErrRenderTemplateFailed, err)
+	}
+	partials := make(map[string]string, len(templates))
+	for i := range templates {
+		if templates[i].ID == tmpl.ID || templates[i].Channel != tmpl.Channel {
+			continue
+		}
+		if active := templates[i].ActiveVersion(); active != nil {
+			partials[templates[i].Name] = active.Content
+		}
+	}
+	return partials, nil
+}
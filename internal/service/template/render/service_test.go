@@ -0,0 +1,206 @@
+//go:build unit
+
+package render
+
+import (
+	"errors"
+	"testing"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	templatemocks "gitee.com/flycash/notification-platform/internal/service/template/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestService_Render(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tmpl := domain.ChannelTemplate{
+		ID:        1,
+		OwnerID:   10,
+		OwnerType: domain.OwnerTypeOrganization,
+		Name:      "订单通知",
+		Channel:   domain.ChannelEmail,
+	}
+	version := domain.ChannelTemplateVersion{
+		ID:      100,
+		Subject: "订单${order}已发货",
+		Content: `{{define "body"}}<p>订单{{.order}}已发货</p>{{end}}{{template "layout" .}}`,
+	}
+	templateSvc := templatemocks.NewMockChannelTemplateService(ctrl)
+	// 编译结果按版本缓存，同一版本只加载一次公共片段
+	templateSvc.EXPECT().GetTemplatesByOwner(gomock.Any(), tmpl.OwnerID, tmpl.OwnerType).Return([]domain.ChannelTemplate{
+		tmpl,
+		{
+			ID:              2,
+			Name:            "layout",
+			Channel:         domain.ChannelEmail,
+			ActiveVersionID: 200,
+			Versions:        []domain.ChannelTemplateVersion{{ID: 200, Content: `<html>{{template "body" .}}</html>`}},
+		},
+		{
+			// 其他渠道的模版不能引用
+			ID:              3,
+			Name:            "sms",
+			Channel:         domain.ChannelSMS,
+			ActiveVersionID: 300,
+			Versions:        []domain.ChannelTemplateVersion{{ID: 300, Content: "短信"}},
+		},
+		{
+			// 未发布的模版不能引用
+			ID:       4,
+			Name:     "draft",
+			Channel:  domain.ChannelEmail,
+			Versions: []domain.ChannelTemplateVersion{{ID: 400, Content: "草稿"}},
+		},
+	}, nil).Times(3)
+
+	svc := NewService(templateSvc)
+	for _, tc := range []struct {
+		order   string
+		escaped string
+	}{
+		{order: "A<1>", escaped: "A&lt;1&gt;"},
+		{order: "A<2>", escaped: "A&lt;2&gt;"},
+	} {
+		res, err := svc.Render(t.Context(), tmpl, version, map[string]string{"order": tc.order})
+		require.NoError(t, err)
+		// 标题是纯文本，不转义
+		assert.Equal(t, "订单"+tc.order+"已发货", res.Subject)
+		assert.Equal(t, "<html><p>订单"+tc.escaped+"已发货</p></html>", res.Content)
+	}
+
+	_, err := svc.Render(t.Context(), tmpl, domain.ChannelTemplateVersion{ID: 101, Content: `{{template "sms" .}}`}, nil)
+	assert.ErrorIs(t, err, errs.ErrRenderTemplateFailed)
+	_, err = svc.Render(t.Context(), tmpl, domain.ChannelTemplateVersion{ID: 102, Content: `{{template "draft" .}}`}, nil)
+	assert.ErrorIs(t, err, errs.ErrRenderTemplateFailed)
+}
+
+func TestService_RenderError(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tmpl := domain.ChannelTemplate{ID: 1, Channel: domain.ChannelInApp}
+	templateSvc := templatemocks.NewMockChannelTemplateService(ctrl)
+	templateSvc.EXPECT().GetTemplatesByOwner(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("mock error"))
+	svc := NewService(templateSvc)
+	_, err := svc.Render(t.Context(), tmpl, domain.ChannelTemplateVersion{ID: 1, Content: "内容"}, nil)
+	assert.ErrorIs(t, err, errs.ErrRenderTemplateFailed)
+
+	templateSvc.EXPECT().GetTemplatesByOwner(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+	_, err = svc.Render(t.Context(), tmpl, domain.ChannelTemplateVersion{ID: 1, Content: "{{if}}"}, nil)
+	assert.ErrorIs(t, err, errs.ErrRenderTemplateFailed)
+}
+
+func TestService_Preview(t *testing.T) {
+	t.Parallel()
+
+	tmpl := domain.ChannelTemplate{
+		ID:              1,
+		OwnerID:         10,
+		OwnerType:       domain.OwnerTypeOrganization,
+		Channel:         domain.ChannelSMS,
+		ActiveVersionID: 100,
+		Versions: []domain.ChannelTemplateVersion{
+			{
+				ID:        100,
+				Signature: "通知平台",
+				Content:   "您的验证码是${code}",
+			},
+			{
+				// 草稿版本
+				ID:      101,
+				Content: "Your code is ${code}, valid for ${minutes} minutes",
+				Params: []domain.TemplateParam{
+					{Name: "code", Type: domain.TemplateParamTypeString, Required: true},
+					{Name: "channel", Type: domain.TemplateParamTypeString, Required: true},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		templateID int64
+		versionID  int64
+		params     map[string]string
+		mock       func(svc *templatemocks.MockChannelTemplateService)
+		want       Preview
+		wantErr    error
+	}{
+		{
+			name:       "当前生效的版本",
+			templateID: 1,
+			params:     map[string]string{"code": "123456"},
+			mock: func(svc *templatemocks.MockChannelTemplateService) {
+				svc.EXPECT().GetTemplateByID(gomock.Any(), int64(1)).Return(tmpl, nil)
+				svc.EXPECT().GetTemplatesByOwner(gomock.Any(), tmpl.OwnerID, tmpl.OwnerType).Return(nil, nil)
+			},
+			want: Preview{
+				Channel:       domain.ChannelSMS,
+				Content:       "您的验证码是123456",
+				SMS:           Segments{Charset: CharsetUCS2, Length: 18, Count: 1},
+				MissingParams: []string{},
+			},
+		},
+		{
+			name:       "草稿版本缺少参数",
+			templateID: 1,
+			versionID:  101,
+			params:     map[string]string{"code": "123456"},
+			mock: func(svc *templatemocks.MockChannelTemplateService) {
+				svc.EXPECT().GetTemplateByID(gomock.Any(), int64(1)).Return(tmpl, nil)
+				svc.EXPECT().GetTemplatesByOwner(gomock.Any(), tmpl.OwnerID, tmpl.OwnerType).Return(nil, nil)
+			},
+			want: Preview{
+				Channel:       domain.ChannelSMS,
+				Content:       "Your code is 123456, valid for  minutes",
+				SMS:           Segments{Charset: CharsetGSM7, Length: 39, Count: 1},
+				MissingParams: []string{"channel", "minutes"},
+			},
+		},
+		{
+			name:       "模版不存在",
+			templateID: 2,
+			mock: func(svc *templatemocks.MockChannelTemplateService) {
+				svc.EXPECT().GetTemplateByID(gomock.Any(), int64(2)).Return(domain.ChannelTemplate{}, nil)
+			},
+			wantErr: errs.ErrTemplateNotFound,
+		},
+		{
+			name:       "版本不存在",
+			templateID: 1,
+			versionID:  102,
+			mock: func(svc *templatemocks.MockChannelTemplateService) {
+				svc.EXPECT().GetTemplateByID(gomock.Any(), int64(1)).Return(tmpl, nil)
+			},
+			wantErr: errs.ErrTemplateVersionNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			templateSvc := templatemocks.NewMockChannelTemplateService(ctrl)
+			tc.mock(templateSvc)
+			got, err := NewService(templateSvc).Preview(t.Context(), tc.templateID, tc.versionID, tc.params)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -10,13 +10,17 @@ import (
 	"github.com/meoying/dlock-go"
 )
 
+// SyncProviderAuditInfoTask 轮询供应商的模版审核结果。审核结果优先通过供应商推送获取，
+// 该任务只查询超过 gracePeriod 没有更新的审核中关联，兜底推送丢失的情况
 type SyncProviderAuditInfoTask struct {
-	dclient dlock.Client
-	svc     templatesvc.ChannelTemplateService
+	dclient     dlock.Client
+	svc         templatesvc.ChannelTemplateService
+	gracePeriod time.Duration
 }
 
-func NewSyncProviderAuditInfoTask(dclient dlock.Client, svc templatesvc.ChannelTemplateService) *SyncProviderAuditInfoTask {
-	return &SyncProviderAuditInfoTask{dclient: dclient, svc: svc}
+// NewSyncProviderAuditInfoTask gracePeriod 为等待推送的时间，为0时不等待推送，直接轮询
+func NewSyncProviderAuditInfoTask(dclient dlock.Client, svc templatesvc.ChannelTemplateService, gracePeriod time.Duration) *SyncProviderAuditInfoTask {
+	return &SyncProviderAuditInfoTask{dclient: dclient, svc: svc, gracePeriod: gracePeriod}
 }
 
 func (s *SyncProviderAuditInfoTask) Start(ctx context.Context) {
@@ -30,12 +34,19 @@ func (s *SyncProviderAuditInfoTask) HandleSyncProviderAuditInfo(ctx context.Cont
 	const minDuration = 3 * time.Second
 
 	now := time.Now()
+	// 供应商关联的 utime 单位是秒，查询或推送更新后 utime 会刷新，不会被重复查询
+	utime := now.Add(-s.gracePeriod).Unix()
 
-	for {
-		providers, total, err := s.svc.GetPendingOrInReviewProviders(ctx, 0, batchSize, now.UnixMilli())
+	// 供应商没有返回结果的关联不会被更新，按第一次查询的总数限制批次数量，避免一直查询同一批
+	var maxBatches int64
+	for batches := int64(1); ; batches++ {
+		providers, total, err := s.svc.GetPendingOrInReviewProviders(ctx, 0, batchSize, utime)
 		if err != nil {
 			return fmt.Errorf("获取未完成审核的供应商关联记录失败: %w", err)
 		}
+		if batches == 1 {
+			maxBatches = (total + batchSize - 1) / batchSize
+		}
 
 		if len(providers) == 0 {
 			break
@@ -50,7 +61,7 @@ func (s *SyncProviderAuditInfoTask) HandleSyncProviderAuditInfo(ctx context.Cont
 			break
 		}
 
-		if int64(batchSize) >= total {
+		if batches >= maxBatches {
 			break
 		}
 	}
@@ -0,0 +1,238 @@
+//go:build e2e
+
+package integration
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	auditevt "gitee.com/flycash/notification-platform/internal/event/audit"
+	evtmocks "gitee.com/flycash/notification-platform/internal/event/mocks"
+	"gitee.com/flycash/notification-platform/internal/repository"
+	"gitee.com/flycash/notification-platform/internal/repository/dao"
+	auditsvc "gitee.com/flycash/notification-platform/internal/service/audit"
+	testioc "gitee.com/flycash/notification-platform/internal/test/ioc"
+	"github.com/ego-component/egorm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAuditServiceSuite(t *testing.T) {
+	suite.Run(t, new(AuditServiceTestSuite))
+}
+
+type AuditServiceTestSuite struct {
+	suite.Suite
+	db   *egorm.Component
+	repo repository.AuditRepository
+}
+
+func (s *AuditServiceTestSuite) SetupSuite() {
+	s.db = testioc.InitDBAndTables()
+	s.repo = repository.NewAuditRepository(dao.NewAuditDAO(s.db))
+}
+
+func (s *AuditServiceTestSuite) TearDownTest() {
+	s.db.Exec("TRUNCATE TABLE `audits`")
+	s.db.Exec("TRUNCATE TABLE `audit_reviewers`")
+}
+
+func (s *AuditServiceTestSuite) newService(t *testing.T, producer auditevt.ResultCallbackEventProducer, sla time.Duration) auditsvc.Service {
+	t.Helper()
+	return auditsvc.NewService(s.repo, producer, auditsvc.Config{SLA: sla})
+}
+
+func (s *AuditServiceTestSuite) createReviewer(t *testing.T, svc auditsvc.Service, name string, level int) domain.AuditReviewer {
+	t.Helper()
+	reviewer, err := svc.SaveReviewer(t.Context(), domain.AuditReviewer{Name: name, Level: level, Enabled: true})
+	require.NoError(t, err)
+	return reviewer
+}
+
+func (s *AuditServiceTestSuite) TestCreateAudit_AssignLeastLoaded() {
+	t := s.T()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	svc := s.newService(t, evtmocks.NewMockResultCallbackEventProducer(ctrl), time.Hour)
+
+	r1 := s.createReviewer(t, svc, "reviewer-1", 0)
+	r2 := s.createReviewer(t, svc, "reviewer-2", 0)
+	// 停用的和其他级别的审核人不参与分配
+	_, err := svc.SaveReviewer(t.Context(), domain.AuditReviewer{Name: "disabled", Level: 0})
+	require.NoError(t, err)
+	s.createReviewer(t, svc, "leader", 1)
+
+	var reviewerIDs []int64
+	for i := int64(1); i <= 3; i++ {
+		id, err1 := svc.CreateAudit(t.Context(), domain.Audit{
+			ResourceID:   i,
+			ResourceType: domain.ResourceTypeTemplate,
+			Content:      "{}",
+		})
+		require.NoError(t, err1)
+		audit, err1 := svc.GetAudit(t.Context(), id)
+		require.NoError(t, err1)
+		assert.Equal(t, domain.AuditStatusInReview, audit.Status)
+		assert.Equal(t, 0, audit.Level)
+		assert.Greater(t, audit.Deadline, time.Now().Unix())
+		reviewerIDs = append(reviewerIDs, audit.ReviewerID)
+	}
+	assert.Equal(t, []int64{r1.ID, r2.ID, r1.ID}, reviewerIDs)
+
+	audits, total, err := svc.ListReviewerQueue(t.Context(), r1.ID, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	assert.Len(t, audits, 2)
+}
+
+func (s *AuditServiceTestSuite) TestApproveAndReject() {
+	t := s.T()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	producer := evtmocks.NewMockResultCallbackEventProducer(ctrl)
+	svc := s.newService(t, producer, time.Hour)
+	reviewer := s.createReviewer(t, svc, "reviewer", 0)
+
+	approveID, err := svc.CreateAudit(t.Context(), domain.Audit{ResourceID: 1, ResourceType: domain.ResourceTypeTemplate})
+	require.NoError(t, err)
+	rejectID, err := svc.CreateAudit(t.Context(), domain.Audit{ResourceID: 2, ResourceType: domain.ResourceTypeTemplate})
+	require.NoError(t, err)
+
+	var events []auditevt.CallbackResultEvent
+	producer.EXPECT().Produce(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, evt auditevt.CallbackResultEvent) error {
+			events = append(events, evt)
+			return nil
+		}).Times(2)
+
+	// 不是当前审核人
+	assert.ErrorIs(t, svc.Approve(t.Context(), approveID, reviewer.ID+1), errs.ErrInvalidOperation)
+	// 拒绝必须填写原因
+	assert.ErrorIs(t, svc.Reject(t.Context(), rejectID, reviewer.ID, " "), errs.ErrInvalidParameter)
+
+	require.NoError(t, svc.Approve(t.Context(), approveID, reviewer.ID))
+	require.NoError(t, svc.Reject(t.Context(), rejectID, reviewer.ID, "包含敏感词"))
+	// 审核已结束
+	assert.ErrorIs(t, svc.Approve(t.Context(), approveID, reviewer.ID), errs.ErrInvalidOperation)
+
+	require.Len(t, events, 2)
+	assert.Equal(t, int64(1), events[0].ResourceID)
+	assert.Equal(t, approveID, events[0].AuditID)
+	assert.Equal(t, reviewer.ID, events[0].AuditorID)
+	assert.Equal(t, domain.AuditStatusApproved.String(), events[0].AuditStatus)
+	assert.Equal(t, domain.AuditStatusRejected.String(), events[1].AuditStatus)
+	assert.Equal(t, "包含敏感词", events[1].RejectReason)
+
+	audit, err := svc.GetAudit(t.Context(), approveID)
+	require.NoError(t, err)
+	assert.True(t, audit.ResultPublished)
+
+	_, total, err := svc.ListReviewerQueue(t.Context(), reviewer.ID, 0, 10)
+	require.NoError(t, err)
+	assert.Zero(t, total)
+}
+
+func (s *AuditServiceTestSuite) TestPublishResults_RetryFailed() {
+	t := s.T()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	producer := evtmocks.NewMockResultCallbackEventProducer(ctrl)
+	svc := s.newService(t, producer, time.Hour)
+	reviewer := s.createReviewer(t, svc, "reviewer", 0)
+
+	id, err := svc.CreateAudit(t.Context(), domain.Audit{ResourceID: 1, ResourceType: domain.ResourceTypeTemplate})
+	require.NoError(t, err)
+
+	// 发送失败不影响审核结果
+	producer.EXPECT().Produce(gomock.Any(), gomock.Any()).Return(errors.New("mock error"))
+	require.NoError(t, svc.Approve(t.Context(), id, reviewer.ID))
+	audit, err := svc.GetAudit(t.Context(), id)
+	require.NoError(t, err)
+	assert.Equal(t, domain.AuditStatusApproved, audit.Status)
+	assert.False(t, audit.ResultPublished)
+
+	producer.EXPECT().Produce(gomock.Any(), gomock.Any()).Return(nil)
+	cnt, err := svc.PublishResults(t.Context(), 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, cnt)
+
+	cnt, err = svc.PublishResults(t.Context(), 10)
+	require.NoError(t, err)
+	assert.Zero(t, cnt)
+}
+
+func (s *AuditServiceTestSuite) TestEscalateOverdue() {
+	t := s.T()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	// 直接修改截止时间模拟超时
+	svc := s.newService(t, evtmocks.NewMockResultCallbackEventProducer(ctrl), time.Hour)
+
+	reviewer := s.createReviewer(t, svc, "reviewer", 0)
+	assignedID, err := svc.CreateAudit(t.Context(), domain.Audit{ResourceID: 1, ResourceType: domain.ResourceTypeTemplate})
+	require.NoError(t, err)
+	notOverdueID, err := svc.CreateAudit(t.Context(), domain.Audit{ResourceID: 2, ResourceType: domain.ResourceTypeTemplate})
+	require.NoError(t, err)
+	require.NoError(t, s.db.Model(&dao.Audit{}).Where("id = ?", assignedID).
+		Update("deadline", time.Now().Add(-time.Minute).Unix()).Error)
+
+	// 没有上一级审核人时保持不变，只重新计时
+	cnt, err := svc.EscalateOverdue(t.Context(), 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, cnt)
+	audit, err := svc.GetAudit(t.Context(), assignedID)
+	require.NoError(t, err)
+	assert.Equal(t, reviewer.ID, audit.ReviewerID)
+	assert.Equal(t, 0, audit.Level)
+	assert.Greater(t, audit.Deadline, time.Now().Unix())
+
+	leader := s.createReviewer(t, svc, "leader", 1)
+	require.NoError(t, s.db.Model(&dao.Audit{}).Where("id = ?", assignedID).
+		Update("deadline", time.Now().Add(-time.Minute).Unix()).Error)
+	cnt, err = svc.EscalateOverdue(t.Context(), 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, cnt)
+	audit, err = svc.GetAudit(t.Context(), assignedID)
+	require.NoError(t, err)
+	assert.Equal(t, leader.ID, audit.ReviewerID)
+	assert.Equal(t, 1, audit.Level)
+
+	// 原审核人不能再处理
+	assert.ErrorIs(t, svc.Approve(t.Context(), assignedID, reviewer.ID), errs.ErrInvalidOperation)
+
+	audit, err = svc.GetAudit(t.Context(), notOverdueID)
+	require.NoError(t, err)
+	assert.Equal(t, reviewer.ID, audit.ReviewerID)
+}
+
+func (s *AuditServiceTestSuite) TestEscalateOverdue_AssignUnassigned() {
+	t := s.T()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	svc := s.newService(t, evtmocks.NewMockResultCallbackEventProducer(ctrl), time.Hour)
+
+	// 创建时没有审核人
+	id, err := svc.CreateAudit(t.Context(), domain.Audit{ResourceID: 1, ResourceType: domain.ResourceTypeTemplate})
+	require.NoError(t, err)
+	audit, err := svc.GetAudit(t.Context(), id)
+	require.NoError(t, err)
+	assert.Zero(t, audit.ReviewerID)
+
+	reviewer := s.createReviewer(t, svc, "reviewer", 0)
+	require.NoError(t, s.db.Model(&dao.Audit{}).Where("id = ?", id).
+		Update("deadline", time.Now().Add(-time.Minute).Unix()).Error)
+	_, err = svc.EscalateOverdue(t.Context(), 10)
+	require.NoError(t, err)
+
+	// 在当前级别重新分配
+	audit, err = svc.GetAudit(t.Context(), id)
+	require.NoError(t, err)
+	assert.Equal(t, reviewer.ID, audit.ReviewerID)
+	assert.Equal(t, 0, audit.Level)
+}
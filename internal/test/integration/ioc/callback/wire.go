@@ -3,6 +3,7 @@
 package callback
 
 import (
+	prodioc "gitee.com/flycash/notification-platform/internal/ioc"
 	"gitee.com/flycash/notification-platform/internal/repository"
 	"gitee.com/flycash/notification-platform/internal/repository/cache/redis"
 	"gitee.com/flycash/notification-platform/internal/repository/dao"
@@ -25,6 +26,8 @@ func Init(cnfigSvc configsvc.BusinessConfigService) *Service {
 		callbacksvc.NewService,
 		repository.NewCallbackLogRepository,
 		dao.NewCallbackLogDAO,
+		repository.NewReceiverResultRepository,
+		prodioc.InitReceiverResultDAO,
 		repository.NewNotificationRepository,
 		dao.NewNotificationDAO,
 		redis.NewQuotaCache,
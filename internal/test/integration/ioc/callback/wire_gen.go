@@ -7,6 +7,7 @@
 package callback
 
 import (
+	ioc2 "gitee.com/flycash/notification-platform/internal/ioc"
 	"gitee.com/flycash/notification-platform/internal/repository"
 	"gitee.com/flycash/notification-platform/internal/repository/cache/redis"
 	"gitee.com/flycash/notification-platform/internal/repository/dao"
@@ -25,7 +26,9 @@ func Init(cnfigSvc config.BusinessConfigService) *Service {
 	notificationRepository := repository.NewNotificationRepository(notificationDAO, quotaCache)
 	callbackLogDAO := dao.NewCallbackLogDAO(v)
 	callbackLogRepository := repository.NewCallbackLogRepository(notificationRepository, callbackLogDAO)
-	service := callback.NewService(cnfigSvc, callbackLogRepository)
+	receiverResultDAO := ioc2.InitReceiverResultDAO(v)
+	receiverResultRepository := repository.NewReceiverResultRepository(receiverResultDAO)
+	service := callback.NewService(cnfigSvc, callbackLogRepository, receiverResultRepository)
 	quotaRepository := repository.NewQuotaRepositoryV2(quotaCache)
 	callbackService := &Service{
 		Svc:              service,
@@ -3,6 +3,7 @@
 package notification
 
 import (
+	prodioc "gitee.com/flycash/notification-platform/internal/ioc"
 	"gitee.com/flycash/notification-platform/internal/repository"
 	"gitee.com/flycash/notification-platform/internal/repository/cache"
 	"gitee.com/flycash/notification-platform/internal/repository/cache/redis"
@@ -27,6 +28,8 @@ func Init() *Service {
 		repository.NewNotificationRepository,
 		notification.NewNotificationService,
 		dao.NewNotificationDAO,
+		repository.NewReceiverResultRepository,
+		prodioc.InitReceiverResultDAO,
 
 		repository.NewQuotaRepositoryV2,
 
@@ -7,6 +7,7 @@
 package notification
 
 import (
+	ioc2 "gitee.com/flycash/notification-platform/internal/ioc"
 	"gitee.com/flycash/notification-platform/internal/repository"
 	"gitee.com/flycash/notification-platform/internal/repository/cache"
 	"gitee.com/flycash/notification-platform/internal/repository/cache/redis"
@@ -23,7 +24,9 @@ func Init() *Service {
 	cmdable := ioc.InitRedis()
 	quotaCache := redis.NewQuotaCache(cmdable)
 	notificationRepository := repository.NewNotificationRepository(notificationDAO, quotaCache)
-	service := notification.NewNotificationService(notificationRepository)
+	receiverResultDAO := ioc2.InitReceiverResultDAO(v)
+	receiverResultRepository := repository.NewReceiverResultRepository(receiverResultDAO)
+	service := notification.NewNotificationService(notificationRepository, receiverResultRepository)
 	quotaRepository := repository.NewQuotaRepositoryV2(quotaCache)
 	callbackLogDAO := dao.NewCallbackLogDAO(v)
 	callbackLogRepository := repository.NewCallbackLogRepository(notificationRepository, callbackLogDAO)
@@ -20,17 +20,23 @@ import (
 	"gitee.com/flycash/notification-platform/internal/repository/dao"
 	auditsvc "gitee.com/flycash/notification-platform/internal/service/audit"
 	"gitee.com/flycash/notification-platform/internal/service/channel"
+	"gitee.com/flycash/notification-platform/internal/service/channel/plugin"
 	configsvc "gitee.com/flycash/notification-platform/internal/service/config"
+	inboxsvc "gitee.com/flycash/notification-platform/internal/service/inbox"
 	notificationsvc "gitee.com/flycash/notification-platform/internal/service/notification"
 	"gitee.com/flycash/notification-platform/internal/service/notification/callback"
 	"gitee.com/flycash/notification-platform/internal/service/provider"
 	providersvc "gitee.com/flycash/notification-platform/internal/service/provider/manage"
+	"gitee.com/flycash/notification-platform/internal/service/provider/registry"
 	"gitee.com/flycash/notification-platform/internal/service/provider/sequential"
 	"gitee.com/flycash/notification-platform/internal/service/provider/sms"
 	"gitee.com/flycash/notification-platform/internal/service/provider/sms/client"
+	"gitee.com/flycash/notification-platform/internal/service/receipt"
+	replysvc "gitee.com/flycash/notification-platform/internal/service/reply"
 	"gitee.com/flycash/notification-platform/internal/service/sender"
 	"gitee.com/flycash/notification-platform/internal/service/sendstrategy"
 	templatesvc "gitee.com/flycash/notification-platform/internal/service/template/manage"
+	"gitee.com/flycash/notification-platform/internal/service/template/render"
 	"github.com/google/wire"
 )
 
@@ -56,6 +62,8 @@ var (
 		notificationsvc.NewNotificationService,
 		repository.NewNotificationRepository,
 		dao.NewNotificationDAO,
+		repository.NewReceiverResultRepository,
+		prodioc.InitReceiverResultDAO,
 		notificationsvc.NewSendingTimeoutTask,
 	)
 	txNotificationSvcSet = wire.NewSet(
@@ -65,6 +73,8 @@ var (
 		notificationsvc.NewTxCheckTask,
 	)
 	senderSvcSet = wire.NewSet(
+		prodioc.InitChannelPluginManager,
+		prodioc.InitChannelPluginSyncer,
 		newChannel,
 		newTaskPool,
 		sender.NewSender,
@@ -86,12 +96,43 @@ var (
 		repository.NewProviderRepository,
 		dao.NewProviderDAO,
 		// 加密密钥
-		prodioc.InitProviderEncryptKey,
+		prodioc.InitProviderKeyring,
+		newProviderRegistry,
 	)
 	templateSvcSet = wire.NewSet(
+		client.NewClients,
 		templatesvc.NewChannelTemplateService,
 		repository.NewChannelTemplateRepository,
 		dao.NewChannelTemplateDAO,
+		render.NewService,
+		wire.Bind(new(render.TemplateGetter), new(templatesvc.ChannelTemplateService)),
+		prodioc.InitTemplateModerator,
+		prodioc.InitSyncProviderAuditInfoTask,
+	)
+	inboxSvcSet = wire.NewSet(
+		inboxsvc.NewService,
+		repository.NewInboxRepository,
+		prodioc.InitInboxDAO,
+	)
+	receiptSvcSet = wire.NewSet(
+		receipt.NewService,
+		repository.NewSendReceiptRepository,
+		prodioc.InitSendReceiptDAO,
+		prodioc.InitSendReceiptSharding,
+		prodioc.InitReceiptReconcileTask,
+	)
+	replySvcSet = wire.NewSet(
+		replysvc.NewService,
+		repository.NewSmsReplyRepository,
+		dao.NewSmsReplyDAO,
+	)
+	auditSvcSet = wire.NewSet(
+		prodioc.InitKafkaProducer,
+		prodioc.InitAuditService,
+		repository.NewAuditRepository,
+		dao.NewAuditDAO,
+		auditsvc.NewEscalationTask,
+		prodioc.InitAuditResultConsumer,
 	)
 	schedulerSet = wire.NewSet(scheduler.NewScheduler)
 	quotaSvcSet  = wire.NewSet(
@@ -129,13 +170,19 @@ func newTaskPool() pool.TaskPool {
 	return p
 }
 
+// newProviderRegistry 测试使用固定的客户端，不注册渠道
+func newProviderRegistry(providerSvc providersvc.Service) *registry.Registry {
+	return registry.NewRegistry(providerSvc, 0)
+}
+
 func newChannel(
 	templateSvc templatesvc.ChannelTemplateService,
 	clients map[string]client.Client,
+	plugins *plugin.Manager,
 ) channel.Channel {
-	return channel.NewDispatcher(map[domain.Channel]channel.Channel{
+	return channel.NewDispatcherWithPlugins(map[domain.Channel]channel.Channel{
 		domain.ChannelSMS: channel.NewSMSChannel(newSMSSelectorBuilder(templateSvc, clients)),
-	})
+	}, plugins)
 }
 
 func newSMSSelectorBuilder(
@@ -179,11 +226,20 @@ func InitGrpcServer(clients map[string]client.Client) *testioc.App {
 		templateSvcSet,
 
 		// 审计服务
-		auditsvc.NewService,
+		auditSvcSet,
 
 		// 事务通知服务
 		txNotificationSvcSet,
 
+		// 站内信服务
+		inboxSvcSet,
+
+		// 回执对账服务
+		receiptSvcSet,
+
+		// 上行短信服务
+		replySvcSet,
+
 		// 调度器
 		schedulerSet,
 
@@ -192,6 +248,14 @@ func InitGrpcServer(clients map[string]client.Client) *testioc.App {
 
 		// GRPC服务器
 		grpcapi.NewServer,
+		grpcapi.NewInboxServer,
+		grpcapi.NewSmsReplyServer,
+		grpcapi.NewProviderServer,
+		prodioc.InitProviderTestSendService,
+		grpcapi.NewSandboxServer,
+		grpcapi.NewTemplateServer,
+		prodioc.InitSandboxConfig,
+		prodioc.InitSandboxService,
 		prodioc.InitGrpc,
 		prodioc.InitTasks,
 		prodioc.Crons,
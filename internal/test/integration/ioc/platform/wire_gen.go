@@ -7,8 +7,6 @@
 package ioc
 
 import (
-	"time"
-
 	"gitee.com/flycash/notification-platform/internal/api/grpc"
 	"gitee.com/flycash/notification-platform/internal/domain"
 	ioc2 "gitee.com/flycash/notification-platform/internal/ioc"
@@ -18,23 +16,30 @@ import (
 	"gitee.com/flycash/notification-platform/internal/repository/dao"
 	"gitee.com/flycash/notification-platform/internal/service/audit"
 	"gitee.com/flycash/notification-platform/internal/service/channel"
+	"gitee.com/flycash/notification-platform/internal/service/channel/plugin"
 	"gitee.com/flycash/notification-platform/internal/service/config"
+	"gitee.com/flycash/notification-platform/internal/service/inbox"
 	"gitee.com/flycash/notification-platform/internal/service/notification"
 	"gitee.com/flycash/notification-platform/internal/service/notification/callback"
 	"gitee.com/flycash/notification-platform/internal/service/provider"
 	"gitee.com/flycash/notification-platform/internal/service/provider/manage"
+	"gitee.com/flycash/notification-platform/internal/service/provider/registry"
 	"gitee.com/flycash/notification-platform/internal/service/provider/sequential"
 	"gitee.com/flycash/notification-platform/internal/service/provider/sms"
 	"gitee.com/flycash/notification-platform/internal/service/provider/sms/client"
 	"gitee.com/flycash/notification-platform/internal/service/quota"
+	"gitee.com/flycash/notification-platform/internal/service/receipt"
+	"gitee.com/flycash/notification-platform/internal/service/reply"
 	"gitee.com/flycash/notification-platform/internal/service/scheduler"
 	"gitee.com/flycash/notification-platform/internal/service/sender"
 	"gitee.com/flycash/notification-platform/internal/service/sendstrategy"
 	manage2 "gitee.com/flycash/notification-platform/internal/service/template/manage"
+	"gitee.com/flycash/notification-platform/internal/service/template/render"
 	"gitee.com/flycash/notification-platform/internal/test/ioc"
 	"github.com/ecodeclub/ekit/pool"
 	"github.com/google/wire"
 	"github.com/gotomicro/ego/core/econf"
+	"time"
 )
 
 // Injectors from wire.go:
@@ -45,15 +50,22 @@ func InitGrpcServer(clients map[string]client.Client) *ioc.App {
 	cmdable := ioc2.InitRedisCmd()
 	quotaCache := redis.NewQuotaCache(cmdable)
 	notificationRepository := repository.NewNotificationRepository(notificationDAO, quotaCache)
-	service := notification.NewNotificationService(notificationRepository)
+	receiverResultDAO := ioc2.InitReceiverResultDAO(v)
+	receiverResultRepository := repository.NewReceiverResultRepository(receiverResultDAO)
+	service := notification.NewNotificationService(notificationRepository, receiverResultRepository)
 	channelTemplateDAO := dao.NewChannelTemplateDAO(v)
 	channelTemplateRepository := repository.NewChannelTemplateRepository(channelTemplateDAO)
-	string2 := ioc2.InitProviderEncryptKey()
-	providerDAO := dao.NewProviderDAO(v, string2)
-	providerRepository := repository.NewProviderRepository(providerDAO)
+	providerDAO := dao.NewProviderDAO(v)
+	keyring := ioc2.InitProviderKeyring()
+	providerRepository := repository.NewProviderRepository(providerDAO, keyring)
 	manageService := manage.NewProviderService(providerRepository)
-	auditService := audit.NewService()
-	channelTemplateService := manage2.NewChannelTemplateService(channelTemplateRepository, manageService, auditService, clients)
+	auditDAO := dao.NewAuditDAO(v)
+	auditRepository := repository.NewAuditRepository(auditDAO)
+	producer := ioc2.InitKafkaProducer()
+	auditService := ioc2.InitAuditService(auditRepository, producer)
+	clientClients := client.NewClients(clients)
+	checker := ioc2.InitTemplateModerator()
+	channelTemplateService := manage2.NewChannelTemplateService(channelTemplateRepository, manageService, auditService, clientClients, checker)
 	businessConfigDAO := dao.NewBusinessConfigDAO(v)
 	redisClient := ioc2.InitRedisClient()
 	cache := ioc2.InitGoCache()
@@ -63,10 +75,15 @@ func InitGrpcServer(clients map[string]client.Client) *ioc.App {
 	businessConfigService := config.NewBusinessConfigService(businessConfigRepository)
 	callbackLogDAO := dao.NewCallbackLogDAO(v)
 	callbackLogRepository := repository.NewCallbackLogRepository(notificationRepository, callbackLogDAO)
-	callbackService := callback.NewService(businessConfigService, callbackLogRepository)
-	channel := newChannel(channelTemplateService, clients)
+	callbackService := callback.NewService(businessConfigService, callbackLogRepository, receiverResultRepository)
+	sendReceiptSharding := ioc2.InitSendReceiptSharding(v)
+	sendReceiptDAO := ioc2.InitSendReceiptDAO(sendReceiptSharding)
+	sendReceiptRepository := repository.NewSendReceiptRepository(sendReceiptDAO)
+	receiptService := receipt.NewService(sendReceiptRepository, notificationRepository, callbackService)
+	manager := ioc2.InitChannelPluginManager()
+	channel := newChannel(channelTemplateService, clients, manager)
 	taskPool := newTaskPool()
-	notificationSender := sender.NewSender(notificationRepository, businessConfigService, callbackService, channel, taskPool)
+	notificationSender := sender.NewSender(notificationRepository, receiverResultRepository, businessConfigService, callbackService, receiptService, channel, taskPool)
 	immediateSendStrategy := sendstrategy.NewImmediateStrategy(notificationRepository, notificationSender)
 	defaultSendStrategy := sendstrategy.NewDefaultStrategy(notificationRepository, businessConfigService)
 	sendStrategy := sendstrategy.NewDispatcher(immediateSendStrategy, defaultSendStrategy)
@@ -76,13 +93,34 @@ func InitGrpcServer(clients map[string]client.Client) *ioc.App {
 	dlockClient := ioc2.InitDistributedLock(redisClient)
 	txNotificationService := notification.NewTxNotificationService(txNotificationRepository, businessConfigService, notificationRepository, dlockClient, notificationSender)
 	notificationServer := grpc.NewServer(service, sendService, txNotificationService, channelTemplateService)
+	inboxDAO := ioc2.InitInboxDAO(v)
+	inboxRepository := repository.NewInboxRepository(inboxDAO)
+	inboxService := inbox.NewService(inboxRepository)
+	inboxServer := grpc.NewInboxServer(inboxService)
+	smsReplyDAO := dao.NewSmsReplyDAO(v)
+	smsReplyRepository := repository.NewSmsReplyRepository(smsReplyDAO)
+	replyService := reply.NewService(smsReplyRepository, sendReceiptRepository, notificationRepository)
+	smsReplyServer := grpc.NewSmsReplyServer(replyService)
+	registry := newProviderRegistry(manageService)
+	testsendService := ioc2.InitProviderTestSendService(manageService, registry)
+	providerServer := grpc.NewProviderServer(manageService, testsendService)
+	sandboxConfig := ioc2.InitSandboxConfig()
+	sandboxService := ioc2.InitSandboxService(sandboxConfig, cmdable)
+	sandboxServer := grpc.NewSandboxServer(sandboxService)
+	renderService := render.NewService(channelTemplateService)
+	templateServer := grpc.NewTemplateServer(renderService, channelTemplateService, businessConfigService)
 	component := ioc2.InitEtcdClient()
-	egrpcComponent := ioc2.InitGrpc(notificationServer, component)
+	egrpcComponent := ioc2.InitGrpc(notificationServer, inboxServer, smsReplyServer, providerServer, sandboxServer, templateServer, component)
 	asyncRequestResultCallbackTask := callback.NewAsyncRequestResultCallbackTask(dlockClient, callbackService)
 	notificationScheduler := scheduler.NewScheduler(service, notificationSender, dlockClient)
 	sendingTimeoutTask := notification.NewSendingTimeoutTask(dlockClient, notificationRepository)
 	txCheckTask := notification.NewTxCheckTask(txNotificationRepository, businessConfigService, dlockClient)
-	v2 := ioc2.InitTasks(asyncRequestResultCallbackTask, notificationScheduler, sendingTimeoutTask, txCheckTask)
+	reconcileTask := ioc2.InitReceiptReconcileTask(sendReceiptSharding, dlockClient, sendReceiptRepository, receiptService, clientClients)
+	syncer := ioc2.InitChannelPluginSyncer(component, manager)
+	escalationTask := audit.NewEscalationTask(dlockClient, auditService)
+	auditResultConsumer := ioc2.InitAuditResultConsumer(channelTemplateService)
+	syncProviderAuditInfoTask := ioc2.InitSyncProviderAuditInfoTask(dlockClient, channelTemplateService)
+	v2 := ioc2.InitTasks(asyncRequestResultCallbackTask, notificationScheduler, sendingTimeoutTask, txCheckTask, reconcileTask, registry, syncer, escalationTask, auditResultConsumer, syncProviderAuditInfoTask)
 	quotaDAO := dao.NewQuotaDAO(v)
 	quotaRepository := repository.NewQuotaRepository(quotaDAO)
 	quotaService := quota.NewService(quotaRepository)
@@ -116,16 +154,19 @@ func InitGrpcServer(clients map[string]client.Client) *ioc.App {
 var (
 	BaseSet              = wire.NewSet(ioc2.InitDB, ioc2.InitDistributedLock, ioc2.InitEtcdClient, ioc2.InitIDGenerator, ioc2.InitRedisClient, ioc2.InitGoCache, ioc2.InitRedisCmd, local.NewLocalCache, redis.NewCache)
 	configSvcSet         = wire.NewSet(config.NewBusinessConfigService, repository.NewBusinessConfigRepository, dao.NewBusinessConfigDAO)
-	notificationSvcSet   = wire.NewSet(redis.NewQuotaCache, notification.NewNotificationService, repository.NewNotificationRepository, dao.NewNotificationDAO, notification.NewSendingTimeoutTask)
+	notificationSvcSet   = wire.NewSet(redis.NewQuotaCache, notification.NewNotificationService, repository.NewNotificationRepository, dao.NewNotificationDAO, repository.NewReceiverResultRepository, ioc2.InitReceiverResultDAO, notification.NewSendingTimeoutTask)
 	txNotificationSvcSet = wire.NewSet(notification.NewTxNotificationService, repository.NewTxNotificationRepository, dao.NewTxNotificationDAO, notification.NewTxCheckTask)
-	senderSvcSet         = wire.NewSet(
-		newChannel,
+	senderSvcSet         = wire.NewSet(ioc2.InitChannelPluginManager, ioc2.InitChannelPluginSyncer, newChannel,
 		newTaskPool, sender.NewSender,
 	)
 	sendNotificationSvcSet = wire.NewSet(notification.NewSendService, sendstrategy.NewDispatcher, sendstrategy.NewImmediateStrategy, sendstrategy.NewDefaultStrategy)
 	callbackSvcSet         = wire.NewSet(callback.NewService, repository.NewCallbackLogRepository, dao.NewCallbackLogDAO, callback.NewAsyncRequestResultCallbackTask)
-	providerSvcSet         = wire.NewSet(manage.NewProviderService, repository.NewProviderRepository, dao.NewProviderDAO, ioc2.InitProviderEncryptKey)
-	templateSvcSet         = wire.NewSet(manage2.NewChannelTemplateService, repository.NewChannelTemplateRepository, dao.NewChannelTemplateDAO)
+	providerSvcSet         = wire.NewSet(manage.NewProviderService, repository.NewProviderRepository, dao.NewProviderDAO, ioc2.InitProviderKeyring, newProviderRegistry)
+	templateSvcSet         = wire.NewSet(client.NewClients, manage2.NewChannelTemplateService, repository.NewChannelTemplateRepository, dao.NewChannelTemplateDAO, render.NewService, wire.Bind(new(render.TemplateGetter), new(manage2.ChannelTemplateService)), ioc2.InitTemplateModerator, ioc2.InitSyncProviderAuditInfoTask)
+	inboxSvcSet            = wire.NewSet(inbox.NewService, repository.NewInboxRepository, ioc2.InitInboxDAO)
+	receiptSvcSet          = wire.NewSet(receipt.NewService, repository.NewSendReceiptRepository, ioc2.InitSendReceiptDAO, ioc2.InitSendReceiptSharding, ioc2.InitReceiptReconcileTask)
+	replySvcSet            = wire.NewSet(reply.NewService, repository.NewSmsReplyRepository, dao.NewSmsReplyDAO)
+	auditSvcSet            = wire.NewSet(ioc2.InitKafkaProducer, ioc2.InitAuditService, repository.NewAuditRepository, dao.NewAuditDAO, audit.NewEscalationTask, ioc2.InitAuditResultConsumer)
 	schedulerSet           = wire.NewSet(scheduler.NewScheduler)
 	quotaSvcSet            = wire.NewSet(quota.NewService, quota.NewQuotaMonthlyResetCron, repository.NewQuotaRepository, dao.NewQuotaDAO)
 )
@@ -154,17 +195,24 @@ func newTaskPool() pool.TaskPool {
 	return p
 }
 
+// newProviderRegistry 测试使用固定的客户端，不注册渠道
+func newProviderRegistry(providerSvc manage.Service) *registry.Registry {
+	return registry.NewRegistry(providerSvc, 0)
+}
+
 func newChannel(
 	templateSvc manage2.ChannelTemplateService,
 	clients map[string]client.Client,
+	plugins *plugin.Manager,
 ) channel.Channel {
-	return channel.NewDispatcher(map[domain.Channel]channel.Channel{domain.ChannelSMS: channel.NewSMSChannel(newSMSSelectorBuilder(templateSvc, clients))})
+	return channel.NewDispatcherWithPlugins(map[domain.Channel]channel.Channel{domain.ChannelSMS: channel.NewSMSChannel(newSMSSelectorBuilder(templateSvc, clients))}, plugins)
 }
 
 func newSMSSelectorBuilder(
 	templateSvc manage2.ChannelTemplateService,
 	clients map[string]client.Client,
 ) *sequential.SelectorBuilder {
+
 	providers := make([]provider.Provider, 0, len(clients))
 	for k := range clients {
 		providers = append(providers, sms.NewSMSProvider(
@@ -16,10 +16,10 @@ import (
 // Injectors from wire.go:
 
 func Init() manage.Service {
-	db := ioc.InitDBAndTables()
-	string2 := ioc.InitProviderEncryptKey()
-	providerDAO := dao.NewProviderDAO(db, string2)
-	providerRepository := repository.NewProviderRepository(providerDAO)
+	v := ioc.InitDBAndTables()
+	providerDAO := dao.NewProviderDAO(v)
+	keyring := ioc.InitProviderKeyring()
+	providerRepository := repository.NewProviderRepository(providerDAO, keyring)
 	service := manage.NewProviderService(providerRepository)
 	return service
 }
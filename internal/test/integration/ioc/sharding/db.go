@@ -24,6 +24,14 @@ func InitTxnSharding() (notificationStrategy, txnStrategy sharding.ShardingStrat
 	return sharding.NewShardingStrategy("notification", "notification", testTableNum, testDBNum), sharding.NewShardingStrategy("notification", "tx_notification", testTableNum, testDBNum)
 }
 
+func InitInboxSharding() sharding.ShardingStrategy {
+	return sharding.NewShardingStrategy("notification", "inbox", testTableNum, testDBNum)
+}
+
+func InitSendReceiptSharding() sharding.ShardingStrategy {
+	return sharding.NewShardingStrategy("notification", "send_receipt", testTableNum, testDBNum)
+}
+
 // Use a singleton pattern with sync.Once to prevent data races
 var (
 	once sync.Once
@@ -13,6 +13,7 @@ import (
 	providersvc "gitee.com/flycash/notification-platform/internal/service/provider/manage"
 	"gitee.com/flycash/notification-platform/internal/service/provider/sms/client"
 	templatesvc "gitee.com/flycash/notification-platform/internal/service/template/manage"
+	"gitee.com/flycash/notification-platform/internal/service/template/moderation"
 	testioc "gitee.com/flycash/notification-platform/internal/test/ioc"
 	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
 	"github.com/google/wire"
@@ -29,6 +30,7 @@ func Init(
 	providerSvc providersvc.Service,
 	auditSvc auditsvc.Service,
 	clients map[string]client.Client,
+	moderator moderation.Checker,
 	producer *kafka.Producer,
 	consumer *kafka.Consumer,
 	batchSize int,
@@ -36,6 +38,7 @@ func Init(
 ) (*Service, error) {
 	wire.Build(
 		testioc.BaseSet,
+		client.NewClients,
 		templatesvc.NewChannelTemplateService,
 		repository.NewChannelTemplateRepository,
 		dao.NewChannelTemplateDAO,
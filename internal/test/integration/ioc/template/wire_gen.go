@@ -17,17 +17,19 @@ import (
 	"gitee.com/flycash/notification-platform/internal/service/provider/manage"
 	"gitee.com/flycash/notification-platform/internal/service/provider/sms/client"
 	manage2 "gitee.com/flycash/notification-platform/internal/service/template/manage"
+	"gitee.com/flycash/notification-platform/internal/service/template/moderation"
 	"gitee.com/flycash/notification-platform/internal/test/ioc"
 	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
 )
 
 // Injectors from wire.go:
 
-func Init(providerSvc manage.Service, auditSvc audit.Service, clients map[string]client.Client, producer *kafka.Producer, consumer *kafka.Consumer, batchSize int, batchTimeout time.Duration) (*Service, error) {
+func Init(providerSvc manage.Service, auditSvc audit.Service, clients map[string]client.Client, moderator moderation.Checker, producer *kafka.Producer, consumer *kafka.Consumer, batchSize int, batchTimeout time.Duration) (*Service, error) {
 	db := ioc.InitDBAndTables()
 	channelTemplateDAO := dao.NewChannelTemplateDAO(db)
 	channelTemplateRepository := repository.NewChannelTemplateRepository(channelTemplateDAO)
-	channelTemplateService := manage2.NewChannelTemplateService(channelTemplateRepository, providerSvc, auditSvc, clients)
+	clientClients := client.NewClients(clients)
+	channelTemplateService := manage2.NewChannelTemplateService(channelTemplateRepository, providerSvc, auditSvc, clientClients, moderator)
 	auditResultConsumer, err := template.NewAuditResultConsumer(channelTemplateService, consumer, batchSize, batchTimeout)
 	if err != nil {
 		return nil, err
@@ -9,6 +9,8 @@ import (
 
 	"gitee.com/flycash/notification-platform/internal/domain"
 	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/pkg/secret"
+	"gitee.com/flycash/notification-platform/internal/repository/dao"
 	providersvc "gitee.com/flycash/notification-platform/internal/service/provider/manage"
 	providerioc "gitee.com/flycash/notification-platform/internal/test/integration/ioc/provider"
 	testioc "gitee.com/flycash/notification-platform/internal/test/ioc"
@@ -66,6 +68,27 @@ func (s *ProviderServiceTestSuite) TestCreate() {
 	s.assertProvider(t, provider, created)
 }
 
+func (s *ProviderServiceTestSuite) TestSecretsEncryptedAtRest() {
+	t := s.T()
+
+	provider := s.createTestProvider(domain.ChannelSMS)
+	created, err := s.svc.Create(t.Context(), provider)
+	require.NoError(t, err)
+
+	// 数据库中只保存密文
+	var entity dao.Provider
+	require.NoError(t, s.db.WithContext(t.Context()).Where("id = ?", created.ID).First(&entity).Error)
+	assert.True(t, secret.IsEnvelope(entity.APIKey))
+	assert.True(t, secret.IsEnvelope(entity.APISecret))
+	assert.NotContains(t, entity.APIKey, provider.APIKey)
+	assert.NotContains(t, entity.APISecret, provider.APISecret)
+
+	// 读取时透明解密
+	found, err := s.svc.GetByID(t.Context(), created.ID)
+	require.NoError(t, err)
+	s.assertProvider(t, provider, found)
+}
+
 func (s *ProviderServiceTestSuite) TestCreateFailed() {
 	t := s.T()
 
@@ -200,6 +223,17 @@ func (s *ProviderServiceTestSuite) TestUpdate() {
 	require.NoError(t, err)
 
 	s.assertProvider(t, created, updated)
+
+	// 密钥为空时保留原来的密钥
+	keep := updated
+	keep.APIKey, keep.APISecret = "", ""
+	keep.Weight = 300
+	require.NoError(t, s.svc.Update(t.Context(), keep))
+	kept, err := s.svc.GetByID(t.Context(), created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "new-api-key", kept.APIKey)
+	assert.Equal(t, "new-api-secret", kept.APISecret)
+	assert.Equal(t, 300, kept.Weight)
 }
 
 // 测试更新供应商失败的情况
@@ -250,10 +284,10 @@ func (s *ProviderServiceTestSuite) TestUpdateFailed() {
 			},
 		},
 		{
-			name: "API Key为空",
+			name: "API Key为脱敏值",
 			provider: func() domain.Provider {
 				p := created
-				p.APIKey = ""
+				p.APIKey = created.Masked().APIKey
 				return p
 			}(),
 			assertErrFunc: func(t assert.TestingT, err error, i ...interface{}) bool {
@@ -261,10 +295,10 @@ func (s *ProviderServiceTestSuite) TestUpdateFailed() {
 			},
 		},
 		{
-			name: "API Secret为空",
+			name: "API Secret为脱敏值",
 			provider: func() domain.Provider {
 				p := created
-				p.APISecret = ""
+				p.APISecret = created.Masked().APISecret
 				return p
 			}(),
 			assertErrFunc: func(t assert.TestingT, err error, i ...interface{}) bool {
@@ -459,3 +493,72 @@ func (s *ProviderServiceTestSuite) assertProvider(t *testing.T, expected, actual
 	assert.Equal(t, expected.AuditCallbackURL, actual.AuditCallbackURL)
 	assert.Equal(t, expected.Status, actual.Status)
 }
+
+func (s *ProviderServiceTestSuite) TestList() {
+	t := s.T()
+
+	_, err := s.svc.Create(t.Context(), s.createTestProvider(domain.ChannelSMS))
+	require.NoError(t, err)
+	email, err := s.svc.Create(t.Context(), s.createTestProvider(domain.ChannelEmail))
+	require.NoError(t, err)
+	require.NoError(t, s.svc.Deactivate(t.Context(), email.ID))
+
+	// 包括禁用的供应商
+	all, err := s.svc.List(t.Context(), "")
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+
+	emails, err := s.svc.List(t.Context(), domain.ChannelEmail)
+	require.NoError(t, err)
+	require.Len(t, emails, 1)
+	assert.Equal(t, domain.ProviderStatusInactive, emails[0].Status)
+
+	// 禁用的供应商不参与发送
+	active, err := s.svc.GetByChannel(t.Context(), domain.ChannelEmail)
+	require.NoError(t, err)
+	assert.Empty(t, active)
+
+	_, err = s.svc.List(t.Context(), "UNKNOWN")
+	assert.ErrorIs(t, err, errs.ErrInvalidParameter)
+}
+
+func (s *ProviderServiceTestSuite) TestPartialUpdate() {
+	t := s.T()
+
+	created, err := s.svc.Create(t.Context(), s.createTestProvider(domain.ChannelSMS))
+	require.NoError(t, err)
+
+	require.NoError(t, s.svc.Deactivate(t.Context(), created.ID))
+	require.NoError(t, s.svc.UpdateWeight(t.Context(), created.ID, 5))
+	require.NoError(t, s.svc.UpdateLimits(t.Context(), created.ID, 10, 1000))
+
+	found, err := s.svc.GetByID(t.Context(), created.ID)
+	require.NoError(t, err)
+	expected := created
+	expected.Status = domain.ProviderStatusInactive
+	expected.Weight = 5
+	expected.QPSLimit = 10
+	expected.DailyLimit = 1000
+	s.assertProvider(t, expected, found)
+
+	require.NoError(t, s.svc.Activate(t.Context(), created.ID))
+	found, err = s.svc.GetByID(t.Context(), created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ProviderStatusActive, found.Status)
+
+	assert.ErrorIs(t, s.svc.UpdateWeight(t.Context(), created.ID, 0), errs.ErrInvalidParameter)
+	assert.ErrorIs(t, s.svc.UpdateLimits(t.Context(), created.ID, 10, 0), errs.ErrInvalidParameter)
+	assert.ErrorIs(t, s.svc.Activate(t.Context(), created.ID+100), errs.ErrProviderNotFound)
+}
+
+func (s *ProviderServiceTestSuite) TestDelete() {
+	t := s.T()
+
+	created, err := s.svc.Create(t.Context(), s.createTestProvider(domain.ChannelSMS))
+	require.NoError(t, err)
+
+	require.NoError(t, s.svc.Delete(t.Context(), created.ID))
+	_, err = s.svc.GetByID(t.Context(), created.ID)
+	assert.ErrorIs(t, err, errs.ErrProviderNotFound)
+	assert.ErrorIs(t, s.svc.Delete(t.Context(), created.ID), errs.ErrProviderNotFound)
+}
@@ -0,0 +1,138 @@
+//go:build e2e
+
+package integration
+
+import (
+	"fmt"
+	"testing"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	idgen "gitee.com/flycash/notification-platform/internal/pkg/id_generator"
+	sharding2 "gitee.com/flycash/notification-platform/internal/pkg/sharding"
+	"gitee.com/flycash/notification-platform/internal/repository/dao"
+	"gitee.com/flycash/notification-platform/internal/repository/dao/sharding"
+	shardingIoc "gitee.com/flycash/notification-platform/internal/test/integration/ioc/sharding"
+	"github.com/ecodeclub/ekit/syncx"
+	"github.com/ego-component/egorm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type ShardingInboxSuite struct {
+	suite.Suite
+	dbs      *syncx.Map[string, *egorm.Component]
+	inboxDAO *sharding.InboxShardingDAO
+	strategy sharding2.ShardingStrategy
+}
+
+func (s *ShardingInboxSuite) SetupSuite() {
+	s.dbs = shardingIoc.InitDbs()
+	s.strategy = shardingIoc.InitInboxSharding()
+	s.inboxDAO = sharding.NewInboxShardingDAO(s.dbs, s.strategy, idgen.NewGenerator())
+}
+
+func (s *ShardingInboxSuite) TearDownTest() {
+	s.T().Helper()
+	s.dbs.Range(func(_ string, db *gorm.DB) bool {
+		for _, table := range []string{"inbox_0", "inbox_1"} {
+			require.NoError(s.T(), db.Exec(fmt.Sprintf("truncate table `%s`", table)).Error)
+		}
+		return true
+	})
+}
+
+func (s *ShardingInboxSuite) TestBatchCreate() {
+	t := s.T()
+	const bizID = int64(1001)
+	users := []string{"user-1", "user-2", "user-3", "user-4"}
+	msgs := make([]dao.InboxMessage, 0, len(users))
+	for _, u := range users {
+		msgs = append(msgs, dao.InboxMessage{
+			BizID:          bizID,
+			UserID:         u,
+			NotificationID: 1,
+			Title:          "标题",
+			Content:        "内容",
+		})
+	}
+	require.NoError(t, s.inboxDAO.BatchCreate(t.Context(), msgs))
+	// 重复投递被忽略
+	require.NoError(t, s.inboxDAO.BatchCreate(t.Context(), msgs))
+
+	for _, u := range users {
+		// 每个用户的站内信都落在分片规则计算出的表中
+		dst := s.strategy.Shard(bizID, u)
+		db, ok := s.dbs.Load(dst.DB)
+		require.True(t, ok)
+		var actual []dao.InboxMessage
+		require.NoError(t, db.Table(dst.Table).Where("biz_id = ? AND user_id = ?", bizID, u).Find(&actual).Error)
+		require.Len(t, actual, 1)
+		assert.Equal(t, domain.InboxMessageStatusUnread.String(), actual[0].Status)
+		assert.Equal(t, dst, s.strategy.ShardWithID(actual[0].ID))
+	}
+}
+
+func (s *ShardingInboxSuite) TestListAndMark() {
+	t := s.T()
+	const (
+		bizID  = int64(1002)
+		userID = "user-list"
+	)
+	const total = 5
+	msgs := make([]dao.InboxMessage, 0, total)
+	for i := 0; i < total; i++ {
+		msgs = append(msgs, dao.InboxMessage{
+			BizID:          bizID,
+			UserID:         userID,
+			NotificationID: uint64(i + 1),
+			Content:        fmt.Sprintf("内容%d", i),
+		})
+	}
+	require.NoError(t, s.inboxDAO.BatchCreate(t.Context(), msgs))
+
+	// 游标分页
+	first, err := s.inboxDAO.List(t.Context(), bizID, userID, 0, 3, false)
+	require.NoError(t, err)
+	require.Len(t, first, 3)
+	second, err := s.inboxDAO.List(t.Context(), bizID, userID, first[len(first)-1].ID, 3, false)
+	require.NoError(t, err)
+	require.Len(t, second, 2)
+	assert.Greater(t, first[len(first)-1].ID, second[0].ID)
+
+	cnt, err := s.inboxDAO.CountUnread(t.Context(), bizID, userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(total), cnt)
+
+	affected, err := s.inboxDAO.MarkRead(t.Context(), bizID, userID, []int64{first[0].ID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), affected)
+
+	affected, err = s.inboxDAO.Archive(t.Context(), bizID, userID, []int64{first[1].ID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), affected)
+	archived, err := s.inboxDAO.List(t.Context(), bizID, userID, 0, 10, true)
+	require.NoError(t, err)
+	require.Len(t, archived, 1)
+	assert.Equal(t, first[1].ID, archived[0].ID)
+
+	// 归档的站内信不计入未读数，也不会被全部已读影响
+	affected, err = s.inboxDAO.MarkAllRead(t.Context(), bizID, userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(total-2), affected)
+	cnt, err = s.inboxDAO.CountUnread(t.Context(), bizID, userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), cnt)
+
+	affected, err = s.inboxDAO.Delete(t.Context(), bizID, userID, []int64{first[2].ID, second[0].ID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), affected)
+	left, err := s.inboxDAO.List(t.Context(), bizID, userID, 0, 10, false)
+	require.NoError(t, err)
+	assert.Len(t, left, 2)
+}
+
+func TestShardingInboxSuite(t *testing.T) {
+	suite.Run(t, new(ShardingInboxSuite))
+}
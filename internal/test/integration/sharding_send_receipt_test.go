@@ -0,0 +1,153 @@
+//go:build e2e
+
+package integration
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	idgen "gitee.com/flycash/notification-platform/internal/pkg/id_generator"
+	sharding2 "gitee.com/flycash/notification-platform/internal/pkg/sharding"
+	"gitee.com/flycash/notification-platform/internal/repository/dao"
+	"gitee.com/flycash/notification-platform/internal/repository/dao/sharding"
+	shardingIoc "gitee.com/flycash/notification-platform/internal/test/integration/ioc/sharding"
+	"github.com/ecodeclub/ekit/syncx"
+	"github.com/ego-component/egorm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type ShardingSendReceiptSuite struct {
+	suite.Suite
+	dbs        *syncx.Map[string, *egorm.Component]
+	receiptDAO *sharding.SendReceiptShardingDAO
+	strategy   sharding2.ShardingStrategy
+}
+
+func (s *ShardingSendReceiptSuite) SetupSuite() {
+	s.dbs = shardingIoc.InitDbs()
+	s.strategy = shardingIoc.InitSendReceiptSharding()
+	s.receiptDAO = sharding.NewSendReceiptShardingDAO(s.dbs, s.strategy)
+}
+
+func (s *ShardingSendReceiptSuite) TearDownTest() {
+	s.T().Helper()
+	s.dbs.Range(func(_ string, db *gorm.DB) bool {
+		for _, table := range []string{"send_receipt_0", "send_receipt_1"} {
+			require.NoError(s.T(), db.Exec(fmt.Sprintf("truncate table `%s`", table)).Error)
+		}
+		return true
+	})
+}
+
+func (s *ShardingSendReceiptSuite) TestBatchCreateAndFind() {
+	t := s.T()
+	gen := idgen.NewGenerator()
+	notificationID := uint64(gen.GenerateID(1001, "receipt-key-1"))
+	receivers := []string{"13800138000", "13800138001"}
+	receipts := make([]dao.SendReceipt, 0, len(receivers))
+	for _, r := range receivers {
+		receipts = append(receipts, dao.SendReceipt{
+			NotificationID: notificationID,
+			Provider:       "aliyun",
+			Receiver:       r,
+			RequestID:      "request-id",
+			ReceiptID:      "biz-id",
+		})
+	}
+	require.NoError(t, s.receiptDAO.BatchCreate(t.Context(), receipts))
+	// 重试发送时重复写入被忽略
+	require.NoError(t, s.receiptDAO.BatchCreate(t.Context(), receipts))
+
+	// 回执与通知落在同一张分表对应的回执表中
+	dst := s.strategy.ShardWithID(int64(notificationID))
+	db, ok := s.dbs.Load(dst.DB)
+	require.True(t, ok)
+	var cnt int64
+	require.NoError(t, db.Table(dst.Table).Where("notification_id = ?", notificationID).Count(&cnt).Error)
+	assert.Equal(t, int64(len(receivers)), cnt)
+
+	found, err := s.receiptDAO.FindByNotificationID(t.Context(), notificationID)
+	require.NoError(t, err)
+	require.Len(t, found, len(receivers))
+	for i := range found {
+		assert.Equal(t, domain.SendReceiptStatusPending.String(), found[i].Status)
+	}
+}
+
+func (s *ShardingSendReceiptSuite) TestFindPendingAndUpdate() {
+	t := s.T()
+	gen := idgen.NewGenerator()
+	notificationID := uint64(gen.GenerateID(1002, "receipt-key-2"))
+	now := time.Now().UnixMilli()
+	require.NoError(t, s.receiptDAO.BatchCreate(t.Context(), []dao.SendReceipt{
+		{NotificationID: notificationID, Provider: "aliyun", Receiver: "13800138000", ReceiptID: "biz-id", NextQueryTime: now - 1000},
+		{NotificationID: notificationID, Provider: "aliyun", Receiver: "13800138001", ReceiptID: "biz-id", NextQueryTime: now + 60000},
+	}))
+
+	dst := s.strategy.ShardWithID(int64(notificationID))
+	// 没有 Dst 时无法确定查询哪张表
+	_, err := s.receiptDAO.FindPending(t.Context(), now, 10)
+	assert.Error(t, err)
+
+	ctx := sharding2.CtxWithDst(t.Context(), dst)
+	pending, err := s.receiptDAO.FindPending(ctx, now, 10)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, "13800138000", pending[0].Receiver)
+
+	receipt := pending[0]
+	receipt.Status = domain.SendReceiptStatusDelivered.String()
+	receipt.QueryCount = 1
+	updated, err := s.receiptDAO.Update(t.Context(), receipt)
+	require.NoError(t, err)
+	assert.True(t, updated)
+
+	// 已有结果的回执不会被再次更新
+	receipt.Status = domain.SendReceiptStatusUndelivered.String()
+	updated, err = s.receiptDAO.Update(t.Context(), receipt)
+	require.NoError(t, err)
+	assert.False(t, updated)
+
+	pending, err = s.receiptDAO.FindPending(ctx, now, 10)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+}
+
+func (s *ShardingSendReceiptSuite) TestFindByReceiptIDAndLatestByReceiver() {
+	t := s.T()
+	gen := idgen.NewGenerator()
+	first := uint64(gen.GenerateID(1003, "receipt-key-3"))
+	require.NoError(t, s.receiptDAO.BatchCreate(t.Context(), []dao.SendReceipt{
+		{NotificationID: first, Provider: "aliyun", Receiver: "13800138000", ReceiptID: "biz-id-1"},
+	}))
+	time.Sleep(time.Millisecond * 5)
+	second := uint64(gen.GenerateID(1004, "receipt-key-4"))
+	require.NoError(t, s.receiptDAO.BatchCreate(t.Context(), []dao.SendReceipt{
+		{NotificationID: second, Provider: "aliyun", Receiver: "13800138000", ReceiptID: "biz-id-2"},
+	}))
+
+	found, err := s.receiptDAO.FindByReceiptID(t.Context(), "aliyun", "biz-id-1", "13800138000")
+	require.NoError(t, err)
+	assert.Equal(t, first, found.NotificationID)
+
+	_, err = s.receiptDAO.FindByReceiptID(t.Context(), "tencentcloud", "biz-id-1", "13800138000")
+	assert.ErrorIs(t, err, errs.ErrSendReceiptNotFound)
+
+	// 不论落在哪张分表，都返回最近一次发送的回执
+	latest, err := s.receiptDAO.FindLatestByReceiver(t.Context(), "aliyun", "13800138000")
+	require.NoError(t, err)
+	assert.Equal(t, second, latest.NotificationID)
+
+	_, err = s.receiptDAO.FindLatestByReceiver(t.Context(), "aliyun", "13800138009")
+	assert.ErrorIs(t, err, errs.ErrSendReceiptNotFound)
+}
+
+func TestShardingSendReceiptSuite(t *testing.T) {
+	suite.Run(t, new(ShardingSendReceiptSuite))
+}
@@ -10,11 +10,21 @@ import (
 	"time"
 
 	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
 	auditevt "gitee.com/flycash/notification-platform/internal/event/audit"
+	"gitee.com/flycash/notification-platform/internal/repository"
+	"gitee.com/flycash/notification-platform/internal/repository/cache/local"
+	"gitee.com/flycash/notification-platform/internal/repository/dao"
 	auditmocks "gitee.com/flycash/notification-platform/internal/service/audit/mocks"
+	"gitee.com/flycash/notification-platform/internal/service/channel"
+	"gitee.com/flycash/notification-platform/internal/service/provider"
 	providermocks "gitee.com/flycash/notification-platform/internal/service/provider/mocks"
+	"gitee.com/flycash/notification-platform/internal/service/provider/sandbox"
+	"gitee.com/flycash/notification-platform/internal/service/provider/sequential"
 	"gitee.com/flycash/notification-platform/internal/service/provider/sms/client"
 	smsmocks "gitee.com/flycash/notification-platform/internal/service/provider/sms/client/mocks"
+	"gitee.com/flycash/notification-platform/internal/service/template/moderation"
+	"gitee.com/flycash/notification-platform/internal/service/template/render"
 	"gitee.com/flycash/notification-platform/internal/test"
 	templateioc "gitee.com/flycash/notification-platform/internal/test/integration/ioc/template"
 	testioc "gitee.com/flycash/notification-platform/internal/test/ioc"
@@ -33,8 +43,21 @@ import (
 const (
 	ownerID   = int64(234)
 	ownerType = "person"
+	// templateAdminToken 修改类接口的访问令牌
+	templateAdminToken = "template-admin-token"
+	// templateOperatorToken 发布和回滚接口的操作人访问令牌，对应的操作人ID为 templateOperatorID
+	templateOperatorToken = "template-operator-token"
+	templateOperatorID    = int64(3)
 )
 
+// authenticateOperator 只认 templateOperatorToken
+func authenticateOperator(_ context.Context, token string) (int64, error) {
+	if token != templateOperatorToken {
+		return 0, errs.ErrInvalidReviewerToken
+	}
+	return templateOperatorID, nil
+}
+
 func TestTemplateHandlerTestSuite(t *testing.T) {
 	t.Skip()
 	suite.Run(t, new(TemplateHandlerTestSuite))
@@ -51,6 +74,11 @@ func (s *TemplateHandlerTestSuite) SetupSuite() {
 }
 
 func (s *TemplateHandlerTestSuite) newService(ctrl *gomock.Controller) (templateSvc *templateioc.Service, providerSvc *providermocks.MockService, auditSvc *auditmocks.MockService, clients map[string]client.Client) {
+	// 默认版本使用的是占位签名，其他用例不关心审核前检查
+	return s.newServiceWithModerator(ctrl, moderation.NewChain())
+}
+
+func (s *TemplateHandlerTestSuite) newServiceWithModerator(ctrl *gomock.Controller, moderator moderation.Checker) (templateSvc *templateioc.Service, providerSvc *providermocks.MockService, auditSvc *auditmocks.MockService, clients map[string]client.Client) {
 	mockProviderSvc := providermocks.NewMockService(ctrl)
 	mockAuditSvc := auditmocks.NewMockService(ctrl)
 	mockClient1 := smsmocks.NewMockClient(ctrl)
@@ -78,7 +106,7 @@ func (s *TemplateHandlerTestSuite) newService(ctrl *gomock.Controller) (template
 	})
 	s.NoError(err)
 
-	svc, err := templateioc.Init(mockProviderSvc, mockAuditSvc, clients, producer, consumer, 10, 5*time.Second)
+	svc, err := templateioc.Init(mockProviderSvc, mockAuditSvc, clients, moderator, producer, consumer, 10, 5*time.Second)
 	s.NoError(err)
 	return svc, mockProviderSvc, mockAuditSvc, clients
 }
@@ -122,6 +150,8 @@ func (s *TemplateHandlerTestSuite) TearDownSuite() {
 	s.NoError(err)
 	err = s.db.Exec("DROP TABLE `channel_template_providers`").Error
 	s.NoError(err)
+	err = s.db.Exec("DROP TABLE `channel_template_publish_records`").Error
+	s.NoError(err)
 }
 
 func (s *TemplateHandlerTestSuite) TearDownTest() {
@@ -131,12 +161,15 @@ func (s *TemplateHandlerTestSuite) TearDownTest() {
 	s.NoError(err)
 	err = s.db.Exec("TRUNCATE TABLE `channel_template_providers`").Error
 	s.NoError(err)
+	err = s.db.Exec("TRUNCATE TABLE `channel_template_publish_records`").Error
+	s.NoError(err)
 }
 
 func (s *TemplateHandlerTestSuite) newGinServer(handler *templateweb.Handler) *egin.Component {
 	econf.Set("server", map[string]any{"contextTimeout": "1s"})
 	server := egin.Load("server").Build()
 	handler.PublicRoutes(server.Engine)
+	handler.PrivateRoutes(server.Engine)
 	return server
 }
 
@@ -182,7 +215,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_ListTemplates() {
 				})
 				require.NoError(t, err)
 
-				handler := templateweb.NewHandler(svc.Svc)
+				handler := templateweb.NewHandler(svc.Svc, render.NewService(svc.Svc), templateAdminToken, authenticateOperator)
 				return handler
 			},
 			req: templateweb.ListTemplatesReq{
@@ -212,12 +245,14 @@ func (s *TemplateHandlerTestSuite) TestHandler_ListTemplates() {
 											ProviderID:      1,
 											ProviderName:    "mock-provider-name-1",
 											ProviderChannel: domain.ChannelSMS.String(),
+											Region:          domain.TemplateRegionDomestic.String(),
 											AuditStatus:     domain.AuditStatusPending.String(),
 										},
 										{
 											ProviderID:      2,
 											ProviderName:    "mock-provider-name-2",
 											ProviderChannel: domain.ChannelSMS.String(),
+											Region:          domain.TemplateRegionDomestic.String(),
 											AuditStatus:     domain.AuditStatusPending.String(),
 										},
 									},
@@ -238,6 +273,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_ListTemplates() {
 				"/templates/list", iox.NewJSONReader(tc.req))
 
 			req.Header.Set("content-type", "application/json")
+			req.Header.Set("Authorization", "Bearer "+templateAdminToken)
 			require.NoError(t, err)
 
 			recorder := test.NewJSONResponseRecorder[templateweb.ListTemplatesResp]()
@@ -284,7 +320,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_CreateTemplate() {
 					},
 				}, nil)
 
-				handler := templateweb.NewHandler(svc.Svc)
+				handler := templateweb.NewHandler(svc.Svc, render.NewService(svc.Svc), templateAdminToken, authenticateOperator)
 				return handler
 			},
 			req: templateweb.CreateTemplateReq{
@@ -317,6 +353,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_CreateTemplate() {
 										ProviderID:      1,
 										ProviderName:    "mock-provider-name-1",
 										ProviderChannel: domain.ChannelSMS.String(),
+										Region:          domain.TemplateRegionDomestic.String(),
 										AuditStatus:     domain.AuditStatusPending.String(),
 									},
 								},
@@ -336,6 +373,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_CreateTemplate() {
 				"/templates/create", iox.NewJSONReader(tc.req))
 
 			req.Header.Set("content-type", "application/json")
+			req.Header.Set("Authorization", "Bearer "+templateAdminToken)
 			require.NoError(t, err)
 
 			recorder := test.NewJSONResponseRecorder[templateweb.CreateTemplateResp]()
@@ -390,7 +428,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_UpdateTemplate() {
 				})
 				require.NoError(t, err)
 
-				handler := templateweb.NewHandler(svc.Svc)
+				handler := templateweb.NewHandler(svc.Svc, render.NewService(svc.Svc), templateAdminToken, authenticateOperator)
 				return handler
 			},
 			req: templateweb.UpdateTemplateReq{
@@ -409,7 +447,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_UpdateTemplate() {
 			newHandlerFunc: func(t *testing.T, ctrl *gomock.Controller) *templateweb.Handler {
 				t.Helper()
 				svc, _, _, _ := s.newService(ctrl)
-				handler := templateweb.NewHandler(svc.Svc)
+				handler := templateweb.NewHandler(svc.Svc, render.NewService(svc.Svc), templateAdminToken, authenticateOperator)
 				return handler
 			},
 			req: templateweb.UpdateTemplateReq{
@@ -434,6 +472,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_UpdateTemplate() {
 				"/templates/update", iox.NewJSONReader(tc.req))
 
 			req.Header.Set("content-type", "application/json")
+			req.Header.Set("Authorization", "Bearer "+templateAdminToken)
 			require.NoError(t, err)
 
 			recorder := test.NewJSONResponseRecorder[any]()
@@ -1362,6 +1401,63 @@ func (s *TemplateHandlerTestSuite) TestService_BatchQueryAndUpdateProviderAuditI
 	}
 }
 
+func (s *TemplateHandlerTestSuite) TestService_ReceiveProviderAuditResults() {
+	t := s.T()
+
+	svc, providerSvc, _, _ := s.newService(gomock.NewController(t))
+	providerSvc.EXPECT().GetByChannel(gomock.Any(), domain.ChannelSMS).Return([]domain.Provider{
+		{
+			ID:      1,
+			Name:    "mock-provider-name-1",
+			Channel: domain.ChannelSMS,
+			Status:  domain.ProviderStatusActive,
+		},
+	}, nil)
+	template, err := svc.Svc.CreateTemplate(t.Context(), domain.ChannelTemplate{
+		OwnerID:      ownerID,
+		OwnerType:    ownerType,
+		Name:         "sms-pushed-template",
+		Description:  "SMS pushed template",
+		Channel:      domain.ChannelSMS,
+		BusinessType: domain.BusinessTypePromotion,
+	})
+	require.NoError(t, err)
+	template, err = svc.Svc.GetTemplateByID(t.Context(), template.ID)
+	require.NoError(t, err)
+	providers := template.Versions[0].Providers
+	require.Len(t, providers, 1)
+	providers[0].AuditStatus = domain.AuditStatusInReview
+	providers[0].ProviderTemplateID = "pushed-template-id"
+	require.NoError(t, svc.Repo.BatchUpdateTemplateProvidersAuditInfo(t.Context(), providers))
+
+	getProvider := func() domain.ChannelTemplateProvider {
+		res, err1 := svc.Repo.GetProvidersByProviderTemplateIDs(t.Context(), "mock-provider-name-1", []string{"pushed-template-id"})
+		require.NoError(t, err1)
+		require.Len(t, res, 1)
+		return res[0]
+	}
+
+	// 仍在审核中的推送和找不到关联的推送都忽略
+	require.NoError(t, svc.Svc.ReceiveProviderAuditResults(t.Context(), "mock-provider-name-1", []domain.ChannelTemplateProvider{
+		{ProviderTemplateID: "pushed-template-id", AuditStatus: domain.AuditStatusInReview},
+		{ProviderTemplateID: "unknown-template-id", AuditStatus: domain.AuditStatusApproved},
+	}))
+	assert.Equal(t, domain.AuditStatusInReview, getProvider().AuditStatus)
+
+	require.NoError(t, svc.Svc.ReceiveProviderAuditResults(t.Context(), "mock-provider-name-1", []domain.ChannelTemplateProvider{
+		{ProviderTemplateID: "pushed-template-id", AuditStatus: domain.AuditStatusRejected, RejectReason: "签名不匹配"},
+	}))
+	actual := getProvider()
+	assert.Equal(t, domain.AuditStatusRejected, actual.AuditStatus)
+	assert.Equal(t, "签名不匹配", actual.RejectReason)
+
+	// 已经有结果的关联不再被重复推送修改
+	require.NoError(t, svc.Svc.ReceiveProviderAuditResults(t.Context(), "mock-provider-name-1", []domain.ChannelTemplateProvider{
+		{ProviderTemplateID: "pushed-template-id", AuditStatus: domain.AuditStatusApproved},
+	}))
+	assert.Equal(t, domain.AuditStatusRejected, getProvider().AuditStatus)
+}
+
 func (s *TemplateHandlerTestSuite) TestHandler_PublishTemplate() {
 	t := s.T()
 
@@ -1422,7 +1518,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_PublishTemplate() {
 				err = svc.Repo.BatchUpdateTemplateVersionAuditInfo(t.Context(), []domain.ChannelTemplateVersion{version})
 				require.NoError(t, err)
 
-				handler := templateweb.NewHandler(svc.Svc)
+				handler := templateweb.NewHandler(svc.Svc, render.NewService(svc.Svc), templateAdminToken, authenticateOperator)
 				return handler
 			},
 			req: templateweb.PublishTemplateReq{
@@ -1439,7 +1535,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_PublishTemplate() {
 			newHandlerFunc: func(t *testing.T, ctrl *gomock.Controller) *templateweb.Handler {
 				t.Helper()
 				svc, _, _, _ := s.newService(ctrl)
-				handler := templateweb.NewHandler(svc.Svc)
+				handler := templateweb.NewHandler(svc.Svc, render.NewService(svc.Svc), templateAdminToken, authenticateOperator)
 				return handler
 			},
 			req: templateweb.PublishTemplateReq{
@@ -1463,6 +1559,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_PublishTemplate() {
 				"/templates/publish", iox.NewJSONReader(tc.req))
 
 			req.Header.Set("content-type", "application/json")
+			req.Header.Set("Authorization", "Bearer "+templateOperatorToken)
 			require.NoError(t, err)
 
 			recorder := test.NewJSONResponseRecorder[any]()
@@ -1480,6 +1577,387 @@ func (s *TemplateHandlerTestSuite) TestHandler_PublishTemplate() {
 	}
 }
 
+// publishTwoVersions 创建模板并依次发布两个版本，返回模板ID和两个版本ID
+func (s *TemplateHandlerTestSuite) publishTwoVersions(t *testing.T, svc *templateioc.Service, providerSvc *providermocks.MockService) (templateID, v1, v2 int64) {
+	t.Helper()
+
+	providerSvc.EXPECT().GetByChannel(gomock.Any(), domain.ChannelSMS).Return([]domain.Provider{
+		{
+			ID:      1,
+			Name:    "mock-provider-name-1",
+			Channel: domain.ChannelSMS,
+			Status:  domain.ProviderStatusActive,
+		},
+	}, nil)
+	template, err := svc.Svc.CreateTemplate(t.Context(), domain.ChannelTemplate{
+		OwnerID:      ownerID,
+		OwnerType:    ownerType,
+		Name:         "rollback-template",
+		Description:  "rollback-template-desc",
+		Channel:      domain.ChannelSMS,
+		BusinessType: domain.BusinessTypePromotion,
+	})
+	require.NoError(t, err)
+	templateFromDB, err := svc.Svc.GetTemplateByID(t.Context(), template.ID)
+	require.NoError(t, err)
+	require.Len(t, templateFromDB.Versions, 1)
+	first := templateFromDB.Versions[0]
+	second, err := svc.Svc.ForkVersion(t.Context(), first.ID)
+	require.NoError(t, err)
+
+	// 两个版本都通过内部审核和供应商审核，依次发布
+	for i, version := range []domain.ChannelTemplateVersion{first, second} {
+		providers, err := svc.Repo.GetProvidersByTemplateIDAndVersionID(t.Context(), template.ID, version.ID)
+		require.NoError(t, err)
+		for j := range providers {
+			providers[j].AuditStatus = domain.AuditStatusApproved
+			providers[j].ProviderTemplateID = fmt.Sprintf("SMS_%d", version.ID)
+		}
+		require.NoError(t, svc.Repo.BatchUpdateTemplateProvidersAuditInfo(t.Context(), providers))
+		version.AuditStatus = domain.AuditStatusApproved
+		require.NoError(t, svc.Repo.BatchUpdateTemplateVersionAuditInfo(t.Context(), []domain.ChannelTemplateVersion{version}))
+		require.NoError(t, svc.Svc.PublishTemplate(t.Context(), template.ID, version.ID, int64(i+1)))
+	}
+	return template.ID, first.ID, second.ID
+}
+
+func (s *TemplateHandlerTestSuite) TestService_InternationalTemplate() {
+	t := s.T()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc, providerSvc, _, clients := s.newService(ctrl)
+	providerSvc.EXPECT().GetByChannel(gomock.Any(), domain.ChannelSMS).Return([]domain.Provider{
+		{ID: 1, Name: "mock-provider-name-1", Channel: domain.ChannelSMS, Status: domain.ProviderStatusActive},
+		{ID: 2, Name: "mock-provider-name-2", Channel: domain.ChannelSMS, Status: domain.ProviderStatusActive},
+	}, nil)
+	template, err := svc.Svc.CreateTemplate(t.Context(), domain.ChannelTemplate{
+		OwnerID:       ownerID,
+		OwnerType:     ownerType,
+		Name:          "international-template",
+		Description:   "international-template-desc",
+		Channel:       domain.ChannelSMS,
+		BusinessType:  domain.BusinessTypeVerificationCode,
+		International: true,
+	})
+	require.NoError(t, err)
+	// 每个供应商各有国内和国际/港澳台两条关联
+	version := template.Versions[0]
+	require.Len(t, version.Providers, 4)
+
+	// 国际/港澳台模版按国际短信提交供应商审核
+	for name, cli := range clients {
+		mockClient := cli.(*smsmocks.MockClient)
+		for _, international := range []bool{false, true} {
+			mockClient.EXPECT().CreateTemplate(gomock.Cond(func(req any) bool {
+				return req.(client.CreateTemplateReq).International == international
+			})).Return(client.CreateTemplateResp{
+				RequestID:  "request-id",
+				TemplateID: fmt.Sprintf("%s-%t", name, international),
+			}, nil)
+		}
+	}
+	version.AuditStatus = domain.AuditStatusApproved
+	require.NoError(t, svc.Repo.BatchUpdateTemplateVersionAuditInfo(t.Context(), []domain.ChannelTemplateVersion{version}))
+	results := svc.Svc.BatchSubmitForProviderReview(t.Context(), []int64{version.ID})
+	require.Len(t, results, 1)
+	require.NoError(t, results[0].Err)
+
+	// 只有国内模版通过审核
+	providers, err := svc.Repo.GetProvidersByTemplateIDAndVersionID(t.Context(), template.ID, version.ID)
+	require.NoError(t, err)
+	approve := func(match func(p domain.ChannelTemplateProvider) bool) {
+		var updates []domain.ChannelTemplateProvider
+		for i := range providers {
+			if match(providers[i]) {
+				updates = append(updates, domain.ChannelTemplateProvider{ID: providers[i].ID, AuditStatus: domain.AuditStatusApproved})
+			}
+		}
+		require.NoError(t, svc.Repo.BatchUpdateTemplateProvidersAuditInfo(t.Context(), updates))
+	}
+	approve(func(p domain.ChannelTemplateProvider) bool { return !p.Region.IsInternational() })
+	require.NoError(t, svc.Svc.PublishTemplate(t.Context(), template.ID, version.ID, 1))
+
+	tmpl, err := svc.Svc.GetTemplateByIDAndProviderInfo(t.Context(), template.ID, "", "mock-provider-name-1", domain.ChannelSMS, domain.TemplateRegionDomestic)
+	require.NoError(t, err)
+	assert.Equal(t, "mock-provider-name-1-false", tmpl.Versions[0].Providers[0].ProviderTemplateID)
+	_, err = svc.Svc.GetTemplateByIDAndProviderInfo(t.Context(), template.ID, "", "mock-provider-name-1", domain.ChannelSMS, domain.TemplateRegionInternational)
+	assert.ErrorIs(t, err, errs.ErrNoInternationalTemplate)
+
+	// 其他供应商有国际/港澳台模版时换供应商发送
+	approve(func(p domain.ChannelTemplateProvider) bool {
+		return p.Region.IsInternational() && p.ProviderName == "mock-provider-name-2"
+	})
+	_, err = svc.Svc.GetTemplateByIDAndProviderInfo(t.Context(), template.ID, "", "mock-provider-name-1", domain.ChannelSMS, domain.TemplateRegionInternational)
+	assert.ErrorIs(t, err, errs.ErrProviderNotFound)
+	tmpl, err = svc.Svc.GetTemplateByIDAndProviderInfo(t.Context(), template.ID, "", "mock-provider-name-2", domain.ChannelSMS, domain.TemplateRegionInternational)
+	require.NoError(t, err)
+	assert.Equal(t, "mock-provider-name-2-true", tmpl.Versions[0].Providers[0].ProviderTemplateID)
+}
+
+func (s *TemplateHandlerTestSuite) TestService_SandboxTemplate() {
+	t := s.T()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// 沙箱供应商没有SMS客户端，提审和轮询审核结果都不能调用客户端
+	svc, providerSvc, _, _ := s.newService(ctrl)
+	providerSvc.EXPECT().GetByChannel(gomock.Any(), domain.ChannelSMS).Return([]domain.Provider{
+		{ID: 1, Name: sandbox.ProviderName, Channel: domain.ChannelSMS, Status: domain.ProviderStatusActive},
+	}, nil)
+	template, err := svc.Svc.CreateTemplate(t.Context(), domain.ChannelTemplate{
+		OwnerID:      ownerID,
+		OwnerType:    ownerType,
+		Name:         "sandbox-template",
+		Description:  "sandbox-template-desc",
+		Channel:      domain.ChannelSMS,
+		BusinessType: domain.BusinessTypeVerificationCode,
+	})
+	require.NoError(t, err)
+	version := template.Versions[0]
+	version.Content = "您的验证码是${code}"
+	require.NoError(t, svc.Svc.UpdateVersion(t.Context(), version))
+
+	// 内部审核通过后提交供应商审核，沙箱供应商直接通过
+	version.AuditStatus = domain.AuditStatusApproved
+	require.NoError(t, svc.Repo.BatchUpdateTemplateVersionAuditInfo(t.Context(), []domain.ChannelTemplateVersion{version}))
+	results := svc.Svc.BatchSubmitForProviderReview(t.Context(), []int64{version.ID})
+	require.Len(t, results, 1)
+	require.NoError(t, results[0].Err)
+	providers, err := svc.Repo.GetProvidersByTemplateIDAndVersionID(t.Context(), template.ID, version.ID)
+	require.NoError(t, err)
+	require.Len(t, providers, 1)
+	assert.Equal(t, domain.AuditStatusApproved, providers[0].AuditStatus)
+	require.NoError(t, svc.Svc.BatchQueryAndUpdateProviderAuditInfo(t.Context(), providers))
+
+	require.NoError(t, svc.Svc.PublishTemplate(t.Context(), template.ID, version.ID, 1))
+
+	// 通过沙箱供应商发送
+	cfg := sandbox.Config{Enabled: true}
+	sandboxSvc := sandbox.NewService(repository.NewSandboxRepository(local.NewSandboxCache()), cfg)
+	p := sandbox.NewProvider(sandbox.ProviderName, domain.ChannelSMS, svc.Svc, render.NewService(svc.Svc), sandboxSvc, cfg)
+	ch := channel.NewSMSChannel(sequential.NewSelectorBuilder([]provider.Provider{p}))
+	resp, err := ch.Send(t.Context(), domain.Notification{
+		ID:        1,
+		BizID:     1,
+		Key:       "sandbox-template",
+		Channel:   domain.ChannelSMS,
+		Receivers: []string{"13800138000"},
+		Template:  domain.Template{ID: template.ID, Params: map[string]string{"code": "123456"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, domain.SendStatusSucceeded, resp.Status)
+	msgs, total, err := sandboxSvc.List(t.Context(), domain.CapturedMessageQuery{BizID: 1})
+	require.NoError(t, err)
+	require.Equal(t, 1, total)
+	assert.Equal(t, version.ID, msgs[0].TemplateVersionID)
+	assert.Equal(t, "您的验证码是123456", msgs[0].Content)
+}
+
+func (s *TemplateHandlerTestSuite) TestHandler_RollbackTemplate() {
+	t := s.T()
+
+	testCases := []struct {
+		name        string
+		auditStatus client.AuditStatus
+		wantResp    test.Result[any]
+		wantActive  func(v1, v2 int64) int64
+		wantRecords int
+	}{
+		{
+			name:        "回滚成功",
+			auditStatus: client.AuditStatusApproved,
+			wantResp:    test.Result[any]{Msg: "OK"},
+			wantActive:  func(v1, _ int64) int64 { return v1 },
+			wantRecords: 3,
+		},
+		{
+			name:        "供应商已撤销审核",
+			auditStatus: client.AuditStatusRejected,
+			wantResp:    test.Result[any]{Code: 506002},
+			wantActive:  func(_, v2 int64) int64 { return v2 },
+			wantRecords: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			defer s.TearDownTest()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svc, providerSvc, _, clients := s.newService(ctrl)
+			templateID, v1, v2 := s.publishTwoVersions(t, svc, providerSvc)
+			providerTemplateID := fmt.Sprintf("SMS_%d", v1)
+			clients["mock-provider-name-1"].(*smsmocks.MockClient).EXPECT().
+				BatchQueryTemplateStatus(client.BatchQueryTemplateStatusReq{TemplateIDs: []string{providerTemplateID}}).
+				Return(client.BatchQueryTemplateStatusResp{
+					Results: map[string]client.QueryTemplateStatusResp{
+						providerTemplateID: {TemplateID: providerTemplateID, AuditStatus: tc.auditStatus},
+					},
+				}, nil)
+
+			server := s.newGinServer(templateweb.NewHandler(svc.Svc, render.NewService(svc.Svc), templateAdminToken, authenticateOperator))
+			req, err := http.NewRequest(http.MethodPost, "/templates/rollback", iox.NewJSONReader(templateweb.RollbackTemplateReq{
+				TemplateID: templateID,
+				VersionID:  v1,
+			}))
+			require.NoError(t, err)
+			req.Header.Set("content-type", "application/json")
+			req.Header.Set("Authorization", "Bearer "+templateOperatorToken)
+			recorder := test.NewJSONResponseRecorder[any]()
+			server.ServeHTTP(recorder, req)
+			require.Equal(t, 200, recorder.Code)
+			actual := recorder.MustScan()
+			assert.Equal(t, tc.wantResp.Code, actual.Code)
+			if tc.wantResp.Code == 0 {
+				assert.Equal(t, tc.wantResp.Msg, actual.Msg)
+			}
+
+			template, err := svc.Svc.GetTemplateByID(t.Context(), templateID)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantActive(v1, v2), template.ActiveVersionID)
+
+			req, err = http.NewRequest(http.MethodPost, "/templates/history", iox.NewJSONReader(templateweb.ListPublishHistoryReq{
+				TemplateID: templateID,
+			}))
+			require.NoError(t, err)
+			req.Header.Set("content-type", "application/json")
+			req.Header.Set("Authorization", "Bearer "+templateAdminToken)
+			historyRecorder := test.NewJSONResponseRecorder[templateweb.ListPublishHistoryResp]()
+			server.ServeHTTP(historyRecorder, req)
+			require.Equal(t, 200, historyRecorder.Code)
+			history := historyRecorder.MustScan().Data
+			assert.Equal(t, int64(tc.wantRecords), history.Total)
+			require.Len(t, history.Records, tc.wantRecords)
+			latest := history.Records[0]
+			if tc.wantResp.Code == 0 {
+				assert.Equal(t, domain.TemplatePublishActionRollback.String(), latest.Action)
+				assert.Equal(t, v1, latest.VersionID)
+				assert.Equal(t, v2, latest.PreviousVersionID)
+				assert.Equal(t, templateOperatorID, latest.OperatorID)
+			} else {
+				assert.Equal(t, domain.TemplatePublishActionPublish.String(), latest.Action)
+				assert.Equal(t, v2, latest.VersionID)
+				assert.Equal(t, v1, latest.PreviousVersionID)
+			}
+		})
+	}
+}
+
+func (s *TemplateHandlerTestSuite) TestService_ArchiveAndDelete() {
+	t := s.T()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	defer s.db.Exec("TRUNCATE TABLE `notifications`")
+
+	svc, providerSvc, _, _ := s.newService(ctrl)
+	templateID, v1, v2 := s.publishTwoVersions(t, svc, providerSvc)
+
+	// 生效中的版本不能删除和归档
+	assert.ErrorIs(t, svc.Svc.DeleteVersion(t.Context(), v2), errs.ErrInvalidOperation)
+	assert.ErrorIs(t, svc.Svc.ArchiveVersion(t.Context(), v2), errs.ErrInvalidOperation)
+
+	// 有通知使用的版本不能删除
+	require.NoError(t, s.db.Create(&dao.Notification{
+		ID:                1,
+		BizID:             1,
+		Key:               "archive-and-delete",
+		Receivers:         `["13800138000"]`,
+		Channel:           domain.ChannelSMS.String(),
+		TemplateID:        templateID,
+		TemplateVersionID: v1,
+		TemplateParams:    "{}",
+		Status:            string(domain.SendStatusSucceeded),
+	}).Error)
+	assert.ErrorIs(t, svc.Svc.DeleteVersion(t.Context(), v1), errs.ErrTemplateInUse)
+
+	// 归档的版本不能修改和发布
+	require.NoError(t, svc.Svc.ArchiveVersion(t.Context(), v1))
+	assert.ErrorIs(t, svc.Svc.PublishTemplate(t.Context(), templateID, v1, 1), errs.ErrTemplateArchived)
+	assert.ErrorIs(t, svc.Svc.UpdateVersion(t.Context(), domain.ChannelTemplateVersion{ID: v1, Content: "验证码${code}"}), errs.ErrTemplateArchived)
+
+	// 已发布的模版需要先归档，归档后不能再发送
+	assert.ErrorIs(t, svc.Svc.DeleteTemplate(t.Context(), templateID), errs.ErrInvalidOperation)
+	require.NoError(t, svc.Svc.ArchiveTemplate(t.Context(), templateID))
+	_, err := svc.Svc.GetTemplateByIDAndProviderInfo(t.Context(), templateID, "", "mock-provider-name-1", domain.ChannelSMS, domain.TemplateRegionDomestic)
+	assert.ErrorIs(t, err, errs.ErrTemplateArchived)
+	assert.ErrorIs(t, svc.Svc.UpdateTemplate(t.Context(), domain.ChannelTemplate{
+		ID:           templateID,
+		Name:         "archived",
+		Description:  "archived",
+		BusinessType: domain.BusinessTypeNotification,
+	}), errs.ErrTemplateArchived)
+
+	assert.ErrorIs(t, svc.Svc.DeleteTemplate(t.Context(), templateID), errs.ErrTemplateInUse)
+	require.NoError(t, s.db.Exec("TRUNCATE TABLE `notifications`").Error)
+	require.NoError(t, svc.Svc.DeleteTemplate(t.Context(), templateID))
+	_, err = svc.Svc.GetTemplateByID(t.Context(), templateID)
+	assert.ErrorIs(t, err, errs.ErrTemplateNotFound)
+	_, err = svc.Repo.GetTemplateVersionByID(t.Context(), v1)
+	assert.ErrorIs(t, err, errs.ErrTemplateVersionNotFound)
+}
+
+func (s *TemplateHandlerTestSuite) TestHandler_DiffVersions() {
+	t := s.T()
+	defer s.TearDownTest()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc, providerSvc, _, _ := s.newService(ctrl)
+	providerSvc.EXPECT().GetByChannel(gomock.Any(), domain.ChannelSMS).Return([]domain.Provider{
+		{
+			ID:      1,
+			Name:    "mock-provider-name-1",
+			Channel: domain.ChannelSMS,
+			Status:  domain.ProviderStatusActive,
+		},
+	}, nil)
+	template, err := svc.Svc.CreateTemplate(t.Context(), domain.ChannelTemplate{
+		OwnerID:      ownerID,
+		OwnerType:    ownerType,
+		Name:         "diff-template",
+		Description:  "diff-template-desc",
+		Channel:      domain.ChannelSMS,
+		BusinessType: domain.BusinessTypeVerificationCode,
+	})
+	require.NoError(t, err)
+	templateFromDB, err := svc.Svc.GetTemplateByID(t.Context(), template.ID)
+	require.NoError(t, err)
+	first := templateFromDB.Versions[0]
+	first.Signature = "旧签名"
+	first.Content = "验证码${code}"
+	require.NoError(t, svc.Svc.UpdateVersion(t.Context(), first))
+	second, err := svc.Svc.ForkVersion(t.Context(), first.ID)
+	require.NoError(t, err)
+	second.Signature = "新签名"
+	second.Content = "验证码${code}\n${minutes}分钟内有效"
+	require.NoError(t, svc.Svc.UpdateVersion(t.Context(), second))
+
+	req, err := http.NewRequest(http.MethodPost, "/templates/versions/diff", iox.NewJSONReader(templateweb.DiffVersionsReq{
+		FromVersionID: first.ID,
+		ToVersionID:   second.ID,
+	}))
+	require.NoError(t, err)
+	req.Header.Set("content-type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+templateAdminToken)
+	recorder := test.NewJSONResponseRecorder[templateweb.DiffVersionsResp]()
+	s.newGinServer(templateweb.NewHandler(svc.Svc, render.NewService(svc.Svc), templateAdminToken, authenticateOperator)).ServeHTTP(recorder, req)
+	require.Equal(t, 200, recorder.Code)
+
+	actual := recorder.MustScan()
+	assert.Equal(t, 0, actual.Code)
+	assert.True(t, actual.Data.Changed)
+	assert.Contains(t, actual.Data.Fields, templateweb.FieldChange{Field: "signature", From: "旧签名", To: "新签名"})
+	assert.Equal(t, []templateweb.DiffLine{
+		{Op: "=", Text: "验证码${code}"},
+		{Op: "+", Text: "${minutes}分钟内有效"},
+	}, actual.Data.Content)
+	require.Len(t, actual.Data.Params, 1)
+	assert.Equal(t, "minutes", actual.Data.Params[0].Name)
+	assert.Nil(t, actual.Data.Params[0].From)
+}
+
 func (s *TemplateHandlerTestSuite) TestHandler_ForkVersion() {
 	t := s.T()
 
@@ -1530,7 +2008,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_ForkVersion() {
 				err = svc.Repo.UpdateTemplateVersion(t.Context(), version)
 				require.NoError(t, err)
 
-				handler := templateweb.NewHandler(svc.Svc)
+				handler := templateweb.NewHandler(svc.Svc, render.NewService(svc.Svc), templateAdminToken, authenticateOperator)
 				return handler
 			},
 			req: templateweb.ForkVersionReq{
@@ -1550,6 +2028,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_ForkVersion() {
 								ProviderID:      1,
 								ProviderName:    "mock-provider-name-1",
 								ProviderChannel: domain.ChannelSMS.String(),
+								Region:          domain.TemplateRegionDomestic.String(),
 								AuditStatus:     domain.AuditStatusPending.String(),
 							},
 						},
@@ -1562,7 +2041,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_ForkVersion() {
 			newHandlerFunc: func(t *testing.T, ctrl *gomock.Controller) *templateweb.Handler {
 				t.Helper()
 				svc, _, _, _ := s.newService(ctrl)
-				handler := templateweb.NewHandler(svc.Svc)
+				handler := templateweb.NewHandler(svc.Svc, render.NewService(svc.Svc), templateAdminToken, authenticateOperator)
 				return handler
 			},
 			req: templateweb.ForkVersionReq{
@@ -1585,6 +2064,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_ForkVersion() {
 				"/templates/versions/fork", iox.NewJSONReader(tc.req))
 
 			req.Header.Set("content-type", "application/json")
+			req.Header.Set("Authorization", "Bearer "+templateAdminToken)
 			require.NoError(t, err)
 
 			recorder := test.NewJSONResponseRecorder[templateweb.ForkVersionResp]()
@@ -1657,7 +2137,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_UpdateVersion() {
 				require.NoError(t, err)
 				require.Len(t, templateFromDB.Versions, 1)
 
-				handler := templateweb.NewHandler(svc.Svc)
+				handler := templateweb.NewHandler(svc.Svc, render.NewService(svc.Svc), templateAdminToken, authenticateOperator)
 				return handler
 			},
 			req: templateweb.UpdateVersionReq{
@@ -1687,7 +2167,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_UpdateVersion() {
 			newHandlerFunc: func(t *testing.T, ctrl *gomock.Controller) *templateweb.Handler {
 				t.Helper()
 				svc, _, _, _ := s.newService(ctrl)
-				handler := templateweb.NewHandler(svc.Svc)
+				handler := templateweb.NewHandler(svc.Svc, render.NewService(svc.Svc), templateAdminToken, authenticateOperator)
 				return handler
 			},
 			req: templateweb.UpdateVersionReq{
@@ -1741,7 +2221,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_UpdateVersion() {
 				err = svc.Repo.BatchUpdateTemplateVersionAuditInfo(t.Context(), []domain.ChannelTemplateVersion{version})
 				require.NoError(t, err)
 
-				handler := templateweb.NewHandler(svc.Svc)
+				handler := templateweb.NewHandler(svc.Svc, render.NewService(svc.Svc), templateAdminToken, authenticateOperator)
 				return handler
 			},
 			req: templateweb.UpdateVersionReq{
@@ -1768,6 +2248,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_UpdateVersion() {
 				"/templates/versions/update", iox.NewJSONReader(tc.req))
 
 			req.Header.Set("content-type", "application/json")
+			req.Header.Set("Authorization", "Bearer "+templateAdminToken)
 			require.NoError(t, err)
 
 			recorder := test.NewJSONResponseRecorder[any]()
@@ -1836,7 +2317,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_SubmitForInternalReview() {
 				// 模拟审核服务
 				auditSvc.EXPECT().CreateAudit(gomock.Any(), gomock.Any()).Return(1, nil)
 
-				handler := templateweb.NewHandler(svc.Svc)
+				handler := templateweb.NewHandler(svc.Svc, render.NewService(svc.Svc), templateAdminToken, authenticateOperator)
 				return handler, templateFromDB.Versions[0].ID
 			},
 			req: templateweb.SubmitForInternalReviewReq{
@@ -1861,7 +2342,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_SubmitForInternalReview() {
 			newHandlerFunc: func(t *testing.T, ctrl *gomock.Controller) (*templateweb.Handler, int64) {
 				t.Helper()
 				svc, _, _, _ := s.newService(ctrl)
-				handler := templateweb.NewHandler(svc.Svc)
+				handler := templateweb.NewHandler(svc.Svc, render.NewService(svc.Svc), templateAdminToken, authenticateOperator)
 				return handler, 0
 			},
 			req: templateweb.SubmitForInternalReviewReq{
@@ -1915,7 +2396,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_SubmitForInternalReview() {
 
 				// 第二次提交不需要mock审核服务，因为应该会在版本状态检查时就失败
 
-				handler := templateweb.NewHandler(svc.Svc)
+				handler := templateweb.NewHandler(svc.Svc, render.NewService(svc.Svc), templateAdminToken, authenticateOperator)
 				return handler, templateFromDB.Versions[0].ID
 			},
 			req: templateweb.SubmitForInternalReviewReq{
@@ -1970,7 +2451,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_SubmitForInternalReview() {
 				// 模拟审核服务返回错误
 				auditSvc.EXPECT().CreateAudit(gomock.Any(), gomock.Any()).Return(0, fmt.Errorf("模拟审核服务错误"))
 
-				handler := templateweb.NewHandler(svc.Svc)
+				handler := templateweb.NewHandler(svc.Svc, render.NewService(svc.Svc), templateAdminToken, authenticateOperator)
 				return handler, templateFromDB.Versions[0].ID
 			},
 			req: templateweb.SubmitForInternalReviewReq{
@@ -1991,6 +2472,65 @@ func (s *TemplateHandlerTestSuite) TestHandler_SubmitForInternalReview() {
 				assert.NotZero(t, version.LastReviewSubmissionTime)
 			},
 		},
+		{
+			name: "审核前检查未通过直接拒绝",
+			newHandlerFunc: func(t *testing.T, ctrl *gomock.Controller) (*templateweb.Handler, int64) {
+				t.Helper()
+
+				svc, providerSvc, _, _ := s.newServiceWithModerator(ctrl, moderation.NewChecker(moderation.Config{
+					SensitiveWords: []string{"赌博"},
+				}))
+
+				providerSvc.EXPECT().GetByChannel(gomock.Any(), domain.ChannelSMS).Return([]domain.Provider{
+					{
+						ID:      1,
+						Name:    "mock-provider-name-1",
+						Channel: domain.ChannelSMS,
+						Status:  domain.ProviderStatusActive,
+					},
+				}, nil)
+
+				template, err := svc.Svc.CreateTemplate(t.Context(), domain.ChannelTemplate{
+					OwnerID:      ownerID,
+					OwnerType:    ownerType,
+					Name:         "moderation-template",
+					Description:  "moderation-template-desc",
+					Channel:      domain.ChannelSMS,
+					BusinessType: domain.BusinessTypeNotification,
+				})
+				require.NoError(t, err)
+
+				templateFromDB, err := svc.Svc.GetTemplateByID(t.Context(), template.ID)
+				require.NoError(t, err)
+				require.Len(t, templateFromDB.Versions, 1)
+
+				err = svc.Svc.UpdateVersion(t.Context(), domain.ChannelTemplateVersion{
+					ID:        templateFromDB.Versions[0].ID,
+					Name:      "moderation-version",
+					Signature: "极客时间",
+					Content:   "赌博网站${code}",
+				})
+				require.NoError(t, err)
+
+				// 不会创建审核记录
+				handler := templateweb.NewHandler(svc.Svc, render.NewService(svc.Svc), templateAdminToken, authenticateOperator)
+				return handler, templateFromDB.Versions[0].ID
+			},
+			wantCode: 200,
+			wantResp: test.Result[any]{
+				Code: 506002,
+				Msg:  "模版内容未通过审核前检查: 内容包含敏感词“赌博”",
+			},
+			after: func(t *testing.T, expected templateweb.SubmitForInternalReviewReq, ctrl *gomock.Controller) {
+				t.Helper()
+				svc, _, _, _ := s.newService(ctrl)
+				version, err := svc.Repo.GetTemplateVersionByID(t.Context(), expected.VersionID)
+				require.NoError(t, err)
+				assert.Equal(t, domain.AuditStatusRejected, version.AuditStatus)
+				assert.Equal(t, "内容包含敏感词“赌博”", version.RejectReason)
+				assert.Zero(t, version.AuditID)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -2005,6 +2545,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_SubmitForInternalReview() {
 				"/templates/versions/review/internal", iox.NewJSONReader(tc.req))
 
 			req.Header.Set("content-type", "application/json")
+			req.Header.Set("Authorization", "Bearer "+templateAdminToken)
 			require.NoError(t, err)
 
 			recorder := test.NewJSONResponseRecorder[any]()
@@ -2026,6 +2567,108 @@ func (s *TemplateHandlerTestSuite) TestHandler_SubmitForInternalReview() {
 	}
 }
 
+func (s *TemplateHandlerTestSuite) TestHandler_PreviewVersion() {
+	t := s.T()
+
+	testCases := []struct {
+		name           string
+		newHandlerFunc func(t *testing.T, ctrl *gomock.Controller) (*templateweb.Handler, templateweb.PreviewVersionReq)
+		params         map[string]string
+		wantResp       test.Result[templateweb.PreviewVersionResp]
+	}{
+		{
+			name: "预览短信草稿版本",
+			newHandlerFunc: func(t *testing.T, ctrl *gomock.Controller) (*templateweb.Handler, templateweb.PreviewVersionReq) {
+				t.Helper()
+
+				svc, providerSvc, _, _ := s.newService(ctrl)
+				providerSvc.EXPECT().GetByChannel(gomock.Any(), domain.ChannelSMS).Return([]domain.Provider{
+					{
+						ID:      1,
+						Name:    "mock-provider-name-1",
+						Channel: domain.ChannelSMS,
+						Status:  domain.ProviderStatusActive,
+					},
+				}, nil)
+
+				template, err := svc.Svc.CreateTemplate(t.Context(), domain.ChannelTemplate{
+					OwnerID:      ownerID,
+					OwnerType:    ownerType,
+					Name:         "preview-template",
+					Description:  "preview-template-desc",
+					Channel:      domain.ChannelSMS,
+					BusinessType: domain.BusinessTypeVerificationCode,
+				})
+				require.NoError(t, err)
+				templateFromDB, err := svc.Svc.GetTemplateByID(t.Context(), template.ID)
+				require.NoError(t, err)
+				require.Len(t, templateFromDB.Versions, 1)
+
+				version := templateFromDB.Versions[0]
+				version.Signature = "通知平台"
+				version.Content = "您的验证码是${code}，${minutes}分钟内有效"
+				require.NoError(t, svc.Svc.UpdateVersion(t.Context(), version))
+
+				return templateweb.NewHandler(svc.Svc, render.NewService(svc.Svc), templateAdminToken, authenticateOperator), templateweb.PreviewVersionReq{
+					TemplateID: template.ID,
+					VersionID:  version.ID,
+				}
+			},
+			params: map[string]string{"code": "123456"},
+			wantResp: test.Result[templateweb.PreviewVersionResp]{
+				Data: templateweb.PreviewVersionResp{
+					Channel:       domain.ChannelSMS.String(),
+					Content:       "您的验证码是123456，分钟内有效",
+					Charset:       "UCS-2",
+					Length:        24,
+					Segments:      1,
+					MissingParams: []string{"minutes"},
+				},
+			},
+		},
+		{
+			name: "模版不存在",
+			newHandlerFunc: func(t *testing.T, ctrl *gomock.Controller) (*templateweb.Handler, templateweb.PreviewVersionReq) {
+				t.Helper()
+				svc, _, _, _ := s.newService(ctrl)
+				return templateweb.NewHandler(svc.Svc, render.NewService(svc.Svc), templateAdminToken, authenticateOperator), templateweb.PreviewVersionReq{
+					TemplateID: 9999,
+					VersionID:  9999,
+				}
+			},
+			wantResp: test.Result[templateweb.PreviewVersionResp]{
+				Code: 506002,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			handler, previewReq := tc.newHandlerFunc(t, ctrl)
+			previewReq.Params = tc.params
+			req, err := http.NewRequest(http.MethodPost,
+				"/templates/versions/preview", iox.NewJSONReader(previewReq))
+			req.Header.Set("content-type", "application/json")
+			req.Header.Set("Authorization", "Bearer "+templateAdminToken)
+			require.NoError(t, err)
+
+			recorder := test.NewJSONResponseRecorder[templateweb.PreviewVersionResp]()
+			server := s.newGinServer(handler)
+			server.ServeHTTP(recorder, req)
+
+			require.Equal(t, 200, recorder.Code)
+			actual := recorder.MustScan()
+			assert.Equal(t, tc.wantResp.Code, actual.Code)
+			if tc.wantResp.Code == 0 {
+				assert.Equal(t, tc.wantResp.Data, actual.Data)
+			}
+		})
+	}
+}
+
 func (s *TemplateHandlerTestSuite) TestEvent_AuditResultConsume() {
 	t := s.T()
 
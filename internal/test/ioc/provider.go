@@ -1,6 +1,20 @@
 package ioc
 
-// InitProviderEncryptKey 提供provider服务所需的加密密钥
-func InitProviderEncryptKey() string {
-	return "c0809a7e7670984d63939d00a4b7b045ed92d20870634d568b64250dab0a06f1"
+import (
+	"gitee.com/flycash/notification-platform/internal/pkg/secret"
+)
+
+// InitProviderKeyring 提供加密供应商密钥所需的密钥环
+func InitProviderKeyring() *secret.Keyring {
+	keyring, err := secret.NewKeyring("test", map[string][]byte{
+		"test": []byte("c0809a7e7670984d63939d00a4b7b045"),
+	})
+	if err != nil {
+		panic(err)
+	}
+	keyring, err = keyring.WithLegacyKey("c0809a7e7670984d63939d00a4b7b045ed92d20870634d568b64250dab0a06f1")
+	if err != nil {
+		panic(err)
+	}
+	return keyring
 }
@@ -0,0 +1,166 @@
+package audit
+
+import (
+	"context"
+	"errors"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/service/audit"
+	"gitee.com/flycash/notification-platform/internal/web/middleware"
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/ecodeclub/ginx"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	systemErrorCode      = 510001
+	invalidParamCode     = 510002
+	notFoundCode         = 510003
+	invalidOperationCode = 510004
+)
+
+var _ ginx.Handler = &Handler{}
+
+// Handler 内部审核接口，供审核人处理待审核队列以及管理审核人
+type Handler struct {
+	svc   audit.Service
+	token string
+}
+
+// NewHandler token 为管理接口的访问令牌，为空时不注册管理接口
+func NewHandler(svc audit.Service, token string) *Handler {
+	return &Handler{svc: svc, token: token}
+}
+
+func (h *Handler) PrivateRoutes(server *gin.Engine) {
+	// 审核人使用自己的访问令牌，只能处理分配给自己的审核
+	r := server.Group("/audits", middleware.OperatorAuth(h.authenticate))
+	r.POST("/get", ginx.B[IDReq](h.GetAudit))
+	r.POST("/queue", ginx.B[ListQueueReq](h.ListQueue))
+	r.POST("/approve", ginx.B[ApproveReq](h.Approve))
+	r.POST("/reject", ginx.B[RejectReq](h.Reject))
+
+	if h.token == "" {
+		return
+	}
+	g := server.Group("/admin/audits", middleware.AdminAuth(h.token))
+	g.POST("/get", ginx.B[IDReq](h.GetAudit))
+	g.POST("/reviewers/list", ginx.W(h.ListReviewers))
+	g.POST("/reviewers/save", ginx.B[Reviewer](h.SaveReviewer))
+	g.POST("/reviewers/token", ginx.B[IDReq](h.ResetReviewerToken))
+}
+
+// authenticate 审核人使用自己的访问令牌认证
+func (h *Handler) authenticate(ctx context.Context, token string) (int64, error) {
+	reviewer, err := h.svc.AuthenticateReviewer(ctx, token)
+	return reviewer.ID, err
+}
+
+func (h *Handler) PublicRoutes(_ *gin.Engine) {
+}
+
+func (h *Handler) GetAudit(ctx *ginx.Context, req IDReq) (ginx.Result, error) {
+	a, err := h.svc.GetAudit(ctx.Request.Context(), req.ID)
+	if err != nil {
+		return h.errorResult(err)
+	}
+	return ginx.Result{Data: h.toAuditVO(a)}, nil
+}
+
+// ListQueue 审核人的待审核队列，按截止时间升序
+func (h *Handler) ListQueue(ctx *ginx.Context, req ListQueueReq) (ginx.Result, error) {
+	audits, total, err := h.svc.ListReviewerQueue(ctx.Request.Context(), ctx.GetInt64(middleware.OperatorIDKey), req.Offset, req.Limit)
+	if err != nil {
+		return h.errorResult(err)
+	}
+	return ginx.Result{
+		Data: ListQueueResp{
+			Audits: slice.Map(audits, func(_ int, src domain.Audit) Audit {
+				return h.toAuditVO(src)
+			}),
+			Total: total,
+		},
+	}, nil
+}
+
+func (h *Handler) Approve(ctx *ginx.Context, req ApproveReq) (ginx.Result, error) {
+	if err := h.svc.Approve(ctx.Request.Context(), req.AuditID, ctx.GetInt64(middleware.OperatorIDKey)); err != nil {
+		return h.errorResult(err)
+	}
+	return ginx.Result{Msg: "OK"}, nil
+}
+
+func (h *Handler) Reject(ctx *ginx.Context, req RejectReq) (ginx.Result, error) {
+	if err := h.svc.Reject(ctx.Request.Context(), req.AuditID, ctx.GetInt64(middleware.OperatorIDKey), req.Reason); err != nil {
+		return h.errorResult(err)
+	}
+	return ginx.Result{Msg: "OK"}, nil
+}
+
+func (h *Handler) ListReviewers(ctx *ginx.Context) (ginx.Result, error) {
+	reviewers, err := h.svc.ListReviewers(ctx.Request.Context())
+	if err != nil {
+		return h.errorResult(err)
+	}
+	return ginx.Result{
+		Data: ListReviewersResp{
+			Reviewers: slice.Map(reviewers, func(_ int, src domain.AuditReviewer) Reviewer {
+				return Reviewer(src)
+			}),
+		},
+	}, nil
+}
+
+// SaveReviewer ID 为0时新增审核人，否则修改名称、级别和是否启用
+func (h *Handler) SaveReviewer(ctx *ginx.Context, req Reviewer) (ginx.Result, error) {
+	reviewer, err := h.svc.SaveReviewer(ctx.Request.Context(), domain.AuditReviewer{
+		ID:      req.ID,
+		Name:    req.Name,
+		Level:   req.Level,
+		Enabled: req.Enabled,
+	})
+	if err != nil {
+		return h.errorResult(err)
+	}
+	return ginx.Result{Data: Reviewer(reviewer)}, nil
+}
+
+// ResetReviewerToken 生成审核人的访问令牌，旧令牌立即失效，令牌只返回这一次
+func (h *Handler) ResetReviewerToken(ctx *ginx.Context, req IDReq) (ginx.Result, error) {
+	token, err := h.svc.ResetReviewerToken(ctx.Request.Context(), req.ID)
+	if err != nil {
+		return h.errorResult(err)
+	}
+	return ginx.Result{Data: ReviewerTokenResp{Token: token}}, nil
+}
+
+func (h *Handler) errorResult(err error) (ginx.Result, error) {
+	switch {
+	case errors.Is(err, errs.ErrInvalidParameter):
+		return ginx.Result{Code: invalidParamCode, Msg: err.Error()}, nil
+	case errors.Is(err, errs.ErrAuditNotFound):
+		return ginx.Result{Code: notFoundCode, Msg: err.Error()}, nil
+	case errors.Is(err, errs.ErrInvalidOperation):
+		return ginx.Result{Code: invalidOperationCode, Msg: err.Error()}, nil
+	default:
+		return ginx.Result{Code: systemErrorCode, Msg: "系统错误"}, err
+	}
+}
+
+func (h *Handler) toAuditVO(src domain.Audit) Audit {
+	return Audit{
+		ID:           src.ID,
+		ResourceID:   src.ResourceID,
+		ResourceType: string(src.ResourceType),
+		Content:      src.Content,
+		ReviewerID:   src.ReviewerID,
+		Level:        src.Level,
+		Status:       src.Status.String(),
+		RejectReason: src.RejectReason,
+		Deadline:     src.Deadline,
+		AuditTime:    src.AuditTime,
+		Ctime:        src.Ctime,
+		Utime:        src.Utime,
+	}
+}
@@ -0,0 +1,62 @@
+package audit
+
+// Audit 内部审核记录
+type Audit struct {
+	ID           int64  `json:"id"`
+	ResourceID   int64  `json:"resourceId"`   // 模版版本ID
+	ResourceType string `json:"resourceType"` // 资源类型
+	Content      string `json:"content"`      // 审核内容，完整JSON串
+	ReviewerID   int64  `json:"reviewerId"`   // 当前审核人ID，0表示没有可分配的审核人
+	Level        int    `json:"level"`        // 当前审核级别
+	Status       string `json:"status"`       // 审核状态：IN_REVIEW、APPROVED、REJECTED
+	RejectReason string `json:"rejectReason"` // 拒绝原因
+	Deadline     int64  `json:"deadline"`     // 当前级别的审核截止时间
+	AuditTime    int64  `json:"auditTime"`    // 审核时间
+	Ctime        int64  `json:"ctime"`
+	Utime        int64  `json:"utime"`
+}
+
+// Reviewer 审核人
+type Reviewer struct {
+	ID      int64  `json:"id"` // 0表示新增
+	Name    string `json:"name"`
+	Level   int    `json:"level"`   // 审核级别，新的审核分配给0级，超时后升级到下一级
+	Enabled bool   `json:"enabled"` // 停用后不再分配新的审核
+	Ctime   int64  `json:"ctime"`
+	Utime   int64  `json:"utime"`
+}
+
+type IDReq struct {
+	ID int64 `json:"id"`
+}
+
+// ListQueueReq 获取审核人的待审核队列，审核人由访问令牌确定
+type ListQueueReq struct {
+	Offset int `json:"offset"`
+	Limit  int `json:"limit"` // 默认20，最大100
+}
+
+type ListQueueResp struct {
+	Audits []Audit `json:"audits"` // 按截止时间升序
+	Total  int64   `json:"total"`
+}
+
+// ApproveReq 访问令牌对应的审核人必须是当前审核人
+type ApproveReq struct {
+	AuditID int64 `json:"auditId"`
+}
+
+// RejectReq 访问令牌对应的审核人必须是当前审核人
+type RejectReq struct {
+	AuditID int64  `json:"auditId"`
+	Reason  string `json:"reason"` // 拒绝原因，必填
+}
+
+// ReviewerTokenResp 审核人的访问令牌，只返回一次，需要交给审核人妥善保存
+type ReviewerTokenResp struct {
+	Token string `json:"token"`
+}
+
+type ListReviewersResp struct {
+	Reviewers []Reviewer `json:"reviewers"`
+}
@@ -0,0 +1,175 @@
+package callback
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/service/provider/sms/client"
+	"gitee.com/flycash/notification-platform/internal/service/receipt"
+	"gitee.com/flycash/notification-platform/internal/service/reply"
+	templatesvc "gitee.com/flycash/notification-platform/internal/service/template/manage"
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/ecodeclub/ginx"
+	"github.com/gin-gonic/gin"
+	"github.com/gotomicro/ego/core/elog"
+)
+
+// maxBodySize 单次推送的最大请求体，供应商批量推送时通常不超过100条
+const maxBodySize = 1 << 20
+
+var _ ginx.Handler = &Handler{}
+
+// Handler 接收短信供应商推送的状态报告、上行短信和模版审核结果
+type Handler struct {
+	clients     *client.Clients
+	receiptSvc  receipt.Service
+	replySvc    reply.Service
+	templateSvc templatesvc.ChannelTemplateService
+	logger      *elog.Component
+}
+
+// NewHandler clients 按供应商名称查找客户端，名称与发送时记录在回执中的 Provider 一致，
+// 只有实现了 client.CallbackParser 的客户端支持推送
+func NewHandler(clients *client.Clients,
+	receiptSvc receipt.Service,
+	replySvc reply.Service,
+	templateSvc templatesvc.ChannelTemplateService,
+) *Handler {
+	return &Handler{
+		clients:     clients,
+		receiptSvc:  receiptSvc,
+		replySvc:    replySvc,
+		templateSvc: templateSvc,
+		logger:      elog.DefaultLogger.With(elog.FieldComponent("callback")),
+	}
+}
+
+func (h *Handler) PrivateRoutes(_ *gin.Engine) {
+}
+
+func (h *Handler) PublicRoutes(server *gin.Engine) {
+	g := server.Group("/callbacks/sms/:provider")
+	g.POST("/report", h.StatusReport)
+	g.POST("/reply", h.UpstreamReply)
+	// 供应商的 AuditCallbackURL 配置为该地址
+	g.POST("/template-audit", h.TemplateAudit)
+}
+
+// StatusReport 状态报告推送，更新回执并在通知的全部回执有结果后回调业务方
+func (h *Handler) StatusReport(ctx *gin.Context) {
+	provider, parser, body, ok := h.verify(ctx)
+	if !ok {
+		return
+	}
+	reports, err := parser.ParseStatusReports(body)
+	if err != nil {
+		ctx.String(http.StatusBadRequest, err.Error())
+		return
+	}
+	err = h.receiptSvc.Report(ctx.Request.Context(), slice.Map(reports, func(_ int, src client.StatusReport) domain.SendReceipt {
+		status := domain.SendReceiptStatusDelivered
+		if !src.Success {
+			status = domain.SendReceiptStatusUndelivered
+		}
+		return domain.SendReceipt{
+			Provider:  provider,
+			Receiver:  src.PhoneNumber,
+			ReceiptID: src.BizID,
+			Status:    status,
+			ErrCode:   src.ErrCode,
+		}
+	}))
+	if err != nil {
+		// 返回非200，供应商会重新推送
+		h.logger.Error("处理状态报告失败", elog.String("Provider", provider), elog.FieldErr(err))
+		ctx.String(http.StatusInternalServerError, "系统错误")
+		return
+	}
+	ctx.JSON(http.StatusOK, parser.CallbackAck())
+}
+
+// UpstreamReply 上行短信推送，保存后供业务方查询
+func (h *Handler) UpstreamReply(ctx *gin.Context) {
+	provider, parser, body, ok := h.verify(ctx)
+	if !ok {
+		return
+	}
+	replies, err := parser.ParseUpstreamReplies(body)
+	if err != nil {
+		ctx.String(http.StatusBadRequest, err.Error())
+		return
+	}
+	err = h.replySvc.Receive(ctx.Request.Context(), slice.Map(replies, func(_ int, src client.UpstreamReply) domain.SmsReply {
+		return domain.SmsReply{
+			Provider:    provider,
+			PhoneNumber: src.PhoneNumber,
+			Content:     strings.TrimSpace(src.Content),
+			SignName:    src.SignName,
+			ExtendCode:  src.ExtendCode,
+			ReplyTime:   src.ReplyTime,
+		}
+	}))
+	if err != nil {
+		h.logger.Error("处理上行短信失败", elog.String("Provider", provider), elog.FieldErr(err))
+		ctx.String(http.StatusInternalServerError, "系统错误")
+		return
+	}
+	ctx.JSON(http.StatusOK, parser.CallbackAck())
+}
+
+// TemplateAudit 模版审核结果推送，更新审核中的供应商关联，轮询任务只用于兜底推送丢失的情况
+func (h *Handler) TemplateAudit(ctx *gin.Context) {
+	provider, parser, body, ok := h.verify(ctx)
+	if !ok {
+		return
+	}
+	results, err := parser.ParseTemplateAuditResults(body)
+	if err != nil {
+		ctx.String(http.StatusBadRequest, err.Error())
+		return
+	}
+	err = h.templateSvc.ReceiveProviderAuditResults(ctx.Request.Context(), provider,
+		slice.Map(results, func(_ int, src client.TemplateAuditResult) domain.ChannelTemplateProvider {
+			return domain.ChannelTemplateProvider{
+				ProviderName:       provider,
+				ProviderChannel:    domain.ChannelSMS,
+				ProviderTemplateID: src.TemplateID,
+				AuditStatus:        src.AuditStatus.ToDomain(),
+				RejectReason:       src.Reason,
+			}
+		}))
+	if err != nil {
+		h.logger.Error("处理模版审核结果失败", elog.String("Provider", provider), elog.FieldErr(err))
+		ctx.String(http.StatusInternalServerError, "系统错误")
+		return
+	}
+	ctx.JSON(http.StatusOK, parser.CallbackAck())
+}
+
+// verify 校验推送地址中的推送令牌并读取请求体，失败时已经写入响应
+func (h *Handler) verify(ctx *gin.Context) (string, client.CallbackParser, []byte, bool) {
+	provider := ctx.Param("provider")
+	cli, ok := h.clients.Get(provider)
+	if !ok {
+		ctx.String(http.StatusNotFound, "未知供应商")
+		return "", nil, nil, false
+	}
+	parser, ok := cli.(client.CallbackParser)
+	if !ok {
+		ctx.String(http.StatusNotFound, "未知供应商")
+		return "", nil, nil, false
+	}
+	if err := parser.VerifyCallback(ctx.Query(client.QueryCallbackToken)); err != nil {
+		h.logger.Warn("推送令牌校验失败", elog.String("Provider", provider), elog.FieldErr(err))
+		ctx.String(http.StatusUnauthorized, err.Error())
+		return "", nil, nil, false
+	}
+	body, err := io.ReadAll(io.LimitReader(ctx.Request.Body, maxBodySize))
+	if err != nil {
+		ctx.String(http.StatusBadRequest, "读取请求体失败")
+		return "", nil, nil, false
+	}
+	return provider, parser, body, true
+}
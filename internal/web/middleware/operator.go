@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"github.com/gin-gonic/gin"
+)
+
+// OperatorIDKey 操作人认证通过后保存操作人ID
+const OperatorIDKey = "operatorID"
+
+// Authenticator 按访问令牌识别操作人，令牌无效时返回 errs.ErrInvalidReviewerToken
+type Authenticator func(ctx context.Context, token string) (int64, error)
+
+// OperatorAuth 操作人认证，校验 Authorization: Bearer <操作人访问令牌>，操作人ID只来自令牌
+func OperatorAuth(authenticate Authenticator) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		token, ok := strings.CutPrefix(ctx.GetHeader("Authorization"), "Bearer ")
+		if !ok {
+			ctx.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+		operatorID, err := authenticate(ctx.Request.Context(), token)
+		if err != nil {
+			if errors.Is(err, errs.ErrInvalidReviewerToken) {
+				ctx.AbortWithStatus(http.StatusUnauthorized)
+				return
+			}
+			_ = ctx.Error(err)
+			ctx.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		ctx.Set(OperatorIDKey, operatorID)
+		ctx.Next()
+	}
+}
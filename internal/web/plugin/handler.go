@@ -0,0 +1,99 @@
+package plugin
+
+import (
+	"errors"
+	"maps"
+	"slices"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/service/channel/plugin"
+	"gitee.com/flycash/notification-platform/internal/web/middleware"
+	"github.com/ecodeclub/ginx"
+	"github.com/gin-gonic/gin"
+	"github.com/gotomicro/ego/core/elog"
+)
+
+const (
+	systemErrorCode  = 507001
+	invalidParamCode = 507002
+	selfCheckCode    = 507003
+)
+
+var _ ginx.Handler = &Handler{}
+
+// Handler 渠道插件的管理接口
+// 先在当前实例加载并自检，通过后再写入 etcd 通知其他实例，避免把有问题的插件推到所有实例
+type Handler struct {
+	manager *plugin.Manager
+	syncer  *plugin.Syncer
+	token   string
+	logger  *elog.Component
+}
+
+// NewHandler token 为管理接口的访问令牌，为空时不注册管理接口
+func NewHandler(manager *plugin.Manager, syncer *plugin.Syncer, token string) *Handler {
+	return &Handler{
+		manager: manager,
+		syncer:  syncer,
+		token:   token,
+		logger:  elog.DefaultLogger.With(elog.FieldComponent("channel.plugin")),
+	}
+}
+
+func (h *Handler) PrivateRoutes(server *gin.Engine) {
+	if h.token == "" {
+		return
+	}
+	g := server.Group("/admin/channel-plugins", middleware.AdminAuth(h.token))
+	g.GET("", ginx.W(h.ListPlugins))
+	g.POST("/reload", ginx.B[LoadPluginReq](h.ReloadPlugin))
+	g.POST("/unload", ginx.B[UnloadPluginReq](h.UnloadPlugin))
+}
+
+func (h *Handler) PublicRoutes(_ *gin.Engine) {
+}
+
+// ListPlugins 当前实例生效的插件版本
+func (h *Handler) ListPlugins(_ *ginx.Context) (ginx.Result, error) {
+	versions := h.manager.Versions()
+	res := make([]PluginVersion, 0, len(versions))
+	for _, ch := range slices.Sorted(maps.Keys(versions)) {
+		res = append(res, PluginVersion{Channel: ch.String(), Version: versions[ch]})
+	}
+	return ginx.Result{Data: ListPluginsResp{Plugins: res}}, nil
+}
+
+// ReloadPlugin 加载插件的指定版本并通知所有实例
+func (h *Handler) ReloadPlugin(ctx *ginx.Context, req LoadPluginReq) (ginx.Result, error) {
+	ch := domain.Channel(req.Channel)
+	err := h.manager.Load(ctx.Request.Context(), ch, req.Version)
+	switch {
+	case err == nil:
+	case errors.Is(err, errs.ErrUnknownChannel), errors.Is(err, errs.ErrInvalidParameter):
+		return ginx.Result{Code: invalidParamCode, Msg: err.Error()}, nil
+	case errors.Is(err, plugin.ErrInvalidPlugin), errors.Is(err, plugin.ErrSelfCheckFailed):
+		return ginx.Result{Code: selfCheckCode, Msg: err.Error()}, nil
+	default:
+		return ginx.Result{Code: systemErrorCode, Msg: "系统错误"}, err
+	}
+	if err = h.syncer.Publish(ctx.Request.Context(), ch, req.Version); err != nil {
+		return ginx.Result{Code: systemErrorCode, Msg: "通知其他实例失败"}, err
+	}
+	h.logger.Info("发布渠道插件", elog.String("Channel", req.Channel), elog.String("Version", req.Version))
+	return ginx.Result{Msg: "OK"}, nil
+}
+
+// UnloadPlugin 卸载插件并通知所有实例恢复使用内置实现
+func (h *Handler) UnloadPlugin(ctx *ginx.Context, req UnloadPluginReq) (ginx.Result, error) {
+	ch := domain.Channel(req.Channel)
+	if !ch.IsValid() {
+		return ginx.Result{Code: invalidParamCode, Msg: errs.ErrUnknownChannel.Error()}, nil
+	}
+	if err := h.syncer.Remove(ctx.Request.Context(), ch); err != nil {
+		return ginx.Result{Code: systemErrorCode, Msg: "通知其他实例失败"}, err
+	}
+	h.manager.Unload(ch)
+	h.logger.Info("卸载渠道插件", elog.String("Channel", req.Channel))
+	return ginx.Result{Msg: "OK"}, nil
+}
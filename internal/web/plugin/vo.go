@@ -0,0 +1,19 @@
+package plugin
+
+type LoadPluginReq struct {
+	Channel string `json:"channel"`
+	Version string `json:"version"`
+}
+
+type UnloadPluginReq struct {
+	Channel string `json:"channel"`
+}
+
+type ListPluginsResp struct {
+	Plugins []PluginVersion `json:"plugins"`
+}
+
+type PluginVersion struct {
+	Channel string `json:"channel"`
+	Version string `json:"version"`
+}
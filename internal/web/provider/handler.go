@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"errors"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/service/provider/manage"
+	"gitee.com/flycash/notification-platform/internal/service/provider/pricing"
+	"gitee.com/flycash/notification-platform/internal/service/provider/testsend"
+	"gitee.com/flycash/notification-platform/internal/web/middleware"
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/ecodeclub/ginx"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	systemErrorCode  = 508001
+	invalidParamCode = 508002
+	notFoundCode     = 508003
+)
+
+var _ ginx.Handler = &Handler{}
+
+// Handler 供应商管理接口，修改后由供应商注册中心在下次加载时生效
+type Handler struct {
+	svc         manage.Service
+	testSendSvc testsend.Service
+	pricingSvc  pricing.Service
+	token       string
+}
+
+// NewHandler token 为管理接口的访问令牌，为空时不注册管理接口
+func NewHandler(svc manage.Service, testSendSvc testsend.Service, pricingSvc pricing.Service, token string) *Handler {
+	return &Handler{svc: svc, testSendSvc: testSendSvc, pricingSvc: pricingSvc, token: token}
+}
+
+func (h *Handler) PrivateRoutes(server *gin.Engine) {
+	if h.token == "" {
+		return
+	}
+	g := server.Group("/admin/providers", middleware.AdminAuth(h.token))
+	g.POST("/list", ginx.B[ListProvidersReq](h.ListProviders))
+	g.POST("/get", ginx.B[IDReq](h.GetProvider))
+	g.POST("/create", ginx.B[Provider](h.CreateProvider))
+	g.POST("/update", ginx.B[Provider](h.UpdateProvider))
+	g.POST("/delete", ginx.B[IDReq](h.DeleteProvider))
+	g.POST("/activate", ginx.B[IDReq](h.ActivateProvider))
+	g.POST("/deactivate", ginx.B[IDReq](h.DeactivateProvider))
+	g.POST("/weight", ginx.B[UpdateWeightReq](h.UpdateWeight))
+	g.POST("/limits", ginx.B[UpdateLimitsReq](h.UpdateLimits))
+	g.POST("/test-send", ginx.B[TestSendReq](h.TestSend))
+	g.POST("/prices/list", ginx.B[ListPricesReq](h.ListPrices))
+	g.POST("/prices/save", ginx.B[Price](h.SavePrice))
+}
+
+func (h *Handler) PublicRoutes(_ *gin.Engine) {
+}
+
+// ListProviders 获取所有供应商，包括禁用的供应商
+func (h *Handler) ListProviders(ctx *ginx.Context, req ListProvidersReq) (ginx.Result, error) {
+	providers, err := h.svc.List(ctx.Request.Context(), domain.Channel(req.Channel))
+	if err != nil {
+		return h.errorResult(err)
+	}
+	return ginx.Result{
+		Data: ListProvidersResp{
+			Providers: slice.Map(providers, func(_ int, src domain.Provider) Provider {
+				return h.toVO(src)
+			}),
+		},
+	}, nil
+}
+
+func (h *Handler) GetProvider(ctx *ginx.Context, req IDReq) (ginx.Result, error) {
+	p, err := h.svc.GetByID(ctx.Request.Context(), req.ID)
+	if err != nil {
+		return h.errorResult(err)
+	}
+	return ginx.Result{Data: h.toVO(p)}, nil
+}
+
+func (h *Handler) CreateProvider(ctx *ginx.Context, req Provider) (ginx.Result, error) {
+	p := h.toDomain(req)
+	p.ID = 0
+	created, err := h.svc.Create(ctx.Request.Context(), p)
+	if err != nil {
+		return h.errorResult(err)
+	}
+	return ginx.Result{Data: h.toVO(created)}, nil
+}
+
+func (h *Handler) UpdateProvider(ctx *ginx.Context, req Provider) (ginx.Result, error) {
+	return h.okResult(h.svc.Update(ctx.Request.Context(), h.toDomain(req)))
+}
+
+func (h *Handler) DeleteProvider(ctx *ginx.Context, req IDReq) (ginx.Result, error) {
+	return h.okResult(h.svc.Delete(ctx.Request.Context(), req.ID))
+}
+
+func (h *Handler) ActivateProvider(ctx *ginx.Context, req IDReq) (ginx.Result, error) {
+	return h.okResult(h.svc.Activate(ctx.Request.Context(), req.ID))
+}
+
+func (h *Handler) DeactivateProvider(ctx *ginx.Context, req IDReq) (ginx.Result, error) {
+	return h.okResult(h.svc.Deactivate(ctx.Request.Context(), req.ID))
+}
+
+func (h *Handler) UpdateWeight(ctx *ginx.Context, req UpdateWeightReq) (ginx.Result, error) {
+	return h.okResult(h.svc.UpdateWeight(ctx.Request.Context(), req.ID, req.Weight))
+}
+
+func (h *Handler) UpdateLimits(ctx *ginx.Context, req UpdateLimitsReq) (ginx.Result, error) {
+	return h.okResult(h.svc.UpdateLimits(ctx.Request.Context(), req.ID, req.QPSLimit, req.DailyLimit))
+}
+
+// TestSend 只通过该供应商发送一条测试通知，发送失败时返回失败原因
+func (h *Handler) TestSend(ctx *ginx.Context, req TestSendReq) (ginx.Result, error) {
+	_, err := h.testSendSvc.Send(ctx.Request.Context(), domain.ProviderTestSend{
+		ProviderID: req.ID,
+		Receiver:   req.Receiver,
+		TemplateID: req.TemplateID,
+		Params:     req.Params,
+	})
+	if errors.Is(err, errs.ErrInvalidParameter) || errors.Is(err, errs.ErrProviderNotFound) {
+		return h.errorResult(err)
+	}
+	if err != nil {
+		return ginx.Result{Data: TestSendResp{ErrorMessage: err.Error()}}, nil
+	}
+	return ginx.Result{Data: TestSendResp{Success: true}}, nil
+}
+
+// ListPrices 获取渠道内所有供应商的价格表
+func (h *Handler) ListPrices(ctx *ginx.Context, req ListPricesReq) (ginx.Result, error) {
+	table, err := h.pricingSvc.GetPriceTable(ctx.Request.Context(), domain.Channel(req.Channel))
+	if err != nil {
+		return h.errorResult(err)
+	}
+	return ginx.Result{
+		Data: ListPricesResp{
+			Prices: slice.Map(table, func(_ int, src domain.ProviderPrice) Price {
+				return Price{
+					Provider:     src.Provider,
+					Channel:      src.Channel.String(),
+					CountryCode:  src.CountryCode,
+					BusinessType: src.BusinessType.ToInt64(),
+					UnitPrice:    src.UnitPrice,
+				}
+			}),
+		},
+	}, nil
+}
+
+// SavePrice 按供应商、渠道、国家码和业务类型新增或者更新单价，成本优先的负载均衡下次选择供应商时生效
+func (h *Handler) SavePrice(ctx *ginx.Context, req Price) (ginx.Result, error) {
+	return h.okResult(h.pricingSvc.SavePrice(ctx.Request.Context(), domain.ProviderPrice{
+		Provider:     req.Provider,
+		Channel:      domain.Channel(req.Channel),
+		CountryCode:  req.CountryCode,
+		BusinessType: domain.BusinessType(req.BusinessType),
+		UnitPrice:    req.UnitPrice,
+	}))
+}
+
+func (h *Handler) okResult(err error) (ginx.Result, error) {
+	if err != nil {
+		return h.errorResult(err)
+	}
+	return ginx.Result{Msg: "OK"}, nil
+}
+
+func (h *Handler) errorResult(err error) (ginx.Result, error) {
+	switch {
+	case errors.Is(err, errs.ErrInvalidParameter):
+		return ginx.Result{Code: invalidParamCode, Msg: err.Error()}, nil
+	case errors.Is(err, errs.ErrProviderNotFound):
+		return ginx.Result{Code: notFoundCode, Msg: err.Error()}, nil
+	default:
+		return ginx.Result{Code: systemErrorCode, Msg: "系统错误"}, err
+	}
+}
+
+func (h *Handler) toDomain(p Provider) domain.Provider {
+	return domain.Provider{
+		ID:               p.ID,
+		Name:             p.Name,
+		Channel:          domain.Channel(p.Channel),
+		Endpoint:         p.Endpoint,
+		RegionID:         p.RegionID,
+		APIKey:           p.APIKey,
+		APISecret:        p.APISecret,
+		APPID:            p.APPID,
+		Weight:           p.Weight,
+		QPSLimit:         p.QPSLimit,
+		DailyLimit:       p.DailyLimit,
+		AuditCallbackURL: p.AuditCallbackURL,
+		CallbackToken:    p.CallbackToken,
+		Status:           domain.ProviderStatus(p.Status),
+	}
+}
+
+// toVO 响应中的密钥脱敏
+func (h *Handler) toVO(p domain.Provider) Provider {
+	p = p.Masked()
+	return Provider{
+		ID:               p.ID,
+		Name:             p.Name,
+		Channel:          p.Channel.String(),
+		Endpoint:         p.Endpoint,
+		RegionID:         p.RegionID,
+		APIKey:           p.APIKey,
+		APISecret:        p.APISecret,
+		APPID:            p.APPID,
+		Weight:           p.Weight,
+		QPSLimit:         p.QPSLimit,
+		DailyLimit:       p.DailyLimit,
+		AuditCallbackURL: p.AuditCallbackURL,
+		CallbackToken:    p.CallbackToken,
+		Status:           p.Status.String(),
+	}
+}
@@ -0,0 +1,74 @@
+package provider
+
+// Provider 响应中的密钥已脱敏，更新时密钥留空表示不修改，不能传入脱敏后的值
+type Provider struct {
+	ID               int64  `json:"id"`
+	Name             string `json:"name"`
+	Channel          string `json:"channel"`
+	Endpoint         string `json:"endpoint"`
+	RegionID         string `json:"regionId"`
+	APIKey           string `json:"apiKey"`
+	APISecret        string `json:"apiSecret"`
+	APPID            string `json:"appId"`
+	Weight           int    `json:"weight"`
+	QPSLimit         int    `json:"qpsLimit"`
+	DailyLimit       int    `json:"dailyLimit"`
+	AuditCallbackURL string `json:"auditCallbackUrl"`
+	CallbackToken    string `json:"callbackToken"`
+	Status           string `json:"status"`
+}
+
+type IDReq struct {
+	ID int64 `json:"id"`
+}
+
+type ListProvidersReq struct {
+	// Channel 为空表示所有渠道
+	Channel string `json:"channel"`
+}
+
+type ListProvidersResp struct {
+	Providers []Provider `json:"providers"`
+}
+
+type UpdateWeightReq struct {
+	ID     int64 `json:"id"`
+	Weight int   `json:"weight"`
+}
+
+type UpdateLimitsReq struct {
+	ID         int64 `json:"id"`
+	QPSLimit   int   `json:"qpsLimit"`
+	DailyLimit int   `json:"dailyLimit"`
+}
+
+type TestSendReq struct {
+	ID       int64  `json:"id"`
+	Receiver string `json:"receiver"`
+	// TemplateID 为0时使用配置的测试模版
+	TemplateID int64             `json:"templateId"`
+	Params     map[string]string `json:"params"`
+}
+
+type TestSendResp struct {
+	Success      bool   `json:"success"`
+	ErrorMessage string `json:"errorMessage"`
+}
+
+// Price 供应商单价，CountryCode 为空表示适用所有国家和地区，BusinessType 为0表示适用所有业务类型
+type Price struct {
+	Provider     string `json:"provider"`
+	Channel      string `json:"channel"`
+	CountryCode  string `json:"countryCode"`
+	BusinessType int64  `json:"businessType"`
+	// UnitPrice 单位为0.0001元
+	UnitPrice int64 `json:"unitPrice"`
+}
+
+type ListPricesReq struct {
+	Channel string `json:"channel"`
+}
+
+type ListPricesResp struct {
+	Prices []Price `json:"prices"`
+}
@@ -0,0 +1,116 @@
+package sandbox
+
+import (
+	"errors"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/service/provider/sandbox"
+	"gitee.com/flycash/notification-platform/internal/web/middleware"
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/ecodeclub/ginx"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	systemErrorCode  = 509001
+	invalidParamCode = 509002
+	notFoundCode     = 509003
+)
+
+var _ ginx.Handler = &Handler{}
+
+// Handler 沙箱消息查询接口，供测试环境断言实际发送的内容
+type Handler struct {
+	svc   sandbox.Service
+	token string
+}
+
+// NewHandler token 为管理接口的访问令牌，为空或者没有开启沙箱供应商时不注册
+func NewHandler(svc sandbox.Service, token string) *Handler {
+	return &Handler{svc: svc, token: token}
+}
+
+func (h *Handler) PrivateRoutes(server *gin.Engine) {
+	if h.token == "" || !h.svc.Enabled() {
+		return
+	}
+	g := server.Group("/admin/sandbox/messages", middleware.AdminAuth(h.token))
+	g.POST("/list", ginx.B[ListMessagesReq](h.ListMessages))
+	g.POST("/get", ginx.B[GetMessageReq](h.GetMessage))
+	g.POST("/clear", ginx.B[ClearMessagesReq](h.ClearMessages))
+}
+
+func (h *Handler) PublicRoutes(_ *gin.Engine) {
+}
+
+// ListMessages 按捕获时间倒序分页查询
+func (h *Handler) ListMessages(ctx *ginx.Context, req ListMessagesReq) (ginx.Result, error) {
+	msgs, total, err := h.svc.List(ctx.Request.Context(), domain.CapturedMessageQuery{
+		BizID:          req.BizID,
+		NotificationID: req.NotificationID,
+		Key:            req.Key,
+		Channel:        domain.Channel(req.Channel),
+		Receiver:       req.Receiver,
+		Offset:         req.Offset,
+		Limit:          req.Limit,
+	})
+	if err != nil {
+		return h.errorResult(err)
+	}
+	return ginx.Result{
+		Data: ListMessagesResp{
+			Messages: slice.Map(msgs, func(_ int, src domain.CapturedMessage) CapturedMessage {
+				return h.toVO(src)
+			}),
+			Total: total,
+		},
+	}, nil
+}
+
+func (h *Handler) GetMessage(ctx *ginx.Context, req GetMessageReq) (ginx.Result, error) {
+	msg, err := h.svc.Get(ctx.Request.Context(), req.BizID, req.ID)
+	if err != nil {
+		return h.errorResult(err)
+	}
+	return ginx.Result{Data: h.toVO(msg)}, nil
+}
+
+func (h *Handler) ClearMessages(ctx *ginx.Context, req ClearMessagesReq) (ginx.Result, error) {
+	if err := h.svc.Clear(ctx.Request.Context(), req.BizID); err != nil {
+		return h.errorResult(err)
+	}
+	return ginx.Result{Msg: "OK"}, nil
+}
+
+func (h *Handler) errorResult(err error) (ginx.Result, error) {
+	switch {
+	case errors.Is(err, errs.ErrInvalidParameter):
+		return ginx.Result{Code: invalidParamCode, Msg: err.Error()}, nil
+	case errors.Is(err, errs.ErrCapturedMessageNotFound):
+		return ginx.Result{Code: notFoundCode, Msg: err.Error()}, nil
+	default:
+		return ginx.Result{Code: systemErrorCode, Msg: "系统错误"}, err
+	}
+}
+
+func (h *Handler) toVO(msg domain.CapturedMessage) CapturedMessage {
+	return CapturedMessage{
+		ID:                msg.ID,
+		BizID:             msg.BizID,
+		NotificationID:    msg.NotificationID,
+		Key:               msg.Key,
+		Channel:           msg.Channel.String(),
+		Provider:          msg.Provider,
+		Receivers:         msg.Receivers,
+		TemplateID:        msg.TemplateID,
+		TemplateVersionID: msg.TemplateVersionID,
+		Params:            msg.Params,
+		Subject:           msg.Subject,
+		Content:           msg.Content,
+		Status:            msg.Status.String(),
+		ErrMessage:        msg.ErrMessage,
+		Latency:           msg.Latency,
+		Ctime:             msg.Ctime,
+	}
+}
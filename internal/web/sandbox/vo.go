@@ -0,0 +1,46 @@
+package sandbox
+
+// CapturedMessage 沙箱供应商捕获的消息
+type CapturedMessage struct {
+	ID                string            `json:"id"`
+	BizID             int64             `json:"bizId"`
+	NotificationID    uint64            `json:"notificationId"`
+	Key               string            `json:"key"`
+	Channel           string            `json:"channel"`
+	Provider          string            `json:"provider"`
+	Receivers         []string          `json:"receivers"`
+	TemplateID        int64             `json:"templateId"`
+	TemplateVersionID int64             `json:"templateVersionId"`
+	Params            map[string]string `json:"params"`
+	Subject           string            `json:"subject"`
+	Content           string            `json:"content"`
+	Status            string            `json:"status"`
+	ErrMessage        string            `json:"errMessage"`
+	Latency           int64             `json:"latency"`
+	Ctime             int64             `json:"ctime"`
+}
+
+type ListMessagesReq struct {
+	BizID int64 `json:"bizId"`
+	// 以下条件为空表示不过滤
+	NotificationID uint64 `json:"notificationId"`
+	Key            string `json:"key"`
+	Channel        string `json:"channel"`
+	Receiver       string `json:"receiver"`
+	Offset         int    `json:"offset"`
+	Limit          int    `json:"limit"`
+}
+
+type ListMessagesResp struct {
+	Messages []CapturedMessage `json:"messages"`
+	Total    int               `json:"total"`
+}
+
+type GetMessageReq struct {
+	BizID int64  `json:"bizId"`
+	ID    string `json:"id"`
+}
+
+type ClearMessagesReq struct {
+	BizID int64 `json:"bizId"`
+}
@@ -3,10 +3,14 @@ package template
 import (
 	"errors"
 	"log"
+	"time"
 
 	"gitee.com/flycash/notification-platform/internal/domain"
 	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/pkg/diff"
 	templatesvc "gitee.com/flycash/notification-platform/internal/service/template/manage"
+	"gitee.com/flycash/notification-platform/internal/service/template/render"
+	"gitee.com/flycash/notification-platform/internal/web/middleware"
 	"github.com/ecodeclub/ekit/slice"
 	"github.com/ecodeclub/ginx"
 
@@ -16,35 +20,67 @@ import (
 var _ ginx.Handler = &Handler{}
 
 type Handler struct {
-	svc templatesvc.ChannelTemplateService
+	svc          templatesvc.ChannelTemplateService
+	renderer     render.Service
+	token        string
+	authenticate middleware.Authenticator
 }
 
-func NewHandler(svc templatesvc.ChannelTemplateService) *Handler {
-	return &Handler{svc: svc}
+// NewHandler token 为管理接口的访问令牌，为空时只注册查询接口和发布接口
+// authenticate 识别发布、回滚的操作人，发布记录中的操作人只来自访问令牌
+func NewHandler(svc templatesvc.ChannelTemplateService, renderer render.Service, token string, authenticate middleware.Authenticator) *Handler {
+	return &Handler{svc: svc, renderer: renderer, token: token, authenticate: authenticate}
 }
 
-func (h *Handler) PrivateRoutes(_ *gin.Engine) {
-}
+// PrivateRoutes 修改模版、版本和审核状态的接口，需要管理接口令牌；发布和回滚需要操作人自己的访问令牌
+func (h *Handler) PrivateRoutes(server *gin.Engine) {
+	p := server.Group("/templates", middleware.OperatorAuth(h.authenticate))
+	p.POST("/publish", ginx.B[PublishTemplateReq](h.PublishTemplate))
+	p.POST("/rollback", ginx.B[RollbackTemplateReq](h.RollbackTemplate))
 
-func (h *Handler) PublicRoutes(server *gin.Engine) {
-	g := server.Group("/templates")
-	g.POST("/list", ginx.B[ListTemplatesReq](h.ListTemplates))
+	if h.token == "" {
+		return
+	}
+	g := server.Group("/templates", middleware.AdminAuth(h.token))
 	g.POST("/create", ginx.B[CreateTemplateReq](h.CreateTemplate))
 	g.POST("/update", ginx.B[UpdateTemplateReq](h.UpdateTemplate))
-	g.POST("/publish", ginx.B[PublishTemplateReq](h.PublishTemplate))
+	g.POST("/archive", ginx.B[TemplateIDReq](h.ArchiveTemplate))
+	g.POST("/delete", ginx.B[TemplateIDReq](h.DeleteTemplate))
 
 	j := g.Group("/versions")
 	j.POST("/fork", ginx.B[ForkVersionReq](h.ForkVersion))
 	j.POST("/update", ginx.B[UpdateVersionReq](h.UpdateVersion))
 	j.POST("/review/internal", ginx.B[SubmitForInternalReviewReq](h.SubmitForInternalReview))
+	j.POST("/review/provider", ginx.B[SubmitForProviderReviewReq](h.SubmitForProviderReview))
+	j.POST("/archive", ginx.B[VersionIDReq](h.ArchiveVersion))
+	j.POST("/delete", ginx.B[VersionIDReq](h.DeleteVersion))
+	j.POST("/diff", ginx.B[DiffVersionsReq](h.DiffVersions))
+}
+
+// PublicRoutes 只读的查询接口
+func (h *Handler) PublicRoutes(server *gin.Engine) {
+	g := server.Group("/templates")
+	g.POST("/list", ginx.B[ListTemplatesReq](h.ListTemplates))
+	g.POST("/get", ginx.B[TemplateIDReq](h.GetTemplate))
+	g.POST("/history", ginx.B[ListPublishHistoryReq](h.ListPublishHistory))
+	g.POST("/reviews/pending", ginx.B[ListPendingReviewsReq](h.ListPendingReviews))
+
+	j := g.Group("/versions")
+	j.POST("/check", ginx.B[CheckVersionReq](h.CheckVersion))
+	j.POST("/preview", ginx.B[PreviewVersionReq](h.PreviewVersion))
 }
 
-// ListTemplates 获取所有模版
+// ListTemplates 获取所有模版，默认不包含已归档的模版
 func (h *Handler) ListTemplates(ctx *ginx.Context, req ListTemplatesReq) (ginx.Result, error) {
 	templates, err := h.svc.GetTemplatesByOwner(ctx.Request.Context(), req.OwnerID, domain.OwnerType(req.OwnerType))
 	if err != nil {
 		return systemErrorResult, err
 	}
+	if !req.IncludeArchived {
+		templates = slice.FilterMap(templates, func(_ int, src domain.ChannelTemplate) (domain.ChannelTemplate, bool) {
+			return src, !src.Archived()
+		})
+	}
 	return ginx.Result{
 		Data: ListTemplatesResp{
 			Templates: slice.Map(templates, func(_ int, src domain.ChannelTemplate) ChannelTemplate {
@@ -56,16 +92,19 @@ func (h *Handler) ListTemplates(ctx *ginx.Context, req ListTemplatesReq) (ginx.R
 
 func (h *Handler) toTemplateVO(src domain.ChannelTemplate) ChannelTemplate {
 	return ChannelTemplate{
-		ID:              src.ID,
-		OwnerID:         src.OwnerID,
-		OwnerType:       src.OwnerType.String(),
-		Name:            src.Name,
-		Description:     src.Description,
-		Channel:         src.Channel.String(),
-		BusinessType:    src.BusinessType.ToInt64(),
-		ActiveVersionID: src.ActiveVersionID,
-		Ctime:           src.Ctime,
-		Utime:           src.Utime,
+		ID:                   src.ID,
+		OwnerID:              src.OwnerID,
+		OwnerType:            src.OwnerType.String(),
+		Name:                 src.Name,
+		Description:          src.Description,
+		Channel:              src.Channel.String(),
+		BusinessType:         src.BusinessType.ToInt64(),
+		International:        src.International,
+		ActiveVersionID:      src.ActiveVersionID,
+		ArchivedTime:         src.ArchivedTime,
+		Ctime:                src.Ctime,
+		Utime:                src.Utime,
+		ActiveLocaleVersions: src.ActiveLocaleVersions,
 		Versions: slice.Map(src.Versions, func(_ int, src domain.ChannelTemplateVersion) ChannelTemplateVersion {
 			return h.toVersionVO(src)
 		}),
@@ -86,8 +125,18 @@ func (h *Handler) toVersionVO(src domain.ChannelTemplateVersion) ChannelTemplate
 		AuditStatus:              src.AuditStatus.String(),
 		RejectReason:             src.RejectReason,
 		LastReviewSubmissionTime: src.LastReviewSubmissionTime,
+		ArchivedTime:             src.ArchivedTime,
 		Ctime:                    src.Ctime,
 		Utime:                    src.Utime,
+		Subject:                  src.Subject,
+		ReplyTo:                  src.ReplyTo,
+		Locale:                   src.Locale,
+		Attachments: slice.Map(src.Attachments, func(_ int, src domain.EmailAttachment) EmailAttachment {
+			return EmailAttachment(src)
+		}),
+		Params: slice.Map(src.Params, func(_ int, src domain.TemplateParam) TemplateParam {
+			return h.toParamVO(src)
+		}),
 		Providers: slice.Map(src.Providers, func(_ int, src domain.ChannelTemplateProvider) ChannelTemplateProvider {
 			return h.toProviderVO(src)
 		}),
@@ -102,6 +151,7 @@ func (h *Handler) toProviderVO(src domain.ChannelTemplateProvider) ChannelTempla
 		ProviderID:               src.ProviderID,
 		ProviderName:             src.ProviderName,
 		ProviderChannel:          src.ProviderChannel.String(),
+		Region:                   src.Region.String(),
 		RequestID:                src.RequestID,
 		ProviderTemplateID:       src.ProviderTemplateID,
 		AuditStatus:              src.AuditStatus.String(),
@@ -115,12 +165,13 @@ func (h *Handler) toProviderVO(src domain.ChannelTemplateProvider) ChannelTempla
 // CreateTemplate 创建模板
 func (h *Handler) CreateTemplate(ctx *ginx.Context, req CreateTemplateReq) (ginx.Result, error) {
 	template := domain.ChannelTemplate{
-		OwnerID:      req.OwnerID,
-		OwnerType:    domain.OwnerType(req.OwnerType),
-		Name:         req.Name,
-		Description:  req.Description,
-		Channel:      domain.Channel(req.Channel),
-		BusinessType: domain.BusinessType(req.BusinessType),
+		OwnerID:       req.OwnerID,
+		OwnerType:     domain.OwnerType(req.OwnerType),
+		Name:          req.Name,
+		Description:   req.Description,
+		Channel:       domain.Channel(req.Channel),
+		BusinessType:  domain.BusinessType(req.BusinessType),
+		International: req.International,
 	}
 
 	createdTemplate, err := h.svc.CreateTemplate(ctx.Request.Context(), template)
@@ -138,13 +189,17 @@ func (h *Handler) CreateTemplate(ctx *ginx.Context, req CreateTemplateReq) (ginx
 // UpdateTemplate 更新模板基础信息
 func (h *Handler) UpdateTemplate(ctx *ginx.Context, req UpdateTemplateReq) (ginx.Result, error) {
 	template := domain.ChannelTemplate{
-		ID:           req.TemplateID,
-		Name:         req.Name,
-		Description:  req.Description,
-		BusinessType: domain.BusinessType(req.BusinessType),
+		ID:            req.TemplateID,
+		Name:          req.Name,
+		Description:   req.Description,
+		BusinessType:  domain.BusinessType(req.BusinessType),
+		International: req.International,
 	}
 
 	if err := h.svc.UpdateTemplate(ctx.Request.Context(), template); err != nil {
+		if errors.Is(err, errs.ErrTemplateArchived) {
+			return ginx.Result{Code: InvalidParamError.Code, Msg: err.Error()}, nil
+		}
 
 		log.Printf("err = %#v\n", err)
 		return systemErrorResult, err
@@ -157,7 +212,10 @@ func (h *Handler) UpdateTemplate(ctx *ginx.Context, req UpdateTemplateReq) (ginx
 
 // PublishTemplate 发布模板
 func (h *Handler) PublishTemplate(ctx *ginx.Context, req PublishTemplateReq) (ginx.Result, error) {
-	if err := h.svc.PublishTemplate(ctx.Request.Context(), req.TemplateID, req.VersionID); err != nil {
+	if err := h.svc.PublishTemplate(ctx.Request.Context(), req.TemplateID, req.VersionID, ctx.GetInt64(middleware.OperatorIDKey)); err != nil {
+		if errors.Is(err, errs.ErrTemplateArchived) {
+			return ginx.Result{Code: InvalidParamError.Code, Msg: err.Error()}, nil
+		}
 		return systemErrorResult, err
 	}
 
@@ -166,6 +224,48 @@ func (h *Handler) PublishTemplate(ctx *ginx.Context, req PublishTemplateReq) (gi
 	}, nil
 }
 
+// RollbackTemplate 回滚到之前审核通过的版本
+func (h *Handler) RollbackTemplate(ctx *ginx.Context, req RollbackTemplateReq) (ginx.Result, error) {
+	if err := h.svc.RollbackTemplate(ctx.Request.Context(), req.TemplateID, req.VersionID, ctx.GetInt64(middleware.OperatorIDKey)); err != nil {
+		if errors.Is(err, errs.ErrInvalidParameter) || errors.Is(err, errs.ErrTemplateArchived) {
+			return ginx.Result{Code: InvalidParamError.Code, Msg: err.Error()}, nil
+		}
+		return systemErrorResult, err
+	}
+
+	return ginx.Result{
+		Msg: "OK",
+	}, nil
+}
+
+// ListPublishHistory 获取模版的发布历史
+func (h *Handler) ListPublishHistory(ctx *ginx.Context, req ListPublishHistoryReq) (ginx.Result, error) {
+	records, total, err := h.svc.GetPublishHistory(ctx.Request.Context(), req.TemplateID, req.Offset, req.Limit)
+	if err != nil {
+		if errors.Is(err, errs.ErrInvalidParameter) {
+			return ginx.Result{Code: InvalidParamError.Code, Msg: err.Error()}, nil
+		}
+		return systemErrorResult, err
+	}
+	return ginx.Result{
+		Data: ListPublishHistoryResp{
+			Records: slice.Map(records, func(_ int, src domain.TemplatePublishRecord) PublishRecord {
+				return PublishRecord{
+					ID:                src.ID,
+					TemplateID:        src.TemplateID,
+					VersionID:         src.VersionID,
+					PreviousVersionID: src.PreviousVersionID,
+					OperatorID:        src.OperatorID,
+					Action:            src.Action.String(),
+					Locale:            src.Locale,
+					Ctime:             src.Ctime,
+				}
+			}),
+			Total: total,
+		},
+	}, nil
+}
+
 // ForkVersion 拷贝模版版本
 func (h *Handler) ForkVersion(ctx *ginx.Context, req ForkVersionReq) (ginx.Result, error) {
 	version, err := h.svc.ForkVersion(ctx.Request.Context(), req.VersionID)
@@ -187,9 +287,27 @@ func (h *Handler) UpdateVersion(ctx *ginx.Context, req UpdateVersionReq) (ginx.R
 		Signature: req.Signature,
 		Content:   req.Content,
 		Remark:    req.Remark,
+		Subject:   req.Subject,
+		ReplyTo:   req.ReplyTo,
+		Locale:    req.Locale,
+		Attachments: slice.Map(req.Attachments, func(_ int, src EmailAttachment) domain.EmailAttachment {
+			return domain.EmailAttachment(src)
+		}),
+		Params: slice.Map(req.Params, func(_ int, src TemplateParam) domain.TemplateParam {
+			return domain.TemplateParam{
+				Name:      src.Name,
+				Type:      domain.TemplateParamType(src.Type),
+				Required:  src.Required,
+				MaxLength: src.MaxLength,
+				Pattern:   src.Pattern,
+			}
+		}),
 	}
 
 	if err := h.svc.UpdateVersion(ctx.Request.Context(), version); err != nil {
+		if errors.Is(err, errs.ErrInvalidParameter) || errors.Is(err, errs.ErrTemplateArchived) {
+			return ginx.Result{Code: InvalidParamError.Code, Msg: err.Error()}, nil
+		}
 		if !errors.Is(err, errs.ErrTemplateVersionNotFound) {
 			return systemErrorResult, err
 		}
@@ -200,13 +318,242 @@ func (h *Handler) UpdateVersion(ctx *ginx.Context, req UpdateVersionReq) (ginx.R
 	}, nil
 }
 
-// SubmitForInternalReview 提交内部审核
+// CheckVersion 审核前检查，只返回发现的问题，不会提交审核
+func (h *Handler) CheckVersion(ctx *ginx.Context, req CheckVersionReq) (ginx.Result, error) {
+	result, err := h.svc.CheckVersion(ctx.Request.Context(), req.VersionID)
+	if err != nil {
+		if errors.Is(err, errs.ErrInvalidParameter) ||
+			errors.Is(err, errs.ErrTemplateNotFound) ||
+			errors.Is(err, errs.ErrTemplateVersionNotFound) {
+			return ginx.Result{Code: InvalidParamError.Code, Msg: err.Error()}, nil
+		}
+		return systemErrorResult, err
+	}
+	return ginx.Result{
+		Data: CheckVersionResp{
+			Blocked:  result.Blocked(),
+			Findings: h.toFindingVOs(result.Findings),
+		},
+	}, nil
+}
+
+// SubmitForInternalReview 提交内部审核，审核前检查没有通过时返回发现的问题
 func (h *Handler) SubmitForInternalReview(ctx *ginx.Context, req SubmitForInternalReviewReq) (ginx.Result, error) {
 	if err := h.svc.SubmitForInternalReview(ctx.Request.Context(), req.VersionID); err != nil {
+		var rejected *domain.ModerationRejectedError
+		if errors.As(err, &rejected) {
+			return ginx.Result{
+				Code: InvalidParamError.Code,
+				Msg:  err.Error(),
+				Data: CheckVersionResp{
+					Blocked:  true,
+					Findings: h.toFindingVOs(rejected.Findings),
+				},
+			}, nil
+		}
+		if errors.Is(err, errs.ErrTemplateArchived) {
+			return ginx.Result{Code: InvalidParamError.Code, Msg: err.Error()}, nil
+		}
+		return systemErrorResult, err
+	}
+
+	return ginx.Result{
+		Msg: "OK",
+	}, nil
+}
+
+// PreviewVersion 使用示例参数预览模版版本，不会发送
+func (h *Handler) PreviewVersion(ctx *ginx.Context, req PreviewVersionReq) (ginx.Result, error) {
+	preview, err := h.renderer.Preview(ctx.Request.Context(), req.TemplateID, req.VersionID, req.Params)
+	if err != nil {
+		if errors.Is(err, errs.ErrTemplateNotFound) ||
+			errors.Is(err, errs.ErrTemplateVersionNotFound) ||
+			errors.Is(err, errs.ErrRenderTemplateFailed) {
+			return ginx.Result{Code: InvalidParamError.Code, Msg: err.Error()}, nil
+		}
+		return systemErrorResult, err
+	}
+	return ginx.Result{
+		Data: PreviewVersionResp{
+			Channel:       preview.Channel.String(),
+			Subject:       preview.Subject,
+			Content:       preview.Content,
+			Charset:       string(preview.SMS.Charset),
+			Length:        preview.SMS.Length,
+			Segments:      preview.SMS.Count,
+			MissingParams: preview.MissingParams,
+		},
+	}, nil
+}
+
+// DiffVersions 比较同一模版的两个版本
+func (h *Handler) DiffVersions(ctx *ginx.Context, req DiffVersionsReq) (ginx.Result, error) {
+	d, err := h.svc.DiffVersions(ctx.Request.Context(), req.FromVersionID, req.ToVersionID)
+	if err != nil {
+		if errors.Is(err, errs.ErrInvalidParameter) || errors.Is(err, errs.ErrTemplateVersionNotFound) {
+			return ginx.Result{Code: InvalidParamError.Code, Msg: err.Error()}, nil
+		}
 		return systemErrorResult, err
 	}
+	return ginx.Result{
+		Data: DiffVersionsResp{
+			TemplateID:    d.TemplateID,
+			FromVersionID: d.FromVersionID,
+			ToVersionID:   d.ToVersionID,
+			Changed:       d.Changed(),
+			Fields: slice.Map(d.Fields, func(_ int, src domain.TemplateFieldChange) FieldChange {
+				return FieldChange(src)
+			}),
+			Content: slice.Map(d.Content, func(_ int, src diff.Line) DiffLine {
+				return DiffLine{Op: string(src.Op), Text: src.Text}
+			}),
+			Params: slice.Map(d.Params, func(_ int, src domain.TemplateParamChange) ParamChange {
+				return ParamChange{
+					Name: src.Name,
+					From: h.toParamVOPtr(src.From),
+					To:   h.toParamVOPtr(src.To),
+				}
+			}),
+		},
+	}, nil
+}
 
+// GetTemplate 获取模版以及所有版本，包含各供应商的审核状态
+func (h *Handler) GetTemplate(ctx *ginx.Context, req TemplateIDReq) (ginx.Result, error) {
+	template, err := h.svc.GetTemplateByID(ctx.Request.Context(), req.TemplateID)
+	if err != nil {
+		return h.errorResult(err)
+	}
+	return ginx.Result{Data: h.toTemplateVO(template)}, nil
+}
+
+// ListPendingReviews 未提交或者审核中的供应商关联，供应商审核结果由定时任务同步
+func (h *Handler) ListPendingReviews(ctx *ginx.Context, req ListPendingReviewsReq) (ginx.Result, error) {
+	const (
+		defaultLimit = 20
+		maxLimit     = 100
+	)
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	providers, total, err := h.svc.GetPendingOrInReviewProviders(ctx.Request.Context(),
+		max(req.Offset, 0), min(limit, maxLimit), time.Now().Unix())
+	if err != nil {
+		return systemErrorResult, err
+	}
+	return ginx.Result{
+		Data: ListPendingReviewsResp{
+			Providers: slice.Map(providers, func(_ int, src domain.ChannelTemplateProvider) ChannelTemplateProvider {
+				return h.toProviderVO(src)
+			}),
+			Total: total,
+		},
+	}, nil
+}
+
+// SubmitForProviderReview 提交供应商审核，返回每个版本是否已提交，审核结果通过获取模版接口查看各供应商的审核状态
+func (h *Handler) SubmitForProviderReview(ctx *ginx.Context, req SubmitForProviderReviewReq) (ginx.Result, error) {
+	if len(req.VersionIDs) == 0 {
+		return ginx.Result{Code: InvalidParamError.Code, Msg: "版本ID不能为空"}, nil
+	}
+	results := h.svc.BatchSubmitForProviderReview(ctx.Request.Context(), req.VersionIDs)
 	return ginx.Result{
 		Msg: "OK",
+		Data: SubmitForProviderReviewResp{
+			Results: slice.Map(results, func(_ int, src domain.ProviderReviewResult) ProviderReviewResult {
+				return h.toProviderReviewResultVO(src)
+			}),
+		},
 	}, nil
 }
+
+// ArchiveTemplate 归档模版，归档后不能修改、发布和发送
+func (h *Handler) ArchiveTemplate(ctx *ginx.Context, req TemplateIDReq) (ginx.Result, error) {
+	if err := h.svc.ArchiveTemplate(ctx.Request.Context(), req.TemplateID); err != nil {
+		return h.errorResult(err)
+	}
+	return ginx.Result{Msg: "OK"}, nil
+}
+
+// DeleteTemplate 删除模版，有通知使用时不能删除
+func (h *Handler) DeleteTemplate(ctx *ginx.Context, req TemplateIDReq) (ginx.Result, error) {
+	if err := h.svc.DeleteTemplate(ctx.Request.Context(), req.TemplateID); err != nil {
+		return h.errorResult(err)
+	}
+	return ginx.Result{Msg: "OK"}, nil
+}
+
+// ArchiveVersion 归档没有生效的版本
+func (h *Handler) ArchiveVersion(ctx *ginx.Context, req VersionIDReq) (ginx.Result, error) {
+	if err := h.svc.ArchiveVersion(ctx.Request.Context(), req.VersionID); err != nil {
+		return h.errorResult(err)
+	}
+	return ginx.Result{Msg: "OK"}, nil
+}
+
+// DeleteVersion 删除没有生效的版本，有通知使用时不能删除
+func (h *Handler) DeleteVersion(ctx *ginx.Context, req VersionIDReq) (ginx.Result, error) {
+	if err := h.svc.DeleteVersion(ctx.Request.Context(), req.VersionID); err != nil {
+		return h.errorResult(err)
+	}
+	return ginx.Result{Msg: "OK"}, nil
+}
+
+func (h *Handler) errorResult(err error) (ginx.Result, error) {
+	switch {
+	case errors.Is(err, errs.ErrTemplateInUse):
+		return ginx.Result{Code: TemplateInUseError.Code, Msg: err.Error()}, nil
+	case errors.Is(err, errs.ErrInvalidParameter),
+		errors.Is(err, errs.ErrInvalidOperation),
+		errors.Is(err, errs.ErrTemplateArchived),
+		errors.Is(err, errs.ErrTemplateNotFound),
+		errors.Is(err, errs.ErrTemplateVersionNotFound):
+		return ginx.Result{Code: InvalidParamError.Code, Msg: err.Error()}, nil
+	default:
+		return systemErrorResult, err
+	}
+}
+
+func (h *Handler) toProviderReviewResultVO(src domain.ProviderReviewResult) ProviderReviewResult {
+	res := ProviderReviewResult{VersionID: src.VersionID, Submitted: src.Err == nil}
+	if src.Err == nil {
+		return res
+	}
+	res.Msg = src.Err.Error()
+	var rejected *domain.ModerationRejectedError
+	if errors.As(src.Err, &rejected) {
+		res.Findings = h.toFindingVOs(rejected.Findings)
+	}
+	return res
+}
+
+func (h *Handler) toFindingVOs(findings []domain.ModerationFinding) []ModerationFinding {
+	return slice.Map(findings, func(_ int, src domain.ModerationFinding) ModerationFinding {
+		return ModerationFinding{
+			Rule:     src.Rule,
+			Severity: src.Severity.String(),
+			Field:    src.Field,
+			Match:    src.Match,
+			Message:  src.Message,
+		}
+	})
+}
+
+func (h *Handler) toParamVOPtr(src *domain.TemplateParam) *TemplateParam {
+	if src == nil {
+		return nil
+	}
+	p := h.toParamVO(*src)
+	return &p
+}
+
+func (h *Handler) toParamVO(src domain.TemplateParam) TemplateParam {
+	return TemplateParam{
+		Name:      src.Name,
+		Type:      string(src.Type),
+		Required:  src.Required,
+		MaxLength: src.MaxLength,
+		Pattern:   src.Pattern,
+	}
+}
@@ -5,11 +5,15 @@ import (
 )
 
 const (
-	SYSTEMERRORCODE = 506001
+	SYSTEMERRORCODE       = 506001
+	INVALIDPARAMERRORCODE = 506002
+	TEMPLATEINUSECODE     = 506003
 )
 
 var (
-	SystemError = ErrorCode{Code: SYSTEMERRORCODE, Msg: "系统错误"}
+	SystemError        = ErrorCode{Code: SYSTEMERRORCODE, Msg: "系统错误"}
+	InvalidParamError  = ErrorCode{Code: INVALIDPARAMERRORCODE, Msg: "参数错误"}
+	TemplateInUseError = ErrorCode{Code: TEMPLATEINUSECODE, Msg: "模版正在被通知使用"}
 
 	systemErrorResult = ginx.Result{
 		Code: SystemError.Code,
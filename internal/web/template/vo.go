@@ -4,6 +4,8 @@ package template
 type ListTemplatesReq struct {
 	OwnerID   int64  `json:"ownerId"` // 商品信息
 	OwnerType string `json:"ownerType"`
+	// IncludeArchived 是否包含已归档的模版，默认不包含
+	IncludeArchived bool `json:"includeArchived"`
 }
 
 type ListTemplatesResp struct {
@@ -19,9 +21,13 @@ type ChannelTemplate struct {
 	Description     string `json:"description"`     // 模板描述
 	Channel         string `json:"channel"`         // 渠道类型
 	BusinessType    int64  `json:"businessType"`    // 业务类型
+	International   bool   `json:"international"`   // 短信模版是否同时申请国际/港澳台模版
 	ActiveVersionID int64  `json:"activeVersionId"` // 活跃版本ID，0表示无活跃版本
+	ArchivedTime    int64  `json:"archivedTime"`    // 归档时间，0表示未归档
 	Ctime           int64  `json:"ctime"`           // 创建时间
 	Utime           int64  `json:"utime"`           // 更新时间
+	// ActiveLocaleVersions 各语言的活跃版本ID，默认语言的活跃版本为 ActiveVersionID
+	ActiveLocaleVersions map[string]int64 `json:"activeLocaleVersions"`
 
 	Versions []ChannelTemplateVersion `json:"versions"` // 关联的所有版本
 }
@@ -48,12 +54,36 @@ type ChannelTemplateVersion struct {
 	AuditStatus              string `json:"auditStatus"`              // 审核状态
 	RejectReason             string `json:"rejectReason"`             // 拒绝原因
 	LastReviewSubmissionTime int64  `json:"lastReviewSubmissionTime"` // 上次提交审核时间
+	ArchivedTime             int64  `json:"archivedTime"`             // 归档时间，0表示未归档
 	Ctime                    int64  `json:"ctime"`                    // 创建时间
 	Utime                    int64  `json:"utime"`                    // 更新时间
 
+	Subject     string            `json:"subject"`     // 邮件主题或站内信标题
+	ReplyTo     string            `json:"replyTo"`     // 邮件回复地址
+	Attachments []EmailAttachment `json:"attachments"` // 邮件附件
+	Params      []TemplateParam   `json:"params"`      // 参数定义
+	Locale      string            `json:"locale"`      // 语言，空表示默认语言
+
 	Providers []ChannelTemplateProvider `json:"providers"` // 关联的所有供应商
 }
 
+// EmailAttachment 邮件附件
+type EmailAttachment struct {
+	Filename    string `json:"filename"`    // 附件文件名
+	URL         string `json:"url"`         // 附件下载地址
+	ContentType string `json:"contentType"` // MIME类型
+	ContentID   string `json:"contentId"`   // 内嵌资源ID，正文中使用 cid:xxx 引用
+}
+
+// TemplateParam 模版参数定义
+type TemplateParam struct {
+	Name      string `json:"name"`      // 参数名
+	Type      string `json:"type"`      // 类型：string、integer、number、date
+	Required  bool   `json:"required"`  // 是否必填
+	MaxLength int    `json:"maxLength"` // 最大字符数，0表示不限制
+	Pattern   string `json:"pattern"`   // 正则表达式，需要完整匹配
+}
+
 // ChannelTemplateProvider 渠道模板供应商关联
 type ChannelTemplateProvider struct {
 	ID                       int64  `json:"id"`                       // 关联ID
@@ -62,6 +92,7 @@ type ChannelTemplateProvider struct {
 	ProviderID               int64  `json:"providerId"`               // 供应商ID
 	ProviderName             string `json:"providerName"`             // 供应商名称
 	ProviderChannel          string `json:"providerChannel"`          // 供应商渠道类型
+	Region                   string `json:"region"`                   // 适用地区，DOMESTIC-国内，INTERNATIONAL-国际/港澳台
 	RequestID                string `json:"requestId"`                // 审核请求ID
 	ProviderTemplateID       string `json:"providerTemplateId"`       // 供应商侧模板ID
 	AuditStatus              string `json:"auditStatus"`              // 审核状态
@@ -79,6 +110,8 @@ type CreateTemplateReq struct {
 	Description  string `json:"description"`  // 模板描述
 	Channel      string `json:"channel"`      // 渠道类型
 	BusinessType int64  `json:"businessType"` // 业务类型
+	// International 短信模版是否同时在供应商侧申请国际/港澳台模版，开启后才能发送境外手机号
+	International bool `json:"international"`
 }
 
 // CreateTemplateResp 创建模板响应
@@ -92,14 +125,47 @@ type UpdateTemplateReq struct {
 	Name         string `json:"name"`         // 模板名称
 	Description  string `json:"description"`  // 模板描述
 	BusinessType int64  `json:"businessType"` // 业务类型
+	// International 开启后再次提交供应商审核时补充申请国际/港澳台模版
+	International bool `json:"international"`
 }
 
-// PublishTemplateReq 发布模板请求
+// PublishTemplateReq 发布模板请求，操作人由访问令牌确定
 type PublishTemplateReq struct {
 	TemplateID int64 `json:"templateId"` // 模板ID
 	VersionID  int64 `json:"versionId"`  // 版本ID
 }
 
+// RollbackTemplateReq 回滚模板请求，操作人由访问令牌确定
+type RollbackTemplateReq struct {
+	TemplateID int64 `json:"templateId"` // 模板ID
+	VersionID  int64 `json:"versionId"`  // 回滚到的版本ID，必须已通过内部审核和供应商审核
+}
+
+// ListPublishHistoryReq 获取发布历史请求
+type ListPublishHistoryReq struct {
+	TemplateID int64 `json:"templateId"` // 模板ID
+	Offset     int   `json:"offset"`
+	Limit      int   `json:"limit"` // 默认20，最大100
+}
+
+// ListPublishHistoryResp 获取发布历史响应
+type ListPublishHistoryResp struct {
+	Records []PublishRecord `json:"records"` // 按时间倒序
+	Total   int64           `json:"total"`
+}
+
+// PublishRecord 发布记录
+type PublishRecord struct {
+	ID                int64  `json:"id"`                // 记录ID
+	TemplateID        int64  `json:"templateId"`        // 模板ID
+	VersionID         int64  `json:"versionId"`         // 发布的版本ID
+	PreviousVersionID int64  `json:"previousVersionId"` // 发布前的活跃版本ID，0表示首次发布
+	OperatorID        int64  `json:"operatorId"`        // 操作人ID
+	Action            string `json:"action"`            // 操作类型：PUBLISH、ROLLBACK
+	Locale            string `json:"locale"`            // 版本语言，空表示默认语言
+	Ctime             int64  `json:"ctime"`             // 操作时间
+}
+
 // UpdateVersionReq 更新模板版本请求
 type UpdateVersionReq struct {
 	VersionID int64  `json:"versionId"` // 版本ID
@@ -107,9 +173,132 @@ type UpdateVersionReq struct {
 	Signature string `json:"signature"` // 签名
 	Content   string `json:"content"`   // 模板内容
 	Remark    string `json:"remark"`    // 申请说明
+
+	Subject     string            `json:"subject"`     // 邮件主题或站内信标题
+	ReplyTo     string            `json:"replyTo"`     // 邮件回复地址，仅邮件渠道使用
+	Attachments []EmailAttachment `json:"attachments"` // 邮件附件，仅邮件渠道使用
+	// Params 参数定义，内容中引用的参数没有声明时自动添加为必填的字符串
+	Params []TemplateParam `json:"params"`
+	// Locale 语言，BCP 47 格式如 ja-JP，空表示默认语言
+	Locale string `json:"locale"`
 }
 
 // SubmitForInternalReviewReq 提交内部审核请求
 type SubmitForInternalReviewReq struct {
 	VersionID int64 `json:"versionId"` // 版本ID
 }
+
+// PreviewVersionReq 预览模版版本请求
+type PreviewVersionReq struct {
+	TemplateID int64             `json:"templateId"` // 模板ID
+	VersionID  int64             `json:"versionId"`  // 版本ID，为0时预览当前生效的版本
+	Params     map[string]string `json:"params"`     // 示例参数
+}
+
+// PreviewVersionResp 预览模版版本响应
+type PreviewVersionResp struct {
+	Channel       string   `json:"channel"`       // 渠道类型
+	Subject       string   `json:"subject"`       // 渲染后的标题，短信没有标题
+	Content       string   `json:"content"`       // 渲染后的内容，邮件为HTML
+	Charset       string   `json:"charset"`       // 短信编码：GSM-7、UCS-2，其他渠道为空
+	Length        int      `json:"length"`        // 短信长度，包含签名
+	Segments      int      `json:"segments"`      // 短信拆分的条数
+	MissingParams []string `json:"missingParams"` // 缺失的参数
+}
+
+// DiffVersionsReq 比较版本请求
+type DiffVersionsReq struct {
+	FromVersionID int64 `json:"fromVersionId"` // 旧版本ID
+	ToVersionID   int64 `json:"toVersionId"`   // 新版本ID
+}
+
+// DiffVersionsResp 比较版本响应
+type DiffVersionsResp struct {
+	TemplateID    int64         `json:"templateId"`    // 模板ID
+	FromVersionID int64         `json:"fromVersionId"` // 旧版本ID
+	ToVersionID   int64         `json:"toVersionId"`   // 新版本ID
+	Changed       bool          `json:"changed"`       // 是否有差异
+	Fields        []FieldChange `json:"fields"`        // 名称、签名、标题和回复地址的变化
+	Content       []DiffLine    `json:"content"`       // 内容逐行的变化，内容没有变化时为空
+	Params        []ParamChange `json:"params"`        // 参数定义的变化
+}
+
+// FieldChange 字段的变化
+type FieldChange struct {
+	Field string `json:"field"` // 字段：name、signature、subject、replyTo
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// DiffLine 一行的变化
+type DiffLine struct {
+	Op   string `json:"op"` // =：未变化，+：新增，-：删除
+	Text string `json:"text"`
+}
+
+// ParamChange 参数定义的变化
+type ParamChange struct {
+	Name string         `json:"name"` // 参数名
+	From *TemplateParam `json:"from"` // 为空表示新增
+	To   *TemplateParam `json:"to"`   // 为空表示删除
+}
+
+// CheckVersionReq 审核前检查请求
+type CheckVersionReq struct {
+	VersionID int64 `json:"versionId"` // 版本ID
+}
+
+// CheckVersionResp 审核前检查响应
+type CheckVersionResp struct {
+	Blocked  bool                `json:"blocked"`  // 是否有明确违规的问题，为 true 时提交审核会被直接拒绝
+	Findings []ModerationFinding `json:"findings"` // 发现的问题
+}
+
+// ModerationFinding 审核前检查发现的问题
+type ModerationFinding struct {
+	Rule     string `json:"rule"`     // 规则：sensitive_word、warning_word、signature、url、business_type
+	Severity string `json:"severity"` // 严重程度：WARNING、BLOCK
+	Field    string `json:"field"`    // 有问题的字段
+	Match    string `json:"match"`    // 命中的内容
+	Message  string `json:"message"`  // 问题说明
+}
+
+// TemplateIDReq 只需要模版ID的请求：查询、归档和删除模版
+type TemplateIDReq struct {
+	TemplateID int64 `json:"templateId"` // 模板ID
+}
+
+// VersionIDReq 只需要版本ID的请求：归档和删除版本
+type VersionIDReq struct {
+	VersionID int64 `json:"versionId"` // 版本ID
+}
+
+// SubmitForProviderReviewReq 提交供应商审核请求
+type SubmitForProviderReviewReq struct {
+	VersionIDs []int64 `json:"versionIds"` // 版本ID，只提交已通过内部审核的版本
+}
+
+// SubmitForProviderReviewResp 提交供应商审核响应，按请求中版本ID的顺序
+type SubmitForProviderReviewResp struct {
+	Results []ProviderReviewResult `json:"results"`
+}
+
+// ProviderReviewResult 单个版本提交供应商审核的结果
+type ProviderReviewResult struct {
+	VersionID int64               `json:"versionId"`
+	Submitted bool                `json:"submitted"`          // 是否已提交给供应商
+	Msg       string              `json:"msg,omitempty"`      // 没有提交的原因
+	Findings  []ModerationFinding `json:"findings,omitempty"` // 审核前检查发现的问题，版本已被直接拒绝
+}
+
+// ListPendingReviewsReq 获取待供应商审核列表请求
+type ListPendingReviewsReq struct {
+	Offset int `json:"offset"`
+	Limit  int `json:"limit"` // 默认20，最大100
+}
+
+// ListPendingReviewsResp 获取待供应商审核列表响应
+type ListPendingReviewsResp struct {
+	Providers []ChannelTemplateProvider `json:"providers"` // 未提交或者审核中的供应商关联
+	Total     int64                     `json:"total"`
+}
@@ -63,7 +63,10 @@ CREATE TABLE `notification_0`
     `template_id`         BIGINT       NOT NULL COMMENT '模板ID',
     `template_version_id` BIGINT       NOT NULL COMMENT '模板版本ID',
     `template_params`     TEXT         NOT NULL COMMENT '模版参数',
-    `status`              ENUM('PREPARE','CANCELED','PENDING','SENDING','SUCCEEDED','FAILED') DEFAULT 'PENDING' COMMENT '发送状态',
+    `fallback_receivers`  TEXT COMMENT '渠道降级时使用的接收者，JSON对象，key为渠道',
+    `delivered_channel`   VARCHAR(16)  NOT NULL DEFAULT '' COMMENT '实际发送成功的渠道，发生渠道降级时与channel不同',
+    `locale`              VARCHAR(35)  NOT NULL DEFAULT '' COMMENT '接收者的语言，为空表示默认版本',
+    `status`              ENUM('PREPARE','CANCELED','PENDING','SENDING','SUCCEEDED','FAILED','DELIVERED','UNDELIVERED') DEFAULT 'PENDING' COMMENT '发送状态',
     `scheduled_stime`     BIGINT       NOT NULL COMMENT '计划发送开始时间',
     `scheduled_etime`     BIGINT       NOT NULL COMMENT '计划发送结束时间',
     `version`             INT          NOT NULL DEFAULT 1 COMMENT '版本号，用于CAS操作',
@@ -85,7 +88,10 @@ CREATE TABLE `notification_1`
     `template_id`         BIGINT       NOT NULL COMMENT '模板ID',
     `template_version_id` BIGINT       NOT NULL COMMENT '模板版本ID',
     `template_params`     TEXT         NOT NULL COMMENT '模版参数',
-    `status`              ENUM('PREPARE','CANCELED','PENDING','SENDING','SUCCEEDED','FAILED') DEFAULT 'PENDING' COMMENT '发送状态',
+    `fallback_receivers`  TEXT COMMENT '渠道降级时使用的接收者，JSON对象，key为渠道',
+    `delivered_channel`   VARCHAR(16)  NOT NULL DEFAULT '' COMMENT '实际发送成功的渠道，发生渠道降级时与channel不同',
+    `locale`              VARCHAR(35)  NOT NULL DEFAULT '' COMMENT '接收者的语言，为空表示默认版本',
+    `status`              ENUM('PREPARE','CANCELED','PENDING','SENDING','SUCCEEDED','FAILED','DELIVERED','UNDELIVERED') DEFAULT 'PENDING' COMMENT '发送状态',
     `scheduled_stime`     BIGINT       NOT NULL COMMENT '计划发送开始时间',
     `scheduled_etime`     BIGINT       NOT NULL COMMENT '计划发送结束时间',
     `version`             INT          NOT NULL DEFAULT 1 COMMENT '版本号，用于CAS操作',
@@ -129,6 +135,114 @@ CREATE TABLE `tx_notification_1`
     INDEX             `idx_next_check_time_status` (`next_check_time`, `status`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='事务通知表';
 
+CREATE TABLE `inbox_0`
+(
+    `id`              BIGINT       NOT NULL COMMENT '雪花算法ID',
+    `biz_id`          BIGINT       NOT NULL COMMENT '业务ID',
+    `user_id`         VARCHAR(256) NOT NULL COMMENT '接收用户ID',
+    `notification_id` BIGINT UNSIGNED NOT NULL COMMENT '来源通知ID',
+    `title`           VARCHAR(512) NOT NULL DEFAULT '' COMMENT '标题',
+    `content`         TEXT         NOT NULL COMMENT '渲染后的内容',
+    `status`          ENUM('UNREAD','READ') NOT NULL DEFAULT 'UNREAD' COMMENT '状态',
+    `archived`        BOOLEAN      NOT NULL DEFAULT FALSE COMMENT '是否已归档',
+    `read_time`       BIGINT       NOT NULL DEFAULT 0 COMMENT '阅读时间',
+    `ctime`           BIGINT       NOT NULL,
+    `utime`           BIGINT       NOT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `idx_notification_id_user_id` (`notification_id`, `user_id`),
+    INDEX             `idx_biz_id_user_id_archived` (`biz_id`, `user_id`, `archived`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='站内信表';
+
+CREATE TABLE `inbox_1`
+(
+    `id`              BIGINT       NOT NULL COMMENT '雪花算法ID',
+    `biz_id`          BIGINT       NOT NULL COMMENT '业务ID',
+    `user_id`         VARCHAR(256) NOT NULL COMMENT '接收用户ID',
+    `notification_id` BIGINT UNSIGNED NOT NULL COMMENT '来源通知ID',
+    `title`           VARCHAR(512) NOT NULL DEFAULT '' COMMENT '标题',
+    `content`         TEXT         NOT NULL COMMENT '渲染后的内容',
+    `status`          ENUM('UNREAD','READ') NOT NULL DEFAULT 'UNREAD' COMMENT '状态',
+    `archived`        BOOLEAN      NOT NULL DEFAULT FALSE COMMENT '是否已归档',
+    `read_time`       BIGINT       NOT NULL DEFAULT 0 COMMENT '阅读时间',
+    `ctime`           BIGINT       NOT NULL,
+    `utime`           BIGINT       NOT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `idx_notification_id_user_id` (`notification_id`, `user_id`),
+    INDEX             `idx_biz_id_user_id_archived` (`biz_id`, `user_id`, `archived`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='站内信表';
+
+CREATE TABLE `send_receipt_0`
+(
+    `id`              BIGINT       NOT NULL AUTO_INCREMENT COMMENT '回执ID',
+    `notification_id` BIGINT UNSIGNED NOT NULL COMMENT '通知ID',
+    `provider`        VARCHAR(64)  NOT NULL COMMENT '供应商名称',
+    `receiver`        VARCHAR(256) NOT NULL COMMENT '接收者',
+    `request_id`      VARCHAR(128) NOT NULL DEFAULT '' COMMENT '供应商请求ID',
+    `receipt_id`      VARCHAR(128) NOT NULL COMMENT '供应商回执ID，阿里云为BizId，腾讯云为SerialNo',
+    `status`          ENUM('PENDING','DELIVERED','UNDELIVERED') NOT NULL DEFAULT 'PENDING' COMMENT '回执状态',
+    `err_code`        VARCHAR(128) NOT NULL DEFAULT '' COMMENT '供应商错误码',
+    `query_count`     INT          NOT NULL DEFAULT 0 COMMENT '已查询次数',
+    `next_query_time` BIGINT       NOT NULL DEFAULT 0 COMMENT '下次查询时间',
+    `ctime`           BIGINT       NOT NULL,
+    `utime`           BIGINT       NOT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `idx_notification_id_receiver` (`notification_id`, `receiver`),
+    INDEX             `idx_receipt_id` (`receipt_id`),
+    INDEX             `idx_status_next_query_time` (`status`, `next_query_time`),
+    INDEX             `idx_receiver_ctime` (`receiver`, `ctime`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='供应商回执表';
+
+CREATE TABLE `send_receipt_1`
+(
+    `id`              BIGINT       NOT NULL AUTO_INCREMENT COMMENT '回执ID',
+    `notification_id` BIGINT UNSIGNED NOT NULL COMMENT '通知ID',
+    `provider`        VARCHAR(64)  NOT NULL COMMENT '供应商名称',
+    `receiver`        VARCHAR(256) NOT NULL COMMENT '接收者',
+    `request_id`      VARCHAR(128) NOT NULL DEFAULT '' COMMENT '供应商请求ID',
+    `receipt_id`      VARCHAR(128) NOT NULL COMMENT '供应商回执ID，阿里云为BizId，腾讯云为SerialNo',
+    `status`          ENUM('PENDING','DELIVERED','UNDELIVERED') NOT NULL DEFAULT 'PENDING' COMMENT '回执状态',
+    `err_code`        VARCHAR(128) NOT NULL DEFAULT '' COMMENT '供应商错误码',
+    `query_count`     INT          NOT NULL DEFAULT 0 COMMENT '已查询次数',
+    `next_query_time` BIGINT       NOT NULL DEFAULT 0 COMMENT '下次查询时间',
+    `ctime`           BIGINT       NOT NULL,
+    `utime`           BIGINT       NOT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `idx_notification_id_receiver` (`notification_id`, `receiver`),
+    INDEX             `idx_receipt_id` (`receipt_id`),
+    INDEX             `idx_status_next_query_time` (`status`, `next_query_time`),
+    INDEX             `idx_receiver_ctime` (`receiver`, `ctime`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='供应商回执表';
+
+CREATE TABLE `receiver_result_0`
+(
+    `id`              BIGINT       NOT NULL AUTO_INCREMENT COMMENT '结果ID',
+    `notification_id` BIGINT UNSIGNED NOT NULL COMMENT '通知ID',
+    `receiver`        VARCHAR(256) NOT NULL COMMENT '接收者',
+    `provider`        VARCHAR(64)  NOT NULL DEFAULT '' COMMENT '最后一次发送的供应商名称',
+    `status`          ENUM('SUCCEEDED','FAILED') NOT NULL COMMENT '发送状态',
+    `err_code`        VARCHAR(128) NOT NULL DEFAULT '' COMMENT '供应商错误码',
+    `err_message`     VARCHAR(512) NOT NULL DEFAULT '' COMMENT '错误信息',
+    `ctime`           BIGINT       NOT NULL,
+    `utime`           BIGINT       NOT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `idx_notification_id_receiver` (`notification_id`, `receiver`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='接收者发送结果表';
+
+CREATE TABLE `receiver_result_1`
+(
+    `id`              BIGINT       NOT NULL AUTO_INCREMENT COMMENT '结果ID',
+    `notification_id` BIGINT UNSIGNED NOT NULL COMMENT '通知ID',
+    `receiver`        VARCHAR(256) NOT NULL COMMENT '接收者',
+    `provider`        VARCHAR(64)  NOT NULL DEFAULT '' COMMENT '最后一次发送的供应商名称',
+    `status`          ENUM('SUCCEEDED','FAILED') NOT NULL COMMENT '发送状态',
+    `err_code`        VARCHAR(128) NOT NULL DEFAULT '' COMMENT '供应商错误码',
+    `err_message`     VARCHAR(512) NOT NULL DEFAULT '' COMMENT '错误信息',
+    `ctime`           BIGINT       NOT NULL,
+    `utime`           BIGINT       NOT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `idx_notification_id_receiver` (`notification_id`, `receiver`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='接收者发送结果表';
+
 CREATE
 DATABASE IF NOT EXISTS `notification_1`;
 
@@ -172,7 +286,10 @@ CREATE TABLE `notification_0`
     `template_id`         BIGINT       NOT NULL COMMENT '模板ID',
     `template_version_id` BIGINT       NOT NULL COMMENT '模板版本ID',
     `template_params`     TEXT         NOT NULL COMMENT '模版参数',
-    `status`              ENUM('PREPARE','CANCELED','PENDING','SENDING','SUCCEEDED','FAILED') DEFAULT 'PENDING' COMMENT '发送状态',
+    `fallback_receivers`  TEXT COMMENT '渠道降级时使用的接收者，JSON对象，key为渠道',
+    `delivered_channel`   VARCHAR(16)  NOT NULL DEFAULT '' COMMENT '实际发送成功的渠道，发生渠道降级时与channel不同',
+    `locale`              VARCHAR(35)  NOT NULL DEFAULT '' COMMENT '接收者的语言，为空表示默认版本',
+    `status`              ENUM('PREPARE','CANCELED','PENDING','SENDING','SUCCEEDED','FAILED','DELIVERED','UNDELIVERED') DEFAULT 'PENDING' COMMENT '发送状态',
     `scheduled_stime`     BIGINT       NOT NULL COMMENT '计划发送开始时间',
     `scheduled_etime`     BIGINT       NOT NULL COMMENT '计划发送结束时间',
     `version`             INT          NOT NULL DEFAULT 1 COMMENT '版本号，用于CAS操作',
@@ -194,7 +311,10 @@ CREATE TABLE `notification_1`
     `template_id`         BIGINT       NOT NULL COMMENT '模板ID',
     `template_version_id` BIGINT       NOT NULL COMMENT '模板版本ID',
     `template_params`     TEXT         NOT NULL COMMENT '模版参数',
-    `status`              ENUM('PREPARE','CANCELED','PENDING','SENDING','SUCCEEDED','FAILED') DEFAULT 'PENDING' COMMENT '发送状态',
+    `fallback_receivers`  TEXT COMMENT '渠道降级时使用的接收者，JSON对象，key为渠道',
+    `delivered_channel`   VARCHAR(16)  NOT NULL DEFAULT '' COMMENT '实际发送成功的渠道，发生渠道降级时与channel不同',
+    `locale`              VARCHAR(35)  NOT NULL DEFAULT '' COMMENT '接收者的语言，为空表示默认版本',
+    `status`              ENUM('PREPARE','CANCELED','PENDING','SENDING','SUCCEEDED','FAILED','DELIVERED','UNDELIVERED') DEFAULT 'PENDING' COMMENT '发送状态',
     `scheduled_stime`     BIGINT       NOT NULL COMMENT '计划发送开始时间',
     `scheduled_etime`     BIGINT       NOT NULL COMMENT '计划发送结束时间',
     `version`             INT          NOT NULL DEFAULT 1 COMMENT '版本号，用于CAS操作',
@@ -237,4 +357,113 @@ CREATE TABLE `tx_notification_1`
     PRIMARY KEY (`tx_id`),
     UNIQUE INDEX `idx_biz_id_key` (`biz_id`, `key`),
     INDEX             `idx_next_check_time_status` (`next_check_time`, `status`)
-) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='事务通知表';
\ No newline at end of file
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='事务通知表';
+
+CREATE TABLE `inbox_0`
+(
+    `id`              BIGINT       NOT NULL COMMENT '雪花算法ID',
+    `biz_id`          BIGINT       NOT NULL COMMENT '业务ID',
+    `user_id`         VARCHAR(256) NOT NULL COMMENT '接收用户ID',
+    `notification_id` BIGINT UNSIGNED NOT NULL COMMENT '来源通知ID',
+    `title`           VARCHAR(512) NOT NULL DEFAULT '' COMMENT '标题',
+    `content`         TEXT         NOT NULL COMMENT '渲染后的内容',
+    `status`          ENUM('UNREAD','READ') NOT NULL DEFAULT 'UNREAD' COMMENT '状态',
+    `archived`        BOOLEAN      NOT NULL DEFAULT FALSE COMMENT '是否已归档',
+    `read_time`       BIGINT       NOT NULL DEFAULT 0 COMMENT '阅读时间',
+    `ctime`           BIGINT       NOT NULL,
+    `utime`           BIGINT       NOT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `idx_notification_id_user_id` (`notification_id`, `user_id`),
+    INDEX             `idx_biz_id_user_id_archived` (`biz_id`, `user_id`, `archived`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='站内信表';
+
+CREATE TABLE `inbox_1`
+(
+    `id`              BIGINT       NOT NULL COMMENT '雪花算法ID',
+    `biz_id`          BIGINT       NOT NULL COMMENT '业务ID',
+    `user_id`         VARCHAR(256) NOT NULL COMMENT '接收用户ID',
+    `notification_id` BIGINT UNSIGNED NOT NULL COMMENT '来源通知ID',
+    `title`           VARCHAR(512) NOT NULL DEFAULT '' COMMENT '标题',
+    `content`         TEXT         NOT NULL COMMENT '渲染后的内容',
+    `status`          ENUM('UNREAD','READ') NOT NULL DEFAULT 'UNREAD' COMMENT '状态',
+    `archived`        BOOLEAN      NOT NULL DEFAULT FALSE COMMENT '是否已归档',
+    `read_time`       BIGINT       NOT NULL DEFAULT 0 COMMENT '阅读时间',
+    `ctime`           BIGINT       NOT NULL,
+    `utime`           BIGINT       NOT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `idx_notification_id_user_id` (`notification_id`, `user_id`),
+    INDEX             `idx_biz_id_user_id_archived` (`biz_id`, `user_id`, `archived`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='站内信表';
+
+CREATE TABLE `send_receipt_0`
+(
+    `id`              BIGINT       NOT NULL AUTO_INCREMENT COMMENT '回执ID',
+    `notification_id` BIGINT UNSIGNED NOT NULL COMMENT '通知ID',
+    `provider`        VARCHAR(64)  NOT NULL COMMENT '供应商名称',
+    `receiver`        VARCHAR(256) NOT NULL COMMENT '接收者',
+    `request_id`      VARCHAR(128) NOT NULL DEFAULT '' COMMENT '供应商请求ID',
+    `receipt_id`      VARCHAR(128) NOT NULL COMMENT '供应商回执ID，阿里云为BizId，腾讯云为SerialNo',
+    `status`          ENUM('PENDING','DELIVERED','UNDELIVERED') NOT NULL DEFAULT 'PENDING' COMMENT '回执状态',
+    `err_code`        VARCHAR(128) NOT NULL DEFAULT '' COMMENT '供应商错误码',
+    `query_count`     INT          NOT NULL DEFAULT 0 COMMENT '已查询次数',
+    `next_query_time` BIGINT       NOT NULL DEFAULT 0 COMMENT '下次查询时间',
+    `ctime`           BIGINT       NOT NULL,
+    `utime`           BIGINT       NOT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `idx_notification_id_receiver` (`notification_id`, `receiver`),
+    INDEX             `idx_receipt_id` (`receipt_id`),
+    INDEX             `idx_status_next_query_time` (`status`, `next_query_time`),
+    INDEX             `idx_receiver_ctime` (`receiver`, `ctime`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='供应商回执表';
+
+
+CREATE TABLE `send_receipt_1`
+(
+    `id`              BIGINT       NOT NULL AUTO_INCREMENT COMMENT '回执ID',
+    `notification_id` BIGINT UNSIGNED NOT NULL COMMENT '通知ID',
+    `provider`        VARCHAR(64)  NOT NULL COMMENT '供应商名称',
+    `receiver`        VARCHAR(256) NOT NULL COMMENT '接收者',
+    `request_id`      VARCHAR(128) NOT NULL DEFAULT '' COMMENT '供应商请求ID',
+    `receipt_id`      VARCHAR(128) NOT NULL COMMENT '供应商回执ID，阿里云为BizId，腾讯云为SerialNo',
+    `status`          ENUM('PENDING','DELIVERED','UNDELIVERED') NOT NULL DEFAULT 'PENDING' COMMENT '回执状态',
+    `err_code`        VARCHAR(128) NOT NULL DEFAULT '' COMMENT '供应商错误码',
+    `query_count`     INT          NOT NULL DEFAULT 0 COMMENT '已查询次数',
+    `next_query_time` BIGINT       NOT NULL DEFAULT 0 COMMENT '下次查询时间',
+    `ctime`           BIGINT       NOT NULL,
+    `utime`           BIGINT       NOT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `idx_notification_id_receiver` (`notification_id`, `receiver`),
+    INDEX             `idx_receipt_id` (`receipt_id`),
+    INDEX             `idx_status_next_query_time` (`status`, `next_query_time`),
+    INDEX             `idx_receiver_ctime` (`receiver`, `ctime`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='供应商回执表';
+
+CREATE TABLE `receiver_result_0`
+(
+    `id`              BIGINT       NOT NULL AUTO_INCREMENT COMMENT '结果ID',
+    `notification_id` BIGINT UNSIGNED NOT NULL COMMENT '通知ID',
+    `receiver`        VARCHAR(256) NOT NULL COMMENT '接收者',
+    `provider`        VARCHAR(64)  NOT NULL DEFAULT '' COMMENT '最后一次发送的供应商名称',
+    `status`          ENUM('SUCCEEDED','FAILED') NOT NULL COMMENT '发送状态',
+    `err_code`        VARCHAR(128) NOT NULL DEFAULT '' COMMENT '供应商错误码',
+    `err_message`     VARCHAR(512) NOT NULL DEFAULT '' COMMENT '错误信息',
+    `ctime`           BIGINT       NOT NULL,
+    `utime`           BIGINT       NOT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `idx_notification_id_receiver` (`notification_id`, `receiver`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='接收者发送结果表';
+
+CREATE TABLE `receiver_result_1`
+(
+    `id`              BIGINT       NOT NULL AUTO_INCREMENT COMMENT '结果ID',
+    `notification_id` BIGINT UNSIGNED NOT NULL COMMENT '通知ID',
+    `receiver`        VARCHAR(256) NOT NULL COMMENT '接收者',
+    `provider`        VARCHAR(64)  NOT NULL DEFAULT '' COMMENT '最后一次发送的供应商名称',
+    `status`          ENUM('SUCCEEDED','FAILED') NOT NULL COMMENT '发送状态',
+    `err_code`        VARCHAR(128) NOT NULL DEFAULT '' COMMENT '供应商错误码',
+    `err_message`     VARCHAR(512) NOT NULL DEFAULT '' COMMENT '错误信息',
+    `ctime`           BIGINT       NOT NULL,
+    `utime`           BIGINT       NOT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX `idx_notification_id_receiver` (`notification_id`, `receiver`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='接收者发送结果表';